@@ -16,6 +16,7 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -143,6 +144,8 @@ type Agent struct {
 	config             *Config
 	connected          bool
 	connectedMu        sync.RWMutex
+	reconnects         int
+	disconnectReason   string
 	contentType        string
 	entityConfig       *corev3.EntityConfig
 	entityConfigCh     chan struct{}
@@ -430,6 +433,7 @@ func (a *Agent) Run(ctx context.Context) error {
 
 func (a *Agent) connectionManager(ctx context.Context, cancel context.CancelFunc) {
 	defer logger.Info("shutting down connection manager")
+	reconnecting := false
 	for {
 		// Make sure the process is not shutting down before trying to connect
 		if ctx.Err() != nil {
@@ -476,11 +480,15 @@ func (a *Agent) connectionManager(ctx context.Context, cancel context.CancelFunc
 
 		a.connectedMu.Lock()
 		a.connected = true
+		if reconnecting {
+			a.reconnects++
+		}
 		a.connectedMu.Unlock()
+		reconnecting = true
 
 		newConnections.WithLabelValues().Inc()
 
-		go a.enforceMaxSessionLength(connCancel)
+		go a.enforceMaxSessionLength(connCtx, connCancel)
 		go a.receiveLoop(connCtx, connCancel, conn)
 
 		// Block until we receive an entity config, or the grace period expires,
@@ -506,6 +514,7 @@ func (a *Agent) connectionManager(ctx context.Context, cancel context.CancelFunc
 
 		if err := a.sendLoop(connCtx, connCancel, conn); err != nil && err != connCtx.Err() {
 			logger.WithError(err).Error("error sending messages")
+			a.recordDisconnect(err.Error())
 		}
 	}
 }
@@ -521,14 +530,20 @@ func (a *Agent) connectionManager(ctx context.Context, cancel context.CancelFunc
 //
 // This effectively makes agents reconnect after some random duration between
 // half --max-session-length and --max-session-length.
-func (a *Agent) enforceMaxSessionLength(connCancel context.CancelFunc) {
+func (a *Agent) enforceMaxSessionLength(connCtx context.Context, connCancel context.CancelFunc) {
 	if a.maxSessionLength > 0 {
 		jitter := time.Duration(rand.Float64() * 0.5 * float64(a.maxSessionLength))
 		timeout := a.maxSessionLength - jitter
 
 		logger.Infof("Session will be terminated in %v", timeout)
-		<-time.After(timeout)
+		select {
+		case <-time.After(timeout):
+		case <-connCtx.Done():
+			// The connection was already closed
+			return
+		}
 		logger.Infof("Ending session after %v (max session length is %v)", timeout, a.maxSessionLength)
+		a.recordDisconnect(fmt.Sprintf("maximum session length of %v reached", a.maxSessionLength))
 		connCancel()
 	} else {
 		logger.Debugf("maxSessionLength is %v, agent won't periodically disconnect", a.maxSessionLength)
@@ -547,6 +562,9 @@ func (a *Agent) receiveLoop(ctx context.Context, cancel context.CancelFunc, conn
 		m, err := conn.Receive()
 		if err != nil {
 			logger.WithError(err).Error("transport receive error")
+			if ctx.Err() == nil {
+				a.recordDisconnect(err.Error())
+			}
 			return
 		}
 		messagesReceived.WithLabelValues().Inc()
@@ -644,14 +662,16 @@ func (a *Agent) newKeepalive() *transport.Message {
 	}
 
 	keepalive.Check = &corev2.Check{
-		ObjectMeta: corev2.NewObjectMeta("keepalive", entity.Namespace),
-		Interval:   a.config.KeepaliveInterval,
-		Timeout:    a.config.KeepaliveWarningTimeout,
-		Ttl:        int64(a.config.KeepaliveCriticalTimeout),
+		ObjectMeta:        corev2.NewObjectMeta("keepalive", entity.Namespace),
+		Interval:          a.config.KeepaliveInterval,
+		Timeout:           a.config.KeepaliveWarningTimeout,
+		Ttl:               int64(a.config.KeepaliveCriticalTimeout),
+		LowFlapThreshold:  a.config.KeepaliveLowFlapThreshold,
+		HighFlapThreshold: a.config.KeepaliveHighFlapThreshold,
 	}
 
 	keepalive.Labels = a.config.KeepaliveCheckLabels
-	keepalive.Annotations = a.config.KeepaliveCheckAnnotations
+	keepalive.Annotations = a.keepaliveAnnotations()
 
 	keepalive.Entity = entity
 	keepalive.Timestamp = time.Now().Unix()
@@ -668,6 +688,36 @@ func (a *Agent) newKeepalive() *transport.Message {
 	return msg
 }
 
+// recordDisconnect records why the agent lost its connection to the backend,
+// so it can be reported in the keepalives sent once reconnected.
+func (a *Agent) recordDisconnect(reason string) {
+	a.connectedMu.Lock()
+	defer a.connectedMu.Unlock()
+	a.disconnectReason = reason
+}
+
+// keepaliveAnnotations returns the annotations of the keepalive events, along
+// with the reconnect count and the last disconnect reason of the agent.
+func (a *Agent) keepaliveAnnotations() map[string]string {
+	a.connectedMu.RLock()
+	defer a.connectedMu.RUnlock()
+	if a.reconnects == 0 && a.disconnectReason == "" {
+		return a.config.KeepaliveCheckAnnotations
+	}
+
+	annotations := make(map[string]string, len(a.config.KeepaliveCheckAnnotations)+2)
+	for k, v := range a.config.KeepaliveCheckAnnotations {
+		annotations[k] = v
+	}
+	if a.reconnects > 0 {
+		annotations[corev2.KeepaliveReconnectsAnnotation] = strconv.Itoa(a.reconnects)
+	}
+	if a.disconnectReason != "" {
+		annotations[corev2.KeepaliveDisconnectReasonAnnotation] = a.disconnectReason
+	}
+	return annotations
+}
+
 // Connected returns true if the agent is connected to a backend.
 func (a *Agent) Connected() bool {
 	a.connectedMu.RLock()
@@ -305,6 +305,27 @@ func TestInvalidKeepaliveTimeout(t *testing.T) {
 	}
 }
 
+func TestKeepaliveAnnotations(t *testing.T) {
+	cfg, cleanup := FixtureConfig()
+	defer cleanup()
+	cfg.KeepaliveCheckAnnotations = map[string]string{"foo": "bar"}
+	ta, err := NewAgent(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"foo": "bar"}, ta.keepaliveAnnotations())
+
+	ta.reconnects = 2
+	ta.recordDisconnect("websocket: close 1006 (abnormal closure)")
+	assert.Equal(t, map[string]string{
+		"foo":                                      "bar",
+		corev2.KeepaliveReconnectsAnnotation:       "2",
+		corev2.KeepaliveDisconnectReasonAnnotation: "websocket: close 1006 (abnormal closure)",
+	}, ta.keepaliveAnnotations())
+
+	// The configured annotations are left untouched
+	assert.Equal(t, map[string]string{"foo": "bar"}, cfg.KeepaliveCheckAnnotations)
+}
+
 // TestConnectionManager validates the connection manager reconnects after a
 // connection is closed. It also validates that it doesn't try to reconnect after
 // the shutdown process is started.
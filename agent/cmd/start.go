@@ -84,6 +84,10 @@ const (
 	flagRetryMultiplier           = "retry-multiplier"
 	flagMaxSessionLength          = "max-session-length"
 
+	// Keepalive flap detection flags
+	flagKeepaliveLowFlapThreshold  = "keepalive-low-flap-threshold"
+	flagKeepaliveHighFlapThreshold = "keepalive-high-flap-threshold"
+
 	// TLS flags
 	flagTrustedCAFile         = "trusted-ca-file"
 	flagInsecureSkipTLSVerify = "insecure-skip-tls-verify"
@@ -130,6 +134,8 @@ func NewAgentConfig(cmd *cobra.Command) (*agent.Config, error) {
 	cfg.KeepaliveCheckLabels = viper.GetStringMapString(flagKeepaliveCheckLabels)
 	cfg.KeepaliveCheckAnnotations = viper.GetStringMapString(flagKeepaliveCheckAnnotations)
 	cfg.KeepalivePipelines = viper.GetStringSlice(flagKeepalivePipelines)
+	cfg.KeepaliveLowFlapThreshold = uint32(viper.GetInt(flagKeepaliveLowFlapThreshold))
+	cfg.KeepaliveHighFlapThreshold = uint32(viper.GetInt(flagKeepaliveHighFlapThreshold))
 	cfg.Namespace = viper.GetString(flagNamespace)
 	cfg.Password = viper.GetString(flagPassword)
 	cfg.Socket.Host = viper.GetString(flagSocketHost)
@@ -166,6 +172,15 @@ func NewAgentConfig(cmd *cobra.Command) (*agent.Config, error) {
 			flagKeepaliveCriticalTimeout, flagKeepaliveWarningTimeout)
 	}
 
+	if (cfg.KeepaliveLowFlapThreshold == 0) != (cfg.KeepaliveHighFlapThreshold == 0) {
+		return nil, fmt.Errorf("--%s and --%s must be set together",
+			flagKeepaliveLowFlapThreshold, flagKeepaliveHighFlapThreshold)
+	}
+	if cfg.KeepaliveHighFlapThreshold != 0 && cfg.KeepaliveLowFlapThreshold >= cfg.KeepaliveHighFlapThreshold {
+		return nil, fmt.Errorf("--%s must be greater than --%s",
+			flagKeepaliveHighFlapThreshold, flagKeepaliveLowFlapThreshold)
+	}
+
 	agentName := viper.GetString(flagAgentName)
 	if agentName != "" {
 		cfg.AgentName = agentName
@@ -309,6 +324,8 @@ func handleConfig(cmd *cobra.Command, arguments []string) error {
 	viper.SetDefault(flagKeepaliveInterval, agent.DefaultKeepaliveInterval)
 	viper.SetDefault(flagKeepaliveWarningTimeout, corev2.DefaultKeepaliveTimeout)
 	viper.SetDefault(flagKeepaliveCriticalTimeout, 0)
+	viper.SetDefault(flagKeepaliveLowFlapThreshold, 0)
+	viper.SetDefault(flagKeepaliveHighFlapThreshold, 0)
 	viper.SetDefault(flagNamespace, agent.DefaultNamespace)
 	viper.SetDefault(flagPassword, agent.DefaultPassword)
 	viper.SetDefault(flagRedact, corev2.DefaultRedactFields)
@@ -437,6 +454,8 @@ func flagSet() *pflag.FlagSet {
 	flagSet.StringToStringVar(&keepaliveCheckLabels, flagKeepaliveCheckLabels, nil, "keepalive labels map to add to keepalive events")
 	flagSet.StringToStringVar(&keepaliveCheckAnnotations, flagKeepaliveCheckAnnotations, nil, "keepalive annotations map to add to keepalive events")
 	flagSet.StringSlice(flagKeepalivePipelines, viper.GetStringSlice(flagKeepalivePipelines), "comma-delimited list of pipeline references for keepalive event")
+	flagSet.Uint32(flagKeepaliveLowFlapThreshold, uint32(viper.GetInt(flagKeepaliveLowFlapThreshold)), "flap detection low threshold (% state change) for keepalive events")
+	flagSet.Uint32(flagKeepaliveHighFlapThreshold, uint32(viper.GetInt(flagKeepaliveHighFlapThreshold)), "flap detection high threshold (% state change) for keepalive events")
 	flagSet.Bool(flagDisableAPI, viper.GetBool(flagDisableAPI), "disable the Agent HTTP API")
 	flagSet.Bool(flagDisableAssets, viper.GetBool(flagDisableAssets), "disable check assets on this agent")
 	flagSet.Bool(flagDisableSockets, viper.GetBool(flagDisableSockets), "disable the Agent TCP and UDP event sockets")
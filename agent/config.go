@@ -142,6 +142,14 @@ type Config struct {
 	// by the backend to create a critical event.
 	KeepaliveCriticalTimeout uint32
 
+	// KeepaliveLowFlapThreshold is the flap detection low threshold (% state
+	// change) applied to the agent's keepalive events by the backend.
+	KeepaliveLowFlapThreshold uint32
+
+	// KeepaliveHighFlapThreshold is the flap detection high threshold (% state
+	// change) applied to the agent's keepalive events by the backend.
+	KeepaliveHighFlapThreshold uint32
+
 	// KeepaliveCheckLabels are key-value pairs that users can provide to keepalive events
 	KeepaliveCheckLabels map[string]string
 
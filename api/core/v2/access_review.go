@@ -0,0 +1,78 @@
+package v2
+
+const (
+	// AccessReviewResource is the resource used to review whether a user is
+	// allowed to perform a request.
+	AccessReviewResource = "accessreview"
+
+	// PermissionsResource is the resource used to list the effective
+	// permissions of a user.
+	PermissionsResource = "permissions"
+)
+
+// BindingReference identifies a ClusterRoleBinding or a RoleBinding, along
+// with the role it references.
+type BindingReference struct {
+	// Type is the type of the binding, ClusterRoleBinding or RoleBinding.
+	Type string `json:"type"`
+
+	// Name is the name of the binding.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the binding. It is empty for
+	// ClusterRoleBindings.
+	Namespace string `json:"namespace,omitempty"`
+
+	// RoleRef is the role referenced by the binding.
+	RoleRef RoleRef `json:"role_ref"`
+}
+
+// AccessReview is the result of the review of a request, which determines
+// whether a user is allowed to perform it.
+type AccessReview struct {
+	// User is the name of the user.
+	User string `json:"user"`
+
+	// Groups are the groups of the user.
+	Groups []string `json:"groups,omitempty"`
+
+	// Verb, Resource, ResourceName and Namespace describe the request.
+	Verb         string `json:"verb"`
+	Resource     string `json:"resource"`
+	ResourceName string `json:"resource_name,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+
+	// Labels are the labels of the object of the request. The rules having a
+	// label selector only allow the requests whose labels match it.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Allowed is true if the request is allowed.
+	Allowed bool `json:"allowed"`
+
+	// Binding is the binding which allows the request, if any.
+	Binding *BindingReference `json:"binding,omitempty"`
+}
+
+// Permission is a set of rules granted to a user by a binding.
+type Permission struct {
+	// Binding is the binding granting the rules. Its namespace is the
+	// namespace in which the rules apply, or empty if they apply to all the
+	// namespaces.
+	Binding BindingReference `json:"binding"`
+
+	// Rules are the rules of the role referenced by the binding.
+	Rules []Rule `json:"rules"`
+}
+
+// EffectivePermissions are all the permissions granted to a user.
+type EffectivePermissions struct {
+	// User is the name of the user.
+	User string `json:"user"`
+
+	// Groups are the groups of the user.
+	Groups []string `json:"groups,omitempty"`
+
+	// Permissions are the permissions granted by the ClusterRoleBindings,
+	// followed by those granted by the RoleBindings.
+	Permissions []Permission `json:"permissions"`
+}
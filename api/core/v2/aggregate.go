@@ -0,0 +1,151 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	stringsutil "github.com/sensu/sensu-go/api/core/v2/internal/stringutil"
+)
+
+const (
+	// AggregatesResource is the name of this resource type
+	AggregatesResource = "aggregates"
+)
+
+// SetObjectMeta sets the object metadata for the resource.
+func (a *Aggregate) SetObjectMeta(meta ObjectMeta) {
+	a.ObjectMeta = meta
+}
+
+// SetNamespace sets the namespace of the resource.
+func (a *Aggregate) SetNamespace(namespace string) {
+	a.Namespace = namespace
+}
+
+// StorePrefix returns the path prefix to this resource in the store.
+func (a *Aggregate) StorePrefix() string {
+	return AggregatesResource
+}
+
+// RBACName describes the name of the resource for RBAC purposes.
+func (a *Aggregate) RBACName() string {
+	return AggregatesResource
+}
+
+// URIPath gives the path component of an aggregate URI.
+func (a *Aggregate) URIPath() string {
+	if a.Namespace == "" {
+		return path.Join(URLPrefix, AggregatesResource, url.PathEscape(a.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(a.Namespace), AggregatesResource, url.PathEscape(a.Name))
+}
+
+// Validate checks if an aggregate resource passes validation rules.
+func (a *Aggregate) Validate() error {
+	if err := ValidateName(a.ObjectMeta.Name); err != nil {
+		return errors.New("name " + err.Error())
+	}
+
+	if a.ObjectMeta.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	if err := ValidateName(a.Check); err != nil {
+		return errors.New("check name " + err.Error())
+	}
+
+	if err := ValidateName(a.ProxyEntityName); err != nil {
+		return errors.New("proxy entity name " + err.Error())
+	}
+
+	if a.Interval == 0 {
+		return errors.New("interval must be greater than 0")
+	}
+
+	for _, subscription := range a.Subscriptions {
+		if subscription == "" {
+			return fmt.Errorf("subscriptions cannot be empty strings")
+		}
+	}
+
+	if a.WarningCount == 0 && a.CriticalCount == 0 && a.WarningPercent == 0 && a.CriticalPercent == 0 {
+		return errors.New("at least one threshold must be set")
+	}
+
+	if a.WarningPercent > 100 || a.CriticalPercent > 100 {
+		return errors.New("percentage thresholds must not be greater than 100")
+	}
+
+	for _, pipeline := range a.Pipelines {
+		if pipeline.APIVersion != "core/v2" || pipeline.Type != "Pipeline" {
+			return fmt.Errorf("resource type not capable of acting as a pipeline: %s.%s", pipeline.APIVersion, pipeline.Type)
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the events of the given entity are part of the
+// aggregate.
+func (a *Aggregate) Matches(entity *Entity) bool {
+	if entity == nil {
+		return false
+	}
+	if len(a.Subscriptions) > 0 && len(stringsutil.Intersect(a.Subscriptions, entity.Subscriptions)) == 0 {
+		return false
+	}
+	for key, value := range a.MatchLabels {
+		if entity.Labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Status returns the check status of the aggregate, given the number of
+// critical entities among the total number of aggregated entities. A
+// threshold is reached when the number, or percentage, of critical entities is
+// greater than it.
+func (a *Aggregate) Status(critical, total uint32) uint32 {
+	reached := func(count, percent uint32) bool {
+		if count > 0 && critical > count {
+			return true
+		}
+		return percent > 0 && total > 0 && critical*100 > percent*total
+	}
+	switch {
+	case reached(a.CriticalCount, a.CriticalPercent):
+		return 2
+	case reached(a.WarningCount, a.WarningPercent):
+		return 1
+	}
+	return 0
+}
+
+// AggregateFields returns a set of fields that represent that resource.
+func AggregateFields(r Resource) map[string]string {
+	resource := r.(*Aggregate)
+	fields := map[string]string{
+		"aggregate.name":              resource.ObjectMeta.Name,
+		"aggregate.namespace":         resource.ObjectMeta.Namespace,
+		"aggregate.check":             resource.Check,
+		"aggregate.proxy_entity_name": resource.ProxyEntityName,
+	}
+	stringsutil.MergeMapWithPrefix(fields, resource.ObjectMeta.Labels, "aggregate.labels.")
+	return fields
+}
+
+// FixtureAggregate returns a testing fixture for an Aggregate object.
+func FixtureAggregate(name, namespace string) *Aggregate {
+	return &Aggregate{
+		ObjectMeta:      NewObjectMeta(name, namespace),
+		Check:           "check-http",
+		Subscriptions:   []string{"web"},
+		Interval:        60,
+		ProxyEntityName: "web-cluster",
+		CriticalPercent: 30,
+		Handlers:        []string{},
+	}
+}
@@ -0,0 +1,1197 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/aggregate.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Aggregate is a check computed by the backend over the events of a check,
+// across the entities selected by subscriptions and labels. Its result is
+// reported as an event of a proxy entity.
+type Aggregate struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// aggregate.
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Check is the name of the check whose events are aggregated.
+	Check string `protobuf:"bytes,2,opt,name=check,proto3" json:"check"`
+	// Subscriptions restricts the aggregate to the entities having at least
+	// one of these subscriptions.
+	Subscriptions []string `protobuf:"bytes,3,rep,name=subscriptions,proto3" json:"subscriptions"`
+	// MatchLabels restricts the aggregate to the entities carrying all of
+	// these labels.
+	MatchLabels map[string]string `protobuf:"bytes,4,rep,name=match_labels,json=matchLabels,proto3" json:"match_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Interval is the number of seconds between two evaluations of the
+	// aggregate.
+	Interval uint32 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval"`
+	// ProxyEntityName is the name of the proxy entity the aggregate events are
+	// reported for.
+	ProxyEntityName string `protobuf:"bytes,6,opt,name=proxy_entity_name,json=proxyEntityName,proto3" json:"proxy_entity_name"`
+	// WarningCount is the number of critical entities above which the
+	// aggregate is in a warning state.
+	WarningCount uint32 `protobuf:"varint,7,opt,name=warning_count,json=warningCount,proto3" json:"warning_count,omitempty"`
+	// CriticalCount is the number of critical entities above which the
+	// aggregate is in a critical state.
+	CriticalCount uint32 `protobuf:"varint,8,opt,name=critical_count,json=criticalCount,proto3" json:"critical_count,omitempty"`
+	// WarningPercent is the percentage of critical entities above which the
+	// aggregate is in a warning state.
+	WarningPercent uint32 `protobuf:"varint,9,opt,name=warning_percent,json=warningPercent,proto3" json:"warning_percent,omitempty"`
+	// CriticalPercent is the percentage of critical entities above which the
+	// aggregate is in a critical state.
+	CriticalPercent uint32 `protobuf:"varint,10,opt,name=critical_percent,json=criticalPercent,proto3" json:"critical_percent,omitempty"`
+	// Handlers are the handlers of the aggregate events.
+	Handlers []string `protobuf:"bytes,11,rep,name=handlers,proto3" json:"handlers"`
+	// Pipelines are the pipelines of the aggregate events.
+	Pipelines            []*ResourceReference `protobuf:"bytes,12,rep,name=pipelines,proto3" json:"pipelines"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Aggregate) Reset()         { *m = Aggregate{} }
+func (m *Aggregate) String() string { return proto.CompactTextString(m) }
+func (*Aggregate) ProtoMessage()    {}
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d2b5d7e9fd02e14, []int{0}
+}
+func (m *Aggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Aggregate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Aggregate.Merge(m, src)
+}
+func (m *Aggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Aggregate proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Aggregate)(nil), "sensu.core.v2.Aggregate")
+	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.Aggregate.MatchLabelsEntry")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/aggregate.proto", fileDescriptor_3d2b5d7e9fd02e14)
+}
+
+var fileDescriptor_3d2b5d7e9fd02e14 = []byte{
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0xeb, 0xe6, 0x6b, 0xbf, 0x78, 0x92, 0xf4, 0x32, 0xe2, 0xe2, 0x06, 0xf0, 0x58, 0xac,
+	0x8c, 0x04, 0x0e, 0x4d, 0x41, 0xa0, 0x2e, 0xaa, 0xd6, 0x55, 0x91, 0x90, 0x28, 0x20, 0x4b, 0x6c,
+	0xd8, 0x44, 0x13, 0xf7, 0xd4, 0x31, 0xf5, 0x4d, 0xe3, 0x71, 0x20, 0x6f, 0xc0, 0x23, 0xb0, 0xec,
+	0xb2, 0x8f, 0xc0, 0x23, 0x74, 0xd9, 0x27, 0x18, 0x41, 0xd8, 0x79, 0xc5, 0x92, 0x25, 0xca, 0x38,
+	0x4e, 0x9d, 0xb0, 0xe9, 0x26, 0x39, 0xf3, 0xff, 0x9f, 0xf9, 0x9d, 0x39, 0xf2, 0x39, 0xe8, 0xb9,
+	0xe7, 0xf3, 0x41, 0xd6, 0xb7, 0xdc, 0x38, 0xec, 0xa4, 0x10, 0xa5, 0x59, 0xf1, 0xfb, 0xc4, 0x8b,
+	0x3b, 0x34, 0xf1, 0x3b, 0x6e, 0xcc, 0xa0, 0x33, 0xec, 0x76, 0xa8, 0xe7, 0x31, 0xf0, 0x28, 0x07,
+	0x2b, 0x61, 0x31, 0x8f, 0x71, 0x4b, 0x66, 0x59, 0x13, 0xdb, 0x1a, 0x76, 0xdb, 0xcf, 0x2a, 0x14,
+	0x2f, 0xf6, 0xe2, 0x8e, 0xcc, 0xea, 0x67, 0xa7, 0xfb, 0xc3, 0x6d, 0x6b, 0xc7, 0xda, 0x96, 0xa2,
+	0xd4, 0x64, 0x54, 0x40, 0xda, 0x4f, 0x6f, 0x56, 0x3b, 0x04, 0x4e, 0xa7, 0x37, 0xf6, 0x6e, 0x76,
+	0x83, 0x41, 0x1a, 0x67, 0xcc, 0x85, 0x1e, 0x83, 0x53, 0x60, 0x10, 0xb9, 0xd3, 0x67, 0x3f, 0xfc,
+	0xbd, 0x8a, 0xd4, 0x83, 0xb2, 0x15, 0xfc, 0x01, 0xd5, 0x27, 0xec, 0x13, 0xca, 0xa9, 0xa6, 0x18,
+	0x8a, 0xd9, 0xe8, 0x6e, 0x59, 0x73, 0x7d, 0x59, 0xef, 0xfa, 0x9f, 0xc0, 0xe5, 0xc7, 0xc0, 0xa9,
+	0xad, 0x5f, 0x0a, 0xb2, 0x74, 0x25, 0x88, 0x92, 0x0b, 0x82, 0xcb, 0x6b, 0x8f, 0xe3, 0xd0, 0xe7,
+	0x10, 0x26, 0x7c, 0xe4, 0xcc, 0x50, 0x98, 0xa0, 0x15, 0x77, 0x00, 0xee, 0x99, 0xb6, 0x6c, 0x28,
+	0xa6, 0x6a, 0xab, 0xb9, 0x20, 0x85, 0xe0, 0x14, 0x7f, 0xf8, 0x05, 0x6a, 0xa5, 0x59, 0x3f, 0x75,
+	0x99, 0x9f, 0x70, 0x3f, 0x8e, 0x52, 0xad, 0x66, 0xd4, 0x4c, 0xd5, 0xde, 0xcc, 0x05, 0x99, 0x37,
+	0x9c, 0xf9, 0x23, 0x1e, 0xa0, 0x66, 0x48, 0xb9, 0x3b, 0xe8, 0x05, 0xb4, 0x0f, 0x41, 0xaa, 0xfd,
+	0x67, 0xd4, 0xcc, 0x46, 0xf7, 0xd1, 0xc2, 0xa3, 0x67, 0x0d, 0x5a, 0xc7, 0x93, 0xe4, 0x37, 0x32,
+	0xf7, 0x28, 0xe2, 0x6c, 0x64, 0xb7, 0x73, 0x41, 0xee, 0x54, 0x11, 0x95, 0x06, 0x1a, 0xe1, 0x75,
+	0x36, 0x36, 0x51, 0xdd, 0x8f, 0x38, 0xb0, 0x21, 0x0d, 0xb4, 0x15, 0x43, 0x31, 0x5b, 0x76, 0x33,
+	0x17, 0x64, 0xa6, 0x39, 0xb3, 0x08, 0x1f, 0xa0, 0xcd, 0x84, 0xc5, 0x5f, 0x46, 0x3d, 0x88, 0xb8,
+	0xcf, 0x47, 0xbd, 0x88, 0x86, 0xa0, 0xad, 0xca, 0xce, 0x6f, 0xe7, 0x82, 0xfc, 0x6b, 0x3a, 0xeb,
+	0x52, 0x3a, 0x92, 0xca, 0x5b, 0x1a, 0x02, 0xde, 0x47, 0xad, 0xcf, 0x94, 0x45, 0x7e, 0xe4, 0xf5,
+	0xdc, 0x38, 0x8b, 0xb8, 0xf6, 0xbf, 0xac, 0x78, 0x2f, 0x17, 0xe4, 0xee, 0x9c, 0x51, 0x79, 0x6d,
+	0x73, 0x6a, 0x1c, 0x4e, 0x74, 0x7c, 0x88, 0xd6, 0x5c, 0xe6, 0x73, 0xdf, 0xa5, 0xc1, 0x14, 0x51,
+	0x97, 0x88, 0xfb, 0xb9, 0x20, 0xda, 0xbc, 0x53, 0x61, 0xb4, 0x4a, 0xa7, 0x80, 0xbc, 0x42, 0xeb,
+	0x65, 0xb5, 0x04, 0x98, 0x0b, 0x11, 0xd7, 0x54, 0x49, 0x79, 0x90, 0x0b, 0xb2, 0xb5, 0x60, 0x55,
+	0x30, 0x6b, 0x53, 0xeb, 0x7d, 0xe1, 0xe0, 0xd7, 0x68, 0x63, 0x56, 0xb2, 0x04, 0x21, 0x09, 0xd2,
+	0x73, 0x41, 0xda, 0x8b, 0x5e, 0x85, 0xb4, 0x5e, 0x7a, 0x25, 0xca, 0x44, 0xf5, 0x01, 0x8d, 0x4e,
+	0x02, 0x60, 0xa9, 0xd6, 0x90, 0x43, 0x22, 0x3f, 0x43, 0xa9, 0x39, 0xb3, 0x08, 0x1f, 0x23, 0x35,
+	0xf1, 0x13, 0x08, 0xfc, 0x08, 0x52, 0xad, 0x29, 0xe7, 0xc2, 0x58, 0x98, 0x0b, 0x67, 0xba, 0x15,
+	0x4e, 0xb9, 0x14, 0x76, 0x2b, 0x17, 0xe4, 0xfa, 0x9a, 0x73, 0x1d, 0xb6, 0xf7, 0xd0, 0xc6, 0xe2,
+	0xf0, 0xe0, 0x0d, 0x54, 0x3b, 0x83, 0x91, 0xdc, 0x14, 0xd5, 0x99, 0x84, 0xf8, 0x16, 0x5a, 0x19,
+	0xd2, 0x20, 0x83, 0x62, 0xd2, 0x9d, 0xe2, 0xb0, 0xbb, 0xfc, 0x52, 0xd9, 0xad, 0x7f, 0x3d, 0x27,
+	0x4b, 0x17, 0xe7, 0x44, 0xb1, 0x8d, 0x3f, 0x3f, 0x75, 0xe5, 0x62, 0xac, 0x2b, 0xdf, 0xc7, 0xba,
+	0x72, 0x39, 0xd6, 0x95, 0xab, 0xb1, 0xae, 0xfc, 0x18, 0xeb, 0xca, 0xb7, 0x5f, 0xfa, 0xd2, 0xc7,
+	0xe5, 0x61, 0xb7, 0xbf, 0x2a, 0x77, 0x73, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x39,
+	0xde, 0xfb, 0x8b, 0x04, 0x00, 0x00,
+}
+
+func (this *Aggregate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Aggregate)
+	if !ok {
+		that2, ok := that.(Aggregate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Check != that1.Check {
+		return false
+	}
+	if len(this.Subscriptions) != len(that1.Subscriptions) {
+		return false
+	}
+	for i := range this.Subscriptions {
+		if this.Subscriptions[i] != that1.Subscriptions[i] {
+			return false
+		}
+	}
+	if len(this.MatchLabels) != len(that1.MatchLabels) {
+		return false
+	}
+	for i := range this.MatchLabels {
+		if this.MatchLabels[i] != that1.MatchLabels[i] {
+			return false
+		}
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.ProxyEntityName != that1.ProxyEntityName {
+		return false
+	}
+	if this.WarningCount != that1.WarningCount {
+		return false
+	}
+	if this.CriticalCount != that1.CriticalCount {
+		return false
+	}
+	if this.WarningPercent != that1.WarningPercent {
+		return false
+	}
+	if this.CriticalPercent != that1.CriticalPercent {
+		return false
+	}
+	if len(this.Handlers) != len(that1.Handlers) {
+		return false
+	}
+	for i := range this.Handlers {
+		if this.Handlers[i] != that1.Handlers[i] {
+			return false
+		}
+	}
+	if len(this.Pipelines) != len(that1.Pipelines) {
+		return false
+	}
+	for i := range this.Pipelines {
+		if !this.Pipelines[i].Equal(that1.Pipelines[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type AggregateFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetCheck() string
+	GetSubscriptions() []string
+	GetMatchLabels() map[string]string
+	GetInterval() uint32
+	GetProxyEntityName() string
+	GetWarningCount() uint32
+	GetCriticalCount() uint32
+	GetWarningPercent() uint32
+	GetCriticalPercent() uint32
+	GetHandlers() []string
+	GetPipelines() []*ResourceReference
+}
+
+func (this *Aggregate) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *Aggregate) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewAggregateFromFace(this)
+}
+
+func (this *Aggregate) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *Aggregate) GetCheck() string {
+	return this.Check
+}
+
+func (this *Aggregate) GetSubscriptions() []string {
+	return this.Subscriptions
+}
+
+func (this *Aggregate) GetMatchLabels() map[string]string {
+	return this.MatchLabels
+}
+
+func (this *Aggregate) GetInterval() uint32 {
+	return this.Interval
+}
+
+func (this *Aggregate) GetProxyEntityName() string {
+	return this.ProxyEntityName
+}
+
+func (this *Aggregate) GetWarningCount() uint32 {
+	return this.WarningCount
+}
+
+func (this *Aggregate) GetCriticalCount() uint32 {
+	return this.CriticalCount
+}
+
+func (this *Aggregate) GetWarningPercent() uint32 {
+	return this.WarningPercent
+}
+
+func (this *Aggregate) GetCriticalPercent() uint32 {
+	return this.CriticalPercent
+}
+
+func (this *Aggregate) GetHandlers() []string {
+	return this.Handlers
+}
+
+func (this *Aggregate) GetPipelines() []*ResourceReference {
+	return this.Pipelines
+}
+
+func NewAggregateFromFace(that AggregateFace) *Aggregate {
+	this := &Aggregate{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.Check = that.GetCheck()
+	this.Subscriptions = that.GetSubscriptions()
+	this.MatchLabels = that.GetMatchLabels()
+	this.Interval = that.GetInterval()
+	this.ProxyEntityName = that.GetProxyEntityName()
+	this.WarningCount = that.GetWarningCount()
+	this.CriticalCount = that.GetCriticalCount()
+	this.WarningPercent = that.GetWarningPercent()
+	this.CriticalPercent = that.GetCriticalPercent()
+	this.Handlers = that.GetHandlers()
+	this.Pipelines = that.GetPipelines()
+	return this
+}
+
+func (m *Aggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Aggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Pipelines) > 0 {
+		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pipelines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAggregate(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Handlers) > 0 {
+		for iNdEx := len(m.Handlers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Handlers[iNdEx])
+			copy(dAtA[i:], m.Handlers[iNdEx])
+			i = encodeVarintAggregate(dAtA, i, uint64(len(m.Handlers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.CriticalPercent != 0 {
+		i = encodeVarintAggregate(dAtA, i, uint64(m.CriticalPercent))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.WarningPercent != 0 {
+		i = encodeVarintAggregate(dAtA, i, uint64(m.WarningPercent))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CriticalCount != 0 {
+		i = encodeVarintAggregate(dAtA, i, uint64(m.CriticalCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.WarningCount != 0 {
+		i = encodeVarintAggregate(dAtA, i, uint64(m.WarningCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ProxyEntityName) > 0 {
+		i -= len(m.ProxyEntityName)
+		copy(dAtA[i:], m.ProxyEntityName)
+		i = encodeVarintAggregate(dAtA, i, uint64(len(m.ProxyEntityName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Interval != 0 {
+		i = encodeVarintAggregate(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MatchLabels) > 0 {
+		for k := range m.MatchLabels {
+			v := m.MatchLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAggregate(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAggregate(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAggregate(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Subscriptions[iNdEx])
+			copy(dAtA[i:], m.Subscriptions[iNdEx])
+			i = encodeVarintAggregate(dAtA, i, uint64(len(m.Subscriptions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Check) > 0 {
+		i -= len(m.Check)
+		copy(dAtA[i:], m.Check)
+		i = encodeVarintAggregate(dAtA, i, uint64(len(m.Check)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAggregate(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAggregate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAggregate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedAggregate(r randyAggregate, easy bool) *Aggregate {
+	this := &Aggregate{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Check = string(randStringAggregate(r))
+	v2 := r.Intn(10)
+	this.Subscriptions = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Subscriptions[i] = string(randStringAggregate(r))
+	}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(10)
+		this.MatchLabels = make(map[string]string)
+		for i := 0; i < v3; i++ {
+			this.MatchLabels[randStringAggregate(r)] = randStringAggregate(r)
+		}
+	}
+	this.Interval = uint32(r.Uint32())
+	this.ProxyEntityName = string(randStringAggregate(r))
+	this.WarningCount = uint32(r.Uint32())
+	this.CriticalCount = uint32(r.Uint32())
+	this.WarningPercent = uint32(r.Uint32())
+	this.CriticalPercent = uint32(r.Uint32())
+	v4 := r.Intn(10)
+	this.Handlers = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.Handlers[i] = string(randStringAggregate(r))
+	}
+	if r.Intn(5) != 0 {
+		v5 := r.Intn(5)
+		this.Pipelines = make([]*ResourceReference, v5)
+		for i := 0; i < v5; i++ {
+			this.Pipelines[i] = NewPopulatedResourceReference(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedAggregate(r, 13)
+	}
+	return this
+}
+
+type randyAggregate interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneAggregate(r randyAggregate) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringAggregate(r randyAggregate) string {
+	v6 := r.Intn(100)
+	tmps := make([]rune, v6)
+	for i := 0; i < v6; i++ {
+		tmps[i] = randUTF8RuneAggregate(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedAggregate(r randyAggregate, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldAggregate(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldAggregate(dAtA []byte, r randyAggregate, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateAggregate(dAtA, uint64(key))
+		v7 := r.Int63()
+		if r.Intn(2) == 0 {
+			v7 *= -1
+		}
+		dAtA = encodeVarintPopulateAggregate(dAtA, uint64(v7))
+	case 1:
+		dAtA = encodeVarintPopulateAggregate(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateAggregate(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateAggregate(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateAggregate(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateAggregate(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Aggregate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovAggregate(uint64(l))
+	l = len(m.Check)
+	if l > 0 {
+		n += 1 + l + sovAggregate(uint64(l))
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, s := range m.Subscriptions {
+			l = len(s)
+			n += 1 + l + sovAggregate(uint64(l))
+		}
+	}
+	if len(m.MatchLabels) > 0 {
+		for k, v := range m.MatchLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAggregate(uint64(len(k))) + 1 + len(v) + sovAggregate(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAggregate(uint64(mapEntrySize))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovAggregate(uint64(m.Interval))
+	}
+	l = len(m.ProxyEntityName)
+	if l > 0 {
+		n += 1 + l + sovAggregate(uint64(l))
+	}
+	if m.WarningCount != 0 {
+		n += 1 + sovAggregate(uint64(m.WarningCount))
+	}
+	if m.CriticalCount != 0 {
+		n += 1 + sovAggregate(uint64(m.CriticalCount))
+	}
+	if m.WarningPercent != 0 {
+		n += 1 + sovAggregate(uint64(m.WarningPercent))
+	}
+	if m.CriticalPercent != 0 {
+		n += 1 + sovAggregate(uint64(m.CriticalPercent))
+	}
+	if len(m.Handlers) > 0 {
+		for _, s := range m.Handlers {
+			l = len(s)
+			n += 1 + l + sovAggregate(uint64(l))
+		}
+	}
+	if len(m.Pipelines) > 0 {
+		for _, e := range m.Pipelines {
+			l = e.Size()
+			n += 1 + l + sovAggregate(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAggregate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAggregate(x uint64) (n int) {
+	return sovAggregate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAggregate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Check = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MatchLabels == nil {
+				m.MatchLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAggregate
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAggregate
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAggregate
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAggregate
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAggregate
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAggregate
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAggregate
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAggregate(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAggregate
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MatchLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyEntityName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyEntityName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningCount", wireType)
+			}
+			m.WarningCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarningCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalCount", wireType)
+			}
+			m.CriticalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CriticalCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningPercent", wireType)
+			}
+			m.WarningPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarningPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalPercent", wireType)
+			}
+			m.CriticalPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CriticalPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipelines = append(m.Pipelines, &ResourceReference{})
+			if err := m.Pipelines[len(m.Pipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAggregate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAggregate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAggregate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAggregate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAggregate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAggregate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAggregate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAggregate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAggregate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAggregate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAggregate = fmt.Errorf("proto: unexpected end of group")
+)
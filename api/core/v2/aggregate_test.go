@@ -0,0 +1,245 @@
+package v2
+
+import (
+	"testing"
+)
+
+func TestAggregate_validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		aggregate func() *Aggregate
+		wantMsg   string
+	}{
+		{
+			name: "fails when name is empty",
+			aggregate: func() *Aggregate {
+				return &Aggregate{}
+			},
+			wantMsg: "name must not be empty",
+		},
+		{
+			name: "fails when namespace is empty",
+			aggregate: func() *Aggregate {
+				return FixtureAggregate("web", "")
+			},
+			wantMsg: "namespace must be set",
+		},
+		{
+			name: "fails without check",
+			aggregate: func() *Aggregate {
+				a := FixtureAggregate("web", "default")
+				a.Check = ""
+				return a
+			},
+			wantMsg: "check name must not be empty",
+		},
+		{
+			name: "fails without proxy entity name",
+			aggregate: func() *Aggregate {
+				a := FixtureAggregate("web", "default")
+				a.ProxyEntityName = ""
+				return a
+			},
+			wantMsg: "proxy entity name must not be empty",
+		},
+		{
+			name: "fails without interval",
+			aggregate: func() *Aggregate {
+				a := FixtureAggregate("web", "default")
+				a.Interval = 0
+				return a
+			},
+			wantMsg: "interval must be greater than 0",
+		},
+		{
+			name: "fails without thresholds",
+			aggregate: func() *Aggregate {
+				a := FixtureAggregate("web", "default")
+				a.CriticalPercent = 0
+				return a
+			},
+			wantMsg: "at least one threshold must be set",
+		},
+		{
+			name: "fails with a percentage above 100",
+			aggregate: func() *Aggregate {
+				a := FixtureAggregate("web", "default")
+				a.WarningPercent = 101
+				return a
+			},
+			wantMsg: "percentage thresholds must not be greater than 100",
+		},
+		{
+			name: "fails with an invalid pipeline",
+			aggregate: func() *Aggregate {
+				a := FixtureAggregate("web", "default")
+				a.Pipelines = []*ResourceReference{{Name: "slack", Type: "Handler", APIVersion: "core/v2"}}
+				return a
+			},
+			wantMsg: "resource type not capable of acting as a pipeline: core/v2.Handler",
+		},
+		{
+			name: "succeeds with the fixture",
+			aggregate: func() *Aggregate {
+				return FixtureAggregate("web", "default")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.aggregate().Validate()
+			if tt.wantMsg == "" {
+				if err != nil {
+					t.Errorf("Aggregate.Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantMsg {
+				t.Errorf("Aggregate.Validate() error = %v, wantMsg %v", err, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestAggregate_Matches(t *testing.T) {
+	entity := FixtureEntity("web-1")
+	entity.Subscriptions = []string{"web", "linux"}
+	entity.Labels = map[string]string{"region": "us-west-1"}
+
+	tests := []struct {
+		name          string
+		subscriptions []string
+		matchLabels   map[string]string
+		entity        *Entity
+		want          bool
+	}{
+		{
+			name: "nil entity",
+			want: false,
+		},
+		{
+			name:   "no selector",
+			entity: entity,
+			want:   true,
+		},
+		{
+			name:          "matching subscription",
+			subscriptions: []string{"web"},
+			entity:        entity,
+			want:          true,
+		},
+		{
+			name:          "mismatching subscription",
+			subscriptions: []string{"db"},
+			entity:        entity,
+			want:          false,
+		},
+		{
+			name:          "mismatching labels",
+			subscriptions: []string{"web"},
+			matchLabels:   map[string]string{"region": "eu-west-1"},
+			entity:        entity,
+			want:          false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := FixtureAggregate("web", "default")
+			a.Subscriptions = tt.subscriptions
+			a.MatchLabels = tt.matchLabels
+			if got := a.Matches(tt.entity); got != tt.want {
+				t.Errorf("Aggregate.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAggregate_Status(t *testing.T) {
+	tests := []struct {
+		name            string
+		warningCount    uint32
+		criticalCount   uint32
+		warningPercent  uint32
+		criticalPercent uint32
+		critical        uint32
+		total           uint32
+		want            uint32
+	}{
+		{
+			name:            "below percentage thresholds",
+			warningPercent:  10,
+			criticalPercent: 30,
+			critical:        0,
+			total:           10,
+			want:            0,
+		},
+		{
+			name:            "at warning percentage",
+			warningPercent:  10,
+			criticalPercent: 30,
+			critical:        1,
+			total:           10,
+			want:            0,
+		},
+		{
+			name:            "above warning percentage",
+			warningPercent:  10,
+			criticalPercent: 30,
+			critical:        2,
+			total:           10,
+			want:            1,
+		},
+		{
+			name:            "at critical percentage",
+			warningPercent:  10,
+			criticalPercent: 30,
+			critical:        3,
+			total:           10,
+			want:            1,
+		},
+		{
+			name:            "above critical percentage",
+			warningPercent:  10,
+			criticalPercent: 30,
+			critical:        4,
+			total:           10,
+			want:            2,
+		},
+		{
+			name:          "at critical count",
+			warningCount:  1,
+			criticalCount: 5,
+			critical:      5,
+			total:         100,
+			want:          1,
+		},
+		{
+			name:          "above critical count",
+			warningCount:  1,
+			criticalCount: 5,
+			critical:      6,
+			total:         100,
+			want:          2,
+		},
+		{
+			name:            "no aggregated entities",
+			criticalPercent: 30,
+			critical:        0,
+			total:           0,
+			want:            0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Aggregate{
+				WarningCount:    tt.warningCount,
+				CriticalCount:   tt.criticalCount,
+				WarningPercent:  tt.warningPercent,
+				CriticalPercent: tt.criticalPercent,
+			}
+			if got := a.Status(tt.critical, tt.total); got != tt.want {
+				t.Errorf("Aggregate.Status() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	stringsutil "github.com/sensu/sensu-go/api/core/v2/internal/stringutil"
@@ -39,9 +41,167 @@ func (a *APIKey) Validate() error {
 		return fmt.Errorf("api key name: %s", err)
 	}
 
+	if a.ExpiresAt < 0 {
+		return fmt.Errorf("api key expiry must not be negative")
+	}
+
+	for _, namespace := range a.Namespaces {
+		if err := ValidateName(namespace); err != nil {
+			return fmt.Errorf("api key namespace %q: %s", namespace, err)
+		}
+	}
+
+	for _, rule := range a.Rules {
+		if err := validateVerbs(split(rule.Verbs)); err != nil {
+			return err
+		}
+		if len(split(rule.Resources)) == 0 {
+			return fmt.Errorf("api key rules must have at least one resource")
+		}
+		if len(rule.LabelSelector) > 0 {
+			return fmt.Errorf("api key rules cannot have a label selector")
+		}
+	}
+
+	return nil
+}
+
+// NormalizeRules splits the comma separated verbs and resources of the rules
+// of the API key.
+func (a *APIKey) NormalizeRules() {
+	for i := range a.Rules {
+		a.Rules[i].Verbs = split(a.Rules[i].Verbs)
+		a.Rules[i].Resources = split(a.Rules[i].Resources)
+	}
+}
+
+// RestrictTo restricts the API key to the scope of the API key used to create
+// it, so an API key can't grant more than its own scope. The API key inherits
+// the namespaces and the rules of the scope it does not restrict further. An
+// error is returned if the namespaces or the normalized rules of the API key
+// go beyond the scope.
+func (a *APIKey) RestrictTo(scope *APIKeyScope) error {
+	if scope == nil {
+		return nil
+	}
+	if len(scope.Namespaces) > 0 {
+		if len(a.Namespaces) == 0 {
+			a.Namespaces = scope.Namespaces
+		}
+		for _, namespace := range a.Namespaces {
+			if !stringsutil.InArray(namespace, scope.Namespaces) {
+				return fmt.Errorf("the namespace %q is outside of the api key scope", namespace)
+			}
+		}
+	}
+	if len(scope.Rules) > 0 {
+		if len(a.Rules) == 0 {
+			a.Rules = scope.Rules
+		}
+		for _, rule := range a.Rules {
+			if !scope.covers(rule) {
+				return fmt.Errorf("the rule allowing %s on %s is outside of the api key scope", strings.Join(rule.Verbs, ","), strings.Join(rule.Resources, ","))
+			}
+		}
+	}
 	return nil
 }
 
+// IsExpired returns whether the API key is expired at the given time.
+func (a *APIKey) IsExpired(now time.Time) bool {
+	return a.ExpiresAt > 0 && now.Unix() >= a.ExpiresAt
+}
+
+// Scope returns the restrictions of the API key, or nil if the API key has
+// all the privileges of its user.
+func (a *APIKey) Scope() *APIKeyScope {
+	if len(a.Namespaces) == 0 && len(a.Rules) == 0 {
+		return nil
+	}
+	return &APIKeyScope{
+		Namespaces: a.Namespaces,
+		Rules:      a.Rules,
+	}
+}
+
+// APIKeyScope restricts the requests authenticated by an API key. These
+// restrictions apply on top of the RBAC rules of the user.
+type APIKeyScope struct {
+	// Namespaces are the namespaces the API key can access. Cluster-wide
+	// resources can't be accessed, except the namespaces themselves.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Rules are the rules the requests must match.
+	Rules []Rule `json:"rules,omitempty"`
+}
+
+// Allows returns whether the scope allows the request with the given
+// namespace, verb, resource and resource name.
+func (s *APIKeyScope) Allows(namespace, verb, resource, resourceName string) bool {
+	if len(s.Namespaces) > 0 {
+		switch {
+		case namespace != "":
+			if !stringsutil.InArray(namespace, s.Namespaces) {
+				return false
+			}
+		case resource == NamespacesResource:
+			if resourceName != "" && !stringsutil.InArray(resourceName, s.Namespaces) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	if len(s.Rules) == 0 {
+		return true
+	}
+	for _, rule := range s.Rules {
+		if rule.VerbMatches(verb) && rule.ResourceMatches(resource) && rule.ResourceNameMatches(resourceName) {
+			return true
+		}
+	}
+	return false
+}
+
+// covers returns whether every request matched by the rule is matched by
+// one of the rules of the scope.
+func (s *APIKeyScope) covers(rule Rule) bool {
+	for _, r := range s.Rules {
+		if ruleCovers(r, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleCovers returns whether every request matched by the inner rule is
+// matched by the outer rule.
+func ruleCovers(outer, inner Rule) bool {
+	for _, verb := range inner.Verbs {
+		if !outer.VerbMatches(verb) {
+			return false
+		}
+	}
+	for _, resource := range inner.Resources {
+		if !outer.ResourceMatches(resource) {
+			return false
+		}
+	}
+	if len(outer.ResourceNames) == 0 {
+		return true
+	}
+	if len(inner.ResourceNames) == 0 {
+		return false
+	}
+	for _, name := range inner.ResourceNames {
+		if !outer.ResourceNameMatches(name) {
+			return false
+		}
+	}
+	return true
+}
+
 // FixtureAPIKey returns a testing fixture for an APIKey struct.
 func FixtureAPIKey(name string, username string) *APIKey {
 	return &APIKey{
@@ -33,7 +33,20 @@ type APIKey struct {
 	// Username is the username associated with the API key.
 	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	// CreatedAt is a timestamp which the API key was created.
-	CreatedAt            int64    `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Description is an optional description of the API key.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// ExpiresAt is a timestamp after which the API key is rejected. The API key
+	// never expires when it is 0.
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// LastUsedAt is a timestamp at which the API key was last used. It is
+	// updated at most once per minute.
+	LastUsedAt int64 `protobuf:"varint,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Namespaces optionally restricts the API key to the given namespaces.
+	Namespaces []string `protobuf:"bytes,7,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Rules optionally restricts the API key to the given rules. The requests
+	// must be allowed by both the RBAC rules of the user and these rules.
+	Rules                []Rule   `protobuf:"bytes,8,rep,name=rules,proto3" json:"rules,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -81,26 +94,35 @@ func init() {
 }
 
 var fileDescriptor_c805b5e2d9435d9b = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4a, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0x4e, 0xcd, 0x2b, 0x2e, 0x85, 0x90, 0xba, 0xe9,
-	0xf9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0xc9, 0xf9, 0x45, 0xa9, 0xfa, 0x65, 0x46, 0x20, 0x76, 0x76,
-	0x6a, 0xa5, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x2f, 0x58, 0x89, 0x1e, 0x48, 0x4e, 0xaf,
-	0xcc, 0x48, 0xca, 0x04, 0xc9, 0x88, 0xf4, 0xfc, 0xf4, 0x7c, 0x7d, 0xb0, 0xaa, 0xa4, 0xd2, 0x34,
-	0x87, 0x32, 0x43, 0x3d, 0x63, 0x3d, 0x43, 0xb0, 0x20, 0x58, 0x0c, 0xcc, 0x82, 0x18, 0x22, 0x65,
-	0x40, 0x9c, 0xc5, 0xb9, 0xa9, 0x25, 0x89, 0x10, 0x1d, 0x4a, 0x4b, 0x18, 0xb9, 0xd8, 0x1c, 0x03,
-	0x3c, 0xbd, 0x53, 0x2b, 0x85, 0x42, 0xb9, 0x38, 0x40, 0x12, 0x29, 0x89, 0x25, 0x89, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x92, 0x7a, 0x28, 0x8e, 0xd2, 0xf3, 0x4f, 0xca, 0x4a, 0x4d, 0x2e,
-	0xf1, 0x4d, 0x2d, 0x49, 0x74, 0x92, 0x3b, 0x71, 0x4f, 0x9e, 0xe1, 0xc2, 0x3d, 0x79, 0xc6, 0x57,
-	0xf7, 0xe4, 0x85, 0x60, 0xda, 0x74, 0xf2, 0x73, 0x33, 0x4b, 0x52, 0x73, 0x0b, 0x4a, 0x2a, 0x83,
-	0xe0, 0x46, 0x09, 0x49, 0x71, 0x71, 0x94, 0x16, 0xa7, 0x16, 0xe5, 0x25, 0xe6, 0xa6, 0x4a, 0x30,
-	0x29, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xf9, 0x42, 0xb2, 0x5c, 0x5c, 0xc9, 0x45, 0xa9, 0x89, 0x25,
-	0xa9, 0x29, 0xf1, 0x89, 0x25, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x9c, 0x50, 0x11, 0xc7,
-	0x12, 0x2b, 0x8e, 0x8e, 0x05, 0xf2, 0x0c, 0x2b, 0x16, 0xc8, 0x33, 0x3a, 0x29, 0xfc, 0x78, 0x28,
-	0xc7, 0xb8, 0xe2, 0x91, 0x1c, 0xe3, 0x8e, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8c, 0xc7, 0x72, 0x0c, 0x51, 0x4c, 0x65, 0x46, 0x49,
-	0x6c, 0x60, 0xff, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x05, 0x2e, 0x64, 0x7c, 0x01,
-	0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbf, 0x8e, 0xd3, 0x30,
+	0x1c, 0xc7, 0xeb, 0x0b, 0x57, 0x5a, 0x17, 0x84, 0x64, 0xfe, 0xe5, 0x2a, 0x11, 0x47, 0x4c, 0x19,
+	0x20, 0xe1, 0x72, 0x48, 0x20, 0x60, 0xa0, 0xd9, 0x10, 0x42, 0xa0, 0x4a, 0xb7, 0xb0, 0x9c, 0x9c,
+	0xf4, 0x47, 0x09, 0x34, 0x75, 0x64, 0x3b, 0x15, 0x7d, 0x03, 0x1e, 0x81, 0xf1, 0xc6, 0x7b, 0x04,
+	0x1e, 0xe1, 0xc6, 0x9b, 0x18, 0x23, 0x08, 0x5b, 0x9e, 0x80, 0x11, 0xd9, 0x39, 0x5a, 0xc3, 0xc4,
+	0x2d, 0x91, 0xf3, 0xc9, 0xf7, 0xf3, 0xfd, 0xd9, 0x56, 0x70, 0x3c, 0xcf, 0xd5, 0xfb, 0x2a, 0x0d,
+	0x33, 0x5e, 0x44, 0x12, 0x96, 0xb2, 0xea, 0x9e, 0xf7, 0xe7, 0x3c, 0x62, 0x65, 0x1e, 0x65, 0x5c,
+	0x40, 0xb4, 0x8a, 0xf5, 0xfa, 0x23, 0xac, 0xc3, 0x52, 0x70, 0xc5, 0xc9, 0x55, 0x13, 0x09, 0xf5,
+	0xb7, 0x70, 0x15, 0x8f, 0x1f, 0x5a, 0x15, 0x73, 0x3e, 0xe7, 0x91, 0x49, 0xa5, 0xd5, 0xbb, 0xe7,
+	0xab, 0xfd, 0xf0, 0x20, 0xdc, 0x37, 0xd0, 0x30, 0xb3, 0xea, 0x4a, 0xc6, 0x0f, 0xfe, 0x6f, 0x70,
+	0x01, 0x8a, 0x5d, 0xcc, 0x10, 0x29, 0xcb, 0x3a, 0xe3, 0xee, 0x37, 0x07, 0xf7, 0x27, 0x6f, 0x5e,
+	0xbc, 0x84, 0x35, 0x39, 0xc4, 0x03, 0x5d, 0x35, 0x63, 0x8a, 0xb9, 0xc8, 0x47, 0xc1, 0x28, 0xde,
+	0x0b, 0xff, 0x3a, 0x46, 0xf8, 0x3a, 0xfd, 0x00, 0x99, 0x7a, 0x05, 0x8a, 0x25, 0xde, 0x69, 0x4d,
+	0x7b, 0x67, 0x35, 0x45, 0x6d, 0x4d, 0xc9, 0x1f, 0xed, 0x1e, 0x2f, 0x72, 0x05, 0x45, 0xa9, 0xd6,
+	0xd3, 0x4d, 0x15, 0x19, 0xe3, 0x41, 0x25, 0x41, 0x2c, 0x59, 0x01, 0xee, 0x8e, 0x8f, 0x82, 0xe1,
+	0x74, 0xf3, 0x4e, 0xee, 0x60, 0x9c, 0x09, 0x60, 0x0a, 0x66, 0x47, 0x4c, 0xb9, 0x8e, 0x8f, 0x02,
+	0x67, 0x3a, 0x3c, 0x27, 0x13, 0x45, 0x9e, 0xe2, 0xd1, 0x0c, 0x64, 0x26, 0xf2, 0x52, 0xe5, 0x7c,
+	0xe9, 0x5e, 0xd2, 0x76, 0xb2, 0xd7, 0xd6, 0xf4, 0xa6, 0x85, 0xad, 0xa1, 0x76, 0x9a, 0x3c, 0xc2,
+	0x18, 0x3e, 0x95, 0xb9, 0x00, 0xa9, 0xbb, 0x77, 0x75, 0x77, 0xe2, 0xb6, 0x35, 0xbd, 0xb1, 0xa5,
+	0x96, 0x3a, 0x3c, 0xa7, 0x13, 0x45, 0x9e, 0xe1, 0x2b, 0x0b, 0x26, 0xd5, 0x51, 0x25, 0xbb, 0x6d,
+	0xf5, 0x8d, 0x3a, 0x6e, 0x6b, 0x7a, 0xcb, 0xe6, 0x96, 0x8c, 0x35, 0x3f, 0x94, 0x66, 0xcf, 0x8f,
+	0x31, 0xd6, 0x47, 0x93, 0x25, 0xcb, 0x40, 0xba, 0x97, 0x7d, 0x27, 0x18, 0x76, 0x63, 0xb7, 0xd4,
+	0x36, 0xb7, 0x94, 0x24, 0x78, 0x57, 0x54, 0x0b, 0x90, 0xee, 0xc0, 0x77, 0x82, 0x51, 0x7c, 0xfd,
+	0x9f, 0xcb, 0x9f, 0x56, 0x0b, 0x48, 0x6e, 0xeb, 0x6b, 0x6f, 0x6b, 0x7a, 0xcd, 0x24, 0xad, 0xa2,
+	0x4e, 0x7d, 0x32, 0xf8, 0x7c, 0x4c, 0x7b, 0x27, 0xc7, 0x14, 0x25, 0xfe, 0xaf, 0x1f, 0x1e, 0x3a,
+	0x69, 0x3c, 0xf4, 0xb5, 0xf1, 0xd0, 0x69, 0xe3, 0xa1, 0xb3, 0xc6, 0x43, 0xdf, 0x1b, 0x0f, 0x7d,
+	0xf9, 0xe9, 0xf5, 0xde, 0xee, 0xac, 0xe2, 0xb4, 0x6f, 0xfe, 0x80, 0x83, 0xdf, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0x86, 0xfd, 0x1f, 0x60, 0xe0, 0x02, 0x00, 0x00,
 }
 
 func (this *APIKey) Equal(that interface{}) bool {
@@ -131,6 +153,31 @@ func (this *APIKey) Equal(that interface{}) bool {
 	if this.CreatedAt != that1.CreatedAt {
 		return false
 	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if this.LastUsedAt != that1.LastUsedAt {
+		return false
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if len(this.Rules) != len(that1.Rules) {
+		return false
+	}
+	for i := range this.Rules {
+		if !this.Rules[i].Equal(&that1.Rules[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -142,6 +189,11 @@ type APIKeyFace interface {
 	GetObjectMeta() ObjectMeta
 	GetUsername() string
 	GetCreatedAt() int64
+	GetDescription() string
+	GetExpiresAt() int64
+	GetLastUsedAt() int64
+	GetNamespaces() []string
+	GetRules() []Rule
 }
 
 func (this *APIKey) Proto() github_com_golang_protobuf_proto.Message {
@@ -164,11 +216,36 @@ func (this *APIKey) GetCreatedAt() int64 {
 	return this.CreatedAt
 }
 
+func (this *APIKey) GetDescription() string {
+	return this.Description
+}
+
+func (this *APIKey) GetExpiresAt() int64 {
+	return this.ExpiresAt
+}
+
+func (this *APIKey) GetLastUsedAt() int64 {
+	return this.LastUsedAt
+}
+
+func (this *APIKey) GetNamespaces() []string {
+	return this.Namespaces
+}
+
+func (this *APIKey) GetRules() []Rule {
+	return this.Rules
+}
+
 func NewAPIKeyFromFace(that APIKeyFace) *APIKey {
 	this := &APIKey{}
 	this.ObjectMeta = that.GetObjectMeta()
 	this.Username = that.GetUsername()
 	this.CreatedAt = that.GetCreatedAt()
+	this.Description = that.GetDescription()
+	this.ExpiresAt = that.GetExpiresAt()
+	this.LastUsedAt = that.GetLastUsedAt()
+	this.Namespaces = that.GetNamespaces()
+	this.Rules = that.GetRules()
 	return this
 }
 
@@ -196,6 +273,46 @@ func (m *APIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApikey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintApikey(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LastUsedAt != 0 {
+		i = encodeVarintApikey(dAtA, i, uint64(m.LastUsedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintApikey(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintApikey(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.CreatedAt != 0 {
 		i = encodeVarintApikey(dAtA, i, uint64(m.CreatedAt))
 		i--
@@ -241,8 +358,30 @@ func NewPopulatedAPIKey(r randyApikey, easy bool) *APIKey {
 	if r.Intn(2) == 0 {
 		this.CreatedAt *= -1
 	}
+	this.Description = string(randStringApikey(r))
+	this.ExpiresAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ExpiresAt *= -1
+	}
+	this.LastUsedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LastUsedAt *= -1
+	}
+	v2 := r.Intn(10)
+	this.Namespaces = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Namespaces[i] = string(randStringApikey(r))
+	}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(5)
+		this.Rules = make([]Rule, v3)
+		for i := 0; i < v3; i++ {
+			v4 := NewPopulatedRule(r, easy)
+			this.Rules[i] = *v4
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedApikey(r, 4)
+		this.XXX_unrecognized = randUnrecognizedApikey(r, 9)
 	}
 	return this
 }
@@ -266,9 +405,9 @@ func randUTF8RuneApikey(r randyApikey) rune {
 	return rune(ru + 61)
 }
 func randStringApikey(r randyApikey) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
+	v5 := r.Intn(100)
+	tmps := make([]rune, v5)
+	for i := 0; i < v5; i++ {
 		tmps[i] = randUTF8RuneApikey(r)
 	}
 	return string(tmps)
@@ -290,11 +429,11 @@ func randFieldApikey(dAtA []byte, r randyApikey, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApikey(dAtA, uint64(key))
-		v3 := r.Int63()
+		v6 := r.Int63()
 		if r.Intn(2) == 0 {
-			v3 *= -1
+			v6 *= -1
 		}
-		dAtA = encodeVarintPopulateApikey(dAtA, uint64(v3))
+		dAtA = encodeVarintPopulateApikey(dAtA, uint64(v6))
 	case 1:
 		dAtA = encodeVarintPopulateApikey(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -334,6 +473,28 @@ func (m *APIKey) Size() (n int) {
 	if m.CreatedAt != 0 {
 		n += 1 + sovApikey(uint64(m.CreatedAt))
 	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovApikey(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovApikey(uint64(m.ExpiresAt))
+	}
+	if m.LastUsedAt != 0 {
+		n += 1 + sovApikey(uint64(m.LastUsedAt))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovApikey(uint64(l))
+		}
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovApikey(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -459,6 +620,142 @@ func (m *APIKey) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			m.LastUsedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApikey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, Rule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApikey(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package v2
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -39,6 +40,109 @@ func TestAPIKeyValidate(t *testing.T) {
 	assert.Equal(t, "226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", a.Name)
 	assert.Equal(t, "bar", a.Username)
 	assert.Equal(t, "", a.Namespace)
+
+	// Invalid namespace
+	a.Namespaces = []string{"not a namespace"}
+	assert.Error(t, a.Validate())
+	a.Namespaces = []string{"dev"}
+
+	// Invalid verb
+	a.Rules = []Rule{{Verbs: []string{"get,destroy"}, Resources: []string{"checks"}}}
+	assert.Error(t, a.Validate())
+
+	// Comma separated verbs and resources are valid, and left as is
+	a.Rules = []Rule{{Verbs: []string{"get,list"}, Resources: []string{"checks,entities"}}}
+	assert.NoError(t, a.Validate())
+	assert.Equal(t, []string{"get,list"}, a.Rules[0].Verbs)
+
+	// Verbs and resources are split by NormalizeRules
+	a.NormalizeRules()
+	assert.Equal(t, []string{"get", "list"}, a.Rules[0].Verbs)
+	assert.Equal(t, []string{"checks", "entities"}, a.Rules[0].Resources)
+
+	// Label selector
+	a.Rules[0].LabelSelector = map[string]string{"team": "a"}
+	assert.Error(t, a.Validate())
+}
+
+func TestAPIKeyIsExpired(t *testing.T) {
+	now := time.Unix(1000, 0)
+	a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+	assert.False(t, a.IsExpired(now))
+	a.ExpiresAt = 1001
+	assert.False(t, a.IsExpired(now))
+	a.ExpiresAt = 1000
+	assert.True(t, a.IsExpired(now))
+}
+
+func TestAPIKeyScopeAllows(t *testing.T) {
+	a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+	assert.Nil(t, a.Scope())
+
+	a.Namespaces = []string{"dev"}
+	a.Rules = []Rule{{Verbs: []string{"get", "list"}, Resources: []string{ResourceAll}}}
+	scope := a.Scope()
+
+	tests := []struct {
+		namespace, verb, resource, name string
+		want                            bool
+	}{
+		{"dev", "get", "checks", "check-cpu", true},
+		{"dev", "list", "entities", "", true},
+		{"dev", "create", "checks", "", false},
+		{"prod", "get", "checks", "check-cpu", false},
+		{"", "list", "namespaces", "", true},
+		{"", "get", "namespaces", "dev", true},
+		{"", "get", "namespaces", "prod", false},
+		{"", "list", "users", "", false},
+	}
+	for _, tt := range tests {
+		got := scope.Allows(tt.namespace, tt.verb, tt.resource, tt.name)
+		assert.Equal(t, tt.want, got, "%s %s %s/%s", tt.verb, tt.namespace, tt.resource, tt.name)
+	}
+}
+
+func TestAPIKeyRestrictTo(t *testing.T) {
+	scope := &APIKeyScope{
+		Namespaces: []string{"dev", "qa"},
+		Rules: []Rule{
+			{Verbs: []string{VerbAll}, Resources: []string{ResourceAll}, ResourceNames: []string{"web"}},
+			{Verbs: []string{"get", "list"}, Resources: []string{ResourceAll}},
+		},
+	}
+
+	// A key created without restrictions inherits the scope
+	a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+	assert.NoError(t, a.RestrictTo(scope))
+	assert.Equal(t, scope.Namespaces, a.Namespaces)
+	assert.Equal(t, scope.Rules, a.Rules)
+
+	// A key can restrict the scope further
+	a = FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+	a.Namespaces = []string{"dev"}
+	a.Rules = []Rule{
+		{Verbs: []string{"update"}, Resources: []string{"checks"}, ResourceNames: []string{"web"}},
+		{Verbs: []string{"get"}, Resources: []string{"entities"}},
+	}
+	assert.NoError(t, a.RestrictTo(scope))
+	assert.Equal(t, []string{"dev"}, a.Namespaces)
+	assert.Len(t, a.Rules, 2)
+
+	// A key can't go beyond the scope
+	a.Namespaces = []string{"prod"}
+	assert.Error(t, a.RestrictTo(scope))
+	a.Namespaces = []string{"dev"}
+	a.Rules = []Rule{{Verbs: []string{"create"}, Resources: []string{"apikeys"}}}
+	assert.Error(t, a.RestrictTo(scope))
+	a.Rules = []Rule{{Verbs: []string{"update"}, Resources: []string{"checks"}}}
+	assert.Error(t, a.RestrictTo(scope))
+	a.Rules = []Rule{{Verbs: []string{VerbAll}, Resources: []string{"checks"}, ResourceNames: []string{"web"}}}
+	assert.NoError(t, a.RestrictTo(scope))
+
+	// Keys created without a scope are not restricted
+	a = FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+	assert.NoError(t, a.RestrictTo(nil))
+	assert.Nil(t, a.Scope())
 }
 
 func TestAPIKeyFields(t *testing.T) {
@@ -131,6 +131,9 @@ func NewCheck(c *CheckConfig) *Check {
 		MaxOutputSize:          c.MaxOutputSize,
 		Scheduler:              c.Scheduler,
 		Pipelines:              c.Pipelines,
+		MaxRetries:             c.MaxRetries,
+		RetryInterval:          c.RetryInterval,
+		MaxBackoffInterval:     c.MaxBackoffInterval,
 	}
 	if check.Labels == nil {
 		check.Labels = make(map[string]string)
@@ -251,9 +254,34 @@ func (c *Check) Validate() error {
 		return err
 	}
 
+	if err := validateRetries(c.MaxRetries, c.RetryInterval, c.MaxBackoffInterval, c.Interval, c.Cron); err != nil {
+		return err
+	}
+
 	return c.Subdue.Validate()
 }
 
+// validateRetries validates the retry and backoff settings of a check.
+func validateRetries(maxRetries, retryInterval, maxBackoffInterval, interval uint32, cron string) error {
+	if maxRetries > 0 {
+		if retryInterval == 0 {
+			return errors.New("retry_interval must be greater than 0 when max_retries is set")
+		}
+		if interval > 0 && retryInterval >= interval {
+			return errors.New("retry_interval must be less than the check interval")
+		}
+	}
+	if maxBackoffInterval > 0 {
+		if cron != "" {
+			return errors.New("max_backoff_interval cannot be used with a cron schedule")
+		}
+		if maxBackoffInterval < interval {
+			return errors.New("max_backoff_interval must be greater than or equal to the check interval")
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (c *Check) MarshalJSON() ([]byte, error) {
 	if c == nil {
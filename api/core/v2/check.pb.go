@@ -316,9 +316,19 @@ type CheckConfig struct {
 	Pipelines              []*ResourceReference  `protobuf:"bytes,32,rep,name=pipelines,proto3" json:"pipelines"`
 	OutputMetricThresholds []*MetricThreshold    `protobuf:"bytes,33,rep,name=output_metric_thresholds,json=outputMetricThresholds,proto3" json:"output_metric_thresholds,omitempty" yaml: "output_metric_thresholds,omitempty"`
 	Subdues                []*TimeWindowRepeated `protobuf:"bytes,34,rep,name=subdues,proto3" json:"subdues,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
-	XXX_unrecognized       []byte                `json:"-"`
-	XXX_sizecache          int32                 `json:"-"`
+	// MaxRetries is the number of times a failing check is executed again, at
+	// the retry interval, before its non-zero status is handled.
+	MaxRetries uint32 `protobuf:"varint,35,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// RetryInterval is the number of seconds between two retries of a failing
+	// check.
+	RetryInterval uint32 `protobuf:"varint,36,opt,name=retry_interval,json=retryInterval,proto3" json:"retry_interval,omitempty"`
+	// MaxBackoffInterval enables the exponential backoff of the check interval
+	// while the check is critical. The interval is doubled after each critical
+	// execution, up to this number of seconds.
+	MaxBackoffInterval   uint32   `protobuf:"varint,37,opt,name=max_backoff_interval,json=maxBackoffInterval,proto3" json:"max_backoff_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CheckConfig) Reset()         { *m = CheckConfig{} }
@@ -468,6 +478,16 @@ type Check struct {
 	// the check status.
 	OutputMetricThresholds []*MetricThreshold    `protobuf:"bytes,47,rep,name=output_metric_thresholds,json=outputMetricThresholds,proto3" json:"output_metric_thresholds,omitempty" yaml: "output_metric_thresholds,omitempty"`
 	Subdues                []*TimeWindowRepeated `protobuf:"bytes,48,rep,name=subdues,proto3" json:"subdues,omitempty"`
+	// MaxRetries is the number of times a failing check is executed again, at
+	// the retry interval, before its non-zero status is handled.
+	MaxRetries uint32 `protobuf:"varint,49,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// RetryInterval is the number of seconds between two retries of a failing
+	// check.
+	RetryInterval uint32 `protobuf:"varint,50,opt,name=retry_interval,json=retryInterval,proto3" json:"retry_interval,omitempty"`
+	// MaxBackoffInterval enables the exponential backoff of the check interval
+	// while the check is critical. The interval is doubled after each critical
+	// execution, up to this number of seconds.
+	MaxBackoffInterval uint32 `protobuf:"varint,51,opt,name=max_backoff_interval,json=maxBackoffInterval,proto3" json:"max_backoff_interval,omitempty"`
 	// ExtendedAttributes store serialized arbitrary JSON-encoded data
 	ExtendedAttributes   []byte   `protobuf:"bytes,99,opt,name=ExtendedAttributes,proto3" json:"-"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -593,121 +613,127 @@ func init() {
 }
 
 var fileDescriptor_6b843265b29f5373 = []byte{
-	// 1812 bytes of a gzipped FileDescriptorProto
+	// 1906 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x73, 0xdb, 0xc6,
-	0xf5, 0x37, 0x24, 0x8b, 0x12, 0x97, 0xa6, 0x7e, 0xac, 0x25, 0x7b, 0xad, 0xd8, 0x04, 0xcd, 0x6f,
-	0x9c, 0xf0, 0x1b, 0xc7, 0x94, 0x4d, 0x37, 0x93, 0xd4, 0xe3, 0xe9, 0xd4, 0x50, 0xed, 0x2a, 0x6d,
-	0x1c, 0x7b, 0xd6, 0x6a, 0x3d, 0xd3, 0x99, 0x0e, 0x66, 0x09, 0xac, 0x48, 0x54, 0x24, 0xc0, 0x62,
-	0x17, 0x94, 0x98, 0x4b, 0x7b, 0xec, 0xb1, 0xc7, 0x1e, 0x73, 0x4c, 0x2f, 0xed, 0xb5, 0x7f, 0x42,
-	0x8e, 0xb9, 0xf6, 0x82, 0x69, 0xd5, 0x1b, 0x8e, 0x39, 0xf5, 0xd8, 0xd9, 0x87, 0x05, 0x08, 0x52,
-	0x94, 0x23, 0xcf, 0x38, 0xd3, 0x4c, 0x27, 0x17, 0x62, 0xf7, 0xb3, 0xef, 0xb3, 0x3f, 0xde, 0x7e,
-	0x76, 0xdf, 0x5b, 0xa2, 0x7b, 0x5d, 0x4f, 0xf6, 0xa2, 0x4e, 0xcb, 0x09, 0x06, 0x3b, 0x82, 0xfb,
-	0x22, 0x4a, 0x7f, 0xef, 0x74, 0x83, 0x1d, 0x36, 0xf4, 0x76, 0x9c, 0x20, 0xe4, 0x3b, 0xa3, 0xf6,
-	0x8e, 0xd3, 0xe3, 0xce, 0x61, 0x6b, 0x18, 0x06, 0x32, 0xc0, 0x55, 0xb0, 0x68, 0xa9, 0xa6, 0xd6,
-	0xa8, 0xbd, 0xfd, 0x83, 0x42, 0x0f, 0xdd, 0xa0, 0x1b, 0xec, 0x80, 0x55, 0x27, 0x3a, 0xf8, 0xf1,
-	0xe8, 0x5e, 0xeb, 0x7e, 0xeb, 0x1e, 0x80, 0x80, 0x41, 0x29, 0xed, 0x64, 0xfb, 0x9c, 0xe3, 0x32,
-	0x21, 0xb8, 0xd4, 0x94, 0xbb, 0xe7, 0xa3, 0xf4, 0x82, 0xe0, 0xf0, 0xf5, 0x18, 0x03, 0x2e, 0x99,
-	0x66, 0x3c, 0x3c, 0x37, 0x23, 0xf4, 0x1c, 0x5b, 0xf6, 0x42, 0x2e, 0x7a, 0x41, 0xdf, 0xd5, 0xec,
-	0xfb, 0xaf, 0xc3, 0x16, 0x9a, 0xf4, 0xa3, 0xf3, 0x91, 0x42, 0x2e, 0x82, 0x28, 0x74, 0xb8, 0x1d,
-	0xf2, 0x03, 0x1e, 0x72, 0xdf, 0xe1, 0x9a, 0xdf, 0x3e, 0x1f, 0x5f, 0x70, 0x27, 0xcc, 0x5d, 0xf9,
-	0xe1, 0xf9, 0x38, 0xd2, 0x1b, 0x70, 0xfb, 0xc8, 0xf3, 0xdd, 0xe0, 0x28, 0x25, 0x36, 0xfe, 0xbc,
-	0x88, 0x2e, 0xed, 0x2a, 0x2d, 0x50, 0xfe, 0xdb, 0x88, 0x0b, 0x89, 0x3f, 0x42, 0x25, 0x27, 0xf0,
-	0x0f, 0xbc, 0x2e, 0x31, 0xea, 0x46, 0xb3, 0xd2, 0xde, 0x6e, 0x4d, 0xa9, 0xa3, 0x05, 0xc6, 0xbb,
-	0x60, 0x61, 0x5d, 0xfc, 0x32, 0x36, 0x0d, 0xaa, 0xed, 0x71, 0x1b, 0x95, 0x60, 0x77, 0x05, 0x59,
-	0xa8, 0x2f, 0x36, 0x2b, 0xed, 0xcd, 0x19, 0xe6, 0x23, 0xd5, 0x08, 0x9c, 0x0b, 0x54, 0x5b, 0xe2,
-	0x0f, 0xd0, 0x92, 0xda, 0x5e, 0x41, 0x16, 0x81, 0x72, 0x6d, 0x86, 0xb2, 0x17, 0x04, 0xc5, 0xb1,
-	0x2e, 0xd0, 0xd4, 0x1a, 0x37, 0x50, 0xe9, 0x63, 0x21, 0x22, 0xee, 0x92, 0x8b, 0x75, 0xa3, 0xb9,
-	0x68, 0xa1, 0x24, 0x36, 0x4b, 0x1e, 0x20, 0x54, 0xb7, 0xe0, 0x5f, 0xa3, 0x8a, 0x32, 0xb6, 0xf5,
-	0x9c, 0x96, 0x60, 0x80, 0xdb, 0xf3, 0x56, 0xa3, 0x97, 0x0e, 0xa3, 0xc1, 0x24, 0xc5, 0x63, 0x5f,
-	0x86, 0x63, 0x6b, 0x2d, 0x89, 0xcd, 0x62, 0x1f, 0x14, 0xf5, 0x72, 0x0b, 0x4c, 0xd0, 0x72, 0xba,
-	0x03, 0x82, 0x94, 0xea, 0x8b, 0xcd, 0x32, 0xcd, 0xaa, 0xdb, 0x2f, 0xd1, 0xda, 0x4c, 0x4f, 0x78,
-	0x1d, 0x2d, 0x1e, 0xf2, 0x31, 0x78, 0xb4, 0x4c, 0x55, 0x11, 0xb7, 0xd0, 0xd2, 0x88, 0xf5, 0x23,
-	0x4e, 0x16, 0xc0, 0xcb, 0x64, 0x9e, 0xaf, 0x3e, 0xf1, 0x84, 0xa4, 0xa9, 0xd9, 0x83, 0x85, 0x8f,
-	0x8c, 0xc6, 0xc7, 0xa8, 0x9c, 0xe3, 0xf8, 0x61, 0xee, 0x6d, 0xe3, 0x15, 0xde, 0x5e, 0x55, 0x5e,
-	0x53, 0xce, 0xd1, 0x2b, 0xd0, 0xdf, 0xc6, 0x5f, 0x0d, 0x54, 0x7d, 0x1e, 0x06, 0xc7, 0x63, 0xbd,
-	0x76, 0x81, 0x2d, 0xb4, 0xc1, 0x7d, 0xe9, 0xc9, 0xb1, 0xcd, 0xa4, 0x0c, 0xbd, 0x4e, 0x24, 0x79,
-	0xda, 0x75, 0xd9, 0xda, 0x4a, 0x62, 0xf3, 0x74, 0x23, 0x5d, 0x4f, 0xa1, 0x47, 0x39, 0x82, 0x4d,
-	0xb4, 0x24, 0x86, 0x7d, 0x36, 0x86, 0x45, 0xad, 0x58, 0xe5, 0x24, 0x36, 0x53, 0x80, 0xa6, 0x1f,
-	0xfc, 0x43, 0xb4, 0x0a, 0x05, 0xdb, 0x09, 0x46, 0x3c, 0x64, 0x5d, 0x4e, 0x16, 0xeb, 0x46, 0xb3,
-	0x6a, 0xe1, 0x24, 0x36, 0x67, 0x5a, 0x68, 0x15, 0xea, 0xbb, 0xba, 0xda, 0xf8, 0xfd, 0x1a, 0xaa,
-	0x14, 0xb4, 0xa7, 0xfc, 0xef, 0x04, 0x83, 0x01, 0xf3, 0x5d, 0xed, 0xd6, 0xac, 0x8a, 0x9b, 0x68,
-	0xa5, 0xc7, 0x7c, 0xb7, 0xcf, 0xc3, 0x54, 0x56, 0x65, 0xeb, 0x52, 0x12, 0x9b, 0x39, 0x46, 0xf3,
-	0x12, 0xfe, 0x29, 0xba, 0xdc, 0xf3, 0xba, 0x3d, 0xfb, 0xa0, 0xcf, 0x86, 0x93, 0xb3, 0x0f, 0x9a,
-	0xaa, 0x5a, 0x57, 0x93, 0xd8, 0x9c, 0xd7, 0x4c, 0x37, 0x14, 0xf8, 0xa4, 0xcf, 0x86, 0xfb, 0x19,
-	0xa4, 0x86, 0xf4, 0x7c, 0xc9, 0xc3, 0x11, 0xeb, 0x93, 0x25, 0x60, 0xc3, 0x90, 0x19, 0x46, 0xf3,
-	0x12, 0xfe, 0x09, 0xc2, 0xfd, 0xe0, 0x68, 0x76, 0xc4, 0x12, 0x70, 0xae, 0x24, 0xb1, 0x39, 0xa7,
-	0x95, 0xae, 0xf7, 0x83, 0xa3, 0xe9, 0xf1, 0x6e, 0xa1, 0xe5, 0x61, 0xd4, 0xe9, 0x7b, 0xa2, 0x47,
-	0xca, 0xe0, 0xea, 0x4a, 0x12, 0x9b, 0x19, 0x44, 0xb3, 0x82, 0x72, 0x77, 0x18, 0xf9, 0x70, 0xe8,
-	0xb5, 0x56, 0x10, 0xf8, 0x03, 0xdc, 0x3d, 0xdd, 0x42, 0xab, 0xba, 0xae, 0xe5, 0xfd, 0x21, 0xaa,
-	0x8a, 0xa8, 0x23, 0x9c, 0xd0, 0x1b, 0x4a, 0x2f, 0xf0, 0x05, 0xa9, 0x00, 0x73, 0x23, 0x89, 0xcd,
-	0xe9, 0x06, 0x3a, 0x5d, 0xc5, 0x1f, 0x20, 0xfc, 0xf8, 0x58, 0x72, 0xdf, 0xe5, 0xee, 0x44, 0x19,
-	0xe4, 0x52, 0xdd, 0x68, 0x5e, 0xb2, 0x96, 0x92, 0xd8, 0x34, 0xee, 0xd0, 0x39, 0x06, 0x78, 0x1f,
-	0x6d, 0x0c, 0x95, 0x1e, 0x6d, 0xad, 0x33, 0x9f, 0x0d, 0x38, 0xa9, 0xaa, 0x8d, 0xb5, 0x9a, 0x27,
-	0xb1, 0xb9, 0x06, 0x62, 0x7d, 0x0c, 0x6d, 0x9f, 0xb2, 0x01, 0x57, 0x8a, 0x3c, 0x65, 0x4f, 0xd7,
-	0x86, 0xd3, 0x56, 0xf8, 0x29, 0xaa, 0x40, 0xa0, 0xb3, 0xd3, 0x4b, 0x66, 0x15, 0x4e, 0xca, 0xd5,
-	0x39, 0x97, 0x8c, 0x3a, 0x52, 0xd6, 0x65, 0x7d, 0x58, 0x8a, 0x1c, 0x8a, 0xa0, 0xb2, 0x07, 0xd7,
-	0x8e, 0xd2, 0xb7, 0x74, 0x3d, 0x9f, 0xac, 0x15, 0xf4, 0xad, 0x00, 0x9a, 0x7e, 0xf0, 0x23, 0x54,
-	0x12, 0x51, 0xc7, 0x8d, 0x38, 0x59, 0x87, 0x63, 0x7d, 0x63, 0x66, 0xa8, 0x7d, 0x6f, 0xc0, 0x5f,
-	0xc2, 0xf5, 0xfb, 0xb2, 0xc7, 0xfd, 0xf4, 0xda, 0x4a, 0x09, 0x54, 0x7f, 0x31, 0x46, 0x17, 0x9d,
-	0x30, 0xf0, 0xc9, 0x06, 0x88, 0x1a, 0xca, 0xf8, 0x1a, 0x5a, 0x94, 0xb2, 0x4f, 0x30, 0xdc, 0x75,
-	0xcb, 0x49, 0x6c, 0xaa, 0x2a, 0x55, 0x3f, 0x4a, 0x09, 0x6a, 0xd7, 0x82, 0x48, 0x92, 0xcb, 0x20,
-	0x22, 0x50, 0x82, 0x86, 0x68, 0x56, 0xc0, 0xbb, 0x68, 0x35, 0x75, 0x57, 0xa8, 0xcf, 0x3b, 0xd9,
-	0x84, 0x09, 0x5e, 0x9f, 0x99, 0xe0, 0xd4, 0x9d, 0x40, 0xab, 0xc3, 0xa9, 0x2b, 0xe2, 0x2e, 0xaa,
-	0x84, 0x41, 0xe4, 0xbb, 0x76, 0x18, 0x74, 0x3c, 0x9f, 0x6c, 0x81, 0x13, 0xe0, 0x92, 0x2c, 0xc0,
-	0x14, 0x41, 0x85, 0xaa, 0x32, 0xfe, 0x19, 0xda, 0x0c, 0x22, 0x39, 0x8c, 0xa4, 0xad, 0x03, 0xec,
-	0x41, 0x10, 0x0e, 0x98, 0x24, 0x57, 0x60, 0x63, 0x49, 0x12, 0x9b, 0x73, 0xdb, 0x29, 0x4e, 0xd1,
-	0xa7, 0x00, 0x3e, 0x01, 0x0c, 0x3f, 0x47, 0x57, 0xa6, 0x6d, 0xf3, 0x43, 0x7e, 0x15, 0xa4, 0xb9,
-	0x9d, 0xc4, 0xe6, 0x19, 0x16, 0x74, 0xb3, 0xd8, 0xdf, 0x5e, 0x76, 0xfc, 0xdf, 0x45, 0x2b, 0xdc,
-	0x1f, 0xd9, 0x23, 0x16, 0x0a, 0x42, 0x26, 0x17, 0x45, 0x86, 0xd1, 0x65, 0xee, 0x8f, 0x7e, 0xc9,
-	0x42, 0x81, 0x7f, 0x81, 0x56, 0x54, 0x4a, 0xe1, 0x32, 0xc9, 0xc8, 0x36, 0xf8, 0x6d, 0x36, 0x50,
-	0x3d, 0xeb, 0xfc, 0x86, 0x3b, 0xaa, 0x7f, 0x66, 0xd5, 0x94, 0x8a, 0xbe, 0x8a, 0x4d, 0x43, 0x9d,
-	0xe6, 0x8c, 0xf6, 0x7e, 0x30, 0xf0, 0x24, 0x1f, 0x0c, 0xe5, 0x98, 0xe6, 0x5d, 0xe1, 0x77, 0xd0,
-	0xda, 0x80, 0x1d, 0xdb, 0x7a, 0xce, 0xc2, 0xfb, 0x8c, 0x93, 0xb7, 0xd4, 0x16, 0xd3, 0xea, 0x80,
-	0x1d, 0x3f, 0x03, 0xf4, 0x85, 0xf7, 0x19, 0xc7, 0xb7, 0xd0, 0xaa, 0xeb, 0x09, 0x87, 0x85, 0xae,
-	0xb6, 0x25, 0xd7, 0x95, 0xeb, 0x69, 0x55, 0xa3, 0xa9, 0x29, 0x7e, 0x38, 0x89, 0x48, 0x37, 0x40,
-	0xe8, 0x5b, 0x33, 0x93, 0x7c, 0x01, 0xad, 0xa9, 0x42, 0xb4, 0x65, 0x1e, 0xb5, 0xf0, 0x1f, 0x0d,
-	0x84, 0xa7, 0xbd, 0x27, 0x59, 0x57, 0x90, 0x1a, 0xf4, 0x34, 0x1b, 0x9e, 0x52, 0x47, 0xee, 0xb3,
-	0xae, 0xb5, 0x97, 0xc4, 0xe6, 0xf5, 0xd3, 0xbc, 0xc9, 0x7a, 0xbf, 0x8e, 0xcd, 0xb7, 0xc7, 0x6c,
-	0xd0, 0x7f, 0x50, 0x6f, 0xbc, 0xca, 0xac, 0x41, 0xd7, 0x8b, 0x7b, 0xb4, 0xcf, 0xba, 0x4a, 0x6f,
-	0x65, 0xe1, 0xf4, 0xb8, 0x1b, 0xf5, 0x79, 0x48, 0x4c, 0x90, 0x0c, 0x86, 0x1b, 0xe4, 0xeb, 0xd8,
-	0x2c, 0xeb, 0x3e, 0xef, 0x34, 0xe8, 0xc4, 0x08, 0x3f, 0x45, 0xe5, 0xa1, 0x37, 0xe4, 0x7d, 0xcf,
-	0xe7, 0x82, 0xd4, 0x61, 0xea, 0xf5, 0x99, 0xa9, 0x53, 0x9d, 0x76, 0xd1, 0x2c, 0xeb, 0xb2, 0xaa,
-	0x49, 0x6c, 0x4e, 0x68, 0x74, 0x52, 0xc4, 0x7f, 0x31, 0x10, 0x99, 0x99, 0x74, 0x76, 0x05, 0x0b,
-	0x72, 0x13, 0xba, 0xaf, 0xcd, 0xf7, 0x4c, 0x66, 0x66, 0xed, 0x27, 0xb1, 0xd9, 0x38, 0xab, 0x8f,
-	0x29, 0x2f, 0xbd, 0x37, 0xdf, 0x4b, 0x73, 0x8c, 0x1b, 0xf4, 0xca, 0x94, 0xaf, 0x72, 0x13, 0x4c,
-	0xd1, 0x72, 0x7a, 0x8d, 0x08, 0xd2, 0x80, 0xe9, 0xdd, 0x3c, 0xf3, 0x02, 0xa2, 0x7c, 0xc8, 0x99,
-	0xe4, 0x6e, 0x1a, 0xdd, 0x35, 0xab, 0x20, 0xd3, 0xac, 0xa3, 0x07, 0x2b, 0x7f, 0xf8, 0xdc, 0xbc,
-	0xf0, 0xc5, 0xe7, 0xa6, 0xd1, 0xf8, 0xfb, 0x26, 0x5a, 0x82, 0x10, 0xfc, 0x7d, 0xf0, 0xfd, 0x8e,
-	0x06, 0xdf, 0xef, 0xa3, 0xe8, 0xff, 0x62, 0x14, 0xdd, 0x46, 0x2b, 0x6e, 0x14, 0x32, 0xb5, 0xc5,
-	0x10, 0x39, 0x0d, 0x9a, 0xd7, 0x95, 0xf8, 0xf9, 0x31, 0x77, 0x22, 0xc9, 0x5d, 0x72, 0x15, 0x56,
-	0x96, 0xc6, 0x30, 0x8d, 0xd1, 0xbc, 0x84, 0x9f, 0xa0, 0xe5, 0x9e, 0x27, 0x64, 0x10, 0x8e, 0x21,
-	0xd8, 0x55, 0xda, 0x6f, 0xcd, 0x7b, 0x0b, 0xed, 0xa5, 0x26, 0xd6, 0x9a, 0xde, 0xc5, 0x8c, 0x43,
-	0xb3, 0x82, 0x7a, 0x7b, 0xa5, 0x2f, 0x2d, 0x72, 0xed, 0xf4, 0xdb, 0x2b, 0xfd, 0x2a, 0x1b, 0x1d,
-	0xa9, 0xb6, 0x41, 0x7c, 0x60, 0x93, 0x22, 0x54, 0x7f, 0xf1, 0xa6, 0x92, 0x01, 0x93, 0x69, 0xcc,
-	0x2b, 0xd3, 0xb4, 0xa2, 0x98, 0xaa, 0x10, 0x09, 0x88, 0x71, 0x55, 0xbd, 0xb9, 0x80, 0x50, 0xfd,
-	0x55, 0xc7, 0x58, 0x06, 0x92, 0xf5, 0x6d, 0xa0, 0xd8, 0x4e, 0x8f, 0xf9, 0x5d, 0x4e, 0x6e, 0x4c,
-	0x8e, 0xf1, 0xe9, 0x56, 0xba, 0x0e, 0xd8, 0x0b, 0x05, 0xed, 0x02, 0x82, 0x5b, 0x68, 0xb9, 0xcf,
-	0x84, 0xb4, 0x83, 0x43, 0x52, 0x83, 0x85, 0x6c, 0x9d, 0xc4, 0x66, 0xe9, 0x13, 0x26, 0xe4, 0xb3,
-	0x9f, 0xab, 0x85, 0xeb, 0x46, 0x5a, 0x52, 0x85, 0x67, 0x87, 0xf8, 0x1e, 0xaa, 0x04, 0x8e, 0x13,
-	0x85, 0x10, 0x34, 0x04, 0xc4, 0xa3, 0xc5, 0x74, 0xdf, 0x0a, 0x30, 0x2d, 0x56, 0xf0, 0xa7, 0x68,
-	0xab, 0x50, 0xb5, 0x8f, 0x98, 0xe4, 0xe1, 0x80, 0x85, 0x87, 0xa4, 0x0e, 0xe4, 0x6b, 0x49, 0x6c,
-	0xce, 0x37, 0xa0, 0x9b, 0x05, 0xf8, 0x65, 0x86, 0xe2, 0x3a, 0x5a, 0x11, 0x5e, 0x5f, 0x81, 0x2e,
-	0x84, 0x9f, 0xb2, 0x7e, 0x81, 0xe7, 0x28, 0xde, 0xc9, 0xde, 0xd3, 0xe9, 0xf5, 0x7f, 0x79, 0xce,
-	0x21, 0xd5, 0x1c, 0xfd, 0x92, 0x3e, 0x2b, 0x43, 0xfb, 0xbf, 0x37, 0x9a, 0xa1, 0xbd, 0xfd, 0x06,
-	0x32, 0xb4, 0x5b, 0xe7, 0xcd, 0xd0, 0xde, 0xf9, 0x56, 0x33, 0xb4, 0x77, 0xcf, 0x97, 0xa1, 0x35,
-	0xbf, 0x21, 0x43, 0xfb, 0xff, 0xd7, 0xcf, 0xd0, 0xee, 0xa2, 0x8a, 0x27, 0xec, 0x5c, 0x00, 0xef,
-	0x4d, 0x2e, 0x8e, 0x02, 0x4c, 0x91, 0x27, 0x5e, 0x64, 0x6a, 0x38, 0x23, 0xa7, 0xbb, 0xfd, 0x5f,
-	0xcc, 0xe9, 0x6e, 0x17, 0x73, 0xba, 0xf7, 0x41, 0x64, 0x90, 0x7f, 0xe5, 0x60, 0x31, 0x9d, 0xdb,
-	0x47, 0x95, 0xe7, 0x61, 0xe0, 0x70, 0x21, 0xb8, 0x6b, 0x8d, 0xc9, 0x1d, 0x30, 0x6f, 0x2b, 0x15,
-	0x0d, 0x33, 0xd8, 0xee, 0x8c, 0xa7, 0xe6, 0xb5, 0xa9, 0xe7, 0x55, 0x34, 0x68, 0xd0, 0x62, 0x37,
-	0xd3, 0x49, 0x62, 0xeb, 0xdb, 0x4d, 0x12, 0x77, 0xbe, 0xdb, 0x49, 0xe2, 0xdd, 0x37, 0x94, 0x24,
-	0x9e, 0xf1, 0xea, 0x77, 0xbe, 0xe1, 0xd5, 0x5f, 0xc8, 0x2d, 0x7f, 0xa7, 0xff, 0x86, 0xdc, 0x9b,
-	0x44, 0x19, 0x1d, 0x07, 0x8c, 0x33, 0xe3, 0x40, 0x31, 0xf6, 0x2d, 0xbc, 0x32, 0xf6, 0xdd, 0x44,
-	0x2b, 0x2a, 0xad, 0x1b, 0x7a, 0x7e, 0x17, 0xfe, 0x71, 0x5a, 0xc9, 0x26, 0x95, 0xc3, 0x56, 0xfd,
-	0xdf, 0xff, 0xac, 0x19, 0x5f, 0x9c, 0xd4, 0x8c, 0xbf, 0x9d, 0xd4, 0x8c, 0x2f, 0x4f, 0x6a, 0xc6,
-	0x57, 0x27, 0x35, 0xe3, 0x1f, 0x27, 0x35, 0xe3, 0x4f, 0xff, 0xaa, 0x5d, 0xf8, 0xd5, 0xc2, 0xa8,
-	0xdd, 0x29, 0xc1, 0x3f, 0xa6, 0xf7, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x42, 0x79, 0x0e, 0x39,
-	0x62, 0x17, 0x00, 0x00,
+	0x15, 0x36, 0xac, 0x88, 0x12, 0x97, 0xa6, 0x25, 0xad, 0x25, 0x79, 0xad, 0xd8, 0x04, 0xcd, 0xd8,
+	0x89, 0x1a, 0xc7, 0x94, 0x45, 0x37, 0x93, 0xd4, 0xe3, 0xe9, 0xd4, 0x50, 0xed, 0xca, 0x6d, 0x1c,
+	0x7b, 0xd6, 0x6a, 0x3d, 0xd3, 0x99, 0x0e, 0x66, 0x09, 0xac, 0x48, 0x54, 0x24, 0xc0, 0x62, 0x17,
+	0x94, 0x98, 0x4b, 0xaf, 0x3d, 0xf6, 0xd8, 0x63, 0x8e, 0xe9, 0xa5, 0xed, 0xb1, 0x7f, 0x42, 0x8e,
+	0xb9, 0xf5, 0x86, 0x69, 0xd5, 0x1b, 0x8e, 0x39, 0xf5, 0xd8, 0xd9, 0x87, 0x05, 0x08, 0x52, 0x94,
+	0x23, 0x4f, 0xec, 0x69, 0xa6, 0x93, 0x0b, 0xb1, 0xfb, 0xbd, 0x1f, 0xbb, 0x78, 0xfb, 0xed, 0x7b,
+	0x8f, 0x40, 0xdb, 0x1d, 0x4f, 0x76, 0xa3, 0x76, 0xd3, 0x09, 0xfa, 0x5b, 0x82, 0xfb, 0x22, 0x4a,
+	0x7f, 0x6f, 0x77, 0x82, 0x2d, 0x36, 0xf0, 0xb6, 0x9c, 0x20, 0xe4, 0x5b, 0xc3, 0xd6, 0x96, 0xd3,
+	0xe5, 0xce, 0x41, 0x73, 0x10, 0x06, 0x32, 0xc0, 0x55, 0xd0, 0x68, 0x2a, 0x51, 0x73, 0xd8, 0xda,
+	0xf8, 0x61, 0xc1, 0x43, 0x27, 0xe8, 0x04, 0x5b, 0xa0, 0xd5, 0x8e, 0xf6, 0x7f, 0x32, 0xdc, 0x6e,
+	0xde, 0x6d, 0x6e, 0x03, 0x08, 0x18, 0x8c, 0x52, 0x27, 0x1b, 0x67, 0x5c, 0x97, 0x09, 0xc1, 0xa5,
+	0x36, 0xb9, 0x73, 0x36, 0x93, 0x6e, 0x10, 0x1c, 0xbc, 0x9a, 0x45, 0x9f, 0x4b, 0xa6, 0x2d, 0xee,
+	0x9f, 0xd9, 0x22, 0xf4, 0x1c, 0x5b, 0x76, 0x43, 0x2e, 0xba, 0x41, 0xcf, 0xd5, 0xd6, 0x77, 0x5f,
+	0xc5, 0x5a, 0x68, 0xa3, 0x1f, 0x9f, 0xcd, 0x28, 0xe4, 0x22, 0x88, 0x42, 0x87, 0xdb, 0x21, 0xdf,
+	0xe7, 0x21, 0xf7, 0x1d, 0xae, 0xed, 0x5b, 0x67, 0xb3, 0x17, 0xdc, 0x09, 0xf3, 0x50, 0x7e, 0x74,
+	0x36, 0x1b, 0xe9, 0xf5, 0xb9, 0x7d, 0xe8, 0xf9, 0x6e, 0x70, 0x98, 0x1a, 0x36, 0xfe, 0x3c, 0x87,
+	0x2e, 0xec, 0x28, 0x2e, 0x50, 0xfe, 0xbb, 0x88, 0x0b, 0x89, 0x3f, 0x46, 0x25, 0x27, 0xf0, 0xf7,
+	0xbd, 0x0e, 0x31, 0xea, 0xc6, 0x66, 0xa5, 0xb5, 0xd1, 0x9c, 0x60, 0x47, 0x13, 0x94, 0x77, 0x40,
+	0xc3, 0x7a, 0xeb, 0xcb, 0xd8, 0x34, 0xa8, 0xd6, 0xc7, 0x2d, 0x54, 0x82, 0xd3, 0x15, 0xe4, 0x7c,
+	0x7d, 0x6e, 0xb3, 0xd2, 0x5a, 0x9d, 0xb2, 0x7c, 0xa0, 0x84, 0x60, 0x73, 0x8e, 0x6a, 0x4d, 0xfc,
+	0x21, 0x9a, 0x57, 0xc7, 0x2b, 0xc8, 0x1c, 0x98, 0x5c, 0x99, 0x32, 0xd9, 0x0d, 0x82, 0xe2, 0x5a,
+	0xe7, 0x68, 0xaa, 0x8d, 0x1b, 0xa8, 0xf4, 0x58, 0x88, 0x88, 0xbb, 0xe4, 0xad, 0xba, 0xb1, 0x39,
+	0x67, 0xa1, 0x24, 0x36, 0x4b, 0x1e, 0x20, 0x54, 0x4b, 0xf0, 0x6f, 0x50, 0x45, 0x29, 0xdb, 0x7a,
+	0x4f, 0xf3, 0xb0, 0xc0, 0xad, 0x59, 0x6f, 0xa3, 0x5f, 0x1d, 0x56, 0x83, 0x4d, 0x8a, 0x87, 0xbe,
+	0x0c, 0x47, 0xd6, 0x52, 0x12, 0x9b, 0x45, 0x1f, 0x14, 0x75, 0x73, 0x0d, 0x4c, 0xd0, 0x42, 0x7a,
+	0x02, 0x82, 0x94, 0xea, 0x73, 0x9b, 0x65, 0x9a, 0x4d, 0x37, 0x5e, 0xa0, 0xa5, 0x29, 0x4f, 0x78,
+	0x19, 0xcd, 0x1d, 0xf0, 0x11, 0x44, 0xb4, 0x4c, 0xd5, 0x10, 0x37, 0xd1, 0xfc, 0x90, 0xf5, 0x22,
+	0x4e, 0xce, 0x43, 0x94, 0xc9, 0xac, 0x58, 0x7d, 0xe2, 0x09, 0x49, 0x53, 0xb5, 0x7b, 0xe7, 0x3f,
+	0x36, 0x1a, 0x8f, 0x51, 0x39, 0xc7, 0xf1, 0xfd, 0x3c, 0xda, 0xc6, 0x4b, 0xa2, 0x7d, 0x51, 0x45,
+	0x4d, 0x05, 0x47, 0xbf, 0x81, 0x7e, 0x36, 0xfe, 0x6a, 0xa0, 0xea, 0xb3, 0x30, 0x38, 0x1a, 0xe9,
+	0x77, 0x17, 0xd8, 0x42, 0x2b, 0xdc, 0x97, 0x9e, 0x1c, 0xd9, 0x4c, 0xca, 0xd0, 0x6b, 0x47, 0x92,
+	0xa7, 0xae, 0xcb, 0xd6, 0x5a, 0x12, 0x9b, 0x27, 0x85, 0x74, 0x39, 0x85, 0x1e, 0xe4, 0x08, 0x36,
+	0xd1, 0xbc, 0x18, 0xf4, 0xd8, 0x08, 0x5e, 0x6a, 0xd1, 0x2a, 0x27, 0xb1, 0x99, 0x02, 0x34, 0x7d,
+	0xe0, 0x1f, 0xa1, 0x8b, 0x30, 0xb0, 0x9d, 0x60, 0xc8, 0x43, 0xd6, 0xe1, 0x64, 0xae, 0x6e, 0x6c,
+	0x56, 0x2d, 0x9c, 0xc4, 0xe6, 0x94, 0x84, 0x56, 0x61, 0xbe, 0xa3, 0xa7, 0x8d, 0x7f, 0x2c, 0xa3,
+	0x4a, 0x81, 0x7b, 0x2a, 0xfe, 0x4e, 0xd0, 0xef, 0x33, 0xdf, 0xd5, 0x61, 0xcd, 0xa6, 0x78, 0x13,
+	0x2d, 0x76, 0x99, 0xef, 0xf6, 0x78, 0x98, 0xd2, 0xaa, 0x6c, 0x5d, 0x48, 0x62, 0x33, 0xc7, 0x68,
+	0x3e, 0xc2, 0x3f, 0x43, 0x97, 0xba, 0x5e, 0xa7, 0x6b, 0xef, 0xf7, 0xd8, 0x60, 0x7c, 0xf7, 0x81,
+	0x53, 0x55, 0xeb, 0x72, 0x12, 0x9b, 0xb3, 0xc4, 0x74, 0x45, 0x81, 0x8f, 0x7a, 0x6c, 0xb0, 0x97,
+	0x41, 0x6a, 0x49, 0xcf, 0x97, 0x3c, 0x1c, 0xb2, 0x1e, 0x99, 0x07, 0x6b, 0x58, 0x32, 0xc3, 0x68,
+	0x3e, 0xc2, 0x3f, 0x45, 0xb8, 0x17, 0x1c, 0x4e, 0xaf, 0x58, 0x02, 0x9b, 0xf5, 0x24, 0x36, 0x67,
+	0x48, 0xe9, 0x72, 0x2f, 0x38, 0x9c, 0x5c, 0xef, 0x26, 0x5a, 0x18, 0x44, 0xed, 0x9e, 0x27, 0xba,
+	0xa4, 0x0c, 0xa1, 0xae, 0x24, 0xb1, 0x99, 0x41, 0x34, 0x1b, 0xa8, 0x70, 0x87, 0x91, 0x0f, 0x97,
+	0x5e, 0x73, 0x05, 0x41, 0x3c, 0x20, 0xdc, 0x93, 0x12, 0x5a, 0xd5, 0x73, 0x4d, 0xef, 0x8f, 0x50,
+	0x55, 0x44, 0x6d, 0xe1, 0x84, 0xde, 0x40, 0x7a, 0x81, 0x2f, 0x48, 0x05, 0x2c, 0x57, 0x92, 0xd8,
+	0x9c, 0x14, 0xd0, 0xc9, 0x29, 0xfe, 0x10, 0xe1, 0x87, 0x47, 0x92, 0xfb, 0x2e, 0x77, 0xc7, 0xcc,
+	0x20, 0x17, 0xea, 0xc6, 0xe6, 0x05, 0x6b, 0x3e, 0x89, 0x4d, 0xe3, 0x36, 0x9d, 0xa1, 0x80, 0xf7,
+	0xd0, 0xca, 0x40, 0xf1, 0xd1, 0xd6, 0x3c, 0xf3, 0x59, 0x9f, 0x93, 0xaa, 0x3a, 0x58, 0x6b, 0xf3,
+	0x38, 0x36, 0x97, 0x80, 0xac, 0x0f, 0x41, 0xf6, 0x29, 0xeb, 0x73, 0xc5, 0xc8, 0x13, 0xfa, 0x74,
+	0x69, 0x30, 0xa9, 0x85, 0x9f, 0xa0, 0x0a, 0x14, 0x3a, 0x3b, 0x4d, 0x32, 0x17, 0xe1, 0xa6, 0x5c,
+	0x9e, 0x91, 0x64, 0xd4, 0x95, 0xb2, 0x2e, 0xe9, 0xcb, 0x52, 0xb4, 0xa1, 0x08, 0x26, 0xbb, 0x90,
+	0x76, 0x14, 0xbf, 0xa5, 0xeb, 0xf9, 0x64, 0xa9, 0xc0, 0x6f, 0x05, 0xd0, 0xf4, 0x81, 0x1f, 0xa0,
+	0x92, 0x88, 0xda, 0x6e, 0xc4, 0xc9, 0x32, 0x5c, 0xeb, 0x6b, 0x53, 0x4b, 0xed, 0x79, 0x7d, 0xfe,
+	0x02, 0xd2, 0xef, 0x8b, 0x2e, 0xf7, 0xd3, 0xb4, 0x95, 0x1a, 0x50, 0xfd, 0xc4, 0x18, 0xbd, 0xe5,
+	0x84, 0x81, 0x4f, 0x56, 0x80, 0xd4, 0x30, 0xc6, 0x57, 0xd0, 0x9c, 0x94, 0x3d, 0x82, 0x21, 0xd7,
+	0x2d, 0x24, 0xb1, 0xa9, 0xa6, 0x54, 0xfd, 0x28, 0x26, 0xa8, 0x53, 0x0b, 0x22, 0x49, 0x2e, 0x01,
+	0x89, 0x80, 0x09, 0x1a, 0xa2, 0xd9, 0x00, 0xef, 0xa0, 0x8b, 0x69, 0xb8, 0x42, 0x7d, 0xdf, 0xc9,
+	0x2a, 0x6c, 0xf0, 0xea, 0xd4, 0x06, 0x27, 0x72, 0x02, 0xad, 0x0e, 0x26, 0x52, 0xc4, 0x1d, 0x54,
+	0x09, 0x83, 0xc8, 0x77, 0xed, 0x30, 0x68, 0x7b, 0x3e, 0x59, 0x83, 0x20, 0x40, 0x92, 0x2c, 0xc0,
+	0x14, 0xc1, 0x84, 0xaa, 0x31, 0xfe, 0x39, 0x5a, 0x0d, 0x22, 0x39, 0x88, 0xa4, 0xad, 0x0b, 0xec,
+	0x7e, 0x10, 0xf6, 0x99, 0x24, 0xeb, 0x70, 0xb0, 0x24, 0x89, 0xcd, 0x99, 0x72, 0x8a, 0x53, 0xf4,
+	0x09, 0x80, 0x8f, 0x00, 0xc3, 0xcf, 0xd0, 0xfa, 0xa4, 0x6e, 0x7e, 0xc9, 0x2f, 0x03, 0x35, 0x37,
+	0x92, 0xd8, 0x3c, 0x45, 0x83, 0xae, 0x16, 0xfd, 0xed, 0x66, 0xd7, 0xff, 0x3d, 0xb4, 0xc8, 0xfd,
+	0xa1, 0x3d, 0x64, 0xa1, 0x20, 0x64, 0x9c, 0x28, 0x32, 0x8c, 0x2e, 0x70, 0x7f, 0xf8, 0x2b, 0x16,
+	0x0a, 0xfc, 0x4b, 0xb4, 0xa8, 0x5a, 0x0a, 0x97, 0x49, 0x46, 0x36, 0x20, 0x6e, 0xd3, 0x85, 0xea,
+	0x69, 0xfb, 0xb7, 0xdc, 0x51, 0xfe, 0x99, 0x55, 0x53, 0x2c, 0xfa, 0x2a, 0x36, 0x0d, 0x75, 0x9b,
+	0x33, 0xb3, 0x0f, 0x82, 0xbe, 0x27, 0x79, 0x7f, 0x20, 0x47, 0x34, 0x77, 0x85, 0xdf, 0x45, 0x4b,
+	0x7d, 0x76, 0x64, 0xeb, 0x3d, 0x0b, 0xef, 0x33, 0x4e, 0xde, 0x56, 0x47, 0x4c, 0xab, 0x7d, 0x76,
+	0xf4, 0x14, 0xd0, 0xe7, 0xde, 0x67, 0x1c, 0xdf, 0x44, 0x17, 0x5d, 0x4f, 0x38, 0x2c, 0x74, 0xb5,
+	0x2e, 0xb9, 0xaa, 0x42, 0x4f, 0xab, 0x1a, 0x4d, 0x55, 0xf1, 0xfd, 0x71, 0x45, 0xba, 0x06, 0x44,
+	0x5f, 0x9b, 0xda, 0xe4, 0x73, 0x90, 0xa6, 0x0c, 0xd1, 0x9a, 0x79, 0xd5, 0xc2, 0x7f, 0x34, 0x10,
+	0x9e, 0x8c, 0x9e, 0x64, 0x1d, 0x41, 0x6a, 0xe0, 0x69, 0xba, 0x3c, 0xa5, 0x81, 0xdc, 0x63, 0x1d,
+	0x6b, 0x37, 0x89, 0xcd, 0xab, 0x27, 0xed, 0xc6, 0xef, 0xfb, 0x75, 0x6c, 0xde, 0x18, 0xb1, 0x7e,
+	0xef, 0x5e, 0xbd, 0xf1, 0x32, 0xb5, 0x06, 0x5d, 0x2e, 0x9e, 0xd1, 0x1e, 0xeb, 0x28, 0xbe, 0x95,
+	0x85, 0xd3, 0xe5, 0x6e, 0xd4, 0xe3, 0x21, 0x31, 0x81, 0x32, 0x18, 0x32, 0xc8, 0xd7, 0xb1, 0x59,
+	0xd6, 0x3e, 0x6f, 0x37, 0xe8, 0x58, 0x09, 0x3f, 0x41, 0xe5, 0x81, 0x37, 0xe0, 0x3d, 0xcf, 0xe7,
+	0x82, 0xd4, 0x61, 0xeb, 0xf5, 0xa9, 0xad, 0x53, 0xdd, 0x76, 0xd1, 0xac, 0xeb, 0xb2, 0xaa, 0x49,
+	0x6c, 0x8e, 0xcd, 0xe8, 0x78, 0x88, 0xff, 0x62, 0x20, 0x32, 0xb5, 0xe9, 0x2c, 0x05, 0x0b, 0x72,
+	0x1d, 0xdc, 0xd7, 0x66, 0x47, 0x26, 0x53, 0xb3, 0xf6, 0x92, 0xd8, 0x6c, 0x9c, 0xe6, 0x63, 0x22,
+	0x4a, 0xef, 0xcf, 0x8e, 0xd2, 0x0c, 0xe5, 0x06, 0x5d, 0x9f, 0x88, 0x55, 0xae, 0x82, 0x29, 0x5a,
+	0x48, 0xd3, 0x88, 0x20, 0x0d, 0xd8, 0xde, 0xf5, 0x53, 0x13, 0x10, 0xe5, 0x03, 0xce, 0x24, 0x77,
+	0xd3, 0xea, 0xae, 0xad, 0x0a, 0x34, 0xcd, 0x1c, 0xe1, 0x7b, 0xa8, 0xa2, 0x58, 0x1a, 0xaa, 0xb5,
+	0xb8, 0x20, 0xef, 0x40, 0x96, 0xb9, 0x92, 0xc4, 0xe6, 0x5a, 0x01, 0x2e, 0x18, 0xa2, 0x3e, 0x3b,
+	0xa2, 0x29, 0xaa, 0xd2, 0x8e, 0x52, 0x18, 0xd9, 0x79, 0x75, 0xbc, 0x01, 0xe6, 0x57, 0x93, 0xd8,
+	0x24, 0x93, 0x92, 0x82, 0x87, 0x2a, 0x48, 0x1e, 0x67, 0x25, 0x73, 0x0f, 0xad, 0xaa, 0x95, 0xda,
+	0xcc, 0x39, 0x08, 0xf6, 0xf7, 0xc7, 0xae, 0x6e, 0x82, 0xab, 0x46, 0x12, 0x9b, 0xb5, 0x59, 0xf2,
+	0x82, 0x43, 0xdc, 0x67, 0x47, 0x56, 0x2a, 0xce, 0xbc, 0xde, 0x5b, 0xfc, 0xc3, 0xe7, 0xe6, 0xb9,
+	0x2f, 0x3e, 0x37, 0x8d, 0xc6, 0xdf, 0xd6, 0xd1, 0x3c, 0x74, 0x16, 0xdf, 0xf7, 0x14, 0xdf, 0xd1,
+	0x9e, 0xe2, 0xfb, 0xe6, 0xe0, 0xff, 0xb1, 0x39, 0xd8, 0x40, 0x8b, 0x6e, 0x14, 0x32, 0x75, 0xc4,
+	0xd0, 0x10, 0x18, 0x34, 0x9f, 0x2b, 0xf2, 0xf3, 0x23, 0xee, 0x44, 0x92, 0xbb, 0xe4, 0x32, 0xbc,
+	0x59, 0x5a, 0x9a, 0x35, 0x46, 0xf3, 0x11, 0x7e, 0x84, 0x16, 0xba, 0x9e, 0x90, 0x41, 0x38, 0x82,
+	0x1a, 0x5e, 0x69, 0xbd, 0x3d, 0xeb, 0x2f, 0xde, 0x6e, 0xaa, 0x62, 0x2d, 0xe9, 0x53, 0xcc, 0x6c,
+	0x68, 0x36, 0x50, 0x7f, 0x29, 0xd3, 0x3f, 0x90, 0xe4, 0xca, 0xc9, 0xbf, 0x94, 0xe9, 0x53, 0xe9,
+	0xe8, 0x02, 0xbc, 0x01, 0xe4, 0x03, 0x9d, 0x14, 0xa1, 0xfa, 0x89, 0x57, 0x15, 0x0d, 0x98, 0x4c,
+	0x4b, 0x79, 0x99, 0xa6, 0x13, 0x65, 0xa9, 0x06, 0x91, 0x80, 0xd2, 0x5d, 0xd5, 0x87, 0x0b, 0x08,
+	0xd5, 0x4f, 0x75, 0x8d, 0x65, 0x20, 0x59, 0xcf, 0x06, 0x13, 0xdb, 0xe9, 0x32, 0xbf, 0xc3, 0xc9,
+	0xb5, 0xf1, 0x35, 0x3e, 0x29, 0xa5, 0xcb, 0x80, 0x3d, 0x57, 0xd0, 0x0e, 0x20, 0xb8, 0x89, 0x16,
+	0x7a, 0x4c, 0x48, 0x3b, 0x38, 0x20, 0x35, 0x78, 0x91, 0xb5, 0xe3, 0xd8, 0x2c, 0x7d, 0xc2, 0x84,
+	0x7c, 0xfa, 0x0b, 0xf5, 0xe2, 0x5a, 0x48, 0x4b, 0x6a, 0xf0, 0xf4, 0x00, 0x6f, 0xa3, 0x4a, 0xe0,
+	0x38, 0x51, 0x08, 0xb5, 0x50, 0x40, 0x99, 0x9d, 0x4b, 0xcf, 0xad, 0x00, 0xd3, 0xe2, 0x04, 0x7f,
+	0x8a, 0xd6, 0x0a, 0x53, 0xfb, 0x90, 0x49, 0x1e, 0xf6, 0x59, 0x78, 0x40, 0xea, 0x60, 0x0c, 0xb5,
+	0x61, 0xa6, 0x02, 0x5d, 0x2d, 0xc0, 0x2f, 0x32, 0x14, 0xd7, 0xd1, 0xa2, 0xf0, 0x7a, 0x0a, 0x74,
+	0xa1, 0xaa, 0x96, 0xf5, 0x87, 0x85, 0x1c, 0xc5, 0x5b, 0xd9, 0x67, 0x82, 0xb4, 0xaa, 0x5d, 0x9a,
+	0x71, 0x49, 0xb5, 0x8d, 0xfe, 0x40, 0x70, 0x5a, 0xe3, 0xf9, 0xce, 0x6b, 0x6d, 0x3c, 0x6f, 0xbc,
+	0x86, 0xc6, 0xf3, 0xe6, 0x59, 0x1b, 0xcf, 0x77, 0xdf, 0x68, 0xe3, 0xf9, 0xde, 0xd9, 0x1a, 0xcf,
+	0xcd, 0x6f, 0x68, 0x3c, 0x7f, 0xf0, 0xea, 0x8d, 0xe7, 0x1d, 0x54, 0xf1, 0x84, 0x9d, 0x13, 0xe0,
+	0xfd, 0x71, 0xe2, 0x28, 0xc0, 0x14, 0x79, 0xe2, 0x79, 0xc6, 0x86, 0x53, 0x5a, 0xd5, 0x5b, 0xff,
+	0xc3, 0x56, 0xf5, 0x56, 0xb1, 0x55, 0xfd, 0x00, 0x48, 0x06, 0x6d, 0x65, 0x0e, 0x16, 0xbb, 0xd4,
+	0x3d, 0x54, 0x79, 0x16, 0x06, 0x0e, 0x17, 0x82, 0xbb, 0xd6, 0x88, 0xdc, 0x06, 0xf5, 0x96, 0x62,
+	0xd1, 0x20, 0x83, 0xed, 0xf6, 0x68, 0x62, 0x5f, 0xab, 0x7a, 0x5f, 0x45, 0x85, 0x06, 0x2d, 0xba,
+	0x99, 0xec, 0x7d, 0x9b, 0x6f, 0xb6, 0xf7, 0xdd, 0xfa, 0x6e, 0xf7, 0xbe, 0x77, 0xde, 0x50, 0xef,
+	0xbb, 0xfd, 0xed, 0x7a, 0xdf, 0xd6, 0xeb, 0xeb, 0x7d, 0xef, 0x7e, 0x9b, 0xde, 0xf7, 0x94, 0x6f,
+	0x34, 0xce, 0x37, 0x7c, 0xa3, 0x29, 0xb4, 0xcc, 0xbf, 0xd7, 0x1f, 0x8d, 0x77, 0xc7, 0xc5, 0x53,
+	0x97, 0x37, 0xe3, 0xd4, 0xf2, 0x56, 0x2c, 0xe9, 0xe7, 0x5f, 0x5a, 0xd2, 0xaf, 0xa3, 0x45, 0xd5,
+	0xad, 0x0e, 0x3c, 0xbf, 0x03, 0xdf, 0x07, 0x17, 0xb3, 0x4d, 0xe5, 0xb0, 0x55, 0xff, 0xcf, 0xbf,
+	0x6a, 0xc6, 0x17, 0xc7, 0x35, 0xe3, 0xef, 0xc7, 0x35, 0xe3, 0xcb, 0xe3, 0x9a, 0xf1, 0xd5, 0x71,
+	0xcd, 0xf8, 0xe7, 0x71, 0xcd, 0xf8, 0xd3, 0xbf, 0x6b, 0xe7, 0x7e, 0x7d, 0x7e, 0xd8, 0x6a, 0x97,
+	0xe0, 0xfb, 0xf6, 0xdd, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x61, 0x6e, 0x8b, 0x10, 0x19,
+	0x00, 0x00,
 }
 
 func (this *CheckRequest) Equal(that interface{}) bool {
@@ -1006,6 +1032,15 @@ func (this *CheckConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.MaxRetries != that1.MaxRetries {
+		return false
+	}
+	if this.RetryInterval != that1.RetryInterval {
+		return false
+	}
+	if this.MaxBackoffInterval != that1.MaxBackoffInterval {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1232,6 +1267,15 @@ func (this *Check) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.MaxRetries != that1.MaxRetries {
+		return false
+	}
+	if this.RetryInterval != that1.RetryInterval {
+		return false
+	}
+	if this.MaxBackoffInterval != that1.MaxBackoffInterval {
+		return false
+	}
 	if !bytes.Equal(this.ExtendedAttributes, that1.ExtendedAttributes) {
 		return false
 	}
@@ -1306,6 +1350,9 @@ type CheckConfigFace interface {
 	GetPipelines() []*ResourceReference
 	GetOutputMetricThresholds() []*MetricThreshold
 	GetSubdues() []*TimeWindowRepeated
+	GetMaxRetries() uint32
+	GetRetryInterval() uint32
+	GetMaxBackoffInterval() uint32
 }
 
 func (this *CheckConfig) Proto() github_com_golang_protobuf_proto.Message {
@@ -1436,6 +1483,18 @@ func (this *CheckConfig) GetSubdues() []*TimeWindowRepeated {
 	return this.Subdues
 }
 
+func (this *CheckConfig) GetMaxRetries() uint32 {
+	return this.MaxRetries
+}
+
+func (this *CheckConfig) GetRetryInterval() uint32 {
+	return this.RetryInterval
+}
+
+func (this *CheckConfig) GetMaxBackoffInterval() uint32 {
+	return this.MaxBackoffInterval
+}
+
 func NewCheckConfigFromFace(that CheckConfigFace) *CheckConfig {
 	this := &CheckConfig{}
 	this.Command = that.GetCommand()
@@ -1468,6 +1527,9 @@ func NewCheckConfigFromFace(that CheckConfigFace) *CheckConfig {
 	this.Pipelines = that.GetPipelines()
 	this.OutputMetricThresholds = that.GetOutputMetricThresholds()
 	this.Subdues = that.GetSubdues()
+	this.MaxRetries = that.GetMaxRetries()
+	this.RetryInterval = that.GetRetryInterval()
+	this.MaxBackoffInterval = that.GetMaxBackoffInterval()
 	return this
 }
 
@@ -1517,6 +1579,9 @@ type CheckFace interface {
 	GetPipelines() []*ResourceReference
 	GetOutputMetricThresholds() []*MetricThreshold
 	GetSubdues() []*TimeWindowRepeated
+	GetMaxRetries() uint32
+	GetRetryInterval() uint32
+	GetMaxBackoffInterval() uint32
 	GetExtendedAttributes() []byte
 }
 
@@ -1704,6 +1769,18 @@ func (this *Check) GetSubdues() []*TimeWindowRepeated {
 	return this.Subdues
 }
 
+func (this *Check) GetMaxRetries() uint32 {
+	return this.MaxRetries
+}
+
+func (this *Check) GetRetryInterval() uint32 {
+	return this.RetryInterval
+}
+
+func (this *Check) GetMaxBackoffInterval() uint32 {
+	return this.MaxBackoffInterval
+}
+
 func (this *Check) GetExtendedAttributes() []byte {
 	return this.ExtendedAttributes
 }
@@ -1754,6 +1831,9 @@ func NewCheckFromFace(that CheckFace) *Check {
 	this.Pipelines = that.GetPipelines()
 	this.OutputMetricThresholds = that.GetOutputMetricThresholds()
 	this.Subdues = that.GetSubdues()
+	this.MaxRetries = that.GetMaxRetries()
+	this.RetryInterval = that.GetRetryInterval()
+	this.MaxBackoffInterval = that.GetMaxBackoffInterval()
 	this.ExtendedAttributes = that.GetExtendedAttributes()
 	return this
 }
@@ -1981,6 +2061,27 @@ func (m *CheckConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MaxBackoffInterval != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.MaxBackoffInterval))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.RetryInterval != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.RetryInterval))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
 	if len(m.Subdues) > 0 {
 		for iNdEx := len(m.Subdues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2328,6 +2429,27 @@ func (m *Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
+	if m.MaxBackoffInterval != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.MaxBackoffInterval))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.RetryInterval != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.RetryInterval))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
 	if len(m.Subdues) > 0 {
 		for iNdEx := len(m.Subdues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3010,8 +3132,11 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 			this.Subdues[i] = NewPopulatedTimeWindowRepeated(r, easy)
 		}
 	}
+	this.MaxRetries = uint32(r.Uint32())
+	this.RetryInterval = uint32(r.Uint32())
+	this.MaxBackoffInterval = uint32(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedCheck(r, 35)
+		this.XXX_unrecognized = randUnrecognizedCheck(r, 38)
 	}
 	return this
 }
@@ -3165,6 +3290,9 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 			this.Subdues[i] = NewPopulatedTimeWindowRepeated(r, easy)
 		}
 	}
+	this.MaxRetries = uint32(r.Uint32())
+	this.RetryInterval = uint32(r.Uint32())
+	this.MaxBackoffInterval = uint32(r.Uint32())
 	v41 := r.Intn(100)
 	this.ExtendedAttributes = make([]byte, v41)
 	for i := 0; i < v41; i++ {
@@ -3490,6 +3618,15 @@ func (m *CheckConfig) Size() (n int) {
 			n += 2 + l + sovCheck(uint64(l))
 		}
 	}
+	if m.MaxRetries != 0 {
+		n += 2 + sovCheck(uint64(m.MaxRetries))
+	}
+	if m.RetryInterval != 0 {
+		n += 2 + sovCheck(uint64(m.RetryInterval))
+	}
+	if m.MaxBackoffInterval != 0 {
+		n += 2 + sovCheck(uint64(m.MaxBackoffInterval))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3685,6 +3822,15 @@ func (m *Check) Size() (n int) {
 			n += 2 + l + sovCheck(uint64(l))
 		}
 	}
+	if m.MaxRetries != 0 {
+		n += 2 + sovCheck(uint64(m.MaxRetries))
+	}
+	if m.RetryInterval != 0 {
+		n += 2 + sovCheck(uint64(m.RetryInterval))
+	}
+	if m.MaxBackoffInterval != 0 {
+		n += 2 + sovCheck(uint64(m.MaxBackoffInterval))
+	}
 	l = len(m.ExtendedAttributes)
 	if l > 0 {
 		n += 2 + l + sovCheck(uint64(l))
@@ -5150,6 +5296,63 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInterval", wireType)
+			}
+			m.RetryInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffInterval", wireType)
+			}
+			m.MaxBackoffInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoffInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCheck(dAtA[iNdEx:])
@@ -6384,6 +6587,63 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInterval", wireType)
+			}
+			m.RetryInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffInterval", wireType)
+			}
+			m.MaxBackoffInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoffInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedAttributes", wireType)
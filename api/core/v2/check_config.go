@@ -169,14 +169,26 @@ func (c *CheckConfig) Validate() error {
 		return err
 	}
 
+	if err := validateRetries(c.MaxRetries, c.RetryInterval, c.MaxBackoffInterval, c.Interval, c.Cron); err != nil {
+		return err
+	}
+
 	return c.Subdue.Validate()
 }
 
 // IsSubdued returns true if the check is subdued at the current time.
 // It returns false otherwise.
 func (c *CheckConfig) IsSubdued() bool {
+	return c.IsSubduedAt(time.Now())
+}
+
+// IsSubduedAt returns true if the check is subdued at the given time.
+// It returns false otherwise. Only the time windows of Subdues are considered:
+// the deprecated Subdue field is not honored by the schedulers, which is why
+// it is ignored here as well.
+func (c *CheckConfig) IsSubduedAt(t time.Time) bool {
 	for _, subdue := range c.Subdues {
-		subdued := subdue.InWindows(time.Now())
+		subdued := subdue.InWindows(t)
 		if subdued {
 			return true
 		}
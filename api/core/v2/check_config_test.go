@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -107,6 +108,28 @@ func TestCheckConfigFlapThresholdValidation(t *testing.T) {
 	assert.Error(t, c.Validate())
 }
 
+func TestCheckConfigIsSubduedAt(t *testing.T) {
+	c := FixtureCheckConfig("check")
+	c.Subdues = []*TimeWindowRepeated{
+		{
+			Begin:  "2022-01-01T09:00:00Z",
+			End:    "2022-01-01T17:00:00Z",
+			Repeat: []string{RepeatPeriodDaily},
+		},
+	}
+	assert.True(t, c.IsSubduedAt(time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, c.IsSubduedAt(time.Date(2022, 3, 1, 18, 0, 0, 0, time.UTC)))
+
+	// The deprecated subdue is not honored
+	c.Subdues = nil
+	c.Subdue = &TimeWindowWhen{
+		Days: TimeWindowDays{
+			All: []*TimeWindowTimeRange{{Begin: "12:00AM", End: "11:59PM"}},
+		},
+	}
+	assert.False(t, c.IsSubduedAt(time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)))
+}
+
 func TestSortCheckConfigsByName(t *testing.T) {
 	a := FixtureCheckConfig("Abernathy")
 	b := FixtureCheckConfig("Bernard")
@@ -0,0 +1,154 @@
+package v2
+
+import (
+	"errors"
+	"net/url"
+	"path"
+)
+
+// CheckRetryStatesResource is the name of this resource type
+const CheckRetryStatesResource = "checkretrystates"
+
+// SetObjectMeta sets the object metadata for the resource.
+func (c *CheckRetryState) SetObjectMeta(meta ObjectMeta) {
+	c.ObjectMeta = meta
+}
+
+// SetNamespace sets the namespace of the resource.
+func (c *CheckRetryState) SetNamespace(namespace string) {
+	c.Namespace = namespace
+}
+
+// StorePrefix returns the path prefix to this resource in the store. The
+// retry states are kept under the name of their check, so that the states of
+// a check can be listed on their own.
+func (c *CheckRetryState) StorePrefix() string {
+	return CheckRetryStatesPrefix(c.CheckName)
+}
+
+// CheckRetryStatesPrefix returns the path prefix to the retry states of a
+// check in the store.
+func CheckRetryStatesPrefix(check string) string {
+	return path.Join(CheckRetryStatesResource, check)
+}
+
+// RBACName describes the name of the resource for RBAC purposes.
+func (c *CheckRetryState) RBACName() string {
+	return CheckRetryStatesResource
+}
+
+// URIPath gives the path component of a check retry state URI.
+func (c *CheckRetryState) URIPath() string {
+	if c.Namespace == "" {
+		return path.Join(URLPrefix, CheckRetryStatesResource, url.PathEscape(c.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(c.Namespace), CheckRetryStatesResource, url.PathEscape(c.Name))
+}
+
+// CheckRetryStateName returns the name of the retry state of a check on an
+// entity.
+func CheckRetryStateName(entity, check string) string {
+	return entity + ":" + check
+}
+
+// NewCheckRetryState returns the initial retry state of a check on an entity.
+func NewCheckRetryState(entity, check, namespace string) *CheckRetryState {
+	return &CheckRetryState{
+		ObjectMeta: NewObjectMeta(CheckRetryStateName(entity, check), namespace),
+		EntityName: entity,
+		CheckName:  check,
+	}
+}
+
+// Validate checks if a check retry state resource passes validation rules.
+func (c *CheckRetryState) Validate() error {
+	if err := ValidateName(c.EntityName); err != nil {
+		return errors.New("entity name " + err.Error())
+	}
+
+	if err := ValidateName(c.CheckName); err != nil {
+		return errors.New("check name " + err.Error())
+	}
+
+	if c.ObjectMeta.Name != CheckRetryStateName(c.EntityName, c.CheckName) {
+		return errors.New("name must be made of the entity and check names")
+	}
+
+	if c.ObjectMeta.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	return nil
+}
+
+// Update updates the retry state with the result of an execution of the
+// check, and returns false once the check is passing again, in which case the
+// state can be dropped. A non-zero status is retried up to max_retries times
+// at the retry interval and, once the retries are exhausted, a critical status
+// doubles the interval up to max_backoff_interval.
+func (c *CheckRetryState) Update(check *Check) bool {
+	if check.Status == 0 {
+		c.Retries = 0
+		c.Backoff = 0
+		c.Retrying = false
+		return false
+	}
+
+	// The next execution is relative to the time the scheduler issued the
+	// request, so it lines up with the schedule of the check
+	issued := check.Issued
+	if issued == 0 {
+		issued = check.Executed
+	}
+	c.NextExecution = issued + int64(c.nextInterval(check))
+	return true
+}
+
+// nextInterval returns the number of seconds to wait before the next
+// execution of the failing check.
+func (c *CheckRetryState) nextInterval(check *Check) uint32 {
+	if c.Retries < check.MaxRetries {
+		c.Retries++
+		c.Retrying = true
+		return check.RetryInterval
+	}
+	c.Retrying = false
+
+	if check.Status != 2 || check.MaxBackoffInterval == 0 || check.Interval == 0 {
+		c.Backoff = 0
+		return check.Interval
+	}
+
+	interval := uint64(check.Interval) << c.Backoff
+	if interval < uint64(check.MaxBackoffInterval) {
+		c.Backoff++
+		interval <<= 1
+	}
+	if interval > uint64(check.MaxBackoffInterval) {
+		interval = uint64(check.MaxBackoffInterval)
+	}
+	return uint32(interval)
+}
+
+// IsDue returns true if the check can be executed on the entity at the given
+// unix timestamp.
+func (c *CheckRetryState) IsDue(now int64) bool {
+	return now >= c.NextExecution
+}
+
+// CheckRetryStateFields returns a set of fields that represent that resource
+func CheckRetryStateFields(r Resource) map[string]string {
+	resource := r.(*CheckRetryState)
+	return map[string]string{
+		"check_retry_state.name":        resource.ObjectMeta.Name,
+		"check_retry_state.namespace":   resource.ObjectMeta.Namespace,
+		"check_retry_state.entity_name": resource.EntityName,
+		"check_retry_state.check_name":  resource.CheckName,
+	}
+}
+
+// FixtureCheckRetryState returns a testing fixture for a CheckRetryState
+// object.
+func FixtureCheckRetryState(entity, check, namespace string) *CheckRetryState {
+	return NewCheckRetryState(entity, check, namespace)
+}
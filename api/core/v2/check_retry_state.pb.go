@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/check_retry_state.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// CheckRetryState is the retry and backoff state of a check on an entity. It
+// is maintained by eventd from the results of the check, and read by the check
+// schedulers, so it survives scheduler restarts and leader changes.
+type CheckRetryState struct {
+	// Metadata contains the name and namespace of the state. The name is made
+	// of the entity and check names.
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Retries is the number of retries executed since the check started
+	// failing.
+	Retries uint32 `protobuf:"varint,2,opt,name=retries,proto3" json:"retries"`
+	// Backoff is the number of times the check interval was doubled since the
+	// check became critical.
+	Backoff uint32 `protobuf:"varint,3,opt,name=backoff,proto3" json:"backoff"`
+	// EntityName is the name of the entity the check is executed on.
+	EntityName string `protobuf:"bytes,4,opt,name=entity_name,json=entityName,proto3" json:"entity_name"`
+	// CheckName is the name of the check.
+	CheckName string `protobuf:"bytes,5,opt,name=check_name,json=checkName,proto3" json:"check_name"`
+	// NextExecution is the unix timestamp from which the check is executed
+	// again on the entity.
+	NextExecution int64 `protobuf:"varint,6,opt,name=next_execution,json=nextExecution,proto3" json:"next_execution"`
+	// Retrying is true while the failing check is retried on the entity, before
+	// its status is reported.
+	Retrying             bool     `protobuf:"varint,7,opt,name=retrying,proto3" json:"retrying"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckRetryState) Reset()         { *m = CheckRetryState{} }
+func (m *CheckRetryState) String() string { return proto.CompactTextString(m) }
+func (*CheckRetryState) ProtoMessage()    {}
+func (*CheckRetryState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a25342e5ee73de8, []int{0}
+}
+func (m *CheckRetryState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckRetryState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckRetryState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckRetryState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckRetryState.Merge(m, src)
+}
+func (m *CheckRetryState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckRetryState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckRetryState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckRetryState proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CheckRetryState)(nil), "sensu.core.v2.CheckRetryState")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/check_retry_state.proto", fileDescriptor_3a25342e5ee73de8)
+}
+
+var fileDescriptor_3a25342e5ee73de8 = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x6e, 0xd4, 0x40,
+	0x14, 0x86, 0x77, 0xb2, 0x90, 0x6c, 0x66, 0xd9, 0x44, 0x9a, 0xca, 0xa4, 0x98, 0xb1, 0x90, 0x90,
+	0x5c, 0x90, 0x71, 0xb2, 0xa1, 0x01, 0x09, 0x09, 0x19, 0x51, 0x02, 0xd2, 0x20, 0x1a, 0x9a, 0xd5,
+	0xd8, 0xbc, 0x75, 0x4c, 0x64, 0xcf, 0xca, 0x1e, 0x5b, 0xd9, 0x0b, 0x20, 0x8e, 0x40, 0x99, 0x32,
+	0x47, 0xe0, 0x08, 0x29, 0x73, 0x82, 0x11, 0x98, 0xce, 0x27, 0xa0, 0x44, 0x33, 0xc6, 0xcb, 0x42,
+	0x45, 0x63, 0xfd, 0xef, 0xf7, 0xf7, 0x3f, 0xfd, 0xf6, 0xc3, 0xcf, 0xd2, 0x4c, 0x9f, 0xd7, 0x31,
+	0x4f, 0x54, 0x1e, 0x56, 0x50, 0x54, 0x75, 0xff, 0x3c, 0x4e, 0x55, 0x28, 0x57, 0x59, 0x98, 0xa8,
+	0x12, 0xc2, 0x66, 0x1e, 0x26, 0xe7, 0x90, 0x5c, 0x2c, 0x4a, 0xd0, 0xe5, 0x7a, 0x51, 0x69, 0xa9,
+	0x81, 0xaf, 0x4a, 0xa5, 0x15, 0x99, 0x39, 0x9a, 0x5b, 0x8c, 0x37, 0xf3, 0xa3, 0xc7, 0x5b, 0xdb,
+	0x52, 0x95, 0xaa, 0xd0, 0x51, 0x71, 0xbd, 0x7c, 0xde, 0x9c, 0xf2, 0x33, 0x7e, 0xea, 0x4c, 0xe7,
+	0x39, 0xd5, 0x2f, 0x39, 0x3a, 0xf9, 0xbf, 0x0e, 0x39, 0x68, 0xd9, 0x27, 0x1e, 0x7c, 0x1a, 0xe3,
+	0xc3, 0x17, 0xb6, 0x92, 0xb0, 0x8d, 0xde, 0xda, 0x42, 0xe4, 0x1d, 0x9e, 0x58, 0xe2, 0x83, 0xd4,
+	0xd2, 0x43, 0x3e, 0x0a, 0xa6, 0xf3, 0xfb, 0xfc, 0xaf, 0x76, 0xfc, 0x4d, 0xfc, 0x11, 0x12, 0xfd,
+	0x0a, 0xb4, 0x8c, 0xe8, 0x8d, 0x61, 0xa3, 0x5b, 0xc3, 0x50, 0x67, 0x18, 0x19, 0x62, 0x8f, 0x54,
+	0x9e, 0x69, 0xc8, 0x57, 0x7a, 0x2d, 0x36, 0xab, 0xc8, 0x43, 0xbc, 0x67, 0x3f, 0x3b, 0x83, 0xca,
+	0xdb, 0xf1, 0x51, 0x30, 0x8b, 0xa6, 0x9d, 0x61, 0x83, 0x25, 0x06, 0x61, 0xb1, 0x58, 0x26, 0x17,
+	0x6a, 0xb9, 0xf4, 0xc6, 0x7f, 0xb0, 0xdf, 0x96, 0x18, 0x04, 0x39, 0xc1, 0x53, 0x28, 0x74, 0xa6,
+	0xd7, 0x8b, 0x42, 0xe6, 0xe0, 0xdd, 0xf1, 0x51, 0xb0, 0x1f, 0x1d, 0x76, 0x86, 0x6d, 0xdb, 0x02,
+	0xf7, 0xc3, 0x6b, 0x99, 0x03, 0x39, 0xc6, 0xb8, 0xff, 0xf9, 0x2e, 0x70, 0xd7, 0x05, 0x0e, 0x3a,
+	0xc3, 0xb6, 0x5c, 0xb1, 0xef, 0xb4, 0xc3, 0x9f, 0xe0, 0x83, 0x02, 0x2e, 0xf5, 0x02, 0x2e, 0x21,
+	0xa9, 0x75, 0xa6, 0x0a, 0x6f, 0xd7, 0x47, 0xc1, 0x38, 0x22, 0x9d, 0x61, 0xff, 0xbc, 0x11, 0x33,
+	0x3b, 0xbf, 0x1c, 0x46, 0x12, 0xe0, 0x89, 0x3b, 0x70, 0x56, 0xa4, 0xde, 0x9e, 0x8f, 0x82, 0x49,
+	0x74, 0xaf, 0x33, 0x6c, 0xe3, 0x89, 0x8d, 0x7a, 0x3a, 0xf9, 0x7c, 0xc5, 0x46, 0xd7, 0x57, 0x0c,
+	0x45, 0xfe, 0xcf, 0xef, 0x14, 0x5d, 0xb7, 0x14, 0x7d, 0x6d, 0x29, 0xba, 0x69, 0x29, 0xba, 0x6d,
+	0x29, 0xfa, 0xd6, 0x52, 0xf4, 0xe5, 0x07, 0x1d, 0xbd, 0xdf, 0x69, 0xe6, 0xf1, 0xae, 0xbb, 0xd8,
+	0xd9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x21, 0xc3, 0xb3, 0x54, 0x69, 0x02, 0x00, 0x00,
+}
+
+func (this *CheckRetryState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckRetryState)
+	if !ok {
+		that2, ok := that.(CheckRetryState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Retries != that1.Retries {
+		return false
+	}
+	if this.Backoff != that1.Backoff {
+		return false
+	}
+	if this.EntityName != that1.EntityName {
+		return false
+	}
+	if this.CheckName != that1.CheckName {
+		return false
+	}
+	if this.NextExecution != that1.NextExecution {
+		return false
+	}
+	if this.Retrying != that1.Retrying {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type CheckRetryStateFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetRetries() uint32
+	GetBackoff() uint32
+	GetEntityName() string
+	GetCheckName() string
+	GetNextExecution() int64
+	GetRetrying() bool
+}
+
+func (this *CheckRetryState) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *CheckRetryState) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewCheckRetryStateFromFace(this)
+}
+
+func (this *CheckRetryState) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *CheckRetryState) GetRetries() uint32 {
+	return this.Retries
+}
+
+func (this *CheckRetryState) GetBackoff() uint32 {
+	return this.Backoff
+}
+
+func (this *CheckRetryState) GetEntityName() string {
+	return this.EntityName
+}
+
+func (this *CheckRetryState) GetCheckName() string {
+	return this.CheckName
+}
+
+func (this *CheckRetryState) GetNextExecution() int64 {
+	return this.NextExecution
+}
+
+func (this *CheckRetryState) GetRetrying() bool {
+	return this.Retrying
+}
+
+func NewCheckRetryStateFromFace(that CheckRetryStateFace) *CheckRetryState {
+	this := &CheckRetryState{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.Retries = that.GetRetries()
+	this.Backoff = that.GetBackoff()
+	this.EntityName = that.GetEntityName()
+	this.CheckName = that.GetCheckName()
+	this.NextExecution = that.GetNextExecution()
+	this.Retrying = that.GetRetrying()
+	return this
+}
+
+func (m *CheckRetryState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckRetryState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckRetryState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Retrying {
+		i--
+		if m.Retrying {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextExecution != 0 {
+		i = encodeVarintCheckRetryState(dAtA, i, uint64(m.NextExecution))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CheckName) > 0 {
+		i -= len(m.CheckName)
+		copy(dAtA[i:], m.CheckName)
+		i = encodeVarintCheckRetryState(dAtA, i, uint64(len(m.CheckName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EntityName) > 0 {
+		i -= len(m.EntityName)
+		copy(dAtA[i:], m.EntityName)
+		i = encodeVarintCheckRetryState(dAtA, i, uint64(len(m.EntityName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Backoff != 0 {
+		i = encodeVarintCheckRetryState(dAtA, i, uint64(m.Backoff))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Retries != 0 {
+		i = encodeVarintCheckRetryState(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCheckRetryState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCheckRetryState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCheckRetryState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedCheckRetryState(r randyCheckRetryState, easy bool) *CheckRetryState {
+	this := &CheckRetryState{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Retries = uint32(r.Uint32())
+	this.Backoff = uint32(r.Uint32())
+	this.EntityName = string(randStringCheckRetryState(r))
+	this.CheckName = string(randStringCheckRetryState(r))
+	this.NextExecution = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.NextExecution *= -1
+	}
+	this.Retrying = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedCheckRetryState(r, 8)
+	}
+	return this
+}
+
+type randyCheckRetryState interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneCheckRetryState(r randyCheckRetryState) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringCheckRetryState(r randyCheckRetryState) string {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
+		tmps[i] = randUTF8RuneCheckRetryState(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedCheckRetryState(r randyCheckRetryState, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldCheckRetryState(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldCheckRetryState(dAtA []byte, r randyCheckRetryState, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateCheckRetryState(dAtA, uint64(key))
+		v3 := r.Int63()
+		if r.Intn(2) == 0 {
+			v3 *= -1
+		}
+		dAtA = encodeVarintPopulateCheckRetryState(dAtA, uint64(v3))
+	case 1:
+		dAtA = encodeVarintPopulateCheckRetryState(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateCheckRetryState(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateCheckRetryState(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateCheckRetryState(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateCheckRetryState(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *CheckRetryState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovCheckRetryState(uint64(l))
+	if m.Retries != 0 {
+		n += 1 + sovCheckRetryState(uint64(m.Retries))
+	}
+	if m.Backoff != 0 {
+		n += 1 + sovCheckRetryState(uint64(m.Backoff))
+	}
+	l = len(m.EntityName)
+	if l > 0 {
+		n += 1 + l + sovCheckRetryState(uint64(l))
+	}
+	l = len(m.CheckName)
+	if l > 0 {
+		n += 1 + l + sovCheckRetryState(uint64(l))
+	}
+	if m.NextExecution != 0 {
+		n += 1 + sovCheckRetryState(uint64(m.NextExecution))
+	}
+	if m.Retrying {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCheckRetryState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCheckRetryState(x uint64) (n int) {
+	return sovCheckRetryState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CheckRetryState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckRetryState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckRetryState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckRetryState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckRetryState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckRetryState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckRetryState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckRetryState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			m.Backoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckRetryState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Backoff |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckRetryState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckRetryState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckRetryState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckRetryState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckRetryState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckRetryState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecution", wireType)
+			}
+			m.NextExecution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckRetryState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExecution |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retrying", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckRetryState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retrying = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckRetryState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckRetryState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCheckRetryState(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCheckRetryState
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckRetryState
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckRetryState
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCheckRetryState
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCheckRetryState
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCheckRetryState
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCheckRetryState        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCheckRetryState          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCheckRetryState = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,61 @@
+package v2
+
+import (
+	"testing"
+)
+
+func TestCheckRetryState_Update(t *testing.T) {
+	check := FixtureCheck("check")
+	check.Interval = 60
+	check.MaxRetries = 2
+	check.RetryInterval = 10
+	check.MaxBackoffInterval = 300
+	check.Issued = 1000
+
+	state := FixtureCheckRetryState("entity", "check", "default")
+	steps := []struct {
+		status       uint32
+		wantKept     bool
+		wantInterval int64
+		wantRetrying bool
+	}{
+		{status: 0, wantKept: false},
+		{status: 2, wantKept: true, wantInterval: 10, wantRetrying: true},
+		{status: 2, wantKept: true, wantInterval: 10, wantRetrying: true},
+		{status: 2, wantKept: true, wantInterval: 120},
+		{status: 2, wantKept: true, wantInterval: 240},
+		{status: 2, wantKept: true, wantInterval: 300},
+		{status: 2, wantKept: true, wantInterval: 300},
+		{status: 1, wantKept: true, wantInterval: 60},
+		{status: 2, wantKept: true, wantInterval: 120},
+		{status: 0, wantKept: false},
+		{status: 1, wantKept: true, wantInterval: 10, wantRetrying: true},
+	}
+	for i, step := range steps {
+		check.Status = step.status
+		if got := state.Update(check); got != step.wantKept {
+			t.Fatalf("step %d: CheckRetryState.Update() = %v, want %v", i, got, step.wantKept)
+		}
+		if !step.wantKept {
+			continue
+		}
+		if got := state.NextExecution - check.Issued; got != step.wantInterval {
+			t.Fatalf("step %d: next execution in %ds, want %ds", i, got, step.wantInterval)
+		}
+		if state.Retrying != step.wantRetrying {
+			t.Fatalf("step %d: Retrying = %v, want %v", i, state.Retrying, step.wantRetrying)
+		}
+	}
+}
+
+func TestCheckRetryState_Validate(t *testing.T) {
+	state := FixtureCheckRetryState("entity", "check", "default")
+	if err := state.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	state.Name = "check"
+	if err := state.Validate(); err == nil {
+		t.Fatal("expected an error for a name not made of the entity and check names")
+	}
+}
@@ -0,0 +1,55 @@
+package v2
+
+// CheckSchedule is a preview of the upcoming executions of a check, as
+// computed by the scheduler without executing the check.
+type CheckSchedule struct {
+	// Check is the name of the check.
+	Check string `json:"check"`
+
+	// Namespace is the namespace of the check.
+	Namespace string `json:"namespace"`
+
+	// Scheduler is the type of scheduler used for the check.
+	Scheduler string `json:"scheduler"`
+
+	// Subdued indicates if the check is currently subdued.
+	Subdued bool `json:"subdued"`
+
+	// Executions are the next executions of the check.
+	Executions []ScheduledExecution `json:"executions"`
+
+	// Agents are the entities, other than proxy entities, whose subscriptions
+	// match the check. They are omitted when the viewer is not allowed to
+	// list the entities of the namespace.
+	Agents []string `json:"agents,omitempty"`
+
+	// AgentCount is the number of entities, other than proxy entities, whose
+	// subscriptions match the check.
+	AgentCount int `json:"agent_count"`
+
+	// ProxyEntities are the entities matching the entity attributes of the
+	// check proxy requests. They are omitted when the viewer is not allowed
+	// to list the entities of the namespace.
+	ProxyEntities []string `json:"proxy_entities,omitempty"`
+
+	// ProxyEntityCount is the number of entities matching the entity
+	// attributes of the check proxy requests.
+	ProxyEntityCount int `json:"proxy_entity_count,omitempty"`
+
+	// RoundRobin lists, by subscription, the entities that will receive the
+	// next execution of a round robin check, as read from the ring when the
+	// preview was computed. The ring may advance, or agents may join or leave
+	// it, before that execution. It is omitted when the viewer is not allowed
+	// to list the entities of the namespace.
+	RoundRobin map[string][]string `json:"round_robin,omitempty"`
+}
+
+// ScheduledExecution is an upcoming execution of a check.
+type ScheduledExecution struct {
+	// Time is the time of the execution, in seconds since the epoch.
+	Time int64 `json:"time"`
+
+	// Subdued indicates if the check will be subdued at that time, in which
+	// case it will not be executed.
+	Subdued bool `json:"subdued"`
+}
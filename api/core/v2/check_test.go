@@ -186,6 +186,34 @@ func TestCheckFlapThresholdValidation(t *testing.T) {
 	assert.Error(t, c.Validate())
 }
 
+func TestCheckRetryValidation(t *testing.T) {
+	c := FixtureCheck("foo")
+	c.Interval = 60
+
+	// retries require a retry interval
+	c.MaxRetries = 3
+	assert.Error(t, c.Validate())
+
+	// the retry interval must be shorter than the interval
+	c.RetryInterval = 60
+	assert.Error(t, c.Validate())
+
+	c.RetryInterval = 10
+	assert.NoError(t, c.Validate())
+
+	// the max backoff interval can't be shorter than the interval
+	c.MaxBackoffInterval = 30
+	assert.Error(t, c.Validate())
+
+	c.MaxBackoffInterval = 600
+	assert.NoError(t, c.Validate())
+
+	// backoff is not supported with cron schedules
+	c.Interval = 0
+	c.Cron = "* * * * *"
+	assert.Error(t, c.Validate())
+}
+
 func TestCheckMerge(t *testing.T) {
 	a := FixtureCheck("check")
 	b := FixtureCheck("check")
@@ -26,6 +26,18 @@ func (a *AdhocRequest) SetMetadata(meta *ObjectMeta) {
 	a.ObjectMeta = *meta
 }
 
+func (a *Aggregate) StoreName() string {
+	return "aggregates"
+}
+
+func (a *Aggregate) GetMetadata() *ObjectMeta {
+	return &a.ObjectMeta
+}
+
+func (a *Aggregate) SetMetadata(meta *ObjectMeta) {
+	a.ObjectMeta = *meta
+}
+
 func (a *Asset) StoreName() string {
 	return "assets"
 }
@@ -62,6 +74,18 @@ func (c *CheckConfig) SetMetadata(meta *ObjectMeta) {
 	c.ObjectMeta = *meta
 }
 
+func (c *CheckRetryState) StoreName() string {
+	return "check_retry_states"
+}
+
+func (c *CheckRetryState) GetMetadata() *ObjectMeta {
+	return &c.ObjectMeta
+}
+
+func (c *CheckRetryState) SetMetadata(meta *ObjectMeta) {
+	c.ObjectMeta = *meta
+}
+
 func (c *ClusterRole) StoreName() string {
 	return "cluster_roles"
 }
@@ -170,6 +194,18 @@ func (h *HookConfig) SetMetadata(meta *ObjectMeta) {
 	h.ObjectMeta = *meta
 }
 
+func (l *LifecyclePolicy) StoreName() string {
+	return "lifecycle_policies"
+}
+
+func (l *LifecyclePolicy) GetMetadata() *ObjectMeta {
+	return &l.ObjectMeta
+}
+
+func (l *LifecyclePolicy) SetMetadata(meta *ObjectMeta) {
+	l.ObjectMeta = *meta
+}
+
 func (m *Mutator) StoreName() string {
 	return "mutators"
 }
@@ -182,6 +218,18 @@ func (m *Mutator) SetMetadata(meta *ObjectMeta) {
 	m.ObjectMeta = *meta
 }
 
+func (p *PasswordPolicy) StoreName() string {
+	return "passwordpolicies"
+}
+
+func (p *PasswordPolicy) GetMetadata() *ObjectMeta {
+	return &p.ObjectMeta
+}
+
+func (p *PasswordPolicy) SetMetadata(meta *ObjectMeta) {
+	p.ObjectMeta = *meta
+}
+
 func (p *Pipeline) StoreName() string {
 	return "pipelines"
 }
@@ -194,6 +242,30 @@ func (p *Pipeline) SetMetadata(meta *ObjectMeta) {
 	p.ObjectMeta = *meta
 }
 
+func (q *ResourceQuota) StoreName() string {
+	return "resource_quotas"
+}
+
+func (q *ResourceQuota) GetMetadata() *ObjectMeta {
+	return &q.ObjectMeta
+}
+
+func (q *ResourceQuota) SetMetadata(meta *ObjectMeta) {
+	q.ObjectMeta = *meta
+}
+
+func (r *RevokedToken) StoreName() string {
+	return "revoked_tokens"
+}
+
+func (r *RevokedToken) GetMetadata() *ObjectMeta {
+	return &r.ObjectMeta
+}
+
+func (r *RevokedToken) SetMetadata(meta *ObjectMeta) {
+	r.ObjectMeta = *meta
+}
+
 func (r *Role) StoreName() string {
 	return "roles"
 }
@@ -218,6 +290,18 @@ func (r *RoleBinding) SetMetadata(meta *ObjectMeta) {
 	r.ObjectMeta = *meta
 }
 
+func (s *Session) StoreName() string {
+	return "sessions"
+}
+
+func (s *Session) GetMetadata() *ObjectMeta {
+	return &s.ObjectMeta
+}
+
+func (s *Session) SetMetadata(meta *ObjectMeta) {
+	s.ObjectMeta = *meta
+}
+
 func (s *Silenced) StoreName() string {
 	return "silenceds"
 }
@@ -1,7 +1,18 @@
 package v2
 
-// DefaultKeepaliveTimeout specifies the default keepalive timeout
-const DefaultKeepaliveTimeout = 120
+const (
+	// DefaultKeepaliveTimeout specifies the default keepalive timeout
+	DefaultKeepaliveTimeout = 120
+
+	// KeepaliveReconnectsAnnotation is the keepalive event annotation that
+	// counts how many times the agent reconnected to a backend after losing
+	// its connection.
+	KeepaliveReconnectsAnnotation = "sensu.io/keepalive_reconnects"
+
+	// KeepaliveDisconnectReasonAnnotation is the keepalive event annotation
+	// that records why the entity was last disconnected.
+	KeepaliveDisconnectReasonAnnotation = "sensu.io/keepalive_last_disconnect_reason"
+)
 
 // NewKeepaliveRecord initializes and returns a KeepaliveRecord from
 // an entity and its expiration time.
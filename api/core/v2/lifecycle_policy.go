@@ -0,0 +1,126 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	stringsutil "github.com/sensu/sensu-go/api/core/v2/internal/stringutil"
+)
+
+const (
+	// LifecyclePoliciesResource is the name of this resource type
+	LifecyclePoliciesResource = "lifecyclepolicies"
+
+	// DefaultLifecyclePolicyInterval is the default interval, in seconds, at
+	// which lifecycle policies are evaluated
+	DefaultLifecyclePolicyInterval = 300
+)
+
+// SetObjectMeta sets the object metadata for the resource.
+func (l *LifecyclePolicy) SetObjectMeta(meta ObjectMeta) {
+	l.ObjectMeta = meta
+}
+
+// SetNamespace sets the namespace of the resource.
+func (l *LifecyclePolicy) SetNamespace(namespace string) {
+	l.Namespace = namespace
+}
+
+// StorePrefix returns the path prefix to this resource in the store.
+func (l *LifecyclePolicy) StorePrefix() string {
+	return LifecyclePoliciesResource
+}
+
+// RBACName describes the name of the resource for RBAC purposes.
+func (l *LifecyclePolicy) RBACName() string {
+	return LifecyclePoliciesResource
+}
+
+// URIPath gives the path component of a lifecycle policy URI.
+func (l *LifecyclePolicy) URIPath() string {
+	if l.Namespace == "" {
+		return path.Join(URLPrefix, LifecyclePoliciesResource, url.PathEscape(l.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(l.Namespace), LifecyclePoliciesResource, url.PathEscape(l.Name))
+}
+
+// Validate checks if a lifecycle policy resource passes validation rules.
+func (l *LifecyclePolicy) Validate() error {
+	if err := ValidateName(l.ObjectMeta.Name); err != nil {
+		return errors.New("name " + err.Error())
+	}
+
+	if l.ObjectMeta.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	if len(l.EntityClasses) == 0 {
+		return errors.New("at least one entity class must be specified")
+	}
+
+	for _, class := range l.EntityClasses {
+		if class == EntityBackendClass {
+			return fmt.Errorf("entity class %q cannot be reaped", class)
+		}
+		if err := ValidateName(class); err != nil {
+			return errors.New("entity class " + err.Error())
+		}
+	}
+
+	if l.StaleAfter <= 0 {
+		return errors.New("stale_after must be greater than 0")
+	}
+
+	if l.DeregistrationHandler != "" {
+		if err := ValidateName(l.DeregistrationHandler); err != nil {
+			return errors.New("deregistration handler " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the given entity falls under the lifecycle policy.
+// Agent entities that are configured to deregister are never matched, since
+// keepalived already takes care of them.
+func (l *LifecyclePolicy) Matches(entity *Entity) bool {
+	if entity == nil {
+		return false
+	}
+	if !stringsutil.InArray(entity.EntityClass, l.EntityClasses) {
+		return false
+	}
+	if entity.EntityClass == EntityAgentClass && entity.Deregister {
+		return false
+	}
+	for key, value := range l.MatchLabels {
+		if entity.Labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// LifecyclePolicyFields returns a set of fields that represent that resource.
+func LifecyclePolicyFields(r Resource) map[string]string {
+	resource := r.(*LifecyclePolicy)
+	fields := map[string]string{
+		"lifecyclepolicy.name":      resource.ObjectMeta.Name,
+		"lifecyclepolicy.namespace": resource.ObjectMeta.Namespace,
+	}
+	stringsutil.MergeMapWithPrefix(fields, resource.ObjectMeta.Labels, "lifecyclepolicy.labels.")
+	return fields
+}
+
+// FixtureLifecyclePolicy returns a testing fixture for a LifecyclePolicy
+// object.
+func FixtureLifecyclePolicy(name, namespace string) *LifecyclePolicy {
+	return &LifecyclePolicy{
+		ObjectMeta:    NewObjectMeta(name, namespace),
+		EntityClasses: []string{EntityProxyClass},
+		StaleAfter:    86400,
+		Interval:      DefaultLifecyclePolicyInterval,
+	}
+}
@@ -0,0 +1,915 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/lifecycle_policy.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// LifecyclePolicy describes when stale entities of a namespace are
+// automatically deregistered by the backend.
+type LifecyclePolicy struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// lifecycle policy.
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// EntityClasses are the classes of entities the policy applies to. Agent
+	// entities are only reaped if they are not configured to deregister.
+	EntityClasses []string `protobuf:"bytes,2,rep,name=entity_classes,json=entityClasses,proto3" json:"entity_classes"`
+	// MatchLabels restricts the policy to the entities carrying all of these
+	// labels.
+	MatchLabels map[string]string `protobuf:"bytes,3,rep,name=match_labels,json=matchLabels,proto3" json:"match_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// StaleAfter is the number of seconds an entity must not have been seen
+	// for before it is reaped.
+	StaleAfter int64 `protobuf:"varint,4,opt,name=stale_after,json=staleAfter,proto3" json:"stale_after"`
+	// Interval is the number of seconds between two evaluations of the policy.
+	Interval uint32 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval"`
+	// DeregistrationHandler is the name of the handler used for the
+	// deregistration events of reaped entities that have none configured.
+	DeregistrationHandler string `protobuf:"bytes,6,opt,name=deregistration_handler,json=deregistrationHandler,proto3" json:"deregistration_handler,omitempty"`
+	// DryRun indicates that stale entities must only be reported, not reaped.
+	DryRun               bool     `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LifecyclePolicy) Reset()         { *m = LifecyclePolicy{} }
+func (m *LifecyclePolicy) String() string { return proto.CompactTextString(m) }
+func (*LifecyclePolicy) ProtoMessage()    {}
+func (*LifecyclePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d932f68e233fa020, []int{0}
+}
+func (m *LifecyclePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LifecyclePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LifecyclePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LifecyclePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecyclePolicy.Merge(m, src)
+}
+func (m *LifecyclePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *LifecyclePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecyclePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecyclePolicy proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*LifecyclePolicy)(nil), "sensu.core.v2.LifecyclePolicy")
+	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.LifecyclePolicy.MatchLabelsEntry")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/lifecycle_policy.proto", fileDescriptor_d932f68e233fa020)
+}
+
+var fileDescriptor_d932f68e233fa020 = []byte{
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x6e, 0xd3, 0x40,
+	0x1c, 0xc6, 0x7b, 0x49, 0x9b, 0x26, 0x97, 0x86, 0x56, 0x27, 0xa8, 0x4c, 0x06, 0x9f, 0x85, 0x3a,
+	0x78, 0x00, 0xbb, 0x4d, 0x19, 0xa0, 0x42, 0x08, 0x8c, 0x90, 0x18, 0x5a, 0x81, 0x2c, 0xb1, 0xc0,
+	0x60, 0x5d, 0x9c, 0x8b, 0x63, 0x38, 0xfb, 0xa2, 0xf3, 0xd9, 0x92, 0xdf, 0x80, 0x47, 0x60, 0xec,
+	0xd8, 0x47, 0x60, 0xe0, 0x01, 0x3a, 0xf6, 0x09, 0x2c, 0x30, 0x9b, 0x9f, 0x80, 0x11, 0xf9, 0xac,
+	0x04, 0x37, 0x62, 0x60, 0xb1, 0xfe, 0xff, 0xcf, 0xbf, 0xef, 0xd3, 0x67, 0xdf, 0xc1, 0x67, 0x41,
+	0x28, 0x17, 0xe9, 0xd4, 0xf2, 0x79, 0x64, 0x27, 0x34, 0x4e, 0xd2, 0xe6, 0xf9, 0x28, 0xe0, 0x36,
+	0x59, 0x86, 0xb6, 0xcf, 0x05, 0xb5, 0xb3, 0x89, 0xcd, 0xc2, 0x39, 0xf5, 0x73, 0x9f, 0x51, 0x6f,
+	0xc9, 0x59, 0xe8, 0xe7, 0xd6, 0x52, 0x70, 0xc9, 0xd1, 0x48, 0xc1, 0x56, 0x4d, 0x59, 0xd9, 0x64,
+	0xfc, 0xb8, 0x15, 0x16, 0xf0, 0x80, 0xdb, 0x8a, 0x9a, 0xa6, 0xf3, 0x17, 0xd9, 0x89, 0x75, 0x6a,
+	0x9d, 0x28, 0x51, 0x69, 0x6a, 0x6a, 0x42, 0xc6, 0xc7, 0xff, 0x57, 0x21, 0xa2, 0x92, 0x34, 0x8e,
+	0x07, 0xdf, 0xb7, 0xe1, 0xfe, 0xf9, 0xaa, 0xd1, 0x3b, 0x55, 0x08, 0xbd, 0x87, 0xfd, 0x9a, 0x98,
+	0x11, 0x49, 0x34, 0x60, 0x00, 0x73, 0x38, 0xb9, 0x6f, 0xdd, 0x6a, 0x67, 0xbd, 0x9d, 0x7e, 0xa2,
+	0xbe, 0xbc, 0xa0, 0x92, 0x38, 0xfa, 0x75, 0x81, 0xb7, 0x6e, 0x0a, 0x0c, 0xaa, 0x02, 0xa3, 0x95,
+	0xed, 0x21, 0x8f, 0x42, 0x49, 0xa3, 0xa5, 0xcc, 0xdd, 0x75, 0x14, 0x7a, 0x0a, 0xef, 0xd0, 0x58,
+	0x86, 0x32, 0xf7, 0x7c, 0x46, 0x92, 0x84, 0x26, 0x5a, 0xc7, 0xe8, 0x9a, 0x03, 0x07, 0x55, 0x05,
+	0xde, 0x78, 0xe3, 0x8e, 0x9a, 0xfd, 0x55, 0xb3, 0xa2, 0x18, 0xee, 0x45, 0x44, 0xfa, 0x0b, 0x8f,
+	0x91, 0x29, 0x65, 0x89, 0xd6, 0x35, 0xba, 0xe6, 0x70, 0x62, 0x6f, 0xb4, 0xda, 0xf8, 0x0e, 0xeb,
+	0xa2, 0xb6, 0x9c, 0x2b, 0xc7, 0xeb, 0x58, 0x8a, 0xdc, 0x19, 0x57, 0x05, 0x3e, 0x6c, 0x07, 0xb5,
+	0x7a, 0x0e, 0xa3, 0xbf, 0x34, 0x3a, 0x86, 0xc3, 0x44, 0x12, 0x46, 0x3d, 0x32, 0x97, 0x54, 0x68,
+	0xdb, 0x06, 0x30, 0xbb, 0xce, 0x7e, 0x55, 0xe0, 0xb6, 0xec, 0x42, 0xb5, 0xbc, 0xac, 0x67, 0x64,
+	0xc2, 0x7e, 0x18, 0x4b, 0x2a, 0x32, 0xc2, 0xb4, 0x1d, 0x03, 0x98, 0x23, 0x67, 0xaf, 0x2a, 0xf0,
+	0x5a, 0x73, 0xd7, 0x13, 0xfa, 0x08, 0x0f, 0x67, 0x54, 0xd0, 0x20, 0x4c, 0xa4, 0x20, 0x32, 0xe4,
+	0xb1, 0xb7, 0x20, 0xf1, 0x8c, 0x51, 0xa1, 0xf5, 0x0c, 0x60, 0x0e, 0x9c, 0xa3, 0xaa, 0xc0, 0xc6,
+	0xbf, 0x89, 0x56, 0xdd, 0x7b, 0xb7, 0x89, 0x37, 0x0d, 0x80, 0x8e, 0xe0, 0xee, 0x4c, 0xe4, 0x9e,
+	0x48, 0x63, 0x6d, 0xd7, 0x00, 0x66, 0xdf, 0x19, 0x56, 0x05, 0x5e, 0x49, 0x6e, 0x6f, 0x26, 0x72,
+	0x37, 0x8d, 0xc7, 0xcf, 0xe1, 0xc1, 0xe6, 0xbf, 0x41, 0x07, 0xb0, 0xfb, 0x99, 0xe6, 0xea, 0xbc,
+	0x07, 0x6e, 0x3d, 0xa2, 0xbb, 0x70, 0x27, 0x23, 0x2c, 0xa5, 0x5a, 0x47, 0x69, 0xcd, 0x72, 0xd6,
+	0x79, 0x02, 0xce, 0xfa, 0x5f, 0x2e, 0xf1, 0xd6, 0xd5, 0x25, 0x06, 0x8e, 0xf1, 0xfb, 0xa7, 0x0e,
+	0xae, 0x4a, 0x1d, 0x7c, 0x2b, 0x75, 0x70, 0x5d, 0xea, 0xe0, 0xa6, 0xd4, 0xc1, 0x8f, 0x52, 0x07,
+	0x5f, 0x7f, 0xe9, 0x5b, 0x1f, 0x3a, 0xd9, 0x64, 0xda, 0x53, 0xf7, 0xec, 0xf4, 0x4f, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xb3, 0xa1, 0xb3, 0x9f, 0x1e, 0x03, 0x00, 0x00,
+}
+
+func (this *LifecyclePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LifecyclePolicy)
+	if !ok {
+		that2, ok := that.(LifecyclePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if len(this.EntityClasses) != len(that1.EntityClasses) {
+		return false
+	}
+	for i := range this.EntityClasses {
+		if this.EntityClasses[i] != that1.EntityClasses[i] {
+			return false
+		}
+	}
+	if len(this.MatchLabels) != len(that1.MatchLabels) {
+		return false
+	}
+	for i := range this.MatchLabels {
+		if this.MatchLabels[i] != that1.MatchLabels[i] {
+			return false
+		}
+	}
+	if this.StaleAfter != that1.StaleAfter {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.DeregistrationHandler != that1.DeregistrationHandler {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type LifecyclePolicyFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetEntityClasses() []string
+	GetMatchLabels() map[string]string
+	GetStaleAfter() int64
+	GetInterval() uint32
+	GetDeregistrationHandler() string
+	GetDryRun() bool
+}
+
+func (this *LifecyclePolicy) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *LifecyclePolicy) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewLifecyclePolicyFromFace(this)
+}
+
+func (this *LifecyclePolicy) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *LifecyclePolicy) GetEntityClasses() []string {
+	return this.EntityClasses
+}
+
+func (this *LifecyclePolicy) GetMatchLabels() map[string]string {
+	return this.MatchLabels
+}
+
+func (this *LifecyclePolicy) GetStaleAfter() int64 {
+	return this.StaleAfter
+}
+
+func (this *LifecyclePolicy) GetInterval() uint32 {
+	return this.Interval
+}
+
+func (this *LifecyclePolicy) GetDeregistrationHandler() string {
+	return this.DeregistrationHandler
+}
+
+func (this *LifecyclePolicy) GetDryRun() bool {
+	return this.DryRun
+}
+
+func NewLifecyclePolicyFromFace(that LifecyclePolicyFace) *LifecyclePolicy {
+	this := &LifecyclePolicy{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.EntityClasses = that.GetEntityClasses()
+	this.MatchLabels = that.GetMatchLabels()
+	this.StaleAfter = that.GetStaleAfter()
+	this.Interval = that.GetInterval()
+	this.DeregistrationHandler = that.GetDeregistrationHandler()
+	this.DryRun = that.GetDryRun()
+	return this
+}
+
+func (m *LifecyclePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LifecyclePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LifecyclePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DeregistrationHandler) > 0 {
+		i -= len(m.DeregistrationHandler)
+		copy(dAtA[i:], m.DeregistrationHandler)
+		i = encodeVarintLifecyclePolicy(dAtA, i, uint64(len(m.DeregistrationHandler)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Interval != 0 {
+		i = encodeVarintLifecyclePolicy(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StaleAfter != 0 {
+		i = encodeVarintLifecyclePolicy(dAtA, i, uint64(m.StaleAfter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MatchLabels) > 0 {
+		for k := range m.MatchLabels {
+			v := m.MatchLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLifecyclePolicy(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLifecyclePolicy(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLifecyclePolicy(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EntityClasses) > 0 {
+		for iNdEx := len(m.EntityClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EntityClasses[iNdEx])
+			copy(dAtA[i:], m.EntityClasses[iNdEx])
+			i = encodeVarintLifecyclePolicy(dAtA, i, uint64(len(m.EntityClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLifecyclePolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLifecyclePolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLifecyclePolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedLifecyclePolicy(r randyLifecyclePolicy, easy bool) *LifecyclePolicy {
+	this := &LifecyclePolicy{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	v2 := r.Intn(10)
+	this.EntityClasses = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.EntityClasses[i] = string(randStringLifecyclePolicy(r))
+	}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(10)
+		this.MatchLabels = make(map[string]string)
+		for i := 0; i < v3; i++ {
+			this.MatchLabels[randStringLifecyclePolicy(r)] = randStringLifecyclePolicy(r)
+		}
+	}
+	this.StaleAfter = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.StaleAfter *= -1
+	}
+	this.Interval = uint32(r.Uint32())
+	this.DeregistrationHandler = string(randStringLifecyclePolicy(r))
+	this.DryRun = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedLifecyclePolicy(r, 8)
+	}
+	return this
+}
+
+type randyLifecyclePolicy interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneLifecyclePolicy(r randyLifecyclePolicy) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringLifecyclePolicy(r randyLifecyclePolicy) string {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
+		tmps[i] = randUTF8RuneLifecyclePolicy(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedLifecyclePolicy(r randyLifecyclePolicy, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldLifecyclePolicy(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldLifecyclePolicy(dAtA []byte, r randyLifecyclePolicy, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateLifecyclePolicy(dAtA, uint64(key))
+		v5 := r.Int63()
+		if r.Intn(2) == 0 {
+			v5 *= -1
+		}
+		dAtA = encodeVarintPopulateLifecyclePolicy(dAtA, uint64(v5))
+	case 1:
+		dAtA = encodeVarintPopulateLifecyclePolicy(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateLifecyclePolicy(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateLifecyclePolicy(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateLifecyclePolicy(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateLifecyclePolicy(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *LifecyclePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovLifecyclePolicy(uint64(l))
+	if len(m.EntityClasses) > 0 {
+		for _, s := range m.EntityClasses {
+			l = len(s)
+			n += 1 + l + sovLifecyclePolicy(uint64(l))
+		}
+	}
+	if len(m.MatchLabels) > 0 {
+		for k, v := range m.MatchLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLifecyclePolicy(uint64(len(k))) + 1 + len(v) + sovLifecyclePolicy(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLifecyclePolicy(uint64(mapEntrySize))
+		}
+	}
+	if m.StaleAfter != 0 {
+		n += 1 + sovLifecyclePolicy(uint64(m.StaleAfter))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovLifecyclePolicy(uint64(m.Interval))
+	}
+	l = len(m.DeregistrationHandler)
+	if l > 0 {
+		n += 1 + l + sovLifecyclePolicy(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovLifecyclePolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLifecyclePolicy(x uint64) (n int) {
+	return sovLifecyclePolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LifecyclePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLifecyclePolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LifecyclePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LifecyclePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecyclePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLifecyclePolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecyclePolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecyclePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLifecyclePolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecyclePolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityClasses = append(m.EntityClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecyclePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLifecyclePolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecyclePolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MatchLabels == nil {
+				m.MatchLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLifecyclePolicy
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLifecyclePolicy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLifecyclePolicy
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLifecyclePolicy
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLifecyclePolicy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLifecyclePolicy
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLifecyclePolicy
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLifecyclePolicy(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLifecyclePolicy
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MatchLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleAfter", wireType)
+			}
+			m.StaleAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecyclePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecyclePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregistrationHandler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecyclePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLifecyclePolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecyclePolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeregistrationHandler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecyclePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLifecyclePolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLifecyclePolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLifecyclePolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLifecyclePolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLifecyclePolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLifecyclePolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLifecyclePolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLifecyclePolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLifecyclePolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLifecyclePolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLifecyclePolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLifecyclePolicy = fmt.Errorf("proto: unexpected end of group")
+)
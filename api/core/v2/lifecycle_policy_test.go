@@ -0,0 +1,153 @@
+package v2
+
+import (
+	"testing"
+)
+
+func TestLifecyclePolicy_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  func() *LifecyclePolicy
+		wantErr bool
+		wantMsg string
+	}{
+		{
+			name: "fails when name is empty",
+			policy: func() *LifecyclePolicy {
+				return &LifecyclePolicy{}
+			},
+			wantErr: true,
+			wantMsg: "name must not be empty",
+		},
+		{
+			name: "fails when namespace is empty",
+			policy: func() *LifecyclePolicy {
+				return FixtureLifecyclePolicy("stale-proxies", "")
+			},
+			wantErr: true,
+			wantMsg: "namespace must be set",
+		},
+		{
+			name: "fails without entity classes",
+			policy: func() *LifecyclePolicy {
+				p := FixtureLifecyclePolicy("stale-proxies", "default")
+				p.EntityClasses = nil
+				return p
+			},
+			wantErr: true,
+			wantMsg: "at least one entity class must be specified",
+		},
+		{
+			name: "fails with the backend entity class",
+			policy: func() *LifecyclePolicy {
+				p := FixtureLifecyclePolicy("stale-proxies", "default")
+				p.EntityClasses = []string{EntityBackendClass}
+				return p
+			},
+			wantErr: true,
+			wantMsg: `entity class "backend" cannot be reaped`,
+		},
+		{
+			name: "fails without stale_after",
+			policy: func() *LifecyclePolicy {
+				p := FixtureLifecyclePolicy("stale-proxies", "default")
+				p.StaleAfter = 0
+				return p
+			},
+			wantErr: true,
+			wantMsg: "stale_after must be greater than 0",
+		},
+		{
+			name: "succeeds with the fixture",
+			policy: func() *LifecyclePolicy {
+				return FixtureLifecyclePolicy("stale-proxies", "default")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy().Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LifecyclePolicy.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantMsg {
+				t.Errorf("LifecyclePolicy.Validate() error = %v, wantMsg %v", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestLifecyclePolicy_Matches(t *testing.T) {
+	proxy := FixtureEntity("router")
+	proxy.EntityClass = EntityProxyClass
+	proxy.Labels = map[string]string{"region": "us-west-1"}
+
+	agent := FixtureEntity("server")
+	agent.EntityClass = EntityAgentClass
+
+	deregistering := FixtureEntity("ephemeral")
+	deregistering.EntityClass = EntityAgentClass
+	deregistering.Deregister = true
+
+	tests := []struct {
+		name        string
+		classes     []string
+		matchLabels map[string]string
+		entity      *Entity
+		want        bool
+	}{
+		{
+			name:    "nil entity",
+			classes: []string{EntityProxyClass},
+			want:    false,
+		},
+		{
+			name:    "matching class",
+			classes: []string{EntityProxyClass},
+			entity:  proxy,
+			want:    true,
+		},
+		{
+			name:    "other class",
+			classes: []string{EntityProxyClass},
+			entity:  agent,
+			want:    false,
+		},
+		{
+			name:    "agent entity",
+			classes: []string{EntityAgentClass},
+			entity:  agent,
+			want:    true,
+		},
+		{
+			name:    "agent entity configured to deregister",
+			classes: []string{EntityAgentClass},
+			entity:  deregistering,
+			want:    false,
+		},
+		{
+			name:        "matching labels",
+			classes:     []string{EntityProxyClass},
+			matchLabels: map[string]string{"region": "us-west-1"},
+			entity:      proxy,
+			want:        true,
+		},
+		{
+			name:        "mismatching labels",
+			classes:     []string{EntityProxyClass},
+			matchLabels: map[string]string{"region": "eu-west-1"},
+			entity:      proxy,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := FixtureLifecyclePolicy("stale", "default")
+			p.EntityClasses = tt.classes
+			p.MatchLabels = tt.matchLabels
+			if got := p.Matches(tt.entity); got != tt.want {
+				t.Errorf("LifecyclePolicy.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
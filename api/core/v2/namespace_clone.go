@@ -0,0 +1,110 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Clone statuses, which describe what happened to each cloned resource.
+const (
+	// CloneCreated is the status of resources created in the destination.
+	CloneCreated = "created"
+
+	// CloneUpdated is the status of resources that already existed in the
+	// destination, and were overwritten.
+	CloneUpdated = "updated"
+
+	// CloneSkipped is the status of resources that already existed in the
+	// destination, and were kept.
+	CloneSkipped = "skipped"
+)
+
+// DefaultNamespaceCloneTypes are the types of the resources copied by a
+// namespace clone that does not specify any.
+var DefaultNamespaceCloneTypes = []string{
+	"Asset",
+	"CheckConfig",
+	"EventFilter",
+	"Handler",
+	"HookConfig",
+	"Mutator",
+	"Pipeline",
+	"Role",
+	"RoleBinding",
+}
+
+// NamespaceClone is a request to copy the resources of a namespace into
+// another namespace.
+type NamespaceClone struct {
+	// Destination is the namespace the resources are copied to. It is created
+	// if it does not exist.
+	Destination string `json:"destination"`
+
+	// Types are the types of the resources to copy, e.g. Handler. The
+	// DefaultNamespaceCloneTypes are copied if empty.
+	Types []string `json:"types,omitempty"`
+
+	// NameTemplate is a token substitution template for the names of the
+	// copied resources, e.g. "{{ .values.team }}-{{ .name }}". The names are
+	// kept if empty.
+	NameTemplate string `json:"name_template,omitempty"`
+
+	// Values are the values available to the templates, as .values.
+	Values map[string]string `json:"values,omitempty"`
+
+	// Overwrite indicates if resources that already exist in the destination
+	// are replaced. They are skipped otherwise.
+	Overwrite bool `json:"overwrite"`
+}
+
+// Validate returns an error if the request is invalid.
+func (c *NamespaceClone) Validate() error {
+	if c.Destination == "" {
+		return errors.New("the destination namespace must be set")
+	}
+	if err := ValidateName(c.Destination); err != nil {
+		return fmt.Errorf("invalid destination namespace: %s", err)
+	}
+	for _, t := range c.Types {
+		if !isNamespaceCloneType(t) {
+			return fmt.Errorf("resources of type %q can't be cloned", t)
+		}
+	}
+	return nil
+}
+
+func isNamespaceCloneType(t string) bool {
+	for _, cloneType := range DefaultNamespaceCloneTypes {
+		if t == cloneType {
+			return true
+		}
+	}
+	return false
+}
+
+// NamespaceCloneResult lists the resources copied by a namespace clone.
+type NamespaceCloneResult struct {
+	// Source is the namespace the resources were copied from.
+	Source string `json:"source"`
+
+	// Destination is the namespace the resources were copied to.
+	Destination string `json:"destination"`
+
+	// Resources are the copied resources.
+	Resources []ClonedResource `json:"resources"`
+}
+
+// ClonedResource is a resource copied by a namespace clone.
+type ClonedResource struct {
+	// Type is the type of the resource, e.g. Handler.
+	Type string `json:"type"`
+
+	// Source is the name of the resource in the source namespace.
+	Source string `json:"source"`
+
+	// Name is the name of the resource in the destination namespace.
+	Name string `json:"name"`
+
+	// Status is one of CloneCreated, CloneUpdated or CloneSkipped.
+	Status string `json:"status"`
+}
@@ -0,0 +1,144 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	stringsutil "github.com/sensu/sensu-go/api/core/v2/internal/stringutil"
+)
+
+const (
+	// PasswordPoliciesResource is the name of this resource type.
+	PasswordPoliciesResource = "passwordpolicies"
+
+	// DefaultPasswordPolicyName is the name of the password policy of the
+	// cluster.
+	DefaultPasswordPolicyName = "default"
+)
+
+// StorePrefix returns the path prefix to this resource in the store.
+func (p *PasswordPolicy) StorePrefix() string {
+	return PasswordPoliciesResource
+}
+
+// URIPath returns the path component of a password policy URI.
+func (p *PasswordPolicy) URIPath() string {
+	return path.Join(URLPrefix, PasswordPoliciesResource, url.PathEscape(p.Name))
+}
+
+// Validate returns an error if the password policy is invalid.
+func (p *PasswordPolicy) Validate() error {
+	if p.Name != DefaultPasswordPolicyName {
+		return fmt.Errorf("password policy name must be %q", DefaultPasswordPolicyName)
+	}
+
+	if p.Namespace != "" {
+		return errors.New("password policy cannot have a namespace")
+	}
+
+	if (p.MaxFailedAttempts > 0 || p.MaxFailedAttemptsPerIP > 0) && p.LockoutDuration == 0 {
+		return errors.New("password policy must have a lockout duration when failed attempts are limited")
+	}
+
+	return nil
+}
+
+// HasRules returns whether the password policy constrains the content of the
+// passwords.
+func (p *PasswordPolicy) HasRules() bool {
+	return p.MinLength > 0 || p.RequireUppercase || p.RequireLowercase ||
+		p.RequireDigit || p.RequireSymbol
+}
+
+// ValidatePassword returns an error if the given password does not comply
+// with the password policy.
+func (p *PasswordPolicy) ValidatePassword(password string) error {
+	if n := utf8.RuneCountInString(password); n < int(p.MinLength) {
+		return fmt.Errorf("password length must be at least %d characters", p.MinLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	if p.RequireUppercase && !upper {
+		return errors.New("password must contain an uppercase letter")
+	}
+	if p.RequireLowercase && !lower {
+		return errors.New("password must contain a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		return errors.New("password must contain a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		return errors.New("password must contain a symbol")
+	}
+
+	return nil
+}
+
+// LockoutDurationTime returns the lockout duration as a time.Duration.
+func (p *PasswordPolicy) LockoutDurationTime() time.Duration {
+	return time.Duration(p.LockoutDuration) * time.Second
+}
+
+// IsPasswordExpired returns whether a password changed at the given unix
+// timestamp is expired at the given time.
+func (p *PasswordPolicy) IsPasswordExpired(changedAt int64, now time.Time) bool {
+	if p.PasswordExpiry == 0 || changedAt == 0 {
+		return false
+	}
+	expiry := time.Duration(p.PasswordExpiry) * 24 * time.Hour
+	return now.After(time.Unix(changedAt, 0).Add(expiry))
+}
+
+// FixturePasswordPolicy returns a testing fixture for a PasswordPolicy struct.
+func FixturePasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		ObjectMeta:        NewObjectMeta(DefaultPasswordPolicyName, ""),
+		MinLength:         12,
+		RequireUppercase:  true,
+		RequireLowercase:  true,
+		RequireDigit:      true,
+		MaxFailedAttempts: 5,
+		LockoutDuration:   900,
+	}
+}
+
+// PasswordPolicyFields returns a set of fields that represent that resource.
+func PasswordPolicyFields(r Resource) map[string]string {
+	resource := r.(*PasswordPolicy)
+	fields := map[string]string{
+		"password_policy.name": resource.ObjectMeta.Name,
+	}
+	stringsutil.MergeMapWithPrefix(fields, resource.ObjectMeta.Labels, "password_policy.labels.")
+	return fields
+}
+
+// SetNamespace sets the namespace of the resource.
+func (p *PasswordPolicy) SetNamespace(namespace string) {}
+
+// SetObjectMeta sets the meta of the resource.
+func (p *PasswordPolicy) SetObjectMeta(meta ObjectMeta) {
+	p.ObjectMeta = meta
+}
+
+// RBACName gets the rbac name of the resource.
+func (*PasswordPolicy) RBACName() string {
+	return PasswordPoliciesResource
+}
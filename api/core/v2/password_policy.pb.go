@@ -0,0 +1,873 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/password_policy.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// PasswordPolicy configures the passwords of the users of the basic
+// authentication provider, and the lockout of the users failing to
+// authenticate. A zero value disables the rule.
+type PasswordPolicy struct {
+	// Metadata contains the name, namespace (N/A), labels and annotations of
+	// the PasswordPolicy. The policy of the cluster is named default.
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// MinLength is the minimum number of characters of the passwords.
+	MinLength uint32 `protobuf:"varint,2,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	// RequireUppercase requires an uppercase letter in the passwords.
+	RequireUppercase bool `protobuf:"varint,3,opt,name=require_uppercase,json=requireUppercase,proto3" json:"require_uppercase,omitempty"`
+	// RequireLowercase requires a lowercase letter in the passwords.
+	RequireLowercase bool `protobuf:"varint,4,opt,name=require_lowercase,json=requireLowercase,proto3" json:"require_lowercase,omitempty"`
+	// RequireDigit requires a digit in the passwords.
+	RequireDigit bool `protobuf:"varint,5,opt,name=require_digit,json=requireDigit,proto3" json:"require_digit,omitempty"`
+	// RequireSymbol requires a character other than a letter or a digit in the
+	// passwords.
+	RequireSymbol bool `protobuf:"varint,6,opt,name=require_symbol,json=requireSymbol,proto3" json:"require_symbol,omitempty"`
+	// MaxFailedAttempts is the number of failed authentications of a user
+	// after which the user is locked out. The failed authentications are
+	// counted in memory by each backend, so a user can fail up to this number
+	// of times on every backend, and the counts are lost when a backend
+	// restarts.
+	MaxFailedAttempts uint32 `protobuf:"varint,7,opt,name=max_failed_attempts,json=maxFailedAttempts,proto3" json:"max_failed_attempts,omitempty"`
+	// MaxFailedAttemptsPerIP is the number of failed authentications from a
+	// source IP after which the source IP is locked out. Like
+	// MaxFailedAttempts, it is a limit per backend.
+	MaxFailedAttemptsPerIP uint32 `protobuf:"varint,8,opt,name=max_failed_attempts_per_ip,json=maxFailedAttemptsPerIp,proto3" json:"max_failed_attempts_per_ip,omitempty"`
+	// LockoutDuration is the number of seconds a user or source IP is locked
+	// out for. The failed authentications older than this duration are not
+	// counted.
+	LockoutDuration uint32 `protobuf:"varint,9,opt,name=lockout_duration,json=lockoutDuration,proto3" json:"lockout_duration,omitempty"`
+	// PasswordExpiry is the number of days after which the passwords must be
+	// changed.
+	PasswordExpiry       uint32   `protobuf:"varint,10,opt,name=password_expiry,json=passwordExpiry,proto3" json:"password_expiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PasswordPolicy) Reset()         { *m = PasswordPolicy{} }
+func (m *PasswordPolicy) String() string { return proto.CompactTextString(m) }
+func (*PasswordPolicy) ProtoMessage()    {}
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1fa428de9184945, []int{0}
+}
+func (m *PasswordPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PasswordPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PasswordPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PasswordPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PasswordPolicy.Merge(m, src)
+}
+func (m *PasswordPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PasswordPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PasswordPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PasswordPolicy proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PasswordPolicy)(nil), "sensu.core.v2.PasswordPolicy")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/password_policy.proto", fileDescriptor_e1fa428de9184945)
+}
+
+var fileDescriptor_e1fa428de9184945 = []byte{
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0xc7, 0x7b, 0x05, 0x4a, 0x7a, 0xd0, 0xb4, 0x35, 0xa8, 0x5c, 0x53, 0xea, 0x0b, 0x88, 0x21,
+	0x03, 0xd8, 0x34, 0x45, 0x42, 0x82, 0xa5, 0x84, 0x52, 0xa9, 0x52, 0x2a, 0x02, 0xa8, 0x0b, 0x8b,
+	0x75, 0x71, 0xae, 0xee, 0x81, 0x9d, 0x3b, 0xec, 0x73, 0x9a, 0x2c, 0xcc, 0x3c, 0x02, 0x63, 0xc7,
+	0xee, 0x2c, 0x3c, 0x42, 0xc7, 0x3e, 0xc1, 0x09, 0xcc, 0xe6, 0x27, 0x60, 0x44, 0xb9, 0xd8, 0xc1,
+	0x49, 0x83, 0xc4, 0x12, 0x45, 0xdf, 0xef, 0xff, 0xff, 0x7d, 0x9f, 0x2c, 0x1d, 0x7c, 0xee, 0x31,
+	0x79, 0x1c, 0xb7, 0x2d, 0x97, 0x07, 0x76, 0x44, 0xbb, 0x51, 0x3c, 0xfa, 0x7d, 0xe4, 0x71, 0x9b,
+	0x08, 0x66, 0xbb, 0x3c, 0xa4, 0x76, 0xaf, 0x6e, 0x0b, 0x12, 0x45, 0x27, 0x3c, 0xec, 0x38, 0x82,
+	0xfb, 0xcc, 0x1d, 0x58, 0x22, 0xe4, 0x92, 0x1b, 0x4b, 0x3a, 0x6b, 0x0d, 0x43, 0x56, 0xaf, 0x5e,
+	0x79, 0x52, 0x70, 0x79, 0xdc, 0xe3, 0xb6, 0x4e, 0xb5, 0xe3, 0xa3, 0x9d, 0xde, 0x96, 0xb5, 0x6d,
+	0x6d, 0xe9, 0xa1, 0x9e, 0xe9, 0x7f, 0x23, 0x49, 0xe5, 0xf1, 0xff, 0x5d, 0x10, 0x50, 0x49, 0x46,
+	0x8d, 0xfb, 0xdf, 0x16, 0x60, 0xb9, 0x95, 0x1d, 0xd4, 0xd2, 0xf7, 0x18, 0x87, 0xb0, 0x34, 0x0c,
+	0x74, 0x88, 0x24, 0x08, 0x54, 0x41, 0xed, 0x46, 0x7d, 0xdd, 0x9a, 0x38, 0xce, 0x7a, 0xdd, 0xfe,
+	0x40, 0x5d, 0x79, 0x40, 0x25, 0x69, 0x98, 0xe7, 0x0a, 0xcf, 0x5d, 0x28, 0x0c, 0x52, 0x85, 0x8d,
+	0xbc, 0xf6, 0x90, 0x07, 0x4c, 0xd2, 0x40, 0xc8, 0xc1, 0xdb, 0xb1, 0xca, 0x78, 0x0a, 0x61, 0xc0,
+	0xba, 0x8e, 0x4f, 0xbb, 0x9e, 0x3c, 0x46, 0xf3, 0x55, 0x50, 0x5b, 0x6a, 0xa0, 0x54, 0xe1, 0xdb,
+	0x7f, 0xa7, 0x85, 0xde, 0x62, 0xc0, 0xba, 0x4d, 0x3d, 0x34, 0x9a, 0x70, 0x35, 0xa4, 0x9f, 0x62,
+	0x16, 0x52, 0x27, 0x16, 0x82, 0x86, 0x2e, 0x89, 0x28, 0xba, 0x52, 0x05, 0xb5, 0x52, 0x03, 0xa7,
+	0x0a, 0x6f, 0x5c, 0x82, 0x05, 0xcd, 0x4a, 0x06, 0x0f, 0x73, 0x56, 0xb4, 0xf9, 0xfc, 0x24, 0xb3,
+	0x5d, 0xbd, 0x6c, 0x1b, 0xc3, 0x19, 0xb6, 0x66, 0xce, 0x8c, 0x1d, 0xb8, 0x94, 0x17, 0x3a, 0xcc,
+	0x63, 0x12, 0x5d, 0xd3, 0xa6, 0x8d, 0x54, 0xe1, 0x3b, 0x13, 0xa0, 0x60, 0xb9, 0x99, 0x81, 0xdd,
+	0xe1, 0xdc, 0x78, 0x09, 0xcb, 0x79, 0x30, 0x1a, 0x04, 0x6d, 0xee, 0xa3, 0x05, 0xad, 0xb8, 0x9b,
+	0x2a, 0x8c, 0x26, 0x49, 0xc1, 0x91, 0x6f, 0x7d, 0xa7, 0x81, 0xf1, 0x06, 0xde, 0x0a, 0x48, 0xdf,
+	0x39, 0x22, 0xcc, 0xa7, 0x1d, 0x87, 0x48, 0x9d, 0x8a, 0xd0, 0x75, 0xfd, 0x91, 0xef, 0xa5, 0x0a,
+	0x6f, 0xce, 0xc0, 0x05, 0xdd, 0x6a, 0x40, 0xfa, 0x7b, 0x9a, 0xbe, 0xc8, 0xa0, 0xf1, 0x19, 0x56,
+	0x66, 0x74, 0x1c, 0x41, 0x43, 0x87, 0x09, 0x54, 0xd2, 0xe6, 0x46, 0xa2, 0xf0, 0xda, 0xc1, 0x74,
+	0xb5, 0x45, 0xc3, 0xfd, 0x56, 0xaa, 0xf0, 0x83, 0x7f, 0xf7, 0x0b, 0xab, 0xd7, 0x82, 0x59, 0x7d,
+	0x61, 0xec, 0xc3, 0x15, 0x9f, 0xbb, 0x1f, 0x79, 0x2c, 0x9d, 0x4e, 0x1c, 0x12, 0xc9, 0x78, 0x17,
+	0x2d, 0xea, 0xad, 0x66, 0xaa, 0x70, 0x65, 0x9a, 0x15, 0x8c, 0xcb, 0x19, 0xdb, 0xcd, 0x90, 0xb1,
+	0x07, 0x97, 0xc7, 0x6f, 0x8e, 0xf6, 0x05, 0x0b, 0x07, 0x08, 0x6a, 0xd3, 0x66, 0xaa, 0xf0, 0xfa,
+	0x14, 0x2a, 0x88, 0xca, 0x39, 0x7a, 0xa5, 0xc9, 0xb3, 0xd2, 0x97, 0x53, 0x3c, 0x77, 0x76, 0x8a,
+	0x41, 0xa3, 0xfa, 0xfb, 0xa7, 0x09, 0xce, 0x12, 0x13, 0x7c, 0x4f, 0x4c, 0x70, 0x9e, 0x98, 0xe0,
+	0x22, 0x31, 0xc1, 0x8f, 0xc4, 0x04, 0x5f, 0x7f, 0x99, 0x73, 0xef, 0xe7, 0x7b, 0xf5, 0xf6, 0x82,
+	0x7e, 0x5e, 0xdb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xae, 0x20, 0xb1, 0xd1, 0x14, 0x04, 0x00,
+	0x00,
+}
+
+func (this *PasswordPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PasswordPolicy)
+	if !ok {
+		that2, ok := that.(PasswordPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.MinLength != that1.MinLength {
+		return false
+	}
+	if this.RequireUppercase != that1.RequireUppercase {
+		return false
+	}
+	if this.RequireLowercase != that1.RequireLowercase {
+		return false
+	}
+	if this.RequireDigit != that1.RequireDigit {
+		return false
+	}
+	if this.RequireSymbol != that1.RequireSymbol {
+		return false
+	}
+	if this.MaxFailedAttempts != that1.MaxFailedAttempts {
+		return false
+	}
+	if this.MaxFailedAttemptsPerIP != that1.MaxFailedAttemptsPerIP {
+		return false
+	}
+	if this.LockoutDuration != that1.LockoutDuration {
+		return false
+	}
+	if this.PasswordExpiry != that1.PasswordExpiry {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type PasswordPolicyFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetMinLength() uint32
+	GetRequireUppercase() bool
+	GetRequireLowercase() bool
+	GetRequireDigit() bool
+	GetRequireSymbol() bool
+	GetMaxFailedAttempts() uint32
+	GetMaxFailedAttemptsPerIP() uint32
+	GetLockoutDuration() uint32
+	GetPasswordExpiry() uint32
+}
+
+func (this *PasswordPolicy) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *PasswordPolicy) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewPasswordPolicyFromFace(this)
+}
+
+func (this *PasswordPolicy) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *PasswordPolicy) GetMinLength() uint32 {
+	return this.MinLength
+}
+
+func (this *PasswordPolicy) GetRequireUppercase() bool {
+	return this.RequireUppercase
+}
+
+func (this *PasswordPolicy) GetRequireLowercase() bool {
+	return this.RequireLowercase
+}
+
+func (this *PasswordPolicy) GetRequireDigit() bool {
+	return this.RequireDigit
+}
+
+func (this *PasswordPolicy) GetRequireSymbol() bool {
+	return this.RequireSymbol
+}
+
+func (this *PasswordPolicy) GetMaxFailedAttempts() uint32 {
+	return this.MaxFailedAttempts
+}
+
+func (this *PasswordPolicy) GetMaxFailedAttemptsPerIP() uint32 {
+	return this.MaxFailedAttemptsPerIP
+}
+
+func (this *PasswordPolicy) GetLockoutDuration() uint32 {
+	return this.LockoutDuration
+}
+
+func (this *PasswordPolicy) GetPasswordExpiry() uint32 {
+	return this.PasswordExpiry
+}
+
+func NewPasswordPolicyFromFace(that PasswordPolicyFace) *PasswordPolicy {
+	this := &PasswordPolicy{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.MinLength = that.GetMinLength()
+	this.RequireUppercase = that.GetRequireUppercase()
+	this.RequireLowercase = that.GetRequireLowercase()
+	this.RequireDigit = that.GetRequireDigit()
+	this.RequireSymbol = that.GetRequireSymbol()
+	this.MaxFailedAttempts = that.GetMaxFailedAttempts()
+	this.MaxFailedAttemptsPerIP = that.GetMaxFailedAttemptsPerIP()
+	this.LockoutDuration = that.GetLockoutDuration()
+	this.PasswordExpiry = that.GetPasswordExpiry()
+	return this
+}
+
+func (m *PasswordPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PasswordPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PasswordPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PasswordExpiry != 0 {
+		i = encodeVarintPasswordPolicy(dAtA, i, uint64(m.PasswordExpiry))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LockoutDuration != 0 {
+		i = encodeVarintPasswordPolicy(dAtA, i, uint64(m.LockoutDuration))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxFailedAttemptsPerIP != 0 {
+		i = encodeVarintPasswordPolicy(dAtA, i, uint64(m.MaxFailedAttemptsPerIP))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxFailedAttempts != 0 {
+		i = encodeVarintPasswordPolicy(dAtA, i, uint64(m.MaxFailedAttempts))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RequireSymbol {
+		i--
+		if m.RequireSymbol {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RequireDigit {
+		i--
+		if m.RequireDigit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RequireLowercase {
+		i--
+		if m.RequireLowercase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequireUppercase {
+		i--
+		if m.RequireUppercase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinLength != 0 {
+		i = encodeVarintPasswordPolicy(dAtA, i, uint64(m.MinLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPasswordPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPasswordPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPasswordPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedPasswordPolicy(r randyPasswordPolicy, easy bool) *PasswordPolicy {
+	this := &PasswordPolicy{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.MinLength = uint32(r.Uint32())
+	this.RequireUppercase = bool(bool(r.Intn(2) == 0))
+	this.RequireLowercase = bool(bool(r.Intn(2) == 0))
+	this.RequireDigit = bool(bool(r.Intn(2) == 0))
+	this.RequireSymbol = bool(bool(r.Intn(2) == 0))
+	this.MaxFailedAttempts = uint32(r.Uint32())
+	this.MaxFailedAttemptsPerIP = uint32(r.Uint32())
+	this.LockoutDuration = uint32(r.Uint32())
+	this.PasswordExpiry = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedPasswordPolicy(r, 11)
+	}
+	return this
+}
+
+type randyPasswordPolicy interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RunePasswordPolicy(r randyPasswordPolicy) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringPasswordPolicy(r randyPasswordPolicy) string {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
+		tmps[i] = randUTF8RunePasswordPolicy(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedPasswordPolicy(r randyPasswordPolicy, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldPasswordPolicy(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldPasswordPolicy(dAtA []byte, r randyPasswordPolicy, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulatePasswordPolicy(dAtA, uint64(key))
+		v3 := r.Int63()
+		if r.Intn(2) == 0 {
+			v3 *= -1
+		}
+		dAtA = encodeVarintPopulatePasswordPolicy(dAtA, uint64(v3))
+	case 1:
+		dAtA = encodeVarintPopulatePasswordPolicy(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulatePasswordPolicy(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulatePasswordPolicy(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulatePasswordPolicy(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulatePasswordPolicy(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *PasswordPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovPasswordPolicy(uint64(l))
+	if m.MinLength != 0 {
+		n += 1 + sovPasswordPolicy(uint64(m.MinLength))
+	}
+	if m.RequireUppercase {
+		n += 2
+	}
+	if m.RequireLowercase {
+		n += 2
+	}
+	if m.RequireDigit {
+		n += 2
+	}
+	if m.RequireSymbol {
+		n += 2
+	}
+	if m.MaxFailedAttempts != 0 {
+		n += 1 + sovPasswordPolicy(uint64(m.MaxFailedAttempts))
+	}
+	if m.MaxFailedAttemptsPerIP != 0 {
+		n += 1 + sovPasswordPolicy(uint64(m.MaxFailedAttemptsPerIP))
+	}
+	if m.LockoutDuration != 0 {
+		n += 1 + sovPasswordPolicy(uint64(m.LockoutDuration))
+	}
+	if m.PasswordExpiry != 0 {
+		n += 1 + sovPasswordPolicy(uint64(m.PasswordExpiry))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPasswordPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPasswordPolicy(x uint64) (n int) {
+	return sovPasswordPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PasswordPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPasswordPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PasswordPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PasswordPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPasswordPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPasswordPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLength", wireType)
+			}
+			m.MinLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireUppercase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireUppercase = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireLowercase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireLowercase = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireDigit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireDigit = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireSymbol", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireSymbol = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailedAttempts", wireType)
+			}
+			m.MaxFailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailedAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailedAttemptsPerIP", wireType)
+			}
+			m.MaxFailedAttemptsPerIP = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailedAttemptsPerIP |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockoutDuration", wireType)
+			}
+			m.LockoutDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockoutDuration |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordExpiry", wireType)
+			}
+			m.PasswordExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PasswordExpiry |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPasswordPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPasswordPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPasswordPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPasswordPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPasswordPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPasswordPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPasswordPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPasswordPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPasswordPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPasswordPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPasswordPolicy = fmt.Errorf("proto: unexpected end of group")
+)
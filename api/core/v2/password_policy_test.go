@@ -0,0 +1,108 @@
+package v2
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	p := FixturePasswordPolicy()
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	p.Name = "foo"
+	if err := p.Validate(); err == nil {
+		t.Fatal("expected an error for a name other than default")
+	}
+
+	p = FixturePasswordPolicy()
+	p.Namespace = "default"
+	if err := p.Validate(); err == nil {
+		t.Fatal("expected an error for a namespace")
+	}
+
+	p = FixturePasswordPolicy()
+	p.LockoutDuration = 0
+	if err := p.Validate(); err == nil {
+		t.Fatal("expected an error for a missing lockout duration")
+	}
+}
+
+func TestPasswordPolicyValidatePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *PasswordPolicy
+		password string
+		wantErr  bool
+	}{
+		{
+			name:     "empty policy",
+			policy:   &PasswordPolicy{},
+			password: "a",
+		},
+		{
+			name:     "too short",
+			policy:   &PasswordPolicy{MinLength: 8},
+			password: "abcdefg",
+			wantErr:  true,
+		},
+		{
+			name:     "missing uppercase",
+			policy:   &PasswordPolicy{RequireUppercase: true},
+			password: "abc",
+			wantErr:  true,
+		},
+		{
+			name:     "missing lowercase",
+			policy:   &PasswordPolicy{RequireLowercase: true},
+			password: "ABC",
+			wantErr:  true,
+		},
+		{
+			name:     "missing digit",
+			policy:   &PasswordPolicy{RequireDigit: true},
+			password: "abc",
+			wantErr:  true,
+		},
+		{
+			name:     "missing symbol",
+			policy:   &PasswordPolicy{RequireSymbol: true},
+			password: "abc1",
+			wantErr:  true,
+		},
+		{
+			name:     "compliant password",
+			policy:   FixturePasswordPolicy(),
+			password: "Sup3rSecretPassw0rd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.ValidatePassword(tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidatePassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicyIsPasswordExpired(t *testing.T) {
+	now := time.Now()
+	p := &PasswordPolicy{PasswordExpiry: 30}
+
+	if p.IsPasswordExpired(now.Add(-24*time.Hour).Unix(), now) {
+		t.Fatal("expected the password not to be expired")
+	}
+	if !p.IsPasswordExpired(now.Add(-31*24*time.Hour).Unix(), now) {
+		t.Fatal("expected the password to be expired")
+	}
+	if p.IsPasswordExpired(0, now) {
+		t.Fatal("expected a password without a change time not to be expired")
+	}
+
+	p.PasswordExpiry = 0
+	if p.IsPasswordExpired(now.Add(-365*24*time.Hour).Unix(), now) {
+		t.Fatal("expected passwords not to expire without an expiry")
+	}
+}
@@ -41,6 +41,13 @@ const (
 	// LocalSelfUserResource represents a local user trying to view itself
 	// or change its password
 	LocalSelfUserResource = "localselfuser"
+
+	// GroupsResource represents the groups of users, which can be
+	// impersonated
+	GroupsResource = "groups"
+
+	// VerbImpersonate is the verb allowing to impersonate users and groups
+	VerbImpersonate = "impersonate"
 )
 
 // CommonCoreResources represents the common "core" resources found in a
@@ -64,6 +71,7 @@ var allowedVerbs = []string{
 	"create",
 	"update",
 	"delete",
+	VerbImpersonate,
 }
 
 // FixtureSubject creates a Subject for testing
@@ -344,6 +352,18 @@ func (r Rule) ResourceNameMatches(requestedResourceName string) bool {
 	return false
 }
 
+// LabelSelectorMatches returns whether the specified labels contain all the
+// labels of the rule label selector
+func (r Rule) LabelSelectorMatches(labels map[string]string) bool {
+	for key, value := range r.LabelSelector {
+		if v, ok := labels[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
 // VerbMatches returns whether the specified requestedVerb matches any of the
 // rule verbs
 func (r Rule) VerbMatches(requestedVerb string) bool {
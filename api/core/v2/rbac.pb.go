@@ -38,10 +38,13 @@ type Rule struct {
 	Resources []string `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources"`
 	// ResourceNames is an optional list of resource names that the rule applies
 	// to.
-	ResourceNames        []string `protobuf:"bytes,3,rep,name=resource_names,json=resourceNames,proto3" json:"resource_names"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ResourceNames []string `protobuf:"bytes,3,rep,name=resource_names,json=resourceNames,proto3" json:"resource_names"`
+	// LabelSelector optionally restricts the rule to the objects having all of
+	// these labels.
+	LabelSelector        map[string]string `protobuf:"bytes,4,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Rule) Reset()         { *m = Rule{} }
@@ -98,6 +101,13 @@ func (m *Rule) GetResourceNames() []string {
 	return nil
 }
 
+func (m *Rule) GetLabelSelector() map[string]string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
 // ClusterRole applies to all namespaces within a cluster.
 type ClusterRole struct {
 	Rules []Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
@@ -395,6 +405,7 @@ var xxx_messageInfo_RoleBinding proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Rule)(nil), "sensu.core.v2.Rule")
+	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.Rule.LabelSelectorEntry")
 	proto.RegisterType((*ClusterRole)(nil), "sensu.core.v2.ClusterRole")
 	proto.RegisterType((*Role)(nil), "sensu.core.v2.Role")
 	proto.RegisterType((*RoleRef)(nil), "sensu.core.v2.RoleRef")
@@ -408,38 +419,43 @@ func init() {
 }
 
 var fileDescriptor_69cb4f8fc3d151bb = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x8e, 0xd3, 0x40,
-	0x10, 0xce, 0xe6, 0x87, 0x4b, 0x36, 0x0a, 0x42, 0x8b, 0x84, 0xcc, 0x09, 0x79, 0xa3, 0x54, 0x91,
-	0x00, 0x9b, 0xf3, 0x51, 0x00, 0x15, 0xf2, 0x41, 0x09, 0x48, 0x8b, 0x68, 0x68, 0x4e, 0xb6, 0x6f,
-	0x62, 0x8c, 0xec, 0x6c, 0xb4, 0x5e, 0x5b, 0xba, 0x8e, 0x92, 0x8e, 0x96, 0xf2, 0xe8, 0xf2, 0x08,
-	0x3c, 0xc2, 0x95, 0xf7, 0x04, 0x16, 0x98, 0xce, 0x4f, 0x00, 0x1d, 0xda, 0x75, 0x9c, 0x1c, 0xa1,
-	0x41, 0x82, 0x14, 0x34, 0xeb, 0x9d, 0x99, 0x6f, 0xbe, 0xfd, 0xe6, 0x1b, 0xc9, 0xf8, 0x5e, 0x18,
-	0xc9, 0x37, 0x99, 0x6f, 0x05, 0x3c, 0xb1, 0x53, 0x98, 0xa7, 0x59, 0x7d, 0xde, 0x0d, 0xb9, 0xed,
-	0x2d, 0x22, 0x3b, 0xe0, 0x02, 0xec, 0xdc, 0xb1, 0x85, 0xef, 0x05, 0xd6, 0x42, 0x70, 0xc9, 0xc9,
-	0x48, 0x03, 0x2c, 0x55, 0xb1, 0x72, 0x67, 0xff, 0xfe, 0x25, 0x82, 0x90, 0x87, 0xdc, 0xd6, 0x28,
-	0x3f, 0x9b, 0x3d, 0xce, 0x0f, 0xac, 0x43, 0xeb, 0x40, 0x27, 0x75, 0x4e, 0xdf, 0x6a, 0x92, 0xfd,
-	0x3f, 0x7c, 0x36, 0x01, 0xe9, 0xd5, 0x1d, 0x93, 0x0f, 0x08, 0x77, 0x59, 0x16, 0x03, 0xa1, 0xb8,
-	0x97, 0x83, 0xf0, 0x53, 0x03, 0x8d, 0x3b, 0xd3, 0x81, 0x3b, 0xa8, 0x0a, 0x5a, 0x27, 0x58, 0xfd,
-	0x21, 0xb7, 0xf1, 0x40, 0x40, 0xca, 0x33, 0x11, 0x40, 0x6a, 0xb4, 0x35, 0x68, 0x54, 0x15, 0x74,
-	0x93, 0x64, 0x9b, 0x2b, 0x79, 0x88, 0xaf, 0x36, 0xc1, 0xf1, 0xdc, 0x4b, 0x20, 0x35, 0x3a, 0xba,
-	0x83, 0x54, 0x05, 0xdd, 0xaa, 0xb0, 0x51, 0x13, 0x3f, 0x57, 0xe1, 0x64, 0x89, 0xf0, 0xf0, 0x28,
-	0xce, 0x52, 0x09, 0x82, 0xf1, 0x18, 0xc8, 0x03, 0xdc, 0x13, 0x59, 0x0c, 0xb5, 0xb0, 0xa1, 0x73,
-	0xdd, 0xfa, 0xc5, 0x28, 0x4b, 0x89, 0x77, 0x47, 0xe7, 0x05, 0x6d, 0x29, 0xc5, 0x1a, 0xc9, 0xea,
-	0x0f, 0x79, 0x85, 0xfb, 0x6a, 0xd2, 0x13, 0x4f, 0x7a, 0x46, 0x67, 0x8c, 0xa6, 0x43, 0xe7, 0xe6,
-	0x56, 0xf3, 0x0b, 0xff, 0x2d, 0x04, 0xf2, 0x19, 0x48, 0xcf, 0x35, 0x15, 0xc5, 0x45, 0x41, 0x51,
-	0x55, 0x50, 0xd2, 0xb4, 0xdd, 0xe1, 0x49, 0x24, 0x21, 0x59, 0xc8, 0x53, 0xb6, 0xa6, 0x7a, 0xd4,
-	0x7f, 0x7f, 0x46, 0x5b, 0xcb, 0x33, 0x8a, 0x26, 0x9f, 0x94, 0x79, 0xff, 0x4e, 0x63, 0x77, 0x17,
-	0x1a, 0x9f, 0xe2, 0x3d, 0x25, 0x91, 0xc1, 0x8c, 0xdc, 0xc2, 0x5d, 0x79, 0xba, 0x00, 0x03, 0x8d,
-	0xd1, 0x74, 0xe0, 0xf6, 0xab, 0x82, 0xea, 0x98, 0xe9, 0x53, 0x55, 0xd5, 0x3e, 0x8c, 0xf6, 0xa6,
-	0xaa, 0x62, 0xa6, 0x4f, 0x45, 0xf3, 0x32, 0xd3, 0x4a, 0xfe, 0x8a, 0xe6, 0x5d, 0x1b, 0x93, 0x4b,
-	0xcb, 0x75, 0xa3, 0xf9, 0x49, 0x34, 0x0f, 0xc9, 0x13, 0xdc, 0x4f, 0x6b, 0xf6, 0xc6, 0xc2, 0x1b,
-	0x5b, 0x2e, 0xac, 0x1e, 0x77, 0xaf, 0xad, 0x5c, 0x5c, 0xe3, 0xd9, 0xfa, 0x46, 0x8e, 0x70, 0x5f,
-	0xf0, 0x18, 0x8e, 0x05, 0xcc, 0xf4, 0xf3, 0xbf, 0xb3, 0xac, 0x9c, 0xd8, 0xb0, 0x34, 0x78, 0xb6,
-	0x27, 0x56, 0x26, 0xed, 0x7c, 0x21, 0x3f, 0x10, 0x1e, 0xfe, 0x07, 0xb3, 0xf7, 0x76, 0x30, 0xbb,
-	0x3b, 0xfe, 0xfe, 0xd5, 0x44, 0xcb, 0xd2, 0x44, 0x9f, 0x4b, 0x13, 0x9d, 0x97, 0x26, 0xba, 0x28,
-	0x4d, 0xf4, 0xa5, 0x34, 0xd1, 0xc7, 0x6f, 0x66, 0xeb, 0x75, 0x3b, 0x77, 0xfc, 0x2b, 0xfa, 0xb7,
-	0x74, 0xf8, 0x33, 0x00, 0x00, 0xff, 0xff, 0x88, 0xee, 0x60, 0x1d, 0x41, 0x05, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xef, 0x39, 0x09, 0x4d, 0x2e, 0x4a, 0x55, 0x1d, 0x08, 0x99, 0xaa, 0xb2, 0xa3, 0x0e, 0x28,
+	0x12, 0x60, 0x53, 0x97, 0xa1, 0x74, 0xaa, 0x5c, 0xba, 0xf1, 0x47, 0xba, 0x8a, 0x85, 0xa5, 0xb2,
+	0xdd, 0x97, 0x10, 0xea, 0xe4, 0xa2, 0xf3, 0xd9, 0x52, 0x36, 0x46, 0x3e, 0x02, 0x63, 0xd9, 0xf2,
+	0x11, 0x98, 0x98, 0x3b, 0xf6, 0x13, 0x58, 0x10, 0x36, 0x7f, 0x02, 0xd8, 0xd0, 0xdd, 0xe5, 0x5f,
+	0x53, 0x06, 0x24, 0xc8, 0xc0, 0x72, 0xf7, 0xfe, 0xfc, 0xde, 0xef, 0xde, 0xfb, 0x3d, 0xcb, 0xf8,
+	0x71, 0xa7, 0x2b, 0xde, 0xa6, 0xa1, 0x13, 0xb1, 0x9e, 0x9b, 0x40, 0x3f, 0x49, 0xf5, 0xf9, 0xa8,
+	0xc3, 0xdc, 0x60, 0xd0, 0x75, 0x23, 0xc6, 0xc1, 0xcd, 0x3c, 0x97, 0x87, 0x41, 0xe4, 0x0c, 0x38,
+	0x13, 0x8c, 0x34, 0x14, 0xc0, 0x91, 0x19, 0x27, 0xf3, 0xb6, 0x9e, 0x2c, 0x10, 0x74, 0x58, 0x87,
+	0xb9, 0x0a, 0x15, 0xa6, 0xed, 0xc3, 0x6c, 0xd7, 0xd9, 0x73, 0x76, 0x55, 0x50, 0xc5, 0x94, 0xa5,
+	0x49, 0xb6, 0xfe, 0xf0, 0xd9, 0x1e, 0x88, 0x40, 0x57, 0xec, 0x7c, 0x31, 0x70, 0x99, 0xa6, 0x31,
+	0x10, 0x1b, 0x57, 0x32, 0xe0, 0x61, 0x62, 0xa2, 0x66, 0xa9, 0x55, 0xf3, 0x6b, 0x45, 0x6e, 0xeb,
+	0x00, 0xd5, 0x17, 0x79, 0x80, 0x6b, 0x1c, 0x12, 0x96, 0xf2, 0x08, 0x12, 0xd3, 0x50, 0xa0, 0x46,
+	0x91, 0xdb, 0xf3, 0x20, 0x9d, 0x9b, 0xe4, 0x29, 0xde, 0x98, 0x3a, 0xa7, 0xfd, 0xa0, 0x07, 0x89,
+	0x59, 0x52, 0x15, 0xa4, 0xc8, 0xed, 0xa5, 0x0c, 0x6d, 0x4c, 0xfd, 0x97, 0xd2, 0x25, 0xe7, 0x78,
+	0x23, 0x0e, 0x42, 0x88, 0x4f, 0x13, 0x88, 0x21, 0x12, 0x8c, 0x9b, 0xe5, 0x66, 0xa9, 0x55, 0xf7,
+	0xee, 0x3b, 0xd7, 0x14, 0x72, 0x64, 0xd7, 0xce, 0x73, 0x89, 0x3c, 0x99, 0x00, 0x8f, 0xfb, 0x82,
+	0x0f, 0xfd, 0xed, 0x22, 0xb7, 0xcd, 0xeb, 0x0c, 0x0f, 0x59, 0xaf, 0x2b, 0xa0, 0x37, 0x10, 0x43,
+	0xda, 0x88, 0x17, 0x2b, 0xb6, 0x0e, 0x31, 0xb9, 0x49, 0x41, 0x36, 0x71, 0xe9, 0x1c, 0x86, 0x26,
+	0x6a, 0xa2, 0x56, 0x8d, 0x4a, 0x93, 0xdc, 0xc1, 0x95, 0x2c, 0x88, 0x53, 0x30, 0x0d, 0x15, 0xd3,
+	0xce, 0x81, 0xb1, 0x8f, 0x76, 0x46, 0x08, 0xd7, 0x8f, 0xe2, 0x34, 0x11, 0xc0, 0x29, 0x8b, 0x81,
+	0xec, 0xe3, 0x0a, 0x4f, 0x63, 0xd0, 0x3a, 0xd6, 0xbd, 0xdb, 0xbf, 0xe9, 0xda, 0x6f, 0x5c, 0xe6,
+	0xf6, 0x9a, 0x14, 0x58, 0x21, 0xa9, 0xbe, 0xc8, 0x6b, 0x5c, 0x95, 0x8b, 0x39, 0x0b, 0x44, 0x60,
+	0x96, 0x9a, 0xa8, 0x55, 0xf7, 0xee, 0x2d, 0x15, 0xbf, 0x0a, 0xdf, 0x41, 0x24, 0x5e, 0x80, 0x08,
+	0x7c, 0x4b, 0x52, 0x5c, 0xe5, 0x36, 0x2a, 0x72, 0x9b, 0x4c, 0xcb, 0x16, 0xe6, 0x9c, 0x51, 0x1d,
+	0x54, 0x3f, 0x5c, 0xd8, 0x6b, 0xa3, 0x0b, 0x1b, 0xed, 0x7c, 0x42, 0xb8, 0xfc, 0x0f, 0x7b, 0x2c,
+	0xaf, 0xa2, 0xc7, 0x63, 0xbc, 0x2e, 0x5b, 0xa4, 0xd0, 0x26, 0xdb, 0xb8, 0x2c, 0x86, 0x03, 0xd0,
+	0x6b, 0xf0, 0xab, 0x45, 0x6e, 0x2b, 0x9f, 0xaa, 0x53, 0x66, 0xe5, 0xe7, 0xa3, 0x17, 0xa2, 0xb3,
+	0xd2, 0xa7, 0xea, 0x94, 0x34, 0x27, 0xa9, 0xea, 0xe4, 0xaf, 0x68, 0xde, 0x1b, 0x98, 0x2c, 0x2c,
+	0xd7, 0xef, 0xf6, 0xcf, 0xba, 0xfd, 0x0e, 0x79, 0x86, 0xab, 0x89, 0x66, 0x9f, 0x4a, 0x78, 0x77,
+	0x49, 0x85, 0xc9, 0xe3, 0xfe, 0xe6, 0x44, 0xc5, 0x19, 0x9e, 0xce, 0x2c, 0x72, 0x84, 0xab, 0x9c,
+	0xc5, 0x70, 0xca, 0xa1, 0xad, 0x9e, 0xbf, 0xc9, 0x32, 0x51, 0x62, 0xce, 0x32, 0xc5, 0xd3, 0x75,
+	0x3e, 0x11, 0x69, 0xe5, 0x0b, 0xf9, 0x89, 0x70, 0xfd, 0x3f, 0x98, 0xbd, 0xb2, 0x82, 0xd9, 0xfd,
+	0xe6, 0x8f, 0x6f, 0x16, 0x1a, 0x8d, 0x2d, 0xf4, 0x79, 0x6c, 0xa1, 0xcb, 0xb1, 0x85, 0xae, 0xc6,
+	0x16, 0xfa, 0x3a, 0xb6, 0xd0, 0xc7, 0xef, 0xd6, 0xda, 0x1b, 0x23, 0xf3, 0xc2, 0x5b, 0xea, 0x2f,
+	0xba, 0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x78, 0x9f, 0xb2, 0xf0, 0x05, 0x00, 0x00,
 }
 
 func (this *Rule) Equal(that interface{}) bool {
@@ -485,6 +501,14 @@ func (this *Rule) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.LabelSelector) != len(that1.LabelSelector) {
+		return false
+	}
+	for i := range this.LabelSelector {
+		if this.LabelSelector[i] != that1.LabelSelector[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -849,6 +873,25 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.LabelSelector) > 0 {
+		for k := range m.LabelSelector {
+			v := m.LabelSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRbac(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRbac(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRbac(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ResourceNames) > 0 {
 		for iNdEx := len(m.ResourceNames) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ResourceNames[iNdEx])
@@ -1213,8 +1256,15 @@ func NewPopulatedRule(r randyRbac, easy bool) *Rule {
 	for i := 0; i < v3; i++ {
 		this.ResourceNames[i] = string(randStringRbac(r))
 	}
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(10)
+		this.LabelSelector = make(map[string]string)
+		for i := 0; i < v4; i++ {
+			this.LabelSelector[randStringRbac(r)] = randStringRbac(r)
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRbac(r, 4)
+		this.XXX_unrecognized = randUnrecognizedRbac(r, 5)
 	}
 	return this
 }
@@ -1222,15 +1272,15 @@ func NewPopulatedRule(r randyRbac, easy bool) *Rule {
 func NewPopulatedClusterRole(r randyRbac, easy bool) *ClusterRole {
 	this := &ClusterRole{}
 	if r.Intn(5) != 0 {
-		v4 := r.Intn(5)
-		this.Rules = make([]Rule, v4)
-		for i := 0; i < v4; i++ {
-			v5 := NewPopulatedRule(r, easy)
-			this.Rules[i] = *v5
+		v5 := r.Intn(5)
+		this.Rules = make([]Rule, v5)
+		for i := 0; i < v5; i++ {
+			v6 := NewPopulatedRule(r, easy)
+			this.Rules[i] = *v6
 		}
 	}
-	v6 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v6
+	v7 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v7
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedRbac(r, 4)
 	}
@@ -1240,15 +1290,15 @@ func NewPopulatedClusterRole(r randyRbac, easy bool) *ClusterRole {
 func NewPopulatedRole(r randyRbac, easy bool) *Role {
 	this := &Role{}
 	if r.Intn(5) != 0 {
-		v7 := r.Intn(5)
-		this.Rules = make([]Rule, v7)
-		for i := 0; i < v7; i++ {
-			v8 := NewPopulatedRule(r, easy)
-			this.Rules[i] = *v8
+		v8 := r.Intn(5)
+		this.Rules = make([]Rule, v8)
+		for i := 0; i < v8; i++ {
+			v9 := NewPopulatedRule(r, easy)
+			this.Rules[i] = *v9
 		}
 	}
-	v9 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v9
+	v10 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v10
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedRbac(r, 5)
 	}
@@ -1278,17 +1328,17 @@ func NewPopulatedSubject(r randyRbac, easy bool) *Subject {
 func NewPopulatedClusterRoleBinding(r randyRbac, easy bool) *ClusterRoleBinding {
 	this := &ClusterRoleBinding{}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(5)
-		this.Subjects = make([]Subject, v10)
-		for i := 0; i < v10; i++ {
-			v11 := NewPopulatedSubject(r, easy)
-			this.Subjects[i] = *v11
+		v11 := r.Intn(5)
+		this.Subjects = make([]Subject, v11)
+		for i := 0; i < v11; i++ {
+			v12 := NewPopulatedSubject(r, easy)
+			this.Subjects[i] = *v12
 		}
 	}
-	v12 := NewPopulatedRoleRef(r, easy)
-	this.RoleRef = *v12
-	v13 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v13
+	v13 := NewPopulatedRoleRef(r, easy)
+	this.RoleRef = *v13
+	v14 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v14
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedRbac(r, 5)
 	}
@@ -1298,17 +1348,17 @@ func NewPopulatedClusterRoleBinding(r randyRbac, easy bool) *ClusterRoleBinding
 func NewPopulatedRoleBinding(r randyRbac, easy bool) *RoleBinding {
 	this := &RoleBinding{}
 	if r.Intn(5) != 0 {
-		v14 := r.Intn(5)
-		this.Subjects = make([]Subject, v14)
-		for i := 0; i < v14; i++ {
-			v15 := NewPopulatedSubject(r, easy)
-			this.Subjects[i] = *v15
+		v15 := r.Intn(5)
+		this.Subjects = make([]Subject, v15)
+		for i := 0; i < v15; i++ {
+			v16 := NewPopulatedSubject(r, easy)
+			this.Subjects[i] = *v16
 		}
 	}
-	v16 := NewPopulatedRoleRef(r, easy)
-	this.RoleRef = *v16
-	v17 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v17
+	v17 := NewPopulatedRoleRef(r, easy)
+	this.RoleRef = *v17
+	v18 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v18
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedRbac(r, 6)
 	}
@@ -1334,9 +1384,9 @@ func randUTF8RuneRbac(r randyRbac) rune {
 	return rune(ru + 61)
 }
 func randStringRbac(r randyRbac) string {
-	v18 := r.Intn(100)
-	tmps := make([]rune, v18)
-	for i := 0; i < v18; i++ {
+	v19 := r.Intn(100)
+	tmps := make([]rune, v19)
+	for i := 0; i < v19; i++ {
 		tmps[i] = randUTF8RuneRbac(r)
 	}
 	return string(tmps)
@@ -1358,11 +1408,11 @@ func randFieldRbac(dAtA []byte, r randyRbac, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRbac(dAtA, uint64(key))
-		v19 := r.Int63()
+		v20 := r.Int63()
 		if r.Intn(2) == 0 {
-			v19 *= -1
+			v20 *= -1
 		}
-		dAtA = encodeVarintPopulateRbac(dAtA, uint64(v19))
+		dAtA = encodeVarintPopulateRbac(dAtA, uint64(v20))
 	case 1:
 		dAtA = encodeVarintPopulateRbac(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1411,6 +1461,14 @@ func (m *Rule) Size() (n int) {
 			n += 1 + l + sovRbac(uint64(l))
 		}
 	}
+	if len(m.LabelSelector) > 0 {
+		for k, v := range m.LabelSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRbac(uint64(len(k))) + 1 + len(v) + sovRbac(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRbac(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1672,6 +1730,133 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			}
 			m.ResourceNames = append(m.ResourceNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LabelSelector == nil {
+				m.LabelSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRbac
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRbac
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRbac
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRbac
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRbac
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRbac
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRbac
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRbac(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRbac
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LabelSelector[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRbac(dAtA[iNdEx:])
@@ -133,6 +133,48 @@ func TestRuleVerbMatches(t *testing.T) {
 	}
 }
 
+func TestRuleLabelSelectorMatches(t *testing.T) {
+	tests := []struct {
+		name          string
+		labelSelector map[string]string
+		labels        map[string]string
+		want          bool
+	}{
+		{
+			name:   "empty label selector",
+			labels: map[string]string{"team": "a"},
+			want:   true,
+		},
+		{
+			name:          "missing label",
+			labelSelector: map[string]string{"team": "a"},
+			want:          false,
+		},
+		{
+			name:          "does not match",
+			labelSelector: map[string]string{"team": "a", "tier": "1"},
+			labels:        map[string]string{"team": "a", "tier": "2"},
+			want:          false,
+		},
+		{
+			name:          "matches",
+			labelSelector: map[string]string{"team": "a"},
+			labels:        map[string]string{"team": "a", "tier": "2"},
+			want:          true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := Rule{
+				LabelSelector: tc.labelSelector,
+			}
+			if got := r.LabelSelectorMatches(tc.labels); got != tc.want {
+				t.Errorf("Rule.LabelSelectorMatches() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func Test_validateVerbs(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -159,6 +201,11 @@ func Test_validateVerbs(t *testing.T) {
 			verbs:   []string{"get", "list", "create", "update", "delete"},
 			wantErr: false,
 		},
+		{
+			name:    "impersonate verb",
+			verbs:   []string{VerbImpersonate},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1,5 +1,7 @@
 package v2
 
+import "fmt"
+
 // Resource represents a Sensu resource.
 type Resource interface {
 	// GetObjectMeta returns the object metadata for the resource.
@@ -23,3 +25,25 @@ type Resource interface {
 	// Validate checks if the fields in the resource are valid.
 	Validate() error
 }
+
+// rbacNameTypes are the types stored for the RBAC names shared by several
+// resource types.
+var rbacNameTypes = map[string]string{
+	"checks": "CheckConfig",
+	"hooks":  "HookConfig",
+}
+
+// ResolveResourceByRBACName returns a zero-valued resource, given its RBAC
+// name. If no resource type has this RBAC name, then an error will be
+// returned.
+func ResolveResourceByRBACName(name string) (Resource, error) {
+	if typeName, ok := rbacNameTypes[name]; ok {
+		return ResolveResource(typeName)
+	}
+	for _, t := range typeMap {
+		if r, ok := t.(Resource); ok && r.RBACName() == name {
+			return newResource(r), nil
+		}
+	}
+	return nil, fmt.Errorf("resource could not be found: %q", name)
+}
@@ -0,0 +1,134 @@
+package v2
+
+import (
+	"errors"
+	"net/url"
+	"path"
+
+	stringsutil "github.com/sensu/sensu-go/api/core/v2/internal/stringutil"
+)
+
+const (
+	// ResourceQuotasResource is the name of this resource type
+	ResourceQuotasResource = "resourcequotas"
+
+	// EventsPerMinuteQuota is the name of the events rate in the quota limits
+	// and usage.
+	EventsPerMinuteQuota = "events_per_minute"
+)
+
+// SetObjectMeta sets the object metadata for the resource.
+func (q *ResourceQuota) SetObjectMeta(meta ObjectMeta) {
+	q.ObjectMeta = meta
+}
+
+// SetNamespace sets the namespace of the resource.
+func (q *ResourceQuota) SetNamespace(namespace string) {
+	q.Namespace = namespace
+}
+
+// StorePrefix returns the path prefix to this resource in the store.
+func (q *ResourceQuota) StorePrefix() string {
+	return ResourceQuotasResource
+}
+
+// RBACName describes the name of the resource for RBAC purposes.
+func (q *ResourceQuota) RBACName() string {
+	return ResourceQuotasResource
+}
+
+// URIPath gives the path component of a resource quota URI.
+func (q *ResourceQuota) URIPath() string {
+	if q.Namespace == "" {
+		return path.Join(URLPrefix, ResourceQuotasResource, url.PathEscape(q.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(q.Namespace), ResourceQuotasResource, url.PathEscape(q.Name))
+}
+
+// Validate checks if a resource quota passes validation rules.
+func (q *ResourceQuota) Validate() error {
+	if err := ValidateName(q.ObjectMeta.Name); err != nil {
+		return errors.New("name " + err.Error())
+	}
+
+	if q.ObjectMeta.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	return nil
+}
+
+// Limit returns the limit of the quota for the given resource, e.g.
+// EntitiesResource or EventsPerMinuteQuota, or 0 if the resource is not
+// limited.
+func (q *ResourceQuota) Limit(resource string) uint32 {
+	switch resource {
+	case EntitiesResource:
+		return q.MaxEntities
+	case ChecksResource:
+		return q.MaxChecks
+	case SilencedResource:
+		return q.MaxSilenced
+	case AssetsResource:
+		return q.MaxAssets
+	case EventsPerMinuteQuota:
+		return q.MaxEventsPerMinute
+	}
+	return 0
+}
+
+// QuotaResources are the resources that can be limited by a resource quota.
+var QuotaResources = []string{
+	EntitiesResource,
+	ChecksResource,
+	SilencedResource,
+	AssetsResource,
+	EventsPerMinuteQuota,
+}
+
+// ResourceQuotaUsage reports the usage of the resources limited by a
+// resource quota.
+type ResourceQuotaUsage struct {
+	// Name is the name of the resource quota.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the resource quota.
+	Namespace string `json:"namespace"`
+
+	// Resources is the usage of each resource limited by the quota.
+	Resources []ResourceUsage `json:"resources"`
+}
+
+// ResourceUsage is the usage of a resource limited by a resource quota.
+type ResourceUsage struct {
+	// Resource is the name of the resource, e.g. entities.
+	Resource string `json:"resource"`
+
+	// Used is the number of resources of the namespace. For the events per
+	// minute, it is the number of events processed by the backend serving the
+	// request during the current minute.
+	Used int64 `json:"used"`
+
+	// Limit is the limit of the quota.
+	Limit uint32 `json:"limit"`
+}
+
+// ResourceQuotaFields returns a set of fields that represent that resource.
+func ResourceQuotaFields(r Resource) map[string]string {
+	resource := r.(*ResourceQuota)
+	fields := map[string]string{
+		"resourcequota.name":      resource.ObjectMeta.Name,
+		"resourcequota.namespace": resource.ObjectMeta.Namespace,
+	}
+	stringsutil.MergeMapWithPrefix(fields, resource.ObjectMeta.Labels, "resourcequota.labels.")
+	return fields
+}
+
+// FixtureResourceQuota returns a testing fixture for a ResourceQuota object.
+func FixtureResourceQuota(name, namespace string) *ResourceQuota {
+	return &ResourceQuota{
+		ObjectMeta:  NewObjectMeta(name, namespace),
+		MaxEntities: 1000,
+		MaxChecks:   100,
+	}
+}
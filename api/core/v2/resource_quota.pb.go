@@ -0,0 +1,675 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/resource_quota.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// ResourceQuota limits the resources of a namespace. A limit of 0 means that
+// the resource is not limited by the quota. Quotas are soft limits: the
+// resources created concurrently can exceed them, and the events per minute
+// are limited per backend.
+type ResourceQuota struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// resource quota.
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// MaxEntities is the maximum number of entities of the namespace.
+	MaxEntities uint32 `protobuf:"varint,2,opt,name=max_entities,json=maxEntities,proto3" json:"max_entities"`
+	// MaxChecks is the maximum number of checks of the namespace.
+	MaxChecks uint32 `protobuf:"varint,3,opt,name=max_checks,json=maxChecks,proto3" json:"max_checks"`
+	// MaxSilenced is the maximum number of silences of the namespace.
+	MaxSilenced uint32 `protobuf:"varint,4,opt,name=max_silenced,json=maxSilenced,proto3" json:"max_silenced"`
+	// MaxAssets is the maximum number of assets of the namespace.
+	MaxAssets uint32 `protobuf:"varint,5,opt,name=max_assets,json=maxAssets,proto3" json:"max_assets"`
+	// MaxEventsPerMinute is the maximum number of events of the namespace
+	// processed by each backend per minute.
+	MaxEventsPerMinute   uint32   `protobuf:"varint,6,opt,name=max_events_per_minute,json=maxEventsPerMinute,proto3" json:"max_events_per_minute"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceQuota) Reset()         { *m = ResourceQuota{} }
+func (m *ResourceQuota) String() string { return proto.CompactTextString(m) }
+func (*ResourceQuota) ProtoMessage()    {}
+func (*ResourceQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9367fbc088cf39a3, []int{0}
+}
+func (m *ResourceQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceQuota.Merge(m, src)
+}
+func (m *ResourceQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceQuota proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ResourceQuota)(nil), "sensu.core.v2.ResourceQuota")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/resource_quota.proto", fileDescriptor_9367fbc088cf39a3)
+}
+
+var fileDescriptor_9367fbc088cf39a3 = []byte{
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0xce, 0xd3, 0x40,
+	0x10, 0x85, 0xb3, 0xff, 0x0f, 0x51, 0x70, 0x08, 0x42, 0x96, 0x90, 0x9c, 0x14, 0xbb, 0x11, 0x55,
+	0x0a, 0xb2, 0x26, 0x0e, 0x55, 0x2a, 0x08, 0xa2, 0x23, 0x02, 0x8c, 0x68, 0x68, 0xac, 0xb5, 0x33,
+	0x38, 0x06, 0xd6, 0x6b, 0xbc, 0x6b, 0x2b, 0x5c, 0x00, 0x71, 0x04, 0xca, 0x94, 0x39, 0x02, 0x47,
+	0x48, 0x99, 0x13, 0x58, 0x60, 0x3a, 0x9f, 0x80, 0x12, 0x79, 0x13, 0xa3, 0x58, 0xa2, 0xa0, 0xb1,
+	0x66, 0xbe, 0x79, 0x33, 0xef, 0x59, 0x6b, 0x2c, 0xc2, 0x48, 0x6d, 0x32, 0x9f, 0x06, 0x82, 0xdb,
+	0x12, 0x62, 0x99, 0x9d, 0xbe, 0xd3, 0x50, 0xd8, 0x2c, 0x89, 0xec, 0x40, 0xa4, 0x60, 0xe7, 0x8e,
+	0x9d, 0x82, 0x14, 0x59, 0x1a, 0x80, 0xf7, 0x29, 0x13, 0x8a, 0xd1, 0x24, 0x15, 0x4a, 0x98, 0x03,
+	0x2d, 0xa5, 0xb5, 0x86, 0xe6, 0xce, 0xe8, 0xd1, 0xc5, 0xa9, 0x50, 0x84, 0xc2, 0xd6, 0x2a, 0x3f,
+	0x7b, 0xf7, 0x38, 0x9f, 0xd1, 0x39, 0x9d, 0x69, 0xa8, 0x99, 0xae, 0x4e, 0x47, 0x46, 0x0f, 0xff,
+	0x2f, 0x00, 0x87, 0xc6, 0xf6, 0xfe, 0x97, 0x6b, 0x63, 0xe0, 0x9e, 0xf3, 0xbc, 0xaa, 0xe3, 0x98,
+	0x6f, 0x8c, 0x5e, 0x3d, 0x5f, 0x33, 0xc5, 0x2c, 0x34, 0x46, 0x93, 0xbe, 0x33, 0xa4, 0xad, 0x6c,
+	0xf4, 0x85, 0xff, 0x1e, 0x02, 0xb5, 0x02, 0xc5, 0x96, 0xf8, 0x50, 0x90, 0xce, 0xb1, 0x20, 0xa8,
+	0x2a, 0x88, 0xd9, 0xac, 0x3d, 0x10, 0x3c, 0x52, 0xc0, 0x13, 0xf5, 0xd9, 0xfd, 0x7b, 0xca, 0x9c,
+	0x1b, 0xb7, 0x39, 0xdb, 0x7a, 0x10, 0xab, 0x48, 0x45, 0x20, 0xad, 0xab, 0x31, 0x9a, 0x0c, 0x96,
+	0x77, 0xab, 0x82, 0xb4, 0xb8, 0xdb, 0xe7, 0x6c, 0xfb, 0xec, 0xdc, 0x98, 0x53, 0xc3, 0xa8, 0x87,
+	0xc1, 0x06, 0x82, 0x0f, 0xd2, 0xba, 0xd6, 0x2b, 0x77, 0xaa, 0x82, 0x5c, 0x50, 0xf7, 0x16, 0x67,
+	0xdb, 0xa7, 0xba, 0x6c, 0x3c, 0x64, 0xf4, 0x11, 0xe2, 0x00, 0xd6, 0xd6, 0x8d, 0xb6, 0x47, 0xc3,
+	0xb5, 0xc7, 0xeb, 0x73, 0xd3, 0x78, 0x30, 0x29, 0x41, 0x49, 0xeb, 0x66, 0xdb, 0xe3, 0x44, 0xb5,
+	0xc7, 0x13, 0x5d, 0x9a, 0xcf, 0x8d, 0x7b, 0x3a, 0x6f, 0x0e, 0xb1, 0x92, 0x5e, 0x02, 0xa9, 0xc7,
+	0xa3, 0x38, 0x53, 0x60, 0x75, 0xf5, 0xe6, 0xb0, 0x2a, 0xc8, 0xbf, 0x05, 0xae, 0x59, 0xff, 0x99,
+	0xa6, 0x2f, 0x21, 0x5d, 0x69, 0xb6, 0xe8, 0x7d, 0xdd, 0x91, 0xce, 0x7e, 0x47, 0xd0, 0x72, 0xfc,
+	0xfb, 0x27, 0x46, 0xfb, 0x12, 0xa3, 0xef, 0x25, 0x46, 0x87, 0x12, 0xa3, 0x63, 0x89, 0xd1, 0x8f,
+	0x12, 0xa3, 0x6f, 0xbf, 0x70, 0xe7, 0xed, 0x55, 0xee, 0xf8, 0x5d, 0xfd, 0x62, 0xf3, 0x3f, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0x25, 0x3d, 0x4b, 0x8f, 0x66, 0x02, 0x00, 0x00,
+}
+
+func (this *ResourceQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResourceQuota)
+	if !ok {
+		that2, ok := that.(ResourceQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.MaxEntities != that1.MaxEntities {
+		return false
+	}
+	if this.MaxChecks != that1.MaxChecks {
+		return false
+	}
+	if this.MaxSilenced != that1.MaxSilenced {
+		return false
+	}
+	if this.MaxAssets != that1.MaxAssets {
+		return false
+	}
+	if this.MaxEventsPerMinute != that1.MaxEventsPerMinute {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type ResourceQuotaFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetMaxEntities() uint32
+	GetMaxChecks() uint32
+	GetMaxSilenced() uint32
+	GetMaxAssets() uint32
+	GetMaxEventsPerMinute() uint32
+}
+
+func (this *ResourceQuota) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *ResourceQuota) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewResourceQuotaFromFace(this)
+}
+
+func (this *ResourceQuota) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *ResourceQuota) GetMaxEntities() uint32 {
+	return this.MaxEntities
+}
+
+func (this *ResourceQuota) GetMaxChecks() uint32 {
+	return this.MaxChecks
+}
+
+func (this *ResourceQuota) GetMaxSilenced() uint32 {
+	return this.MaxSilenced
+}
+
+func (this *ResourceQuota) GetMaxAssets() uint32 {
+	return this.MaxAssets
+}
+
+func (this *ResourceQuota) GetMaxEventsPerMinute() uint32 {
+	return this.MaxEventsPerMinute
+}
+
+func NewResourceQuotaFromFace(that ResourceQuotaFace) *ResourceQuota {
+	this := &ResourceQuota{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.MaxEntities = that.GetMaxEntities()
+	this.MaxChecks = that.GetMaxChecks()
+	this.MaxSilenced = that.GetMaxSilenced()
+	this.MaxAssets = that.GetMaxAssets()
+	this.MaxEventsPerMinute = that.GetMaxEventsPerMinute()
+	return this
+}
+
+func (m *ResourceQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxEventsPerMinute != 0 {
+		i = encodeVarintResourceQuota(dAtA, i, uint64(m.MaxEventsPerMinute))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxAssets != 0 {
+		i = encodeVarintResourceQuota(dAtA, i, uint64(m.MaxAssets))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxSilenced != 0 {
+		i = encodeVarintResourceQuota(dAtA, i, uint64(m.MaxSilenced))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxChecks != 0 {
+		i = encodeVarintResourceQuota(dAtA, i, uint64(m.MaxChecks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxEntities != 0 {
+		i = encodeVarintResourceQuota(dAtA, i, uint64(m.MaxEntities))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintResourceQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintResourceQuota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovResourceQuota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedResourceQuota(r randyResourceQuota, easy bool) *ResourceQuota {
+	this := &ResourceQuota{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.MaxEntities = uint32(r.Uint32())
+	this.MaxChecks = uint32(r.Uint32())
+	this.MaxSilenced = uint32(r.Uint32())
+	this.MaxAssets = uint32(r.Uint32())
+	this.MaxEventsPerMinute = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedResourceQuota(r, 7)
+	}
+	return this
+}
+
+type randyResourceQuota interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneResourceQuota(r randyResourceQuota) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringResourceQuota(r randyResourceQuota) string {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
+		tmps[i] = randUTF8RuneResourceQuota(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedResourceQuota(r randyResourceQuota, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldResourceQuota(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldResourceQuota(dAtA []byte, r randyResourceQuota, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(key))
+		v3 := r.Int63()
+		if r.Intn(2) == 0 {
+			v3 *= -1
+		}
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(v3))
+	case 1:
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateResourceQuota(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *ResourceQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovResourceQuota(uint64(l))
+	if m.MaxEntities != 0 {
+		n += 1 + sovResourceQuota(uint64(m.MaxEntities))
+	}
+	if m.MaxChecks != 0 {
+		n += 1 + sovResourceQuota(uint64(m.MaxChecks))
+	}
+	if m.MaxSilenced != 0 {
+		n += 1 + sovResourceQuota(uint64(m.MaxSilenced))
+	}
+	if m.MaxAssets != 0 {
+		n += 1 + sovResourceQuota(uint64(m.MaxAssets))
+	}
+	if m.MaxEventsPerMinute != 0 {
+		n += 1 + sovResourceQuota(uint64(m.MaxEventsPerMinute))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovResourceQuota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozResourceQuota(x uint64) (n int) {
+	return sovResourceQuota(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ResourceQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResourceQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResourceQuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResourceQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntities", wireType)
+			}
+			m.MaxEntities = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntities |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChecks", wireType)
+			}
+			m.MaxChecks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChecks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSilenced", wireType)
+			}
+			m.MaxSilenced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSilenced |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssets", wireType)
+			}
+			m.MaxAssets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAssets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventsPerMinute", wireType)
+			}
+			m.MaxEventsPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEventsPerMinute |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResourceQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResourceQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipResourceQuota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowResourceQuota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthResourceQuota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupResourceQuota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthResourceQuota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthResourceQuota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowResourceQuota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupResourceQuota = fmt.Errorf("proto: unexpected end of group")
+)
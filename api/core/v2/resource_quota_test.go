@@ -0,0 +1,67 @@
+package v2
+
+import (
+	"testing"
+)
+
+func TestResourceQuota_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		quota   *ResourceQuota
+		wantErr bool
+		wantMsg string
+	}{
+		{
+			name:    "fails when name is empty",
+			quota:   &ResourceQuota{},
+			wantErr: true,
+			wantMsg: "name must not be empty",
+		},
+		{
+			name:    "fails when namespace is empty",
+			quota:   FixtureResourceQuota("limits", ""),
+			wantErr: true,
+			wantMsg: "namespace must be set",
+		},
+		{
+			name:  "succeeds with valid quota",
+			quota: FixtureResourceQuota("limits", "default"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.quota.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantMsg {
+				t.Errorf("Validate() error = %q, want %q", err, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestResourceQuotaLimit(t *testing.T) {
+	quota := &ResourceQuota{
+		MaxEntities:        1,
+		MaxChecks:          2,
+		MaxSilenced:        3,
+		MaxAssets:          4,
+		MaxEventsPerMinute: 5,
+	}
+	for i, resource := range QuotaResources {
+		if got, want := quota.Limit(resource), uint32(i+1); got != want {
+			t.Errorf("Limit(%q) = %d, want %d", resource, got, want)
+		}
+	}
+	if got := quota.Limit(HandlersResource); got != 0 {
+		t.Errorf("Limit(%q) = %d, want 0", HandlersResource, got)
+	}
+}
+
+func TestResourceQuotaURIPath(t *testing.T) {
+	quota := FixtureResourceQuota("limits", "default")
+	if got, want := quota.URIPath(), "/api/core/v2/namespaces/default/resourcequotas/limits"; got != want {
+		t.Errorf("URIPath() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,36 @@
+package v2
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestResolveResourceByRBACName(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Resource
+		wantErr bool
+	}{
+		{name: "checks", want: &CheckConfig{}},
+		{name: "hooks", want: &HookConfig{}},
+		{name: "handlers", want: &Handler{}},
+		{name: "unknown", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ResolveResourceByRBACName(tc.name)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ResolveResourceByRBACName() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if got, want := got.RBACName(), tc.want.RBACName(); got != want {
+				t.Errorf("RBACName() = %q, want %q", got, want)
+			}
+			if got, want := fmt.Sprintf("%T", got), fmt.Sprintf("%T", tc.want); got != want {
+				t.Errorf("ResolveResourceByRBACName() = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,1111 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/session.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// A Session is the login of a user, which lasts until the session is revoked.
+type Session struct {
+	// Metadata contains the name, namespace (N/A), labels and annotations of
+	// the Session. The name of the session is the identifier of its refresh
+	// token.
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Username is the name of the user who logged in.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// IssuedAt is a timestamp at which the user logged in.
+	IssuedAt int64 `protobuf:"varint,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// RefreshedAt is a timestamp at which the access token of the session was
+	// last refreshed.
+	RefreshedAt int64 `protobuf:"varint,4,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	// ClientIP is the address of the client which logged in.
+	ClientIP string `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	// UserAgent is the user agent of the client which logged in.
+	UserAgent string `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// ExpiresAt is a timestamp at which the refresh token of the session
+	// expires, after which the session can be removed.
+	ExpiresAt            int64    `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba625eed402ce310, []int{0}
+}
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
+}
+func (m *Session) XXX_Size() int {
+	return m.Size()
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+// A RevokedToken denies the access tokens with a given identifier, or issued
+// for a given session, until they expire.
+type RevokedToken struct {
+	// Metadata contains the name, namespace (N/A), labels and annotations of
+	// the RevokedToken. The name is the identifier of the token or session.
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Username is the name of the user of the token.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// ExpiresAt is a timestamp after which the revoked tokens are expired, and
+	// the RevokedToken can be removed.
+	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokedToken) Reset()         { *m = RevokedToken{} }
+func (m *RevokedToken) String() string { return proto.CompactTextString(m) }
+func (*RevokedToken) ProtoMessage()    {}
+func (*RevokedToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba625eed402ce310, []int{1}
+}
+func (m *RevokedToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokedToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokedToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokedToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokedToken.Merge(m, src)
+}
+func (m *RevokedToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokedToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokedToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokedToken proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Session)(nil), "sensu.core.v2.Session")
+	proto.RegisterType((*RevokedToken)(nil), "sensu.core.v2.RevokedToken")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/session.proto", fileDescriptor_ba625eed402ce310)
+}
+
+var fileDescriptor_ba625eed402ce310 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xb1, 0x6e, 0xd4, 0x40,
+	0x10, 0x86, 0x6f, 0x73, 0x90, 0xf8, 0x36, 0xa1, 0x31, 0x28, 0x32, 0x87, 0xf0, 0x9e, 0x42, 0x73,
+	0x05, 0xac, 0x89, 0x0f, 0x09, 0x09, 0x09, 0x29, 0x67, 0xaa, 0x14, 0x08, 0x74, 0x40, 0x43, 0x73,
+	0xda, 0xbb, 0x9b, 0x38, 0x4b, 0xb0, 0xd7, 0xf2, 0xae, 0x2d, 0x78, 0x03, 0x9e, 0x00, 0x51, 0xa6,
+	0xcc, 0x23, 0x50, 0x53, 0xa5, 0xcc, 0x13, 0x58, 0x60, 0x3a, 0x3f, 0x01, 0x25, 0xda, 0x5d, 0xe2,
+	0x38, 0x88, 0x82, 0x26, 0x8d, 0x65, 0x7f, 0x33, 0xff, 0xcc, 0x3f, 0xfa, 0x8d, 0x27, 0x31, 0x57,
+	0x87, 0xc5, 0x82, 0x2e, 0x45, 0x12, 0x48, 0x48, 0x65, 0x61, 0x9f, 0x0f, 0x62, 0x11, 0xb0, 0x8c,
+	0x07, 0x4b, 0x91, 0x43, 0x50, 0x86, 0x81, 0x04, 0x29, 0xb9, 0x48, 0x69, 0x96, 0x0b, 0x25, 0xdc,
+	0x1b, 0xa6, 0x87, 0xea, 0x22, 0x2d, 0xc3, 0xe1, 0xa3, 0xce, 0x8c, 0x58, 0xc4, 0x22, 0x30, 0x5d,
+	0x8b, 0xe2, 0x60, 0xaf, 0xdc, 0xa5, 0x13, 0xba, 0x6b, 0xa0, 0x61, 0xe6, 0xcd, 0x0e, 0x19, 0x3e,
+	0xfc, 0xbf, 0xcd, 0x09, 0x28, 0x66, 0x15, 0x3b, 0x9f, 0xfb, 0x78, 0xe3, 0x95, 0x35, 0xe2, 0xbe,
+	0xc1, 0x8e, 0xae, 0xac, 0x98, 0x62, 0x1e, 0x1a, 0xa1, 0xf1, 0x66, 0x78, 0x9b, 0x5e, 0x72, 0x45,
+	0x5f, 0x2c, 0xde, 0xc1, 0x52, 0x3d, 0x07, 0xc5, 0x22, 0xff, 0xb4, 0x22, 0xbd, 0xb3, 0x8a, 0xa0,
+	0xa6, 0x22, 0xee, 0xb9, 0xec, 0xbe, 0x48, 0xb8, 0x82, 0x24, 0x53, 0x1f, 0x67, 0xed, 0x28, 0x77,
+	0x88, 0x9d, 0x42, 0x42, 0x9e, 0xb2, 0x04, 0xbc, 0xb5, 0x11, 0x1a, 0x0f, 0x66, 0xed, 0xb7, 0x7b,
+	0x07, 0x0f, 0xb8, 0x94, 0x05, 0xac, 0xe6, 0x4c, 0x79, 0xfd, 0x11, 0x1a, 0xf7, 0x67, 0x8e, 0x05,
+	0x53, 0xe5, 0x3e, 0xc5, 0x5b, 0x39, 0x1c, 0xe4, 0x20, 0x0f, 0x6d, 0xfd, 0x9a, 0xae, 0x47, 0xc3,
+	0xa6, 0x22, 0xdb, 0x5d, 0xde, 0x59, 0xba, 0xd9, 0xf2, 0xa9, 0x72, 0xf7, 0xf0, 0x60, 0xf9, 0x9e,
+	0x43, 0xaa, 0xe6, 0x3c, 0xf3, 0xae, 0xeb, 0xc5, 0xd1, 0xbd, 0xba, 0x22, 0xce, 0x33, 0x03, 0xf7,
+	0x5f, 0x36, 0x15, 0xb9, 0xd9, 0x36, 0x74, 0x9d, 0x5b, 0xb8, 0x9f, 0xb9, 0x8f, 0x31, 0xd6, 0x4e,
+	0xe7, 0x2c, 0x86, 0x54, 0x79, 0xeb, 0x66, 0x84, 0xd7, 0x54, 0xe4, 0xd6, 0x05, 0xed, 0xe8, 0x06,
+	0x9a, 0x4e, 0x35, 0xd4, 0x42, 0xf8, 0x90, 0xf1, 0x1c, 0xa4, 0xf6, 0xbd, 0x61, 0x7c, 0x1b, 0xe1,
+	0x05, 0xed, 0x0a, 0xff, 0xd0, 0xa9, 0x7a, 0xe2, 0x7c, 0x3a, 0x26, 0xbd, 0x93, 0x63, 0x82, 0x76,
+	0xbe, 0x21, 0xbc, 0x35, 0x83, 0x52, 0x1c, 0xc1, 0xea, 0xb5, 0x38, 0x82, 0x2b, 0x4b, 0x27, 0xfc,
+	0x3b, 0x9d, 0x68, 0x5b, 0x6b, 0xce, 0x59, 0x57, 0xd3, 0xa6, 0x76, 0xf7, 0xd2, 0x79, 0x36, 0xb6,
+	0x7f, 0x1d, 0x11, 0x8d, 0x7e, 0xfd, 0xf0, 0xd1, 0x49, 0xed, 0xa3, 0xaf, 0xb5, 0x8f, 0x4e, 0x6b,
+	0x1f, 0x9d, 0xd5, 0x3e, 0xfa, 0x5e, 0xfb, 0xe8, 0xcb, 0x4f, 0xbf, 0xf7, 0x76, 0xad, 0x0c, 0x17,
+	0xeb, 0xe6, 0x37, 0x9c, 0xfc, 0x1e, 0x00, 0xa3, 0xf1, 0x10, 0x5e, 0x34, 0x03, 0x00, 0x00,
+}
+
+func (this *Session) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Session)
+	if !ok {
+		that2, ok := that.(Session)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.IssuedAt != that1.IssuedAt {
+		return false
+	}
+	if this.RefreshedAt != that1.RefreshedAt {
+		return false
+	}
+	if this.ClientIP != that1.ClientIP {
+		return false
+	}
+	if this.UserAgent != that1.UserAgent {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RevokedToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RevokedToken)
+	if !ok {
+		that2, ok := that.(RevokedToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type SessionFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetUsername() string
+	GetIssuedAt() int64
+	GetRefreshedAt() int64
+	GetClientIP() string
+	GetUserAgent() string
+	GetExpiresAt() int64
+}
+
+func (this *Session) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *Session) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewSessionFromFace(this)
+}
+
+func (this *Session) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *Session) GetUsername() string {
+	return this.Username
+}
+
+func (this *Session) GetIssuedAt() int64 {
+	return this.IssuedAt
+}
+
+func (this *Session) GetRefreshedAt() int64 {
+	return this.RefreshedAt
+}
+
+func (this *Session) GetClientIP() string {
+	return this.ClientIP
+}
+
+func (this *Session) GetUserAgent() string {
+	return this.UserAgent
+}
+
+func (this *Session) GetExpiresAt() int64 {
+	return this.ExpiresAt
+}
+
+func NewSessionFromFace(that SessionFace) *Session {
+	this := &Session{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.Username = that.GetUsername()
+	this.IssuedAt = that.GetIssuedAt()
+	this.RefreshedAt = that.GetRefreshedAt()
+	this.ClientIP = that.GetClientIP()
+	this.UserAgent = that.GetUserAgent()
+	this.ExpiresAt = that.GetExpiresAt()
+	return this
+}
+
+type RevokedTokenFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetUsername() string
+	GetExpiresAt() int64
+}
+
+func (this *RevokedToken) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *RevokedToken) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewRevokedTokenFromFace(this)
+}
+
+func (this *RevokedToken) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *RevokedToken) GetUsername() string {
+	return this.Username
+}
+
+func (this *RevokedToken) GetExpiresAt() int64 {
+	return this.ExpiresAt
+}
+
+func NewRevokedTokenFromFace(that RevokedTokenFace) *RevokedToken {
+	this := &RevokedToken{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.Username = that.GetUsername()
+	this.ExpiresAt = that.GetExpiresAt()
+	return this
+}
+
+func (m *Session) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.UserAgent) > 0 {
+		i -= len(m.UserAgent)
+		copy(dAtA[i:], m.UserAgent)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.UserAgent)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClientIP) > 0 {
+		i -= len(m.ClientIP)
+		copy(dAtA[i:], m.ClientIP)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.ClientIP)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RefreshedAt != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.RefreshedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSession(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokedToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokedToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokedToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSession(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSession(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSession(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedSession(r randySession, easy bool) *Session {
+	this := &Session{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Username = string(randStringSession(r))
+	this.IssuedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.IssuedAt *= -1
+	}
+	this.RefreshedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.RefreshedAt *= -1
+	}
+	this.ClientIP = string(randStringSession(r))
+	this.UserAgent = string(randStringSession(r))
+	this.ExpiresAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ExpiresAt *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedSession(r, 8)
+	}
+	return this
+}
+
+func NewPopulatedRevokedToken(r randySession, easy bool) *RevokedToken {
+	this := &RevokedToken{}
+	v2 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v2
+	this.Username = string(randStringSession(r))
+	this.ExpiresAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ExpiresAt *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedSession(r, 4)
+	}
+	return this
+}
+
+type randySession interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneSession(r randySession) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringSession(r randySession) string {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
+		tmps[i] = randUTF8RuneSession(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedSession(r randySession, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldSession(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldSession(dAtA []byte, r randySession, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateSession(dAtA, uint64(key))
+		v4 := r.Int63()
+		if r.Intn(2) == 0 {
+			v4 *= -1
+		}
+		dAtA = encodeVarintPopulateSession(dAtA, uint64(v4))
+	case 1:
+		dAtA = encodeVarintPopulateSession(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateSession(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateSession(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateSession(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateSession(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovSession(uint64(l))
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovSession(uint64(m.IssuedAt))
+	}
+	if m.RefreshedAt != 0 {
+		n += 1 + sovSession(uint64(m.RefreshedAt))
+	}
+	l = len(m.ClientIP)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.UserAgent)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovSession(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokedToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovSession(uint64(l))
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovSession(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSession(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSession(x uint64) (n int) {
+	return sovSession(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Session) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshedAt", wireType)
+			}
+			m.RefreshedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefreshedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAgent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserAgent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokedToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokedToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokedToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSession(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSession
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSession
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSession
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSession        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSession          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSession = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,74 @@
+package v2
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSession_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		session *Session
+		wantErr bool
+	}{
+		{
+			name:    "fails when name is empty",
+			session: FixtureSession("", "foo"),
+			wantErr: true,
+		},
+		{
+			name:    "fails when username is empty",
+			session: FixtureSession("abc", ""),
+			wantErr: true,
+		},
+		{
+			name: "fails with a namespace",
+			session: func() *Session {
+				s := FixtureSession("abc", "foo")
+				s.Namespace = "default"
+				return s
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "succeeds with valid session",
+			session: FixtureSession("abc", "foo"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.session.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRevokedToken_Validate(t *testing.T) {
+	token := FixtureRevokedToken("abc")
+	if err := token.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	token.ExpiresAt = 0
+	if err := token.Validate(); err == nil {
+		t.Fatal("expected an error without an expiry")
+	}
+}
+
+func TestRevokedToken_IsExpired(t *testing.T) {
+	token := FixtureRevokedToken("abc")
+	now := time.Now()
+	if token.IsExpired(now) {
+		t.Error("expected the revoked token not to be expired")
+	}
+	if !token.IsExpired(now.Add(2 * time.Hour)) {
+		t.Error("expected the revoked token to be expired")
+	}
+}
+
+func TestSessionURIPath(t *testing.T) {
+	session := FixtureSession("abc", "foo")
+	if got, want := session.URIPath(), "/api/core/v2/sessions/abc"; got != want {
+		t.Errorf("URIPath() = %q, want %q", got, want)
+	}
+}
@@ -19,6 +19,18 @@ type Claims struct {
 	Groups   []string           `json:"groups"`
 	Provider AuthProviderClaims `json:"provider"`
 	APIKey   bool               `json:"api_key"`
+
+	// Scope contains the restrictions of the API key used to authenticate,
+	// if any.
+	Scope *APIKeyScope `json:"scope,omitempty"`
+
+	// Impersonator is the name of the user who impersonates the subject of
+	// the claims, if any.
+	Impersonator string `json:"impersonator,omitempty"`
+
+	// SessionID is the identifier of the session of the access token, which
+	// is the identifier of its refresh token.
+	SessionID string `json:"sid,omitempty"`
 }
 
 // AuthProviderClaims contains information from the authentication provider
@@ -13,6 +13,8 @@ var typeMap = map[string]interface{}{
 	"api_key":                &APIKey{},
 	"AdhocRequest":           &AdhocRequest{},
 	"adhoc_request":          &AdhocRequest{},
+	"Aggregate":              &Aggregate{},
+	"aggregate":              &Aggregate{},
 	"Any":                    &Any{},
 	"any":                    &Any{},
 	"Asset":                  &Asset{},
@@ -31,6 +33,8 @@ var typeMap = map[string]interface{}{
 	"check_history":          &CheckHistory{},
 	"CheckRequest":           &CheckRequest{},
 	"check_request":          &CheckRequest{},
+	"CheckRetryState":        &CheckRetryState{},
+	"check_retry_state":      &CheckRetryState{},
 	"Claims":                 &Claims{},
 	"claims":                 &Claims{},
 	"ClusterHealth":          &ClusterHealth{},
@@ -63,6 +67,8 @@ var typeMap = map[string]interface{}{
 	"hook_list":              &HookList{},
 	"KeepaliveRecord":        &KeepaliveRecord{},
 	"keepalive_record":       &KeepaliveRecord{},
+	"LifecyclePolicy":        &LifecyclePolicy{},
+	"lifecycle_policy":       &LifecyclePolicy{},
 	"MetricPoint":            &MetricPoint{},
 	"metric_point":           &MetricPoint{},
 	"MetricTag":              &MetricTag{},
@@ -85,6 +91,8 @@ var typeMap = map[string]interface{}{
 	"network_interface":      &NetworkInterface{},
 	"ObjectMeta":             &ObjectMeta{},
 	"object_meta":            &ObjectMeta{},
+	"PasswordPolicy":         &PasswordPolicy{},
+	"password_policy":        &PasswordPolicy{},
 	"Pipeline":               &Pipeline{},
 	"pipeline":               &Pipeline{},
 	"PipelineWorkflow":       &PipelineWorkflow{},
@@ -95,8 +103,12 @@ var typeMap = map[string]interface{}{
 	"process":                &Process{},
 	"ProxyRequests":          &ProxyRequests{},
 	"proxy_requests":         &ProxyRequests{},
+	"ResourceQuota":          &ResourceQuota{},
+	"resource_quota":         &ResourceQuota{},
 	"ResourceReference":      &ResourceReference{},
 	"resource_reference":     &ResourceReference{},
+	"RevokedToken":           &RevokedToken{},
+	"revoked_token":          &RevokedToken{},
 	"Role":                   &Role{},
 	"role":                   &Role{},
 	"RoleBinding":            &RoleBinding{},
@@ -107,6 +119,8 @@ var typeMap = map[string]interface{}{
 	"rule":                   &Rule{},
 	"Secret":                 &Secret{},
 	"secret":                 &Secret{},
+	"Session":                &Session{},
+	"session":                &Session{},
 	"Silenced":               &Silenced{},
 	"silenced":               &Silenced{},
 	"Subject":                &Subject{},
@@ -40,6 +40,23 @@ func TestResolveAdhocRequest(t *testing.T) {
 	}
 }
 
+func TestResolveAggregate(t *testing.T) {
+	var value interface{} = new(Aggregate)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("Aggregate"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("Aggregate")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"Aggregate" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolveAny(t *testing.T) {
 	var value interface{} = new(Any)
 	if _, ok := value.(Resource); ok {
@@ -193,6 +210,23 @@ func TestResolveCheckRequest(t *testing.T) {
 	}
 }
 
+func TestResolveCheckRetryState(t *testing.T) {
+	var value interface{} = new(CheckRetryState)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("CheckRetryState"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("CheckRetryState")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"CheckRetryState" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolveClaims(t *testing.T) {
 	var value interface{} = new(Claims)
 	if _, ok := value.(Resource); ok {
@@ -465,6 +499,23 @@ func TestResolveKeepaliveRecord(t *testing.T) {
 	}
 }
 
+func TestResolveLifecyclePolicy(t *testing.T) {
+	var value interface{} = new(LifecyclePolicy)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("LifecyclePolicy"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("LifecyclePolicy")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"LifecyclePolicy" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolveMetricPoint(t *testing.T) {
 	var value interface{} = new(MetricPoint)
 	if _, ok := value.(Resource); ok {
@@ -652,6 +703,23 @@ func TestResolveObjectMeta(t *testing.T) {
 	}
 }
 
+func TestResolvePasswordPolicy(t *testing.T) {
+	var value interface{} = new(PasswordPolicy)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("PasswordPolicy"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("PasswordPolicy")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"PasswordPolicy" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolvePipeline(t *testing.T) {
 	var value interface{} = new(Pipeline)
 	if _, ok := value.(Resource); ok {
@@ -737,6 +805,23 @@ func TestResolveProxyRequests(t *testing.T) {
 	}
 }
 
+func TestResolveResourceQuota(t *testing.T) {
+	var value interface{} = new(ResourceQuota)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("ResourceQuota"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("ResourceQuota")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"ResourceQuota" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolveResourceReference(t *testing.T) {
 	var value interface{} = new(ResourceReference)
 	if _, ok := value.(Resource); ok {
@@ -754,6 +839,23 @@ func TestResolveResourceReference(t *testing.T) {
 	}
 }
 
+func TestResolveRevokedToken(t *testing.T) {
+	var value interface{} = new(RevokedToken)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("RevokedToken"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("RevokedToken")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"RevokedToken" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolveRole(t *testing.T) {
 	var value interface{} = new(Role)
 	if _, ok := value.(Resource); ok {
@@ -839,6 +941,23 @@ func TestResolveSecret(t *testing.T) {
 	}
 }
 
+func TestResolveSession(t *testing.T) {
+	var value interface{} = new(Session)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("Session"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("Session")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"Session" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolveSilenced(t *testing.T) {
 	var value interface{} = new(Silenced)
 	if _, ok := value.(Resource); ok {
@@ -12,6 +12,12 @@ import (
 const (
 	// UsersResource is the name of this resource type
 	UsersResource = "users"
+
+	// PasswordHashesResource is the RBAC resource allowing to list the
+	// password hashes of the users, so they can be backed up. It is not
+	// included in the default roles, except through the wildcard of the
+	// cluster-admin role.
+	PasswordHashesResource = "passwordhashes"
 )
 
 // GetObjectMeta is a dummy implementation to meet the Resource interface.
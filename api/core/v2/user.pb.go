@@ -33,7 +33,10 @@ type User struct {
 	Groups   []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
 	Disabled bool     `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled"`
 	// PasswordHash is the hashed password, which is safe to display
-	PasswordHash         string   `protobuf:"bytes,5,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	PasswordHash string `protobuf:"bytes,5,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	// PasswordChangedAt is a timestamp at which the password was last changed,
+	// used to expire the password
+	PasswordChangedAt    int64    `protobuf:"varint,6,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -107,6 +110,13 @@ func (m *User) GetPasswordHash() string {
 	return ""
 }
 
+func (m *User) GetPasswordChangedAt() int64 {
+	if m != nil {
+		return m.PasswordChangedAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*User)(nil), "sensu.core.v2.User")
 }
@@ -116,24 +126,27 @@ func init() {
 }
 
 var fileDescriptor_d52a21ed40de01ab = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x48, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0x4e, 0xcd, 0x2b, 0x2e, 0x85, 0x90, 0xba, 0xe9,
-	0xf9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0xc9, 0xf9, 0x45, 0xa9, 0xfa, 0x65, 0x46, 0xfa, 0xa5, 0xc5,
-	0xa9, 0x45, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xbc, 0x60, 0x05, 0x7a, 0x20, 0x19, 0xbd,
-	0x32, 0x23, 0x29, 0x13, 0x24, 0x03, 0xd2, 0xf3, 0xd3, 0xf3, 0xf5, 0xc1, 0xaa, 0x92, 0x4a, 0xd3,
-	0x1c, 0xca, 0x0c, 0xf5, 0x8c, 0xf5, 0x0c, 0xc1, 0x82, 0x60, 0x31, 0x30, 0x0b, 0x62, 0x88, 0xd2,
-	0x52, 0x46, 0x2e, 0x96, 0xd0, 0xe2, 0xd4, 0x22, 0x21, 0x29, 0x2e, 0x0e, 0x90, 0xd9, 0x79, 0x89,
-	0xb9, 0xa9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x70, 0x3e, 0x48, 0xae, 0x20, 0xb1, 0xb8,
-	0xb8, 0x3c, 0xbf, 0x28, 0x45, 0x82, 0x09, 0x22, 0x07, 0xe3, 0x0b, 0x89, 0x71, 0xb1, 0xa5, 0x17,
-	0xe5, 0x97, 0x16, 0x14, 0x4b, 0x30, 0x2b, 0x30, 0x6b, 0x70, 0x06, 0x41, 0x79, 0x42, 0x1a, 0x5c,
-	0x1c, 0x29, 0x99, 0xc5, 0x89, 0x49, 0x39, 0xa9, 0x29, 0x12, 0x2c, 0x0a, 0x8c, 0x1a, 0x1c, 0x4e,
-	0x3c, 0xaf, 0xee, 0xc9, 0xc3, 0xc5, 0x82, 0xe0, 0x2c, 0x21, 0x65, 0x2e, 0x5e, 0x98, 0x69, 0xf1,
-	0x19, 0x89, 0xc5, 0x19, 0x12, 0xac, 0x60, 0x2b, 0x78, 0x60, 0x82, 0x1e, 0x89, 0xc5, 0x19, 0x4e,
-	0x0a, 0x3f, 0x1e, 0xca, 0x31, 0xae, 0x78, 0x24, 0xc7, 0xb8, 0xe3, 0x91, 0x1c, 0xe3, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe3, 0xb1, 0x1c, 0x43, 0x14,
-	0x53, 0x99, 0x51, 0x12, 0x1b, 0xd8, 0x43, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x98, 0xf2,
-	0xff, 0x46, 0x49, 0x01, 0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x18, 0x85, 0x71, 0x5b, 0xaa, 0xd6, 0x6a, 0x07, 0x82, 0x84, 0xac, 0x4a, 0xb8, 0x01, 0x96, 0x0c,
+	0x60, 0xd3, 0x96, 0x03, 0x40, 0x59, 0x58, 0x89, 0xc4, 0xc2, 0x52, 0x39, 0x8d, 0x71, 0x22, 0x91,
+	0x3a, 0xb2, 0x9d, 0x20, 0x6e, 0xc2, 0x11, 0x38, 0x02, 0x47, 0x60, 0xe4, 0x04, 0x15, 0x84, 0xad,
+	0x27, 0xe8, 0x88, 0xe2, 0x90, 0x88, 0x81, 0xc5, 0x7a, 0xef, 0x7d, 0x4f, 0xcf, 0xd2, 0x0f, 0xcf,
+	0x45, 0x6c, 0xa2, 0x2c, 0x20, 0x4b, 0x99, 0x50, 0xcd, 0x57, 0x3a, 0xab, 0xde, 0x33, 0x21, 0x29,
+	0x4b, 0x63, 0xba, 0x94, 0x8a, 0xd3, 0x7c, 0x4a, 0x33, 0xcd, 0x15, 0x49, 0x95, 0x34, 0xd2, 0x19,
+	0xda, 0x02, 0x29, 0x09, 0xc9, 0xa7, 0xa3, 0x8b, 0x3f, 0x03, 0x42, 0x0a, 0x49, 0x6d, 0x2b, 0xc8,
+	0x1e, 0x2e, 0xf3, 0x09, 0x99, 0x91, 0x89, 0x0d, 0x6d, 0x66, 0x55, 0x35, 0x72, 0xbc, 0x05, 0xb0,
+	0x73, 0xa7, 0xb9, 0x72, 0x46, 0xb0, 0x57, 0x6e, 0xaf, 0x58, 0xc2, 0x11, 0x70, 0x81, 0xd7, 0xf7,
+	0x1b, 0x5f, 0xb2, 0x94, 0x69, 0xfd, 0x24, 0x55, 0x88, 0x5a, 0x15, 0xab, 0xbd, 0x73, 0x00, 0xbb,
+	0x42, 0xc9, 0x2c, 0xd5, 0xa8, 0xed, 0xb6, 0xbd, 0xbe, 0xff, 0xeb, 0x1c, 0x0f, 0xf6, 0xc2, 0x58,
+	0xb3, 0xe0, 0x91, 0x87, 0xa8, 0xe3, 0x02, 0xaf, 0x37, 0x1f, 0x6c, 0xd6, 0xe3, 0x26, 0xf3, 0x1b,
+	0xe5, 0x9c, 0xc0, 0x61, 0xbd, 0xb6, 0x88, 0x98, 0x8e, 0xd0, 0xae, 0xfd, 0x62, 0x50, 0x87, 0x37,
+	0x4c, 0x47, 0xce, 0x2d, 0xdc, 0x6f, 0x4a, 0xcb, 0x88, 0xad, 0x04, 0x0f, 0x17, 0xcc, 0xa0, 0xae,
+	0x0b, 0xbc, 0xf6, 0xfc, 0x68, 0xb3, 0x1e, 0x1f, 0xfe, 0x83, 0x4f, 0x65, 0x12, 0x1b, 0x9e, 0xa4,
+	0xe6, 0xd9, 0xdf, 0xab, 0xf1, 0x75, 0x45, 0xaf, 0xcc, 0xdc, 0xdd, 0x7e, 0x61, 0xf0, 0x5a, 0x60,
+	0xf0, 0x56, 0x60, 0xf0, 0x5e, 0x60, 0xf0, 0x51, 0x60, 0xf0, 0x59, 0x60, 0xf0, 0xf2, 0x8d, 0x77,
+	0xee, 0x5b, 0xf9, 0x34, 0xe8, 0xda, 0x1b, 0xcd, 0x7e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x9c,
+	0x33, 0x59, 0x9c, 0x01, 0x00, 0x00,
 }
 
 func (this *User) Equal(that interface{}) bool {
@@ -175,6 +188,9 @@ func (this *User) Equal(that interface{}) bool {
 	if this.PasswordHash != that1.PasswordHash {
 		return false
 	}
+	if this.PasswordChangedAt != that1.PasswordChangedAt {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -204,6 +220,11 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PasswordChangedAt != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.PasswordChangedAt))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.PasswordHash) > 0 {
 		i -= len(m.PasswordHash)
 		copy(dAtA[i:], m.PasswordHash)
@@ -269,8 +290,12 @@ func NewPopulatedUser(r randyUser, easy bool) *User {
 	}
 	this.Disabled = bool(bool(r.Intn(2) == 0))
 	this.PasswordHash = string(randStringUser(r))
+	this.PasswordChangedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.PasswordChangedAt *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedUser(r, 6)
+		this.XXX_unrecognized = randUnrecognizedUser(r, 7)
 	}
 	return this
 }
@@ -374,6 +399,9 @@ func (m *User) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovUser(uint64(l))
 	}
+	if m.PasswordChangedAt != 0 {
+		n += 1 + sovUser(uint64(m.PasswordChangedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -563,6 +591,25 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			}
 			m.PasswordHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordChangedAt", wireType)
+			}
+			m.PasswordChangedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PasswordChangedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -19,9 +19,11 @@ import (
 	"github.com/sensu/sensu-go/backend/ringv2"
 	"github.com/sensu/sensu-go/backend/store"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	"github.com/sensu/sensu-go/backend/tracing"
 	"github.com/sensu/sensu-go/handler"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -629,7 +631,7 @@ func (s *Session) handleKeepalive(_ context.Context, payload []byte) error {
 }
 
 // handleEvent is the event message handler.
-func (s *Session) handleEvent(_ context.Context, payload []byte) error {
+func (s *Session) handleEvent(ctx context.Context, payload []byte) (fErr error) {
 	// Decode the payload to an event
 	event := &corev2.Event{}
 	if err := s.unmarshal(payload, event); err != nil {
@@ -641,6 +643,17 @@ func (s *Session) handleEvent(_ context.Context, payload []byte) error {
 		return err
 	}
 
+	// The event is part of the trace started by the agent, if any, and its
+	// trace context is propagated to eventd
+	ctx, span := tracing.Tracer().Start(tracing.ExtractEvent(ctx, event), "agentd.handle_event",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(tracing.EventAttributes(event)...),
+	)
+	defer func() {
+		tracing.EndSpan(span, fErr)
+	}()
+	tracing.InjectEvent(ctx, event)
+
 	// Add the entity subscription to the subscriptions of this entity
 	event.Entity.Subscriptions = corev2.AddEntitySubscription(event.Entity.Name, event.Entity.Subscriptions)
 
@@ -0,0 +1,173 @@
+package aggregated
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/leader"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// componentName identifies Aggregated as the component/daemon implemented
+	// in this package.
+	componentName = "aggregated"
+
+	// defaultTickInterval is the interval at which aggregates are checked for
+	// a due evaluation.
+	defaultTickInterval = time.Second
+)
+
+// aggregateCache provides the aggregates of all namespaces.
+type aggregateCache interface {
+	GetAll() []cache.Value
+}
+
+// Aggregated is the aggregate checks daemon. A single backend of the cluster,
+// elected through etcd, evaluates the aggregates on their interval and
+// publishes their results as events, which then flow through eventd and the
+// pipelines like any other event.
+type Aggregated struct {
+	aggregates   aggregateCache
+	evaluator    *Evaluator
+	bus          messaging.MessageBus
+	client       *clientv3.Client
+	tickInterval time.Duration
+	lastRuns     map[string]time.Time
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	errChan      chan error
+}
+
+// Option is a functional option.
+type Option func(*Aggregated) error
+
+// Config configures Aggregated.
+type Config struct {
+	Client     *clientv3.Client
+	EventStore store.EventStore
+	Bus        messaging.MessageBus
+}
+
+// New creates a new Aggregated.
+func New(ctx context.Context, c Config, opts ...Option) (*Aggregated, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	aggregates, err := cache.New(ctx, c.Client, &corev2.Aggregate{}, false)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	a := &Aggregated{
+		aggregates: aggregates,
+		evaluator: &Evaluator{
+			EventStore: c.EventStore,
+		},
+		bus:          c.Bus,
+		client:       c.Client,
+		tickInterval: defaultTickInterval,
+		lastRuns:     make(map[string]time.Time),
+		ctx:          ctx,
+		cancel:       cancel,
+		errChan:      make(chan error, 1),
+	}
+	for _, o := range opts {
+		if err := o(a); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+// Start the Aggregated daemon.
+func (a *Aggregated) Start() error {
+	a.wg.Add(1)
+	go a.run()
+	return nil
+}
+
+// Stop the Aggregated daemon.
+func (a *Aggregated) Stop() error {
+	a.cancel()
+	a.wg.Wait()
+	close(a.errChan)
+	return nil
+}
+
+// Err returns a channel on which to listen for terminal errors.
+func (a *Aggregated) Err() <-chan error {
+	return a.errChan
+}
+
+// Name returns the daemon name.
+func (a *Aggregated) Name() string {
+	return componentName
+}
+
+// run evaluates the aggregates while this backend leads the cluster.
+func (a *Aggregated) run() {
+	defer a.wg.Done()
+	leader.Run(a.ctx, a.client, componentName, func(ctx context.Context) {
+		a.lastRuns = make(map[string]time.Time)
+
+		ticker := time.NewTicker(a.tickInterval)
+		defer ticker.Stop()
+		for {
+			a.evaluate(ctx, time.Now())
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// evaluate computes the aggregates that are due at the given time and
+// publishes their events.
+func (a *Aggregated) evaluate(ctx context.Context, now time.Time) {
+	aggregates := a.aggregates.GetAll()
+	seen := make(map[string]struct{}, len(aggregates))
+	for _, value := range aggregates {
+		aggregate, ok := value.Resource.(*corev2.Aggregate)
+		if !ok {
+			continue
+		}
+		key := aggregate.URIPath()
+		seen[key] = struct{}{}
+
+		interval := time.Duration(aggregate.Interval) * time.Second
+		if last, ok := a.lastRuns[key]; ok && now.Sub(last) < interval {
+			continue
+		}
+		a.lastRuns[key] = now
+
+		fields := map[string]interface{}{
+			"namespace": aggregate.Namespace,
+			"aggregate": aggregate.Name,
+		}
+		event, err := a.evaluator.Evaluate(ctx, aggregate, now)
+		if err != nil {
+			logger.WithError(err).WithFields(fields).Error("error evaluating aggregate")
+			continue
+		}
+		if err := a.bus.Publish(messaging.TopicEventRaw, event); err != nil {
+			logger.WithError(err).WithFields(fields).Error("error publishing aggregate event")
+		}
+	}
+
+	// Drop the deleted aggregates
+	for key := range a.lastRuns {
+		if _, ok := seen[key]; !ok {
+			delete(a.lastRuns, key)
+		}
+	}
+}
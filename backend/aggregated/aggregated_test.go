@@ -0,0 +1,40 @@
+package aggregated
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store/cache"
+	"github.com/sensu/sensu-go/testing/mockbus"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEvaluateInterval(t *testing.T) {
+	aggregate := corev2.FixtureAggregate("web", "default")
+	aggregate.Interval = 60
+
+	st := &mockstore.MockStore{}
+	st.On("GetEvents", mock.Anything, mock.Anything).Return([]*corev2.Event{}, nil)
+
+	bus := &mockbus.MockBus{}
+	bus.On("Publish", messaging.TopicEventRaw, mock.AnythingOfType("*v2.Event")).Return(nil)
+
+	a := &Aggregated{
+		aggregates: cache.NewFromResources([]corev2.Resource{aggregate}, false),
+		evaluator:  &Evaluator{EventStore: st},
+		bus:        bus,
+		lastRuns:   make(map[string]time.Time),
+	}
+
+	now := time.Now()
+	a.evaluate(context.Background(), now)
+	a.evaluate(context.Background(), now.Add(30*time.Second))
+	bus.AssertNumberOfCalls(t, "Publish", 1)
+
+	a.evaluate(context.Background(), now.Add(60*time.Second))
+	bus.AssertNumberOfCalls(t, "Publish", 2)
+}
@@ -0,0 +1,91 @@
+package aggregated
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// eventsPageSize is the number of events fetched at once from the store when
+// evaluating an aggregate.
+const eventsPageSize = 500
+
+// Evaluator computes the result of aggregates from the event store.
+type Evaluator struct {
+	EventStore store.EventStore
+}
+
+// Evaluate computes the given aggregate and returns its result as an event of
+// the aggregate's proxy entity. The entities are selected from the snapshot
+// carried by their events, so only the events of the namespace are read.
+func (e *Evaluator) Evaluate(ctx context.Context, aggregate *corev2.Aggregate, now time.Time) (*corev2.Event, error) {
+	ctx = store.NamespaceContext(ctx, aggregate.Namespace)
+
+	// Only the entities that ran the check are aggregated
+	var total, warning, critical, unknown uint32
+	pred := &store.SelectionPredicate{Limit: eventsPageSize}
+	for {
+		events, err := e.EventStore.GetEvents(ctx, pred)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching events: %s", err)
+		}
+		for _, event := range events {
+			if !event.HasCheck() || event.Check.Name != aggregate.Check || !aggregate.Matches(event.Entity) {
+				continue
+			}
+			total++
+			switch event.Check.Status {
+			case 0:
+			case 1:
+				warning++
+			case 2:
+				critical++
+			default:
+				unknown++
+			}
+		}
+		if pred.Continue == "" || len(events) < eventsPageSize {
+			break
+		}
+	}
+
+	var percent uint32
+	if total > 0 {
+		percent = critical * 100 / total
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	entity := corev2.NewEntity(corev2.NewObjectMeta(aggregate.ProxyEntityName, aggregate.Namespace))
+	entity.EntityClass = corev2.EntityProxyClass
+
+	check := corev2.NewCheck(&corev2.CheckConfig{
+		ObjectMeta:      corev2.NewObjectMeta(aggregate.Name, aggregate.Namespace),
+		Interval:        aggregate.Interval,
+		Handlers:        aggregate.Handlers,
+		Pipelines:       aggregate.Pipelines,
+		ProxyEntityName: aggregate.ProxyEntityName,
+	})
+	check.Status = aggregate.Status(critical, total)
+	check.Output = fmt.Sprintf(
+		"%d of %d entities (%d%%) critical for check %s: %d warning, %d unknown\n",
+		critical, total, percent, aggregate.Check, warning, unknown,
+	)
+	check.Issued = now.Unix()
+	check.Executed = now.Unix()
+
+	return &corev2.Event{
+		ObjectMeta: corev2.NewObjectMeta("", aggregate.Namespace),
+		Entity:     entity,
+		Check:      check,
+		ID:         id[:],
+		Timestamp:  now.Unix(),
+	}, nil
+}
@@ -0,0 +1,120 @@
+package aggregated
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func fixtureEvent(entityName string, subscriptions []string, checkName string, status uint32) *corev2.Event {
+	event := corev2.FixtureEvent(entityName, checkName)
+	event.Entity.Subscriptions = subscriptions
+	event.Check.Status = status
+	return event
+}
+
+func TestEvaluate(t *testing.T) {
+	now := time.Unix(100000, 0)
+
+	events := []*corev2.Event{
+		fixtureEvent("web-1", []string{"web"}, "check-http", 0),
+		fixtureEvent("web-2", []string{"web"}, "check-http", 2),
+		fixtureEvent("web-3", []string{"web"}, "check-http", 1),
+		fixtureEvent("web-4", []string{"web"}, "check-http", 0),
+		fixtureEvent("web-4", []string{"web"}, "check-disk", 2),
+		fixtureEvent("db-1", []string{"db"}, "check-http", 2),
+	}
+
+	st := &mockstore.MockStore{}
+	st.On("GetEvents", mock.Anything, mock.Anything).Return(events, nil)
+
+	// 1 of the 4 web entities is critical, so 25% of them
+	tests := []struct {
+		name            string
+		warningPercent  uint32
+		criticalPercent uint32
+		wantStatus      uint32
+	}{
+		{
+			name:            "at warning threshold",
+			warningPercent:  25,
+			criticalPercent: 80,
+			wantStatus:      0,
+		},
+		{
+			name:            "above warning threshold",
+			warningPercent:  24,
+			criticalPercent: 80,
+			wantStatus:      1,
+		},
+		{
+			name:            "at critical threshold",
+			warningPercent:  10,
+			criticalPercent: 25,
+			wantStatus:      1,
+		},
+		{
+			name:            "above critical threshold",
+			warningPercent:  10,
+			criticalPercent: 24,
+			wantStatus:      2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aggregate := corev2.FixtureAggregate("web", "default")
+			aggregate.Handlers = []string{"slack"}
+			aggregate.WarningPercent = tt.warningPercent
+			aggregate.CriticalPercent = tt.criticalPercent
+
+			evaluator := &Evaluator{EventStore: st}
+			event, err := evaluator.Evaluate(context.Background(), aggregate, now)
+			require.NoError(t, err)
+			require.NoError(t, event.Validate())
+
+			assert.Equal(t, "web-cluster", event.Entity.Name)
+			assert.Equal(t, corev2.EntityProxyClass, event.Entity.EntityClass)
+			assert.Equal(t, "web", event.Check.Name)
+			assert.Equal(t, "web-cluster", event.Check.ProxyEntityName)
+			assert.Equal(t, []string{"slack"}, event.Check.Handlers)
+			assert.Equal(t, tt.wantStatus, event.Check.Status)
+			assert.Equal(t, "1 of 4 entities (25%) critical for check check-http: 1 warning, 0 unknown\n", event.Check.Output)
+			assert.Equal(t, now.Unix(), event.Timestamp)
+		})
+	}
+}
+
+func TestEvaluatePages(t *testing.T) {
+	page := make([]*corev2.Event, eventsPageSize)
+	for i := range page {
+		page[i] = fixtureEvent(fmt.Sprintf("web-%d", i), []string{"web"}, "check-http", 0)
+	}
+	last := []*corev2.Event{fixtureEvent("web-last", []string{"web"}, "check-http", 2)}
+
+	st := &mockstore.MockStore{}
+	st.On("GetEvents", mock.Anything, &store.SelectionPredicate{Limit: eventsPageSize}).
+		Run(func(args mock.Arguments) {
+			pred := args.Get(1).(*store.SelectionPredicate)
+			pred.Continue = "web-last"
+		}).Return(page, nil).Once()
+	st.On("GetEvents", mock.Anything, &store.SelectionPredicate{Limit: eventsPageSize, Continue: "web-last"}).
+		Run(func(args mock.Arguments) {
+			pred := args.Get(1).(*store.SelectionPredicate)
+			pred.Continue = ""
+		}).Return(last, nil).Once()
+
+	aggregate := corev2.FixtureAggregate("web", "default")
+	evaluator := &Evaluator{EventStore: st}
+	event, err := evaluator.Evaluate(context.Background(), aggregate, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("1 of %d entities (0%%) critical for check check-http: 0 warning, 0 unknown\n", eventsPageSize+1), event.Check.Output)
+	st.AssertExpectations(t)
+}
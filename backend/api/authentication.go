@@ -9,23 +9,31 @@ import (
 	"github.com/sensu/sensu-go/backend/authentication"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/authentication/providers/basic"
+	"github.com/sensu/sensu-go/backend/authentication/session"
+	"github.com/sensu/sensu-go/backend/store"
 )
 
 // AuthenticationClient is an API client for authentication.
 type AuthenticationClient struct {
-	auth *authentication.Authenticator
+	auth  *authentication.Authenticator
+	store store.ResourceStore
 }
 
-// NewAuthenticationClient creates a new AuthenticationClient, given a a store
-// and an authenticator.
-func NewAuthenticationClient(auth *authentication.Authenticator) *AuthenticationClient {
+// NewAuthenticationClient creates a new AuthenticationClient, given an
+// authenticator and a store for the sessions.
+func NewAuthenticationClient(auth *authentication.Authenticator, store store.ResourceStore) *AuthenticationClient {
 	return &AuthenticationClient{
-		auth: auth,
+		auth:  auth,
+		store: store,
 	}
 }
 
 // CreateAccessToken creates a new access token, given a valid username and
-// password.
+// password. A session is created for the refresh token, recording the
+// following optional context key-values:
+//
+// jwt.ClientIPKey -> string
+// jwt.UserAgentKey -> string
 func (a *AuthenticationClient) CreateAccessToken(ctx context.Context, username, password string) (*corev2.Tokens, error) {
 	claims, err := a.auth.Authenticate(ctx, username, password)
 	if err != nil {
@@ -40,15 +48,23 @@ func (a *AuthenticationClient) CreateAccessToken(ctx context.Context, username,
 		claims.Issuer = issuer.(string)
 	}
 
-	// Create an access token and its signed version
-	_, tokenString, err := jwt.AccessToken(claims)
+	// Create a refresh token and its signed version
+	refreshClaims := &corev2.Claims{StandardClaims: corev2.StandardClaims(claims.Subject)}
+	_, refreshTokenString, err := jwt.RefreshToken(refreshClaims)
 	if err != nil {
 		return nil, fmt.Errorf("error creating access token: %s", err)
 	}
 
-	// Create a refresh token and its signed version
-	refreshClaims := &corev2.Claims{StandardClaims: corev2.StandardClaims(claims.Subject)}
-	_, refreshTokenString, err := jwt.RefreshToken(refreshClaims)
+	// Create the session of the refresh token
+	clientIP, _ := ctx.Value(jwt.ClientIPKey).(string)
+	userAgent, _ := ctx.Value(jwt.UserAgentKey).(string)
+	if _, err := session.Create(ctx, a.store, refreshClaims, clientIP, userAgent); err != nil {
+		return nil, fmt.Errorf("error creating session: %s", err)
+	}
+
+	// Create an access token and its signed version, bound to the session
+	claims.SessionID = refreshClaims.Id
+	_, tokenString, err := jwt.AccessToken(claims)
 	if err != nil {
 		return nil, fmt.Errorf("error creating access token: %s", err)
 	}
@@ -79,12 +95,26 @@ func (a *AuthenticationClient) TestCreds(ctx context.Context, username, password
 	return errors.New("basic provider is disabled")
 }
 
-// Logout logs a user out. The context must carry the user's access and refresh
-// claims, with the following context key-values:
+// Logout logs a user out, by revoking their access token and session. The
+// context must carry the user's access and refresh claims, with the following
+// context key-values:
 //
 // corev2.AccessTokenClaims -> *corev2.Claims
 // corev2.RefreshTokenClaims -> *corev2.Claims
 func (a *AuthenticationClient) Logout(ctx context.Context) error {
+	if value := ctx.Value(corev2.AccessTokenClaims); value != nil {
+		if err := session.RevokeToken(ctx, a.store, value.(*corev2.Claims)); err != nil {
+			return err
+		}
+	}
+
+	if value := ctx.Value(corev2.RefreshTokenClaims); value != nil {
+		err := session.Revoke(ctx, a.store, value.(*corev2.Claims).Id)
+		if _, ok := err.(*store.ErrNotFound); err != nil && !ok {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -106,7 +136,10 @@ func (a *AuthenticationClient) RefreshAccessToken(ctx context.Context) (*corev2.
 	}
 
 	// Get the refresh token claims
-	if value := ctx.Value(corev2.RefreshTokenClaims); value == nil {
+	var refreshClaims *corev2.Claims
+	if value := ctx.Value(corev2.RefreshTokenClaims); value != nil {
+		refreshClaims = value.(*corev2.Claims)
+	} else {
 		return nil, corev2.ErrInvalidToken
 	}
 
@@ -130,11 +163,17 @@ func (a *AuthenticationClient) RefreshAccessToken(ctx context.Context) (*corev2.
 		accessClaims.Provider.ProviderID = basic.Type
 	}
 
+	// Make sure the session of the refresh token was not revoked
+	if _, err := session.Refresh(ctx, a.store, refreshClaims); err != nil {
+		return nil, err
+	}
+
 	// Refresh the user claims
 	claims, err := a.auth.Refresh(ctx, accessClaims)
 	if err != nil {
 		return nil, err
 	}
+	claims.SessionID = refreshClaims.Id
 
 	// Ensure the 'system:users' group is present
 	claims.Groups = append(claims.Groups, "system:users")
@@ -4,19 +4,26 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/authentication/providers/basic"
+	"github.com/sensu/sensu-go/backend/authentication/session"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/stretchr/testify/mock"
 )
 
-func defaultAuth(store store.Store) *authentication.Authenticator {
+func defaultAuth(st store.Store) *authentication.Authenticator {
+	// No password policy is configured
+	if s, ok := st.(*mockstore.MockStore); ok {
+		s.On("GetResource", mock.Anything, corev2.DefaultPasswordPolicyName, mock.AnythingOfType("*v2.PasswordPolicy")).
+			Return(&store.ErrNotFound{}).Maybe()
+	}
 	auth := &authentication.Authenticator{}
-	provider := &basic.Provider{Store: store, ObjectMeta: corev2.ObjectMeta{Name: basic.Type}}
+	provider := &basic.Provider{Store: st, ObjectMeta: corev2.ObjectMeta{Name: basic.Type}}
 	auth.AddProvider(provider)
 	return auth
 }
@@ -33,6 +40,12 @@ func contextWithClaims(claims *corev2.Claims) context.Context {
 	return ctx
 }
 
+func contextWithRefreshToken(claims *corev2.Claims) context.Context {
+	ctx := contextWithClaims(claims)
+	_, refreshTokenString, _ := jwt.RefreshToken(ctx.Value(corev2.RefreshTokenClaims).(*corev2.Claims))
+	return context.WithValue(ctx, corev2.RefreshTokenString, refreshTokenString)
+}
+
 func TestCreateAccessToken(t *testing.T) {
 	tests := []struct {
 		Name          string
@@ -76,6 +89,8 @@ func TestCreateAccessToken(t *testing.T) {
 				store := &mockstore.MockStore{}
 				user := corev2.FixtureUser("foo")
 				store.On("AuthenticateUser", mock.Anything, "foo", "P@ssw0rd!").Return(user, nil)
+				store.On("CreateResource", mock.Anything, mock.AnythingOfType("*v2.Session")).Return(nil)
+				store.On("ListResources", mock.Anything, corev2.SessionsResource, mock.Anything, mock.Anything).Return(nil)
 				return store
 			},
 			Authenticator: defaultAuth,
@@ -85,7 +100,7 @@ func TestCreateAccessToken(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			store := test.Store()
-			authn := NewAuthenticationClient(test.Authenticator(store))
+			authn := NewAuthenticationClient(test.Authenticator(store), store)
 			tokens, err := authn.CreateAccessToken(test.Context(), test.Username, test.Password)
 			if test.WantError && err == nil {
 				t.Fatal("want error, got nil")
@@ -158,7 +173,7 @@ func TestTestCreds(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			store := test.Store()
-			authn := NewAuthenticationClient(test.Authenticator(store))
+			authn := NewAuthenticationClient(test.Authenticator(store), store)
 			err := authn.TestCreds(test.Context(), test.Username, test.Password)
 
 			if test.WantError && test.Error != err {
@@ -189,15 +204,28 @@ func TestRefreshAccessToken(t *testing.T) {
 				st.On("GetUser",
 					mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("string"),
 				).Return(user, nil)
+				st.On("GetResource", mock.Anything, mock.Anything, mock.AnythingOfType("*v2.Session")).
+					Run(func(args mock.Arguments) {
+						*args[2].(*corev2.Session) = *corev2.FixtureSession(args[1].(string), "foo")
+					}).Return(nil)
+				st.On("CreateOrUpdateResource", mock.Anything, mock.AnythingOfType("*v2.Session")).Return(nil)
 				return st
 			},
 			Authenticator: defaultAuth,
-			Context: func(claims *corev2.Claims) context.Context {
-				ctx := contextWithClaims(claims)
-				_, refreshTokenString, _ := jwt.RefreshToken(ctx.Value(corev2.RefreshTokenClaims).(*corev2.Claims))
-				ctx = context.WithValue(ctx, corev2.RefreshTokenString, refreshTokenString)
-				return ctx
+			Context:       contextWithRefreshToken,
+		},
+		{
+			Name: "revoked session",
+			Store: func() store.Store {
+				st := &mockstore.MockStore{}
+				st.On("GetResource", mock.Anything, mock.Anything, mock.AnythingOfType("*v2.Session")).
+					Return(&store.ErrNotFound{})
+				return st
 			},
+			Authenticator: defaultAuth,
+			Context:       contextWithRefreshToken,
+			WantError:     true,
+			Error:         session.ErrSessionRevoked,
 		},
 	}
 
@@ -207,14 +235,56 @@ func TestRefreshAccessToken(t *testing.T) {
 			ctx := test.Context(claims)
 			store := test.Store()
 			authenticator := test.Authenticator(store)
-			auth := NewAuthenticationClient(authenticator)
-			_, err := auth.RefreshAccessToken(ctx)
+			auth := NewAuthenticationClient(authenticator, store)
+			tokens, err := auth.RefreshAccessToken(ctx)
 			if err == nil && test.WantError {
 				t.Fatal("got non-nil error")
 			}
 			if err != nil && !test.WantError {
 				t.Fatal(err)
 			}
+			if test.Error != nil && err != test.Error {
+				t.Fatalf("bad error: got %v, want %v", err, test.Error)
+			}
+			if tokens != nil {
+				// The new access token is bound to the session
+				token, err := jwt.ValidateToken(tokens.Access)
+				if err != nil {
+					t.Fatal(err)
+				}
+				refreshClaims := ctx.Value(corev2.RefreshTokenClaims).(*corev2.Claims)
+				if got, want := token.Claims.(*corev2.Claims).SessionID, refreshClaims.Id; got != want {
+					t.Fatalf("bad session id: got %q, want %q", got, want)
+				}
+			}
 		})
 	}
 }
+
+func TestLogout(t *testing.T) {
+	st := &mockstore.MockStore{}
+	st.On("GetResource", mock.Anything, "refresh", mock.AnythingOfType("*v2.Session")).
+		Run(func(args mock.Arguments) {
+			*args[2].(*corev2.Session) = *corev2.FixtureSession("refresh", "foo")
+		}).Return(nil)
+	st.On("ListResources", mock.Anything, corev2.RevokedTokensResource, mock.Anything, mock.Anything).Return(nil)
+	st.On("CreateOrUpdateResource", mock.Anything, mock.MatchedBy(func(token *corev2.RevokedToken) bool {
+		return token.Name == "access"
+	})).Return(nil)
+	st.On("CreateOrUpdateResource", mock.Anything, mock.MatchedBy(func(token *corev2.RevokedToken) bool {
+		return token.Name == "refresh"
+	})).Return(nil)
+	st.On("DeleteResource", mock.Anything, corev2.SessionsResource, "refresh").Return(nil)
+
+	claims := corev2.FixtureClaims("foo", nil)
+	claims.Id = "access"
+	claims.ExpiresAt = time.Now().Add(time.Minute).Unix()
+	ctx := contextWithClaims(claims)
+	ctx.Value(corev2.RefreshTokenClaims).(*corev2.Claims).Id = "refresh"
+
+	auth := NewAuthenticationClient(defaultAuth(st), st)
+	if err := auth.Logout(ctx); err != nil {
+		t.Fatal(err)
+	}
+	st.AssertExpectations(t)
+}
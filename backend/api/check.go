@@ -85,7 +85,12 @@ func (c *CheckClient) ListChecks(ctx context.Context) ([]*corev2.CheckConfig, er
 		Continue: corev2.PageContinueFromContext(ctx),
 		Limit:    int64(corev2.PageSizeFromContext(ctx)),
 	}
-	return c.store.GetCheckConfigs(ctx, pred)
+	checks, err := c.store.GetCheckConfigs(ctx, pred)
+	if err != nil {
+		return nil, err
+	}
+	attrs.LabelSelectors.Filter(&checks)
+	return checks, nil
 }
 
 func checkListAttributes(ctx context.Context) *authorization.Attributes {
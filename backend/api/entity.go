@@ -143,6 +143,7 @@ func (e *EntityClient) ListEntities(ctx context.Context, pred *store.SelectionPr
 	if err != nil {
 		return nil, err
 	}
+	attrs.LabelSelectors.Filter(&slice)
 	return slice, nil
 }
 
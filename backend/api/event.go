@@ -9,6 +9,7 @@ import (
 	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/tracing"
 )
 
 // Publisher is an interface that represents the message bus concept.
@@ -57,7 +58,8 @@ func (e *EventClient) UpdateEvent(ctx context.Context, event *corev2.Event) erro
 		event.Check.CreatedBy = claims.StandardClaims.Subject
 		event.Entity.CreatedBy = claims.StandardClaims.Subject
 	}
-	// Update the event through eventd
+	// Update the event through eventd, as part of the trace of the request
+	tracing.InjectEvent(ctx, event)
 	return e.bus.Publish(messaging.TopicEventRaw, event)
 }
 
@@ -93,6 +95,7 @@ func (e *EventClient) ListEvents(ctx context.Context, pred *store.SelectionPredi
 	if err != nil {
 		return nil, fmt.Errorf("couldn't list events: %s", err)
 	}
+	attrs.LabelSelectors.Filter(&events)
 	return events, nil
 }
 
@@ -107,6 +110,7 @@ func (e *EventClient) ListEventsByEntity(ctx context.Context, entity string, pre
 	if err != nil {
 		return nil, fmt.Errorf("couldn't list events by entity: %s", err)
 	}
+	attrs.LabelSelectors.Filter(&events)
 	return events, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"reflect"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	corev3 "github.com/sensu/sensu-go/api/core/v3"
@@ -73,7 +74,10 @@ func (g *GenericClient) Create(ctx context.Context, value corev2.Resource) error
 	if err := value.Validate(); err != nil {
 		return err
 	}
-	if err := g.Authorize(ctx, "create", value.GetObjectMeta().Name); err != nil {
+	labels := func() ([]map[string]string, error) {
+		return []map[string]string{value.GetObjectMeta().Labels}, nil
+	}
+	if _, err := g.authorize(ctx, VerbCreate, value.GetObjectMeta().Name, labels); err != nil {
 		return err
 	}
 	setCreatedBy(ctx, value)
@@ -126,7 +130,15 @@ func (g *GenericClient) Update(ctx context.Context, value corev2.Resource) error
 	if err := value.Validate(); err != nil {
 		return err
 	}
-	if err := g.Authorize(ctx, "update", value.GetObjectMeta().Name); err != nil {
+	name := value.GetObjectMeta().Name
+	labels := func() ([]map[string]string, error) {
+		stored, err := g.storedLabels(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return append(stored, value.GetObjectMeta().Labels), nil
+	}
+	if _, err := g.authorize(ctx, VerbUpdate, name, labels); err != nil {
 		return err
 	}
 	setCreatedBy(ctx, value)
@@ -151,7 +163,10 @@ func (g *GenericClient) Delete(ctx context.Context, name string) error {
 	if err := g.validateConfig(); err != nil {
 		return err
 	}
-	if err := g.Authorize(ctx, "delete", name); err != nil {
+	labels := func() ([]map[string]string, error) {
+		return g.storedLabels(ctx, name)
+	}
+	if _, err := g.authorize(ctx, VerbDelete, name, labels); err != nil {
 		return err
 	}
 	return g.deleteResource(ctx, name)
@@ -179,7 +194,10 @@ func (g *GenericClient) Get(ctx context.Context, name string, val corev2.Resourc
 	if err := g.validateConfig(); err != nil {
 		return err
 	}
-	if err := g.Authorize(ctx, "get", name); err != nil {
+	labels := func() ([]map[string]string, error) {
+		return g.storedLabels(ctx, name)
+	}
+	if _, err := g.authorize(ctx, VerbGet, name, labels); err != nil {
 		return err
 	}
 	return g.getResource(ctx, name, val)
@@ -219,15 +237,25 @@ func (g *GenericClient) List(ctx context.Context, resources interface{}, pred *s
 	if err := g.validateConfig(); err != nil {
 		return err
 	}
-	if err := g.Authorize(ctx, "list", ""); err != nil {
+	attrs, err := g.authorize(ctx, VerbList, "", nil)
+	if err != nil {
 		return err
 	}
-	return g.list(ctx, resources, pred)
+	return attrs.LabelSelectors.List(pred, resources, func(resources interface{}) error {
+		return g.list(ctx, resources, pred)
+	})
 }
 
 // Authorize tests whether or not the current user can perform an action.
 // Returns nil if action is allow and otherwise an auth error.
 func (g *GenericClient) Authorize(ctx context.Context, verb RBACVerb, name string) error {
+	_, err := g.authorize(ctx, verb, name, nil)
+	return err
+}
+
+// authorize tests whether or not the current user can perform an action on the
+// resources having the given labels, and returns the authorization attributes.
+func (g *GenericClient) authorize(ctx context.Context, verb RBACVerb, name string, labels authorization.ObjectLabels) (*authorization.Attributes, error) {
 	attrs := &authorization.Attributes{
 		APIGroup:     g.APIGroup,
 		APIVersion:   g.APIVersion,
@@ -235,9 +263,31 @@ func (g *GenericClient) Authorize(ctx context.Context, verb RBACVerb, name strin
 		Resource:     g.Kind.RBACName(),
 		ResourceName: name,
 		Verb:         string(verb),
+		Labels:       labels,
 	}
 	if err := authorize(ctx, g.Auth, attrs); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return attrs, nil
+}
+
+// storedLabels returns the labels of the stored resource, if it exists.
+func (g *GenericClient) storedLabels(ctx context.Context, name string) ([]map[string]string, error) {
+	value := g.newKind()
+	if err := g.getResource(ctx, name, value); err != nil {
+		if _, ok := err.(*store.ErrNotFound); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return []map[string]string{value.GetObjectMeta().Labels}, nil
+}
+
+// newKind returns a zero-valued resource of the kind of the client.
+func (g *GenericClient) newKind() corev2.Resource {
+	if proxy, ok := g.Kind.(*corev3.V2ResourceProxy); ok {
+		resource := reflect.New(reflect.TypeOf(proxy.Resource).Elem()).Interface().(corev3.Resource)
+		return corev3.V3ToV2Resource(resource)
+	}
+	return reflect.New(reflect.TypeOf(g.Kind).Elem()).Interface().(corev2.Resource)
 }
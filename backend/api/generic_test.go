@@ -380,6 +380,86 @@ func TestGenericClient_SetTypeMeta(t *testing.T) {
 	}
 }
 
+// labelAuth allows the resources labeled team=a
+type labelAuth struct{}
+
+func (labelAuth) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	selector := map[string]string{"team": "a"}
+	if attrs.Verb == string(VerbList) {
+		attrs.LabelSelectors = authorization.LabelSelectors{selector}
+		return true, nil
+	}
+	if attrs.Labels == nil {
+		return false, nil
+	}
+	objects, err := attrs.Labels()
+	if err != nil || len(objects) == 0 {
+		return false, err
+	}
+	for _, labels := range objects {
+		if !(corev2.Rule{LabelSelector: selector}).LabelSelectorMatches(labels) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func TestGenericClientLabelSelectors(t *testing.T) {
+	assetA := corev2.FixtureAsset("a")
+	assetA.Labels = map[string]string{"team": "a"}
+	assetB := corev2.FixtureAsset("b")
+	assetB.Labels = map[string]string{"team": "b"}
+
+	st := &mockstore.MockStore{}
+	for _, asset := range []*corev2.Asset{assetA, assetB} {
+		asset := asset
+		st.On("GetResource", mock.Anything, asset.Name, mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(2).(*corev2.Asset) = *asset
+		}).Return(nil)
+	}
+	st.On("GetResource", mock.Anything, "new", mock.Anything).Return(&store.ErrNotFound{})
+	st.On("ListResources", mock.Anything, (&corev2.Asset{}).StorePrefix(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(2).(*[]*corev2.Asset) = []*corev2.Asset{assetA, assetB}
+	}).Return(nil)
+	st.On("CreateResource", mock.Anything, mock.Anything).Return(nil)
+	st.On("CreateOrUpdateResource", mock.Anything, mock.Anything).Return(nil)
+	st.On("DeleteResource", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	client := defaultTestClient(st, labelAuth{})
+	ctx := contextWithUser(defaultContext(), "tom", nil)
+
+	var got corev2.Asset
+	if err := client.Get(ctx, "a", &got); err != nil {
+		t.Errorf("get a: %s", err)
+	}
+	if err := client.Get(ctx, "b", &got); err == nil {
+		t.Error("get b: expected non-nil error")
+	}
+	if err := client.Delete(ctx, "b"); err == nil {
+		t.Error("delete b: expected non-nil error")
+	}
+
+	relabeled := corev2.FixtureAsset("a")
+	relabeled.Labels = map[string]string{"team": "b"}
+	if err := client.Update(ctx, relabeled); err == nil {
+		t.Error("update a: expected non-nil error")
+	}
+
+	created := corev2.FixtureAsset("new")
+	created.Labels = map[string]string{"team": "a"}
+	if err := client.Create(ctx, created); err != nil {
+		t.Errorf("create: %s", err)
+	}
+
+	var assets []*corev2.Asset
+	if err := client.List(ctx, &assets, &store.SelectionPredicate{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(assets) != 1 || assets[0].Name != "a" {
+		t.Errorf("unexpected assets: %v", assets)
+	}
+}
+
 func TestGenericClientStoreV2(t *testing.T) {
 	tests := []struct {
 		Name      string
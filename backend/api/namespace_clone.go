@@ -0,0 +1,366 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/token"
+)
+
+// TemplateError is returned when the name template or the labels of a
+// resource can't be evaluated while cloning a namespace.
+type TemplateError struct {
+	Resource string
+	Err      error
+}
+
+func (e *TemplateError) Error() string {
+	return fmt.Sprintf("could not evaluate the templates of %s: %s", e.Resource, e.Err)
+}
+
+// CloneNamespace copies the resources of the source namespace to the
+// destination namespace of the request, creating it if it does not exist.
+// The name template and the label values of the resources are evaluated with
+// token substitution, and the references between the cloned resources are
+// renamed accordingly.
+//
+// Every resource is authorized separately: the resources of a type must be
+// listable in the source namespace, and creatable, or updatable when they are
+// overwritten, in the destination namespace. Cloning stops at the first error,
+// leaving the resources copied so far in the destination namespace.
+func (a *NamespaceClient) CloneNamespace(ctx context.Context, source string, clone *corev2.NamespaceClone) (*corev2.NamespaceCloneResult, error) {
+	return a.cloneNamespace(ctx, source, clone, false)
+}
+
+// CloneNamespaceDryRun returns the result CloneNamespace would have, without
+// creating the destination namespace or copying any resource. The resources
+// are validated and authorized the same way.
+func (a *NamespaceClient) CloneNamespaceDryRun(ctx context.Context, source string, clone *corev2.NamespaceClone) (*corev2.NamespaceCloneResult, error) {
+	return a.cloneNamespace(ctx, source, clone, true)
+}
+
+func (a *NamespaceClient) cloneNamespace(ctx context.Context, source string, clone *corev2.NamespaceClone, dryRun bool) (*corev2.NamespaceCloneResult, error) {
+	if _, err := a.FetchNamespace(ctx, source); err != nil {
+		return nil, err
+	}
+	ensure, copyTo := a.ensureNamespace, copyResource
+	if dryRun {
+		ensure, copyTo = a.checkNamespace, checkCopyResource
+	}
+	if err := ensure(ctx, clone.Destination); err != nil {
+		return nil, err
+	}
+
+	sourceCtx := context.WithValue(ctx, corev2.NamespaceKey, source)
+	destinationCtx := context.WithValue(ctx, corev2.NamespaceKey, clone.Destination)
+
+	cloneTypes := clone.Types
+	if len(cloneTypes) == 0 {
+		cloneTypes = corev2.DefaultNamespaceCloneTypes
+	}
+
+	// All the resources are listed and renamed before any is copied, so that
+	// the references between them can be renamed too.
+	kinds := make([]clonedKind, 0, len(cloneTypes))
+	names := cloneNames{}
+	for _, cloneType := range cloneTypes {
+		kind, err := corev2.ResolveResource(cloneType)
+		if err != nil {
+			return nil, err
+		}
+		client := GenericClient{
+			Kind:       kind,
+			Store:      a.client.Store,
+			StoreV2:    a.storev2,
+			Auth:       a.auth,
+			APIGroup:   "core",
+			APIVersion: "v2",
+		}
+		resources, err := listKind(sourceCtx, client)
+		if err != nil {
+			return nil, err
+		}
+		cloned := clonedKind{
+			cloneType: cloneType,
+			client:    client,
+			resources: resources,
+			sources:   make([]string, 0, len(resources)),
+		}
+		typeName := reflect.Indirect(reflect.ValueOf(kind)).Type().Name()
+		for _, resource := range resources {
+			name := resource.GetObjectMeta().Name
+			if err := applyCloneTemplates(resource, source, clone); err != nil {
+				return nil, err
+			}
+			cloned.sources = append(cloned.sources, name)
+			names.add(typeName, name, resource.GetObjectMeta().Name)
+		}
+		kinds = append(kinds, cloned)
+	}
+
+	result := &corev2.NamespaceCloneResult{
+		Source:      source,
+		Destination: clone.Destination,
+		Resources:   []corev2.ClonedResource{},
+	}
+	for _, kind := range kinds {
+		for i, resource := range kind.resources {
+			names.rewriteReferences(resource)
+			cloned := corev2.ClonedResource{
+				Type:   kind.cloneType,
+				Source: kind.sources[i],
+				Name:   resource.GetObjectMeta().Name,
+			}
+			var err error
+			if cloned.Status, err = copyTo(destinationCtx, kind.client, resource, clone.Overwrite); err != nil {
+				return nil, err
+			}
+			result.Resources = append(result.Resources, cloned)
+		}
+	}
+
+	return result, nil
+}
+
+// clonedKind holds the resources of a type copied by a namespace clone, along
+// with their names in the source namespace.
+type clonedKind struct {
+	cloneType string
+	client    GenericClient
+	resources []corev2.Resource
+	sources   []string
+}
+
+// cloneNames maps the names of the cloned resources in the source namespace to
+// their names in the destination namespace, by type.
+type cloneNames map[string]map[string]string
+
+func (n cloneNames) add(typeName, source, name string) {
+	if source == name {
+		return
+	}
+	if n[typeName] == nil {
+		n[typeName] = map[string]string{}
+	}
+	n[typeName][source] = name
+}
+
+// rename returns the name in the destination namespace of the resource, or
+// its name if it was not renamed, or not cloned.
+func (n cloneNames) rename(typeName, name string) string {
+	if renamed, ok := n[typeName][name]; ok {
+		return renamed
+	}
+	return name
+}
+
+func (n cloneNames) renameAll(typeName string, names []string) {
+	for i := range names {
+		names[i] = n.rename(typeName, names[i])
+	}
+}
+
+func (n cloneNames) renameReference(ref *corev2.ResourceReference) {
+	if ref == nil || (ref.APIVersion != "" && ref.APIVersion != "core/v2") {
+		return
+	}
+	ref.Name = n.rename(ref.Type, ref.Name)
+}
+
+// rewriteReferences renames the resources referenced by the resource that
+// were renamed by the clone.
+func (n cloneNames) rewriteReferences(resource corev2.Resource) {
+	if len(n) == 0 {
+		return
+	}
+	switch r := resource.(type) {
+	case *corev2.CheckConfig:
+		n.renameAll("Handler", r.Handlers)
+		n.renameAll("Asset", r.RuntimeAssets)
+		for i := range r.CheckHooks {
+			n.renameAll("HookConfig", r.CheckHooks[i].Hooks)
+		}
+		for _, ref := range r.Pipelines {
+			n.renameReference(ref)
+		}
+	case *corev2.Handler:
+		n.renameAll("EventFilter", r.Filters)
+		n.renameAll("Handler", r.Handlers)
+		n.renameAll("Asset", r.RuntimeAssets)
+		if r.Mutator != "" {
+			r.Mutator = n.rename("Mutator", r.Mutator)
+		}
+	case *corev2.EventFilter:
+		n.renameAll("Asset", r.RuntimeAssets)
+	case *corev2.Mutator:
+		n.renameAll("Asset", r.RuntimeAssets)
+	case *corev2.HookConfig:
+		n.renameAll("Asset", r.RuntimeAssets)
+	case *corev2.Pipeline:
+		for _, workflow := range r.Workflows {
+			if workflow == nil {
+				continue
+			}
+			for _, ref := range workflow.Filters {
+				n.renameReference(ref)
+			}
+			n.renameReference(workflow.Mutator)
+			n.renameReference(workflow.Handler)
+		}
+	case *corev2.RoleBinding:
+		if r.RoleRef.Type == "Role" {
+			r.RoleRef.Name = n.rename("Role", r.RoleRef.Name)
+		}
+	}
+}
+
+// ensureNamespace creates the namespace if it does not exist.
+func (a *NamespaceClient) ensureNamespace(ctx context.Context, name string) error {
+	var namespace corev2.Namespace
+	err := a.client.Store.GetResource(ctx, name, &namespace)
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*store.ErrNotFound); !ok {
+		return err
+	}
+	return a.CreateNamespace(ctx, &corev2.Namespace{Name: name})
+}
+
+// checkNamespace makes sure the namespace exists or can be created, without
+// creating it.
+func (a *NamespaceClient) checkNamespace(ctx context.Context, name string) error {
+	var namespace corev2.Namespace
+	err := a.client.Store.GetResource(ctx, name, &namespace)
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*store.ErrNotFound); !ok {
+		return err
+	}
+	namespace = corev2.Namespace{Name: name}
+	if err := namespace.Validate(); err != nil {
+		return err
+	}
+	return a.client.Authorize(ctx, VerbCreate, name)
+}
+
+// listKind lists the resources of the kind of the client, in the namespace of
+// the context.
+func listKind(ctx context.Context, client GenericClient) ([]corev2.Resource, error) {
+	sliceType := reflect.SliceOf(reflect.TypeOf(client.Kind))
+	ptr := reflect.New(sliceType)
+	ptr.Elem().Set(reflect.MakeSlice(sliceType, 0, 0))
+	if err := client.List(ctx, ptr.Interface(), &store.SelectionPredicate{}); err != nil {
+		return nil, err
+	}
+	values := ptr.Elem()
+	resources := make([]corev2.Resource, 0, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		if resource, ok := values.Index(i).Interface().(corev2.Resource); ok {
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+// applyCloneTemplates moves the resource to the destination namespace, and
+// evaluates the name template and the label values of the resource.
+func applyCloneTemplates(resource corev2.Resource, source string, clone *corev2.NamespaceClone) error {
+	meta := resource.GetObjectMeta()
+	values := clone.Values
+	if values == nil {
+		values = map[string]string{}
+	}
+	data := map[string]interface{}{
+		"name":             meta.Name,
+		"namespace":        clone.Destination,
+		"source_namespace": source,
+		"values":           values,
+	}
+	templateErr := func(err error) error {
+		return &TemplateError{Resource: fmt.Sprintf("%s %s", resource.RBACName(), meta.Name), Err: err}
+	}
+
+	if clone.NameTemplate != "" {
+		b, err := token.Substitution(data, clone.NameTemplate)
+		if err != nil {
+			return templateErr(err)
+		}
+		if err := json.Unmarshal(b, &meta.Name); err != nil {
+			return templateErr(err)
+		}
+	}
+	if len(meta.Labels) > 0 {
+		b, err := token.Substitution(data, meta.Labels)
+		if err != nil {
+			return templateErr(err)
+		}
+		labels := map[string]string{}
+		if err := json.Unmarshal(b, &labels); err != nil {
+			return templateErr(err)
+		}
+		meta.Labels = labels
+	}
+
+	meta.Namespace = clone.Destination
+	meta.CreatedBy = ""
+	resource.SetObjectMeta(meta)
+	return nil
+}
+
+// copyResource creates the resource, or overwrites it if it already exists and
+// overwrite is set, and returns the clone status of the resource.
+func copyResource(ctx context.Context, client GenericClient, resource corev2.Resource, overwrite bool) (string, error) {
+	err := client.Create(ctx, resource)
+	if err == nil {
+		return corev2.CloneCreated, nil
+	}
+	if _, ok := err.(*store.ErrAlreadyExists); !ok {
+		return "", err
+	}
+	if !overwrite {
+		return corev2.CloneSkipped, nil
+	}
+	if err := client.Update(ctx, resource); err != nil {
+		return "", err
+	}
+	return corev2.CloneUpdated, nil
+}
+
+// checkCopyResource validates and authorizes the copy of the resource like
+// copyResource, and returns the clone status it would have, without copying
+// it.
+func checkCopyResource(ctx context.Context, client GenericClient, resource corev2.Resource, overwrite bool) (string, error) {
+	if err := client.validateConfig(); err != nil {
+		return "", err
+	}
+	if err := resource.Validate(); err != nil {
+		return "", err
+	}
+	name := resource.GetObjectMeta().Name
+	stored, err := client.storedLabels(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	labels := func() ([]map[string]string, error) {
+		return append(stored, resource.GetObjectMeta().Labels), nil
+	}
+	if stored == nil {
+		if _, err := client.authorize(ctx, VerbCreate, name, labels); err != nil {
+			return "", err
+		}
+		return corev2.CloneCreated, nil
+	}
+	if !overwrite {
+		return corev2.CloneSkipped, nil
+	}
+	if _, err := client.authorize(ctx, VerbUpdate, name, labels); err != nil {
+		return "", err
+	}
+	return corev2.CloneUpdated, nil
+}
@@ -0,0 +1,335 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/v2/wrap"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+// cloneAuth allows the verbs on the resources of the namespaces, keyed by
+// "verb resource namespace".
+type cloneAuth map[string]bool
+
+func (a cloneAuth) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	return a[fmt.Sprintf("%s %s %s", attrs.Verb, attrs.Resource, attrs.Namespace)], nil
+}
+
+func TestCloneNamespace(t *testing.T) {
+	allowed := cloneAuth{
+		"get namespaces ":             true,
+		"create namespaces ":          true,
+		"update roles ":               true,
+		"update rolebindings ":        true,
+		"list handlers source":        true,
+		"create handlers destination": true,
+		"update handlers destination": true,
+	}
+
+	tests := []struct {
+		name          string
+		clone         *corev2.NamespaceClone
+		auth          cloneAuth
+		createErr     error
+		wantResources []corev2.ClonedResource
+		wantLabels    map[string]string
+		wantErr       bool
+	}{
+		{
+			name: "resources are copied with templates",
+			clone: &corev2.NamespaceClone{
+				Destination:  "destination",
+				Types:        []string{"Handler"},
+				NameTemplate: "{{ .values.team }}-{{ .name }}",
+				Values:       map[string]string{"team": "ops"},
+			},
+			auth: allowed,
+			wantResources: []corev2.ClonedResource{
+				{Type: "Handler", Source: "slack", Name: "ops-slack", Status: corev2.CloneCreated},
+			},
+			wantLabels: map[string]string{"team": "ops", "origin": "source", "tier": "1"},
+		},
+		{
+			name: "existing resources are skipped",
+			clone: &corev2.NamespaceClone{
+				Destination: "destination",
+				Types:       []string{"Handler"},
+				Values:      map[string]string{"team": "ops"},
+			},
+			auth:      allowed,
+			createErr: &store.ErrAlreadyExists{},
+			wantResources: []corev2.ClonedResource{
+				{Type: "Handler", Source: "slack", Name: "slack", Status: corev2.CloneSkipped},
+			},
+		},
+		{
+			name: "existing resources are overwritten",
+			clone: &corev2.NamespaceClone{
+				Destination: "destination",
+				Types:       []string{"Handler"},
+				Values:      map[string]string{"team": "ops"},
+				Overwrite:   true,
+			},
+			auth:      allowed,
+			createErr: &store.ErrAlreadyExists{},
+			wantResources: []corev2.ClonedResource{
+				{Type: "Handler", Source: "slack", Name: "slack", Status: corev2.CloneUpdated},
+			},
+		},
+		{
+			name: "missing template values",
+			clone: &corev2.NamespaceClone{
+				Destination: "destination",
+				Types:       []string{"Handler"},
+			},
+			auth:    allowed,
+			wantErr: true,
+		},
+		{
+			name: "creating resources in the destination is not allowed",
+			clone: &corev2.NamespaceClone{
+				Destination: "destination",
+				Types:       []string{"Handler"},
+				Values:      map[string]string{"team": "ops"},
+			},
+			auth: cloneAuth{
+				"get namespaces ":      true,
+				"create namespaces ":   true,
+				"update roles ":        true,
+				"update rolebindings ": true,
+				"list handlers source": true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "listing resources in the source is not allowed",
+			clone: &corev2.NamespaceClone{
+				Destination: "destination",
+				Types:       []string{"CheckConfig", "Handler"},
+				Values:      map[string]string{"team": "ops"},
+			},
+			auth: cloneAuth{
+				"get namespaces ":             true,
+				"create namespaces ":          true,
+				"update roles ":               true,
+				"update rolebindings ":        true,
+				"create handlers destination": true,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := new(mockstore.MockStore)
+			s.On("GetResource", mock.Anything, "source", mock.Anything).Return(nil)
+			s.On("GetResource", mock.Anything, "destination", mock.Anything).Return(&store.ErrNotFound{})
+			s.On("CreateResource", mock.Anything, mock.AnythingOfType("*v2.Namespace")).Return(nil)
+			s.On("CreateOrUpdateResource", mock.Anything, mock.Anything).Return(nil)
+			s.On("ListResources", mock.Anything, "checks", mock.Anything, mock.Anything).Return(nil)
+			s.On("ListResources", mock.Anything, "handlers", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				if ns := corev2.ContextNamespace(args[0].(context.Context)); ns != "source" {
+					t.Errorf("handlers listed in namespace %q", ns)
+				}
+				handler := corev2.FixtureHandler("slack")
+				handler.Namespace = "source"
+				handler.CreatedBy = "someone"
+				handler.Labels = map[string]string{
+					"team":   "{{ .values.team }}",
+					"origin": "{{ .source_namespace }}",
+					"tier":   "1",
+				}
+				*args[2].(*[]*corev2.Handler) = []*corev2.Handler{handler}
+			}).Return(nil)
+			var created *corev2.Handler
+			s.On("CreateResource", mock.Anything, mock.AnythingOfType("*v2.Handler")).Run(func(args mock.Arguments) {
+				created = args[1].(*corev2.Handler)
+			}).Return(tt.createErr)
+
+			s2 := new(mockstore.V2MockStore)
+			s2.On("List", mock.Anything, mock.Anything).Return(wrap.List{}, nil)
+
+			ctx := contextWithUser(context.Background(), "admin", nil)
+			client := NewNamespaceClient(s, s, tt.auth, s2)
+			result, err := client.CloneNamespace(ctx, "source", tt.clone)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CloneNamespace() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got, want := fmt.Sprint(result.Resources), fmt.Sprint(tt.wantResources); got != want {
+				t.Errorf("cloned resources = %s, want %s", got, want)
+			}
+			if created == nil {
+				t.Fatal("no handler was created")
+			}
+			if created.Namespace != "destination" {
+				t.Errorf("handler created in namespace %q", created.Namespace)
+			}
+			if created.CreatedBy != "admin" {
+				t.Errorf("handler created by %q", created.CreatedBy)
+			}
+			if tt.wantLabels != nil && fmt.Sprint(created.Labels) != fmt.Sprint(tt.wantLabels) {
+				t.Errorf("labels = %v, want %v", created.Labels, tt.wantLabels)
+			}
+			s.AssertCalled(t, "CreateResource", mock.Anything, &corev2.Namespace{Name: "destination"})
+		})
+	}
+}
+
+func TestCloneNamespaceDryRun(t *testing.T) {
+	allowed := cloneAuth{
+		"get namespaces ":             true,
+		"create namespaces ":          true,
+		"list handlers source":        true,
+		"create handlers destination": true,
+		"update handlers destination": true,
+	}
+
+	tests := []struct {
+		name          string
+		auth          cloneAuth
+		overwrite     bool
+		storedErr     error
+		wantResources []corev2.ClonedResource
+		wantErr       bool
+	}{
+		{
+			name:      "missing resources would be created",
+			auth:      allowed,
+			storedErr: &store.ErrNotFound{},
+			wantResources: []corev2.ClonedResource{
+				{Type: "Handler", Source: "slack", Name: "slack", Status: corev2.CloneCreated},
+			},
+		},
+		{
+			name: "existing resources would be skipped",
+			auth: allowed,
+			wantResources: []corev2.ClonedResource{
+				{Type: "Handler", Source: "slack", Name: "slack", Status: corev2.CloneSkipped},
+			},
+		},
+		{
+			name:      "existing resources would be overwritten",
+			auth:      allowed,
+			overwrite: true,
+			wantResources: []corev2.ClonedResource{
+				{Type: "Handler", Source: "slack", Name: "slack", Status: corev2.CloneUpdated},
+			},
+		},
+		{
+			name: "creating resources in the destination is not allowed",
+			auth: cloneAuth{
+				"get namespaces ":      true,
+				"create namespaces ":   true,
+				"list handlers source": true,
+			},
+			storedErr: &store.ErrNotFound{},
+			wantErr:   true,
+		},
+		{
+			name: "creating the destination is not allowed",
+			auth: cloneAuth{
+				"get namespaces ":             true,
+				"list handlers source":        true,
+				"create handlers destination": true,
+			},
+			storedErr: &store.ErrNotFound{},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := new(mockstore.MockStore)
+			s.On("GetResource", mock.Anything, "source", mock.Anything).Return(nil)
+			s.On("GetResource", mock.Anything, "destination", mock.Anything).Return(&store.ErrNotFound{})
+			s.On("GetResource", mock.Anything, "slack", mock.AnythingOfType("*v2.Handler")).Return(tt.storedErr)
+			s.On("ListResources", mock.Anything, "handlers", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				handler := corev2.FixtureHandler("slack")
+				handler.Namespace = "source"
+				*args[2].(*[]*corev2.Handler) = []*corev2.Handler{handler}
+			}).Return(nil)
+
+			ctx := contextWithUser(context.Background(), "admin", nil)
+			client := NewNamespaceClient(s, s, tt.auth, new(mockstore.V2MockStore))
+			clone := &corev2.NamespaceClone{
+				Destination: "destination",
+				Types:       []string{"Handler"},
+				Overwrite:   tt.overwrite,
+			}
+			result, err := client.CloneNamespaceDryRun(ctx, "source", clone)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CloneNamespaceDryRun() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if got, want := fmt.Sprint(result.Resources), fmt.Sprint(tt.wantResources); got != want {
+					t.Errorf("cloned resources = %s, want %s", got, want)
+				}
+			}
+
+			// Nothing is written
+			s.AssertNotCalled(t, "CreateResource", mock.Anything, mock.Anything)
+			s.AssertNotCalled(t, "CreateOrUpdateResource", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCloneNamespaceReferences(t *testing.T) {
+	auth := cloneAuth{
+		"get namespaces ":             true,
+		"create namespaces ":          true,
+		"list checks source":          true,
+		"create checks destination":   true,
+		"list handlers source":        true,
+		"create handlers destination": true,
+	}
+
+	s := new(mockstore.MockStore)
+	s.On("GetResource", mock.Anything, "source", mock.Anything).Return(nil)
+	s.On("GetResource", mock.Anything, "destination", mock.Anything).Return(nil)
+	s.On("ListResources", mock.Anything, "checks", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		check := corev2.FixtureCheckConfig("cpu")
+		check.Namespace = "source"
+		check.Handlers = []string{"slack", "email"}
+		*args[2].(*[]*corev2.CheckConfig) = []*corev2.CheckConfig{check}
+	}).Return(nil)
+	s.On("ListResources", mock.Anything, "handlers", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		handler := corev2.FixtureHandler("slack")
+		handler.Namespace = "source"
+		*args[2].(*[]*corev2.Handler) = []*corev2.Handler{handler}
+	}).Return(nil)
+	var check *corev2.CheckConfig
+	s.On("CreateResource", mock.Anything, mock.AnythingOfType("*v2.CheckConfig")).Run(func(args mock.Arguments) {
+		check = args[1].(*corev2.CheckConfig)
+	}).Return(nil)
+	s.On("CreateResource", mock.Anything, mock.AnythingOfType("*v2.Handler")).Return(nil)
+
+	ctx := contextWithUser(context.Background(), "admin", nil)
+	client := NewNamespaceClient(s, s, auth, new(mockstore.V2MockStore))
+	clone := &corev2.NamespaceClone{
+		Destination:  "destination",
+		Types:        []string{"CheckConfig", "Handler"},
+		NameTemplate: "ops-{{ .name }}",
+	}
+	if _, err := client.CloneNamespace(ctx, "source", clone); err != nil {
+		t.Fatal(err)
+	}
+	if check == nil {
+		t.Fatal("no check was created")
+	}
+	if got, want := check.Name, "ops-cpu"; got != want {
+		t.Errorf("check name = %q, want %q", got, want)
+	}
+	// The cloned handler is renamed, the handler that was not cloned is not
+	if got, want := fmt.Sprint(check.Handlers), "[ops-slack email]"; got != want {
+		t.Errorf("check handlers = %s, want %s", got, want)
+	}
+}
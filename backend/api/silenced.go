@@ -75,6 +75,7 @@ func (s *SilencedClient) ListSilenced(ctx context.Context) ([]*corev2.Silenced,
 	if err != nil {
 		return nil, fmt.Errorf("couldn't list silenced entries: %s", err)
 	}
+	attrs.LabelSelectors.Filter(&silenceds)
 	return silenceds, nil
 }
 
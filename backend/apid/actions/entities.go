@@ -57,6 +57,20 @@ func (c EntityController) List(ctx context.Context, pred *store.SelectionPredica
 
 // Create instatiates, validates and persists new resource if viewer has access.
 func (c EntityController) Create(ctx context.Context, entity corev2.Entity) error {
+	if err := c.ValidateCreate(ctx, entity); err != nil {
+		return err
+	}
+
+	// Persist the resource in the store
+	if err := c.store.UpdateEntity(ctx, &entity); err != nil {
+		return NewError(InternalErr, err)
+	}
+	return nil
+}
+
+// ValidateCreate validates a new entity and makes sure it does not already
+// exist, without persisting it.
+func (c EntityController) ValidateCreate(ctx context.Context, entity corev2.Entity) error {
 	// Check for an already existing resource
 	if e, err := c.store.GetEntityByName(ctx, entity.Name); err != nil {
 		return NewError(InternalErr, err)
@@ -69,10 +83,28 @@ func (c EntityController) Create(ctx context.Context, entity corev2.Entity) erro
 		return NewError(InvalidArgument, err)
 	}
 
-	// Persist the resource in the store
-	if err := c.store.UpdateEntity(ctx, &entity); err != nil {
+	return nil
+}
+
+// ValidateCreateOrReplace validates an entity and makes sure it can be
+// created or replaced, without persisting it.
+func (c EntityController) ValidateCreateOrReplace(ctx context.Context, entity corev2.Entity) error {
+	if err := entity.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+	if entity.EntityClass == corev2.EntityProxyClass {
+		return nil
+	}
+
+	// Entities managed by their agent can't be replaced
+	e, err := c.store.GetEntityByName(ctx, entity.Name)
+	if err != nil {
 		return NewError(InternalErr, err)
 	}
+	if e != nil && e.Labels[corev2.ManagedByLabel] == "sensu-agent" {
+		return NewError(AlreadyExistsErr, errors.New("entity is managed by its agent"))
+	}
+
 	return nil
 }
 
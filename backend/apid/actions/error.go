@@ -52,6 +52,10 @@ const (
 	// the operation has completed successfully. For example, a successful
 	// response from a server could have been delayed long
 	DeadlineExceeded
+
+	// QuotaExceeded is used when creating a resource would exceed one of the
+	// resource quotas of its namespace.
+	QuotaExceeded
 )
 
 // Default error messages if not message is provided.
@@ -65,6 +69,7 @@ var standardErrorMessages = map[ErrCode]string{
 	PaymentRequired:    "license required",
 	PreconditionFailed: "precondition failed",
 	DeadlineExceeded:   "deadline exceeded",
+	QuotaExceeded:      "resource quota exceeded",
 }
 
 // Error describes an issue that ocurred while performing the action.
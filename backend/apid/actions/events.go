@@ -7,6 +7,7 @@ import (
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/tracing"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 )
@@ -72,18 +73,9 @@ func (a EventController) Get(ctx context.Context, entity, check string) (*corev2
 
 // Delete destroys the event indicated by the supplied entity and check.
 func (a EventController) Delete(ctx context.Context, entity, check string) error {
-	// Destroy (for events) requires both an entity and check
-	if entity == "" || check == "" {
-		return NewErrorf(InvalidArgument, "Delete() requires both an entity and a check")
-	}
-
-	result, err := a.store.GetEventByEntityCheck(ctx, entity, check)
+	result, err := a.findForDelete(ctx, entity, check)
 	if err != nil {
-		return NewError(InternalErr, err)
-	}
-
-	if result == nil {
-		return NewErrorf(NotFound)
+		return err
 	}
 
 	if result.HasCheck() && result.Check.Ttl > 0 {
@@ -109,9 +101,34 @@ func (a EventController) Delete(ctx context.Context, entity, check string) error
 	return nil
 }
 
-// CreateOrReplace creates the event indicated by the supplied entity and check.
-// If an event already exists for the entity and check, it updates that event.
-func (a EventController) CreateOrReplace(ctx context.Context, event *corev2.Event) error {
+// ValidateDelete makes sure the event indicated by the supplied entity and
+// check can be deleted, without deleting it.
+func (a EventController) ValidateDelete(ctx context.Context, entity, check string) error {
+	_, err := a.findForDelete(ctx, entity, check)
+	return err
+}
+
+func (a EventController) findForDelete(ctx context.Context, entity, check string) (*corev2.Event, error) {
+	// Destroy (for events) requires both an entity and check
+	if entity == "" || check == "" {
+		return nil, NewErrorf(InvalidArgument, "Delete() requires both an entity and a check")
+	}
+
+	result, err := a.store.GetEventByEntityCheck(ctx, entity, check)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
+
+// ValidateCreateOrReplace defaults and validates the event, without publishing
+// it.
+func (a EventController) ValidateCreateOrReplace(ctx context.Context, event *corev2.Event) error {
 	if event.Entity != nil && event.Entity.EntityClass == "" {
 		event.Entity.EntityClass = corev2.EntityProxyClass
 	}
@@ -134,7 +151,18 @@ func (a EventController) CreateOrReplace(ctx context.Context, event *corev2.Even
 		event.Entity.CreatedBy = claims.StandardClaims.Subject
 	}
 
-	// Publish to event pipeline
+	return nil
+}
+
+// CreateOrReplace creates the event indicated by the supplied entity and check.
+// If an event already exists for the entity and check, it updates that event.
+func (a EventController) CreateOrReplace(ctx context.Context, event *corev2.Event) error {
+	if err := a.ValidateCreateOrReplace(ctx, event); err != nil {
+		return err
+	}
+
+	// Publish to event pipeline, as part of the trace of the request
+	tracing.InjectEvent(ctx, event)
 	if err := a.bus.Publish(messaging.TopicEventRaw, event); err != nil {
 		return NewError(InternalErr, err)
 	}
@@ -42,16 +42,23 @@ func (c SilencedController) List(ctx context.Context, sub, check string) ([]*cor
 
 // Create creates a new silenced entry. It returns an error if the entry already exists.
 func (c SilencedController) Create(ctx context.Context, entry *corev2.Silenced) error {
-	// Prepare the silenced entry for storage
-	entry.Prepare(ctx)
+	if err := c.ValidateCreate(ctx, entry); err != nil {
+		return err
+	}
 
-	// Validate the silenced entry
-	if err := entry.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
+	// Persist
+	if err := c.Store.UpdateSilencedEntry(ctx, entry); err != nil {
+		return NewError(InternalErr, err)
 	}
 
-	if claims := jwt.GetClaimsFromContext(ctx); claims != nil {
-		entry.CreatedBy = claims.StandardClaims.Subject
+	return nil
+}
+
+// ValidateCreate prepares and validates a new silenced entry, and makes sure
+// it does not already exist, without persisting it.
+func (c SilencedController) ValidateCreate(ctx context.Context, entry *corev2.Silenced) error {
+	if err := c.ValidateCreateOrReplace(ctx, entry); err != nil {
+		return err
 	}
 
 	// Check for existing
@@ -61,6 +68,15 @@ func (c SilencedController) Create(ctx context.Context, entry *corev2.Silenced)
 		return NewErrorf(AlreadyExistsErr)
 	}
 
+	return nil
+}
+
+// CreateOrReplace creates or replaces a silenced entry.
+func (c SilencedController) CreateOrReplace(ctx context.Context, entry *corev2.Silenced) error {
+	if err := c.ValidateCreateOrReplace(ctx, entry); err != nil {
+		return err
+	}
+
 	// Persist
 	if err := c.Store.UpdateSilencedEntry(ctx, entry); err != nil {
 		return NewError(InternalErr, err)
@@ -69,8 +85,9 @@ func (c SilencedController) Create(ctx context.Context, entry *corev2.Silenced)
 	return nil
 }
 
-// CreateOrReplace creates or replaces a silenced entry.
-func (c SilencedController) CreateOrReplace(ctx context.Context, entry *corev2.Silenced) error {
+// ValidateCreateOrReplace prepares and validates a silenced entry, without
+// persisting it.
+func (c SilencedController) ValidateCreateOrReplace(ctx context.Context, entry *corev2.Silenced) error {
 	// Prepare the silenced entry for storage
 	entry.Prepare(ctx)
 
@@ -83,11 +100,6 @@ func (c SilencedController) CreateOrReplace(ctx context.Context, entry *corev2.S
 		entry.CreatedBy = claims.StandardClaims.Subject
 	}
 
-	// Persist
-	if err := c.Store.UpdateSilencedEntry(ctx, entry); err != nil {
-		return NewError(InternalErr, err)
-	}
-
 	return nil
 }
 
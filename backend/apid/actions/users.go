@@ -3,21 +3,25 @@ package actions
 import (
 	"context"
 	"errors"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication/bcrypt"
+	"github.com/sensu/sensu-go/backend/authentication/session"
 	"github.com/sensu/sensu-go/backend/store"
 )
 
 // UserController exposes actions in which a viewer can perform.
 type UserController struct {
-	store store.UserStore
+	store     store.UserStore
+	resources store.ResourceStore
 }
 
 // NewUserController returns new UserController
 func NewUserController(store store.Store) UserController {
 	return UserController{
-		store: store,
+		store:     store,
+		resources: store,
 	}
 }
 
@@ -40,6 +44,25 @@ func (a UserController) List(ctx context.Context, pred *store.SelectionPredicate
 	return resources, nil
 }
 
+// ListWithPasswordHashes returns the users along with their password hashes,
+// so that they can be restored from a backup. Callers are responsible for
+// making sure the viewer is allowed to read them.
+func (a UserController) ListWithPasswordHashes(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+	users, err := a.store.GetAllUsers(pred)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	resources := make([]corev2.Resource, len(users))
+	for i, user := range users {
+		// The password field only holds a copy of the hash
+		user.Password = ""
+		resources[i] = corev2.Resource(user)
+	}
+
+	return resources, nil
+}
+
 // Get returns resource associated with given parameters if available to the
 // viewer.
 func (a UserController) Get(ctx context.Context, name string) (*corev2.User, error) {
@@ -61,6 +84,16 @@ func (a UserController) Get(ctx context.Context, name string) (*corev2.User, err
 
 // Create creates a new user. It returns an error if the user already exists.
 func (a UserController) Create(ctx context.Context, user *corev2.User) error {
+	if err := a.ValidateCreate(ctx, user); err != nil {
+		return err
+	}
+
+	return a.updateUser(ctx, user)
+}
+
+// ValidateCreate validates a new user and its password, and makes sure it
+// does not already exist, without persisting it.
+func (a UserController) ValidateCreate(ctx context.Context, user *corev2.User) error {
 	// Check for existing
 	if e, err := a.store.GetUser(ctx, user.Username); err != nil {
 		return NewError(InternalErr, err)
@@ -68,15 +101,53 @@ func (a UserController) Create(ctx context.Context, user *corev2.User) error {
 		return NewErrorf(AlreadyExistsErr)
 	}
 
-	return a.CreateOrReplace(ctx, user)
+	return a.prepare(ctx, user, true)
+}
+
+// UpdatePassword changes the password of an existing user. The cleartext
+// password is required if the password policy constrains the passwords, and
+// the expiry of the password is only restarted when it is given.
+func (a UserController) UpdatePassword(ctx context.Context, user *corev2.User) error {
+	return a.createOrReplace(ctx, user, true)
 }
 
-// CreateOrReplace creates or replaces a user.
+// ValidateUpdatePassword validates the new password of an existing user,
+// without persisting it.
+func (a UserController) ValidateUpdatePassword(ctx context.Context, user *corev2.User) error {
+	return a.prepare(ctx, user, true)
+}
+
+// CreateOrReplace creates or replaces a user. Only a password hash can be
+// provided, so users can be restored from a backup.
 func (a UserController) CreateOrReplace(ctx context.Context, user *corev2.User) error {
+	return a.createOrReplace(ctx, user, false)
+}
+
+// ValidateCreateOrReplace validates a user and its password, without
+// persisting it.
+func (a UserController) ValidateCreateOrReplace(ctx context.Context, user *corev2.User) error {
+	return a.prepare(ctx, user, false)
+}
+
+func (a UserController) createOrReplace(ctx context.Context, user *corev2.User, requireCleartext bool) error {
+	if err := a.prepare(ctx, user, requireCleartext); err != nil {
+		return err
+	}
+
+	return a.updateUser(ctx, user)
+}
+
+// prepare validates the user and its password, and hashes the cleartext
+// password. The expiry of the password is restarted when the cleartext
+// password is given, since it is then validated against the password policy.
+func (a UserController) prepare(ctx context.Context, user *corev2.User, requireCleartext bool) error {
 	// Validate
 	if err := user.Validate(); err != nil {
 		return NewError(InvalidArgument, err)
 	}
+	if err := a.enforcePasswordPolicy(ctx, user, requireCleartext); err != nil {
+		return err
+	}
 
 	// Determine if a hashed and/or cleartext password was provided
 	if user.Password != "" && user.PasswordHash != "" {
@@ -88,6 +159,7 @@ func (a UserController) CreateOrReplace(ctx context.Context, user *corev2.User)
 				errors.New("hashed password does not the match the cleartext password, only one of those should be provided"),
 			)
 		}
+		user.PasswordChangedAt = time.Now().Unix()
 	} else if user.Password != "" {
 		// We need to validate the cleartext passsword so it matches our minimal
 		// requirements
@@ -101,6 +173,7 @@ func (a UserController) CreateOrReplace(ctx context.Context, user *corev2.User)
 			return NewError(InternalErr, err)
 		}
 		user.PasswordHash = hash
+		user.PasswordChangedAt = time.Now().Unix()
 	} else if user.PasswordHash == "" {
 		return NewError(InvalidArgument, errors.New("a password or its hash is required"))
 	}
@@ -108,11 +181,6 @@ func (a UserController) CreateOrReplace(ctx context.Context, user *corev2.User)
 	// Also add the hash to the password field for backward compatibility
 	user.Password = user.PasswordHash
 
-	// Persist
-	if err := a.store.UpdateUser(user); err != nil {
-		return NewError(InternalErr, err)
-	}
-
 	return nil
 }
 
@@ -133,6 +201,12 @@ func (a UserController) Disable(ctx context.Context, name string) error {
 		return NewError(InternalErr, err)
 	}
 
+	// Revoke the sessions of the user, so their access tokens are rejected
+	// before they expire
+	if err := session.RevokeUser(ctx, a.resources, name); err != nil {
+		return NewError(InternalErr, err)
+	}
+
 	return nil
 }
 
@@ -196,6 +270,38 @@ func (a UserController) RemoveAllGroups(ctx context.Context, username string) er
 	})
 }
 
+// enforcePasswordPolicy returns an error if the cleartext password of the
+// user does not comply with the password policy of the cluster. A hash can't
+// be checked, so the cleartext password can be required.
+func (a UserController) enforcePasswordPolicy(ctx context.Context, user *corev2.User, requireCleartext bool) error {
+	ctx = context.WithValue(ctx, corev2.NamespaceKey, "")
+	policy := &corev2.PasswordPolicy{}
+	if err := a.resources.GetResource(ctx, corev2.DefaultPasswordPolicyName, policy); err != nil {
+		if _, ok := err.(*store.ErrNotFound); ok {
+			return nil
+		}
+		return NewError(InternalErr, err)
+	}
+
+	if !policy.HasRules() {
+		return nil
+	}
+	if user.Password == "" {
+		if !requireCleartext {
+			return nil
+		}
+		return NewError(
+			InvalidArgument,
+			errors.New("the password policy requires the cleartext password"),
+		)
+	}
+	if err := policy.ValidatePassword(user.Password); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	return nil
+}
+
 func (a UserController) findUser(ctx context.Context, name string) (*corev2.User, error) {
 	result, serr := a.store.GetUser(ctx, name)
 	if serr != nil {
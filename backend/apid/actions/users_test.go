@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/testing/testutil"
@@ -13,6 +14,17 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+func mockPasswordPolicy(s *mockstore.MockStore, policy *corev2.PasswordPolicy) {
+	call := s.On("GetResource", mock.Anything, corev2.DefaultPasswordPolicyName, mock.AnythingOfType("*v2.PasswordPolicy"))
+	if policy == nil {
+		call.Return(&store.ErrNotFound{})
+		return
+	}
+	call.Run(func(args mock.Arguments) {
+		*args[2].(*corev2.PasswordPolicy) = *policy
+	}).Return(nil)
+}
+
 func TestNewUserController(t *testing.T) {
 	assert := assert.New(t)
 
@@ -84,6 +96,24 @@ func TestUserList(t *testing.T) {
 	}
 }
 
+func TestUserListWithPasswordHashes(t *testing.T) {
+	s := &mockstore.MockStore{}
+	actions := NewUserController(s)
+
+	user := types.FixtureUser("user1")
+	user.PasswordHash = user.Password
+	pred := &store.SelectionPredicate{}
+	s.On("GetAllUsers", pred).Return([]*types.User{user}, nil)
+
+	results, err := actions.ListWithPasswordHashes(context.Background(), pred)
+	assert.NoError(t, err)
+	if assert.Len(t, results, 1) {
+		result := results[0].(*types.User)
+		assert.Empty(t, result.Password)
+		assert.NotEmpty(t, result.PasswordHash)
+	}
+}
+
 func TestUserGet(t *testing.T) {
 	ctxWithAuthorizedViewer := testutil.NewContext(
 		testutil.ContextWithNamespace("default"),
@@ -170,6 +200,7 @@ func TestUserCreateOrReplace(t *testing.T) {
 		fetchResult     *types.User
 		fetchErr        error
 		createErr       error
+		policy          *corev2.PasswordPolicy
 		expectedErr     bool
 		expectedErrCode ErrCode
 	}{
@@ -200,6 +231,23 @@ func TestUserCreateOrReplace(t *testing.T) {
 			expectedErr:     true,
 			expectedErrCode: InvalidArgument,
 		},
+		{
+			name:            "Password Policy Violation",
+			ctx:             defaultCtx,
+			argument:        types.FixtureUser("user1"),
+			policy:          corev2.FixturePasswordPolicy(),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name: "Password Hash With Password Policy",
+			ctx:  defaultCtx,
+			argument: &types.User{
+				Username:     "user1",
+				PasswordHash: "$2a$10$iyEH7.Dd7yAk5e4mUpHRkuP2Cn7IwzMHSxj6ciJvRsuupqTDgVYnO",
+			},
+			policy: corev2.FixturePasswordPolicy(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -214,6 +262,7 @@ func TestUserCreateOrReplace(t *testing.T) {
 			store.
 				On("GetUser", mock.Anything, mock.Anything).
 				Return(tc.fetchResult, tc.fetchErr)
+			mockPasswordPolicy(store, tc.policy)
 
 			// Exec Query
 			err := actions.CreateOrReplace(tc.ctx, tc.argument)
@@ -247,6 +296,7 @@ func TestUserCreate(t *testing.T) {
 		fetchResult     *types.User
 		fetchErr        error
 		createErr       error
+		policy          *corev2.PasswordPolicy
 		expectedErr     bool
 		expectedErrCode ErrCode
 	}{
@@ -279,6 +329,34 @@ func TestUserCreate(t *testing.T) {
 			expectedErr:     true,
 			expectedErrCode: InvalidArgument,
 		},
+		{
+			name:            "Password Policy Violation",
+			ctx:             defaultCtx,
+			argument:        types.FixtureUser("user1"),
+			policy:          corev2.FixturePasswordPolicy(),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name: "Password Policy Requires Cleartext",
+			ctx:  defaultCtx,
+			argument: &types.User{
+				Username:     "user1",
+				PasswordHash: "$2a$10$iyEH7.Dd7yAk5e4mUpHRkuP2Cn7IwzMHSxj6ciJvRsuupqTDgVYnO",
+			},
+			policy:          corev2.FixturePasswordPolicy(),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name: "Password Policy Compliance",
+			ctx:  defaultCtx,
+			argument: &types.User{
+				Username: "user1",
+				Password: "Sup3rSecretPassw0rd",
+			},
+			policy: corev2.FixturePasswordPolicy(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -293,6 +371,7 @@ func TestUserCreate(t *testing.T) {
 			store.
 				On("GetUser", mock.Anything, mock.Anything).
 				Return(tc.fetchResult, tc.fetchErr)
+			mockPasswordPolicy(store, tc.policy)
 
 			// Exec Query
 			err := actions.Create(tc.ctx, tc.argument)
@@ -312,6 +391,47 @@ func TestUserCreate(t *testing.T) {
 	}
 }
 
+func TestUserUpdatePassword(t *testing.T) {
+	ctx := context.Background()
+
+	s := &mockstore.MockStore{}
+	mockPasswordPolicy(s, corev2.FixturePasswordPolicy())
+	s.On("UpdateUser", mock.Anything).Return(nil)
+	actions := NewUserController(s)
+
+	// The password must comply with the password policy
+	user := corev2.FixtureUser("foo")
+	err := actions.UpdatePassword(ctx, user)
+	if assert.Error(t, err) {
+		assert.Equal(t, InvalidArgument, err.(Error).Code)
+	}
+	s.AssertNotCalled(t, "UpdateUser", mock.Anything)
+
+	// The expiry of the password is restarted
+	user.Password = "Sup3rSecretPassw0rd"
+	assert.NoError(t, actions.UpdatePassword(ctx, user))
+	assert.NotZero(t, user.PasswordChangedAt)
+	assert.NotEmpty(t, user.PasswordHash)
+}
+
+func TestUserUpdatePasswordHash(t *testing.T) {
+	ctx := context.Background()
+
+	s := &mockstore.MockStore{}
+	mockPasswordPolicy(s, nil)
+	s.On("UpdateUser", mock.Anything).Return(nil)
+	actions := NewUserController(s)
+
+	// The expiry of the password is not restarted by its hash, which was not
+	// validated against the password policy
+	user := corev2.FixtureUser("foo")
+	user.Password = ""
+	user.PasswordHash = "$2a$10$iA.sBNfM.Ck6J4TmY1Ab6eE3uuJIsdrNq6MLQ7vT6BalKEDPqTbkq"
+	user.PasswordChangedAt = 42
+	assert.NoError(t, actions.UpdatePassword(ctx, user))
+	assert.Equal(t, int64(42), user.PasswordChangedAt)
+}
+
 func TestUserDisable(t *testing.T) {
 	defaultCtx := testutil.NewContext(
 		testutil.ContextWithNamespace("default"),
@@ -324,6 +444,7 @@ func TestUserDisable(t *testing.T) {
 		fetchResult     *types.User
 		fetchErr        error
 		updateErr       error
+		sessionsErr     error
 		expectedErr     bool
 		expectedErrCode ErrCode
 	}{
@@ -334,6 +455,15 @@ func TestUserDisable(t *testing.T) {
 			fetchResult: types.FixtureUser("user1"),
 			expectedErr: false,
 		},
+		{
+			name:            "store Err on Sessions",
+			ctx:             defaultCtx,
+			argument:        "user1",
+			fetchResult:     types.FixtureUser("user1"),
+			sessionsErr:     errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
 		{
 			name:            "Does Not Exist",
 			ctx:             defaultCtx,
@@ -367,6 +497,9 @@ func TestUserDisable(t *testing.T) {
 			store.
 				On("GetUser", mock.Anything, mock.Anything).
 				Return(tc.fetchResult, tc.fetchErr)
+			store.
+				On("ListResources", mock.Anything, "sessions", mock.Anything, mock.Anything).
+				Return(tc.sessionsErr)
 
 			// Exec Query
 			err := actions.Disable(tc.ctx, tc.argument)
@@ -19,9 +19,13 @@ import (
 	"github.com/sensu/sensu-go/backend/apid/graphql"
 	"github.com/sensu/sensu-go/backend/apid/middlewares"
 	"github.com/sensu/sensu-go/backend/apid/routers"
+	"github.com/sensu/sensu-go/backend/audit"
 	"github.com/sensu/sensu-go/backend/authentication"
+	"github.com/sensu/sensu-go/backend/authentication/session"
 	"github.com/sensu/sensu-go/backend/authorization/rbac"
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/quota"
+	"github.com/sensu/sensu-go/backend/ringv2"
 	"github.com/sensu/sensu-go/backend/store"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
 	"github.com/sensu/sensu-go/types"
@@ -72,6 +76,12 @@ type Config struct {
 	ClusterVersion      string
 	GraphQLService      *graphql.Service
 	HealthRouter        *routers.HealthRouter
+	RingPool            *ringv2.RingPool
+	Quotas              *quota.Enforcer
+	Denylist            *session.Denylist
+	AuditLogger         audit.Logger
+	AuditIncludeBodies  bool
+	AuditIncludeDiff    bool
 }
 
 // New creates a new APId.
@@ -144,6 +154,7 @@ func NewRouter() *mux.Router {
 func AuthenticationSubrouter(router *mux.Router, cfg Config) *mux.Router {
 	subrouter := NewSubrouter(
 		router.NewRoute(),
+		middlewares.Tracing{},
 		middlewares.SimpleLogger{},
 		middlewares.RefreshToken{},
 		middlewares.LimitRequest{Limit: cfg.RequestLimit},
@@ -161,33 +172,42 @@ func AuthenticationSubrouter(router *mux.Router, cfg Config) *mux.Router {
 func CoreSubrouter(router *mux.Router, cfg Config) *mux.Router {
 	subrouter := NewSubrouter(
 		router.PathPrefix("/api/{group:core}/{version:v2}/"),
+		middlewares.Tracing{},
 		middlewares.Namespace{},
-		middlewares.Authentication{Store: cfg.Store},
+		middlewares.Authentication{Store: cfg.Store, Authorizer: &rbac.Authorizer{Store: cfg.Store}, Denylist: cfg.Denylist},
 		middlewares.SimpleLogger{},
 		middlewares.AuthorizationAttributes{},
-		middlewares.Authorization{Authorizer: &rbac.Authorizer{Store: cfg.Store}},
+		auditMiddleware(router, cfg),
+		middlewares.Authorization{Authorizer: &rbac.Authorizer{Store: cfg.Store}, Store: cfg.Store},
+		middlewares.ResourceQuota{Enforcer: cfg.Quotas, Store: cfg.Store},
 		middlewares.LimitRequest{Limit: cfg.RequestLimit},
 		middlewares.Pagination{},
 	)
 	mountRouters(
 		subrouter,
+		routers.NewAccessReviewRouter(cfg.Store),
+		routers.NewAggregatesRouter(cfg.Store),
 		routers.NewAssetRouter(cfg.Store),
 		routers.NewAPIKeysRouter(cfg.Store),
-		routers.NewChecksRouter(cfg.Store, cfg.QueueGetter),
+		routers.NewChecksRouter(cfg.Store, cfg.QueueGetter, cfg.RingPool, &rbac.Authorizer{Store: cfg.Store}),
 		routers.NewClusterRolesRouter(cfg.Store),
 		routers.NewClusterRoleBindingsRouter(cfg.Store),
 		routers.NewClusterRouter(actions.NewClusterController(cfg.Cluster, cfg.Store)),
 		routers.NewEventFiltersRouter(cfg.Store),
 		routers.NewHandlersRouter(cfg.Store),
 		routers.NewHooksRouter(cfg.Store),
+		routers.NewLifecyclePoliciesRouter(cfg.Store, cfg.EventStore),
 		routers.NewMutatorsRouter(cfg.Store),
 		routers.NewNamespacesRouter(cfg.Store, cfg.Store, &rbac.Authorizer{Store: cfg.Store}, cfg.Storev2),
+		routers.NewPasswordPoliciesRouter(cfg.Store),
 		routers.NewPipelinesRouter(cfg.Store),
+		routers.NewResourceQuotasRouter(cfg.Store, cfg.Quotas),
 		routers.NewRolesRouter(cfg.Store),
 		routers.NewRoleBindingsRouter(cfg.Store),
+		routers.NewSessionsRouter(cfg.Store),
 		routers.NewSilencedRouter(cfg.Store),
 		routers.NewTessenRouter(actions.NewTessenController(cfg.Store, cfg.Bus)),
-		routers.NewUsersRouter(cfg.Store),
+		routers.NewUsersRouter(cfg.Store, &rbac.Authorizer{Store: cfg.Store}),
 	)
 
 	return subrouter
@@ -198,28 +218,46 @@ func CoreSubrouter(router *mux.Router, cfg Config) *mux.Router {
 func EntityLimitedCoreSubrouter(router *mux.Router, cfg Config) *mux.Router {
 	subrouter := NewSubrouter(
 		router.PathPrefix("/api/{group:core}/{version:v2}/"),
+		middlewares.Tracing{},
 		middlewares.Namespace{},
-		middlewares.Authentication{Store: cfg.Store},
+		middlewares.Authentication{Store: cfg.Store, Authorizer: &rbac.Authorizer{Store: cfg.Store}, Denylist: cfg.Denylist},
 		middlewares.SimpleLogger{},
 		middlewares.AuthorizationAttributes{},
-		middlewares.Authorization{Authorizer: &rbac.Authorizer{Store: cfg.Store}},
+		auditMiddleware(router, cfg),
+		middlewares.Authorization{Authorizer: &rbac.Authorizer{Store: cfg.Store}, Store: cfg.Store},
+		middlewares.ResourceQuota{Enforcer: cfg.Quotas, Store: cfg.Store},
 		middlewares.LimitRequest{Limit: cfg.RequestLimit},
 		middlewares.Pagination{},
 	)
 	mountRouters(
 		subrouter,
 		routers.NewEntitiesRouter(cfg.Store, cfg.Storev2, cfg.EventStore),
-		routers.NewEventsRouter(cfg.EventStore, cfg.Bus),
+		// End event streams cleanly before the server hangs up on the client
+		routers.NewEventsRouter(cfg.EventStore, cfg.Bus, &rbac.Authorizer{Store: cfg.Store}, cfg.WriteTimeout-(50*time.Millisecond)),
 	)
 
 	return subrouter
 }
 
+// auditMiddleware returns the middleware recording the mutating requests of
+// the REST API in the audit log.
+func auditMiddleware(router *mux.Router, cfg Config) middlewares.Audit {
+	mware := middlewares.Audit{
+		Logger:        cfg.AuditLogger,
+		IncludeBodies: cfg.AuditIncludeBodies,
+	}
+	if cfg.AuditIncludeDiff {
+		mware.Router = router
+	}
+	return mware
+}
+
 // GraphQLSubrouter initializes a subrouter that handles all requests for
 // GraphQL
 func GraphQLSubrouter(router *mux.Router, cfg Config) *mux.Router {
 	subrouter := NewSubrouter(
 		router.NewRoute(),
+		middlewares.Tracing{},
 		middlewares.LimitRequest{Limit: cfg.RequestLimit},
 		// We permit requests that do not include an access token or API key,
 		// this allows unauthenticated clients to run introspecton queries or
@@ -228,8 +266,13 @@ func GraphQLSubrouter(router *mux.Router, cfg Config) *mux.Router {
 		//
 		// https://github.com/graphql/graphiql
 		// https://graphql.org/learn/introspection/
-		middlewares.Authentication{IgnoreUnauthorized: true, Store: cfg.Store},
+		middlewares.Authentication{IgnoreUnauthorized: true, Store: cfg.Store, Authorizer: &rbac.Authorizer{Store: cfg.Store}, Denylist: cfg.Denylist},
 		middlewares.SimpleLogger{},
+		middlewares.Audit{
+			Logger:        cfg.AuditLogger,
+			IncludeBodies: cfg.AuditIncludeBodies,
+			GraphQL:       true,
+		},
 	)
 
 	// The write timeout hangs up the request making it more difficult for
@@ -243,8 +286,9 @@ func GraphQLSubrouter(router *mux.Router, cfg Config) *mux.Router {
 	mountRouters(
 		subrouter,
 		&routers.GraphQLRouter{
-			Service: cfg.GraphQLService,
-			Timeout: timeout,
+			Service:  cfg.GraphQLService,
+			Timeout:  timeout,
+			Denylist: cfg.Denylist,
 		},
 	)
 
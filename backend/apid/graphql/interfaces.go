@@ -6,7 +6,9 @@ import (
 	dto "github.com/prometheus/client_model/go"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/api"
+	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
+	storev2 "github.com/sensu/sensu-go/backend/store/v2"
 )
 
 type AssetClient interface {
@@ -141,3 +143,12 @@ type VersionController interface {
 type MetricGatherer interface {
 	Gather() ([]*dto.MetricFamily, error)
 }
+
+type MessageBus interface {
+	Subscribe(topic string, consumer string, subscriber messaging.Subscriber) (messaging.Subscription, error)
+}
+
+type ResourceWatcher interface {
+	Get(req storev2.ResourceRequest) (storev2.Wrapper, error)
+	Watch(ctx context.Context, req storev2.ResourceRequest) <-chan []storev2.WatchEvent
+}
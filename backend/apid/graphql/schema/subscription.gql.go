@@ -0,0 +1,196 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	graphql1 "github.com/graphql-go/graphql"
+	mapstructure "github.com/mitchellh/mapstructure"
+	graphql "github.com/sensu/sensu-go/graphql"
+)
+
+// SubscriptionEventUpdatedFieldResolverArgs contains arguments provided to eventUpdated when selected
+type SubscriptionEventUpdatedFieldResolverArgs struct {
+	Namespace string // Namespace of the events, the events of all namespaces are emitted if empty.
+}
+
+// SubscriptionEventUpdatedFieldResolverParams contains contextual info to resolve eventUpdated field
+type SubscriptionEventUpdatedFieldResolverParams struct {
+	graphql.ResolveParams
+	Args SubscriptionEventUpdatedFieldResolverArgs
+}
+
+// SubscriptionEntityUpdatedFieldResolverArgs contains arguments provided to entityUpdated when selected
+type SubscriptionEntityUpdatedFieldResolverArgs struct {
+	Namespace string // Namespace of the entities, the entities of all namespaces are emitted if empty.
+}
+
+// SubscriptionEntityUpdatedFieldResolverParams contains contextual info to resolve entityUpdated field
+type SubscriptionEntityUpdatedFieldResolverParams struct {
+	graphql.ResolveParams
+	Args SubscriptionEntityUpdatedFieldResolverArgs
+}
+
+// SubscriptionKeepaliveTransitionedFieldResolverArgs contains arguments provided to keepaliveTransitioned when selected
+type SubscriptionKeepaliveTransitionedFieldResolverArgs struct {
+	Namespace string // Namespace of the events, the events of all namespaces are emitted if empty.
+}
+
+// SubscriptionKeepaliveTransitionedFieldResolverParams contains contextual info to resolve keepaliveTransitioned field
+type SubscriptionKeepaliveTransitionedFieldResolverParams struct {
+	graphql.ResolveParams
+	Args SubscriptionKeepaliveTransitionedFieldResolverArgs
+}
+
+//
+// SubscriptionFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'Subscription' type.
+type SubscriptionFieldResolvers interface {
+	// EventUpdated implements response to request for 'eventUpdated' field.
+	EventUpdated(p SubscriptionEventUpdatedFieldResolverParams) (interface{}, error)
+
+	// EntityUpdated implements response to request for 'entityUpdated' field.
+	EntityUpdated(p SubscriptionEntityUpdatedFieldResolverParams) (interface{}, error)
+
+	// KeepaliveTransitioned implements response to request for 'keepaliveTransitioned' field.
+	KeepaliveTransitioned(p SubscriptionKeepaliveTransitionedFieldResolverParams) (interface{}, error)
+}
+
+// SubscriptionAliases implements all methods on SubscriptionFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+type SubscriptionAliases struct{}
+
+// EventUpdated implements response to request for 'eventUpdated' field.
+func (_ SubscriptionAliases) EventUpdated(p SubscriptionEventUpdatedFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// EntityUpdated implements response to request for 'entityUpdated' field.
+func (_ SubscriptionAliases) EntityUpdated(p SubscriptionEntityUpdatedFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// KeepaliveTransitioned implements response to request for 'keepaliveTransitioned' field.
+func (_ SubscriptionAliases) KeepaliveTransitioned(p SubscriptionKeepaliveTransitionedFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+/*
+SubscriptionType The root type for implementing GraphQL subscriptions. Subscriptions are served
+over websocket, using the graphql-ws protocol.
+*/
+var SubscriptionType = graphql.NewType("Subscription", graphql.ObjectKind)
+
+// RegisterSubscription registers Subscription object type with given service.
+func RegisterSubscription(svc *graphql.Service, impl SubscriptionFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeSubscriptionDesc, impl)
+}
+func _ObjTypeSubscriptionEventUpdatedHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(interface {
+		EventUpdated(p SubscriptionEventUpdatedFieldResolverParams) (interface{}, error)
+	})
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := SubscriptionEventUpdatedFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.EventUpdated(frp)
+	}
+}
+
+func _ObjTypeSubscriptionEntityUpdatedHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(interface {
+		EntityUpdated(p SubscriptionEntityUpdatedFieldResolverParams) (interface{}, error)
+	})
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := SubscriptionEntityUpdatedFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.EntityUpdated(frp)
+	}
+}
+
+func _ObjTypeSubscriptionKeepaliveTransitionedHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(interface {
+		KeepaliveTransitioned(p SubscriptionKeepaliveTransitionedFieldResolverParams) (interface{}, error)
+	})
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := SubscriptionKeepaliveTransitionedFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.KeepaliveTransitioned(frp)
+	}
+}
+
+func _ObjectTypeSubscriptionConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "The root type for implementing GraphQL subscriptions. Subscriptions are served\nover websocket, using the graphql-ws protocol.",
+		Fields: graphql1.Fields{
+			"entityUpdated": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"namespace": &graphql1.ArgumentConfig{
+					DefaultValue: "",
+					Description:  "Namespace of the entities, the entities of all namespaces are emitted if empty.",
+					Type:         graphql1.String,
+				}},
+				DeprecationReason: "",
+				Description:       "Emits the entities as their state changes.",
+				Name:              "entityUpdated",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Entity")),
+			},
+			"eventUpdated": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"namespace": &graphql1.ArgumentConfig{
+					DefaultValue: "",
+					Description:  "Namespace of the events, the events of all namespaces are emitted if empty.",
+					Type:         graphql1.String,
+				}},
+				DeprecationReason: "",
+				Description:       "Emits the events as they are processed by the backend serving the subscription. The events processed by the other backends of a cluster are not emitted.",
+				Name:              "eventUpdated",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Event")),
+			},
+			"keepaliveTransitioned": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"namespace": &graphql1.ArgumentConfig{
+					DefaultValue: "",
+					Description:  "Namespace of the events, the events of all namespaces are emitted if empty.",
+					Type:         graphql1.String,
+				}},
+				DeprecationReason: "",
+				Description:       "Emits the keepalive events whose status changed, when an entity stops or\nresumes sending keepalives.",
+				Name:              "keepaliveTransitioned",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Event")),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see SubscriptionFieldResolvers.")
+		},
+		Name: "Subscription",
+	}
+}
+
+// describe Subscription's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeSubscriptionDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeSubscriptionConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"entityUpdated":         _ObjTypeSubscriptionEntityUpdatedHandler,
+		"eventUpdated":          _ObjTypeSubscriptionEventUpdatedHandler,
+		"keepaliveTransitioned": _ObjTypeSubscriptionKeepaliveTransitionedHandler,
+	},
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/sensu/sensu-go/backend/apid/graphql/relay"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/cli/client"
 	"github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/graphql/tracing"
@@ -40,6 +42,19 @@ type ServiceConfig struct {
 	GenericClient      GenericClient
 	MetricGatherer     MetricGatherer
 	ClusterMetricStore ClusterMetricStore
+
+	// Bus and ResourceWatcher feed the subscriptions, whose payloads are
+	// filtered with Authorizer
+	Bus             MessageBus
+	ResourceWatcher ResourceWatcher
+	Authorizer      authorization.Authorizer
+
+	// QueryLimits limits the depth, the complexity and the rate of the
+	// queries. The rate of the queries is limited per user.
+	QueryLimits graphql.QueryLimits
+
+	// PersistedQueries are the only queries executed, if any.
+	PersistedQueries graphql.PersistedQueries
 }
 
 // Service describes the Sensu GraphQL service capable of handling queries.
@@ -195,6 +210,9 @@ func NewService(cfg ServiceConfig) (*Service, error) {
 	schema.RegisterUpdateCheckPayload(svc, &checkMutationPayload{})
 	schema.RegisterPutWrappedPayload(svc, &schema.PutWrappedPayloadAliases{})
 
+	// Register subscriptions
+	schema.RegisterSubscription(svc, &subscriptionImpl{svc: cfg})
+
 	// Errors
 	schema.RegisterStandardError(svc, stdErrImpl{})
 	schema.RegisterError(svc, &errImpl{})
@@ -214,10 +232,29 @@ func NewService(cfg ServiceConfig) (*Service, error) {
 	}
 	svc.RegisterMiddleware(tracer)
 
+	// Configure the limits of the queries
+	if cfg.QueryLimits.Enabled() {
+		limits := cfg.QueryLimits
+		if limits.KeyFunc == nil {
+			limits.KeyFunc = queryLimitsKey
+		}
+		svc.RegisterMiddleware(graphql.NewQueryLimiter(limits))
+	}
+	svc.PersistedQueries = cfg.PersistedQueries
+
 	err := svc.Regenerate()
 	return &wrapper, err
 }
 
+// queryLimitsKey returns the user of the query, whose queries are rate limited
+// together.
+func queryLimitsKey(ctx context.Context) string {
+	if claims := jwt.GetClaimsFromContext(ctx); claims != nil {
+		return claims.Subject
+	}
+	return ""
+}
+
 // Do executes given query string and variables
 func (svc *Service) Do(ctx context.Context, p graphql.QueryParams) *graphql.Result {
 	// Instantiate loaders and lift them into the context
@@ -1,8 +1,10 @@
 package graphql
 
 import (
+	"context"
 	"testing"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,3 +36,28 @@ func TestInitHooks(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, flag)
 }
+
+func TestServiceQueryLimits(t *testing.T) {
+	svc, err := NewService(ServiceConfig{
+		QueryLimits: graphql.QueryLimits{MaxDepth: 2},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	result := svc.Do(ctx, graphql.QueryParams{Query: `{ viewer { user { username } } }`})
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, graphql.ErrCodeQueryTooDeep, result.Errors[0].Extensions["code"])
+
+	// The limits are enforced when the validation is skipped
+	result = svc.Do(ctx, graphql.QueryParams{Query: `{ viewer { user { username } } }`, SkipValidation: true})
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, graphql.ErrCodeQueryTooDeep, result.Errors[0].Extensions["code"])
+}
+
+func TestQueryLimitsKey(t *testing.T) {
+	assert.Equal(t, "", queryLimitsKey(context.Background()))
+
+	claims := corev2.FixtureClaims("alice", nil)
+	ctx := context.WithValue(context.Background(), corev2.ClaimsKey, claims)
+	assert.Equal(t, "alice", queryLimitsKey(ctx))
+}
@@ -0,0 +1,490 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	"github.com/sensu/sensu-go/backend/tracing"
+	"github.com/sensu/sensu-go/graphql"
+)
+
+const (
+	// subscriptionRootKey is the key of the subscription state in the root
+	// object of subscription operations.
+	subscriptionRootKey = "subscription"
+
+	// subscriptionBufferSize is the number of messages buffered for a
+	// subscription before messages are dropped.
+	subscriptionBufferSize = 100
+)
+
+var (
+	errSubscriptionTransport  = errors.New("subscriptions are only supported over websocket")
+	errSubscriptionRootFields = errors.New("subscriptions must select a single root field")
+	errSubscriptionSource     = errors.New("subscription operation expected")
+)
+
+// subscriptionRoot is the state of a subscription operation, shared with the
+// resolvers of the subscription type through the root object. The operation
+// is first executed to start the source stream of the selected field, then
+// once for every payload of the stream.
+type subscriptionRoot struct {
+	payload interface{}
+	source  <-chan interface{}
+	err     error
+	fields  int
+}
+
+func subscriptionRootFromSource(source interface{}) *subscriptionRoot {
+	obj, ok := source.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	root, _ := obj[subscriptionRootKey].(*subscriptionRoot)
+	return root
+}
+
+// sourceStreamFn starts the source stream of a subscription field. The stream
+// is closed when the context is done.
+type sourceStreamFn func(ctx context.Context) (<-chan interface{}, error)
+
+var _ schema.SubscriptionFieldResolvers = (*subscriptionImpl)(nil)
+
+//
+// Implement SubscriptionFieldResolvers
+//
+
+type subscriptionImpl struct {
+	svc ServiceConfig
+}
+
+// EventUpdated implements response to request for 'eventUpdated' field.
+func (r *subscriptionImpl) EventUpdated(p schema.SubscriptionEventUpdatedFieldResolverParams) (interface{}, error) {
+	return r.resolve(p.ResolveParams, func(ctx context.Context) (<-chan interface{}, error) {
+		return r.events(ctx, p.Args.Namespace, nil)
+	})
+}
+
+// EntityUpdated implements response to request for 'entityUpdated' field.
+func (r *subscriptionImpl) EntityUpdated(p schema.SubscriptionEntityUpdatedFieldResolverParams) (interface{}, error) {
+	return r.resolve(p.ResolveParams, func(ctx context.Context) (<-chan interface{}, error) {
+		return r.entities(ctx, p.Args.Namespace)
+	})
+}
+
+// KeepaliveTransitioned implements response to request for
+// 'keepaliveTransitioned' field.
+func (r *subscriptionImpl) KeepaliveTransitioned(p schema.SubscriptionKeepaliveTransitionedFieldResolverParams) (interface{}, error) {
+	return r.resolve(p.ResolveParams, func(ctx context.Context) (<-chan interface{}, error) {
+		return r.events(ctx, p.Args.Namespace, isKeepaliveTransition)
+	})
+}
+
+// resolve starts the source stream of the field when the operation is
+// started, and returns the payload of the stream otherwise.
+func (r *subscriptionImpl) resolve(p graphql.ResolveParams, start sourceStreamFn) (interface{}, error) {
+	root := subscriptionRootFromSource(p.Source)
+	if root == nil {
+		return nil, errSubscriptionTransport
+	}
+	if root.payload != nil {
+		return root.payload, nil
+	}
+	root.fields++
+	if root.fields > 1 {
+		root.err = errSubscriptionRootFields
+		return nil, root.err
+	}
+	root.source, root.err = start(p.Context)
+	return nil, root.err
+}
+
+// events streams the events processed by the backend that are in the
+// namespace, when given, and match the filter. Events are only sent when the
+// client is allowed to list the events of their namespace.
+func (r *subscriptionImpl) events(ctx context.Context, namespace string, filter func(*corev2.Event) bool) (<-chan interface{}, error) {
+	if r.svc.Bus == nil {
+		return nil, errors.New("event subscriptions are not available")
+	}
+	auth, err := newSubscriptionAuth(ctx, r.svc.Authorizer, corev2.EventsResource)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher := newBusWatcher()
+	consumer := fmt.Sprintf("graphql-subscription-%s", uuid.New().String())
+	subscription, err := r.svc.Bus.Subscribe(messaging.TopicEvent, consumer, watcher)
+	if err != nil {
+		return nil, err
+	}
+	go watcher.relay()
+
+	out := make(chan interface{})
+	go func() {
+		defer close(out)
+		defer func() {
+			if err := subscription.Cancel(); err != nil {
+				logger.WithError(err).Error("could not cancel event subscription")
+			}
+			// The bus tolerates sends to closed receivers, closing it stops the
+			// relay without blocking a send that raced the cancellation
+			close(watcher.receiver)
+		}()
+		for {
+			var msg interface{}
+			select {
+			case <-ctx.Done():
+				return
+			case msg = <-watcher.buffer:
+			}
+			event, ok := msg.(*corev2.Event)
+			if !ok || !event.HasCheck() || event.Entity == nil {
+				continue
+			}
+			if namespace != "" && event.Namespace != namespace {
+				continue
+			}
+			if filter != nil && !filter(event) {
+				continue
+			}
+			if !auth.Allows(ctx, event.Namespace, event.Labels) {
+				continue
+			}
+			select {
+			case out <- tracing.StrippedEvent(event):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// entities streams the entities whose state is created or updated in the
+// namespace, when given. Entities are only sent when the client is allowed to
+// list the entities of their namespace.
+func (r *subscriptionImpl) entities(ctx context.Context, namespace string) (<-chan interface{}, error) {
+	if r.svc.ResourceWatcher == nil {
+		return nil, errors.New("entity subscriptions are not available")
+	}
+	auth, err := newSubscriptionAuth(ctx, r.svc.Authorizer, corev2.EntitiesResource)
+	if err != nil {
+		return nil, err
+	}
+
+	stateReq := storev2.NewResourceRequest(ctx, namespace, "", (&corev3.EntityState{}).StoreName())
+	states := r.svc.ResourceWatcher.Watch(ctx, stateReq)
+	configs := newEntityConfigCache(ctx, r.svc.ResourceWatcher, namespace)
+
+	out := make(chan interface{})
+	go func() {
+		defer close(out)
+		for {
+			var events []storev2.WatchEvent
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case events, ok = <-states:
+				if !ok {
+					return
+				}
+			case events, ok = <-configs.watch:
+				if !ok {
+					return
+				}
+				configs.Update(events)
+				continue
+			}
+			for _, event := range events {
+				if event.Type != storev2.WatchCreate && event.Type != storev2.WatchUpdate {
+					continue
+				}
+				if event.Value == nil {
+					continue
+				}
+				var state corev3.EntityState
+				if err := event.Value.UnwrapInto(&state); err != nil {
+					logger.WithError(err).Warn("could not unwrap subscribed entity state")
+					continue
+				}
+				meta := state.GetMetadata()
+				if meta == nil {
+					continue
+				}
+				if !auth.Allows(ctx, meta.Namespace, nil) {
+					continue
+				}
+				config, err := configs.Get(ctx, meta.Namespace, meta.Name)
+				if err != nil {
+					logger.WithError(err).Warn("could not get subscribed entity config")
+					continue
+				}
+				if config == nil || !auth.Allows(ctx, meta.Namespace, config.Metadata.Labels) {
+					continue
+				}
+				entity, err := corev3.V3EntityToV2(config, &state)
+				if err != nil {
+					logger.WithError(err).Warn("could not convert subscribed entity")
+					continue
+				}
+				select {
+				case out <- entity:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// subscriptionAuth authorizes the client of a subscription to list the
+// resources of each namespace, once per namespace, as the events watch of the
+// REST API does.
+type subscriptionAuth struct {
+	auth  authorization.Authorizer
+	attrs authorization.Attributes
+
+	// namespaces caches the authorization of each namespace, it is only
+	// accessed by the goroutine of the source stream
+	namespaces map[string]namespaceAuth
+}
+
+// namespaceAuth is the authorization to list the resources of a namespace.
+// When the client is only allowed to list the resources matching the label
+// selectors of its rules, selectors holds them.
+type namespaceAuth struct {
+	authorized bool
+	selectors  authorization.LabelSelectors
+}
+
+func newSubscriptionAuth(ctx context.Context, auth authorization.Authorizer, resource string) (*subscriptionAuth, error) {
+	if auth == nil {
+		return nil, errors.New("subscriptions are not available")
+	}
+	claims := jwt.GetClaimsFromContext(ctx)
+	if claims == nil {
+		return nil, authorization.ErrNoClaims
+	}
+	return &subscriptionAuth{
+		auth: auth,
+		attrs: authorization.Attributes{
+			APIGroup:   "core",
+			APIVersion: "v2",
+			Resource:   resource,
+			Verb:       "list",
+			User: corev2.User{
+				Username: claims.Subject,
+				Groups:   claims.Groups,
+			},
+		},
+		namespaces: map[string]namespaceAuth{},
+	}, nil
+}
+
+// Allows returns whether the client is allowed to list the resources of the
+// namespace. When the client is only allowed to list the resources matching
+// the label selectors of its rules, the labels must match one of them; nil
+// labels skip this check, for resources whose labels are not known yet.
+func (a *subscriptionAuth) Allows(ctx context.Context, namespace string, labels map[string]string) bool {
+	auth, ok := a.namespaces[namespace]
+	if !ok {
+		attrs := a.attrs
+		attrs.Namespace = namespace
+		authorized, err := a.auth.Authorize(ctx, &attrs)
+		if err != nil {
+			logger.WithError(err).WithField("namespace", namespace).Error("could not authorize subscription")
+			// Do not cache errors, the next payload will be authorized again
+			return false
+		}
+		auth = namespaceAuth{authorized: authorized, selectors: attrs.LabelSelectors}
+		a.namespaces[namespace] = auth
+	}
+	if !auth.authorized {
+		return false
+	}
+	if auth.selectors == nil || labels == nil {
+		return true
+	}
+	return auth.selectors.Matches(labels)
+}
+
+// entityConfigCache caches the entity configs of a subscription, so that they
+// are not read from the store for every entity state update. The configs are
+// read when first needed, then kept up to date with a watch.
+type entityConfigCache struct {
+	store   ResourceWatcher
+	watch   <-chan []storev2.WatchEvent
+	configs map[string]*corev3.EntityConfig
+}
+
+func newEntityConfigCache(ctx context.Context, store ResourceWatcher, namespace string) *entityConfigCache {
+	req := storev2.NewResourceRequest(ctx, namespace, "", (&corev3.EntityConfig{}).StoreName())
+	return &entityConfigCache{
+		store:   store,
+		watch:   store.Watch(ctx, req),
+		configs: map[string]*corev3.EntityConfig{},
+	}
+}
+
+// Get returns the config of the entity, or nil if it does not exist.
+func (c *entityConfigCache) Get(ctx context.Context, namespace, name string) (*corev3.EntityConfig, error) {
+	key := path.Join(namespace, name)
+	if config, ok := c.configs[key]; ok {
+		return config, nil
+	}
+	req := storev2.NewResourceRequest(ctx, namespace, name, (&corev3.EntityConfig{}).StoreName())
+	req.UsePostgres = true
+	wrapper, err := c.store.Get(req)
+	if err != nil {
+		var notFound *store.ErrNotFound
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var config corev3.EntityConfig
+	if err := wrapper.UnwrapInto(&config); err != nil {
+		return nil, err
+	}
+	c.configs[key] = &config
+	return &config, nil
+}
+
+// Update applies the watched changes of the entity configs to the cache.
+func (c *entityConfigCache) Update(events []storev2.WatchEvent) {
+	for _, event := range events {
+		if event.Value == nil {
+			continue
+		}
+		var config corev3.EntityConfig
+		if err := event.Value.UnwrapInto(&config); err != nil {
+			logger.WithError(err).Warn("could not unwrap subscribed entity config")
+			continue
+		}
+		meta := config.GetMetadata()
+		if meta == nil {
+			continue
+		}
+		key := path.Join(meta.Namespace, meta.Name)
+		switch event.Type {
+		case storev2.WatchCreate, storev2.WatchUpdate:
+			c.configs[key] = &config
+		case storev2.WatchDelete:
+			delete(c.configs, key)
+		}
+	}
+}
+
+// isKeepaliveTransition returns whether the event is a keepalive event whose
+// status differs from the status of the previous keepalive.
+func isKeepaliveTransition(event *corev2.Event) bool {
+	if event.Check.Name != corev2.KeepaliveCheckName {
+		return false
+	}
+	history := event.Check.History
+	if len(history) < 2 {
+		return false
+	}
+	return history[len(history)-1].Status != history[len(history)-2].Status
+}
+
+// busWatcher receives messages from the message bus. The bus blocks until its
+// subscribers receive a message, so messages are relayed to a buffer and
+// dropped when the subscriber is too slow to keep up.
+type busWatcher struct {
+	receiver chan interface{}
+	buffer   chan interface{}
+	dropped  uint64
+}
+
+func newBusWatcher() *busWatcher {
+	return &busWatcher{
+		receiver: make(chan interface{}),
+		buffer:   make(chan interface{}, subscriptionBufferSize),
+	}
+}
+
+// Receiver returns the channel the message bus sends messages to.
+func (w *busWatcher) Receiver() chan<- interface{} {
+	return w.receiver
+}
+
+// relay buffers the messages until the receiver is closed. It never blocks
+// the message bus.
+func (w *busWatcher) relay() {
+	for msg := range w.receiver {
+		select {
+		case w.buffer <- msg:
+		default:
+			if dropped := atomic.AddUint64(&w.dropped, 1); dropped%subscriptionBufferSize == 1 {
+				logger.WithField("dropped", dropped).Warn("subscription is too slow, dropping messages")
+			}
+		}
+	}
+}
+
+// Subscribe executes the given subscription operation, and returns the
+// results of its execution for every payload of the source stream of the
+// selected field. The results channel is closed when the context is done or
+// the source stream ends.
+func (svc *Service) Subscribe(ctx context.Context, p graphql.QueryParams) (<-chan *graphql.Result, error) {
+	// Start the source stream, which is stopped with the context
+	ctx, cancel := context.WithCancel(ctx)
+	root := &subscriptionRoot{}
+	params := p
+	params.RootObject = map[string]interface{}{subscriptionRootKey: root}
+	result := svc.Do(ctx, params)
+	if root.err != nil {
+		cancel()
+		return nil, root.err
+	}
+	if root.source == nil {
+		cancel()
+		if result.HasErrors() {
+			return nil, result.Errors[0]
+		}
+		return nil, errSubscriptionSource
+	}
+
+	results := make(chan *graphql.Result)
+	go func() {
+		defer close(results)
+		defer cancel()
+		for {
+			var payload interface{}
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok = <-root.source:
+				if !ok {
+					return
+				}
+			}
+			params := p
+			params.RootObject = map[string]interface{}{
+				subscriptionRootKey: &subscriptionRoot{payload: payload},
+			}
+			select {
+			case results <- svc.Do(ctx, params):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return results, nil
+}
@@ -0,0 +1,249 @@
+package graphql
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	"github.com/sensu/sensu-go/backend/store/v2/storetest"
+	"github.com/sensu/sensu-go/backend/store/v2/wrap"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func nextResult(t *testing.T, results <-chan *graphql.Result) *graphql.Result {
+	t.Helper()
+	select {
+	case result, ok := <-results:
+		require.True(t, ok, "results closed")
+		return result
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for result")
+	}
+	return nil
+}
+
+// subscriptionAuthorizer allows listing the resources of its namespaces, only
+// those matching the label selectors if any, and counts the authorizations.
+type subscriptionAuthorizer struct {
+	namespaces map[string]authorization.LabelSelectors
+	calls      int32
+}
+
+func (a *subscriptionAuthorizer) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	atomic.AddInt32(&a.calls, 1)
+	if attrs.Verb != "list" || attrs.User.Username != "alice" {
+		return false, nil
+	}
+	selectors, ok := a.namespaces[attrs.Namespace]
+	attrs.LabelSelectors = selectors
+	return ok, nil
+}
+
+func contextWithClaims() context.Context {
+	return context.WithValue(context.Background(), corev2.ClaimsKey, corev2.FixtureClaims("alice", nil))
+}
+
+func TestSubscribeEventUpdated(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	allowed := corev2.FixtureEvent("allowed", "check-cpu")
+	allowed.Labels = map[string]string{"team": "a"}
+	denied := corev2.FixtureEvent("denied", "check-cpu")
+	other := corev2.FixtureEvent("other", "check-cpu")
+	other.Namespace = "dev"
+	other.Labels = map[string]string{"team": "a"}
+
+	auth := &subscriptionAuthorizer{
+		namespaces: map[string]authorization.LabelSelectors{"default": {{"team": "a"}}},
+	}
+	svc, err := NewService(ServiceConfig{Bus: bus, Authorizer: auth})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(contextWithClaims())
+	defer cancel()
+	results, err := svc.Subscribe(ctx, graphql.QueryParams{
+		Query: `subscription { eventUpdated { entity { name } } }`,
+	})
+	require.NoError(t, err)
+
+	for _, event := range []*corev2.Event{other, denied, allowed, allowed} {
+		require.NoError(t, bus.Publish(messaging.TopicEvent, event))
+	}
+	for i := 0; i < 2; i++ {
+		result := nextResult(t, results)
+		require.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{
+			"eventUpdated": map[string]interface{}{
+				"entity": map[string]interface{}{"name": "allowed"},
+			},
+		}, result.Data)
+	}
+	// Each namespace is authorized once
+	assert.Equal(t, int32(2), atomic.LoadInt32(&auth.calls))
+
+	cancel()
+	for range results {
+	}
+}
+
+func TestSubscribeEntityUpdated(t *testing.T) {
+	stateStore := (&corev3.EntityState{}).StoreName()
+	configStore := (&corev3.EntityConfig{}).StoreName()
+	states := make(chan []storev2.WatchEvent, 1)
+	configs := make(chan []storev2.WatchEvent)
+
+	foo := corev3.FixtureEntityConfig("foo")
+	foo.Metadata.Labels = map[string]string{"team": "a"}
+	fooConfig, err := wrap.Resource(foo)
+	require.NoError(t, err)
+	barConfig, err := wrap.Resource(corev3.FixtureEntityConfig("bar"))
+	require.NoError(t, err)
+
+	watcher := new(storetest.Store)
+	watcher.On("Watch", mock.Anything, mock.MatchedBy(func(req storev2.ResourceRequest) bool {
+		return req.StoreName == stateStore
+	})).Return((<-chan []storev2.WatchEvent)(states))
+	watcher.On("Watch", mock.Anything, mock.MatchedBy(func(req storev2.ResourceRequest) bool {
+		return req.StoreName == configStore
+	})).Return((<-chan []storev2.WatchEvent)(configs))
+	watcher.On("Get", mock.MatchedBy(func(req storev2.ResourceRequest) bool {
+		return req.StoreName == configStore && req.Name == "foo"
+	})).Return(fooConfig, nil)
+	watcher.On("Get", mock.MatchedBy(func(req storev2.ResourceRequest) bool {
+		return req.StoreName == configStore && req.Name == "bar"
+	})).Return(barConfig, nil)
+
+	auth := &subscriptionAuthorizer{
+		namespaces: map[string]authorization.LabelSelectors{"default": {{"team": "a"}}},
+	}
+	svc, err := NewService(ServiceConfig{ResourceWatcher: watcher, Authorizer: auth})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(contextWithClaims())
+	defer cancel()
+	results, err := svc.Subscribe(ctx, graphql.QueryParams{
+		Query: `subscription { entityUpdated { name } }`,
+	})
+	require.NoError(t, err)
+
+	fooState, err := wrap.Resource(corev3.FixtureEntityState("foo"))
+	require.NoError(t, err)
+	barState, err := wrap.Resource(corev3.FixtureEntityState("bar"))
+	require.NoError(t, err)
+	states <- []storev2.WatchEvent{
+		{Type: storev2.WatchDelete, Value: fooState},
+		{Type: storev2.WatchUpdate, Value: barState},
+		{Type: storev2.WatchUpdate, Value: fooState},
+		{Type: storev2.WatchUpdate, Value: fooState},
+	}
+	for i := 0; i < 2; i++ {
+		result := nextResult(t, results)
+		require.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{
+			"entityUpdated": map[string]interface{}{"name": "foo"},
+		}, result.Data)
+	}
+	// The entity configs are cached
+	watcher.AssertNumberOfCalls(t, "Get", 2)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&auth.calls))
+
+	// The results are closed with the source stream
+	close(states)
+	for range results {
+	}
+}
+
+func TestEntityConfigCacheUpdate(t *testing.T) {
+	configs := make(chan []storev2.WatchEvent)
+	watcher := new(storetest.Store)
+	watcher.On("Watch", mock.Anything, mock.Anything).Return((<-chan []storev2.WatchEvent)(configs))
+	cache := newEntityConfigCache(context.Background(), watcher, "default")
+
+	foo := corev3.FixtureEntityConfig("foo")
+	foo.Metadata.Labels = map[string]string{"team": "a"}
+	wrapper, err := wrap.Resource(foo)
+	require.NoError(t, err)
+
+	cache.Update([]storev2.WatchEvent{{Type: storev2.WatchCreate, Value: wrapper}})
+	config, err := cache.Get(context.Background(), "default", "foo")
+	require.NoError(t, err)
+	assert.Equal(t, foo.Metadata.Labels, config.Metadata.Labels)
+
+	cache.Update([]storev2.WatchEvent{{Type: storev2.WatchDelete, Value: wrapper}})
+	watcher.On("Get", mock.Anything).Return((storev2.Wrapper)(nil), &store.ErrNotFound{Key: "foo"})
+	config, err = cache.Get(context.Background(), "default", "foo")
+	require.NoError(t, err)
+	assert.Nil(t, config)
+}
+
+func TestSubscribeErrors(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+
+	svc, err := NewService(ServiceConfig{Bus: bus})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "invalid query", query: `subscription { nope }`},
+		{name: "query operation", query: `query { viewer { user { username } } }`},
+		{name: "multiple fields", query: `subscription { eventUpdated { id } keepaliveTransitioned { id } }`},
+		{name: "source not available", query: `subscription { entityUpdated { id } }`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Subscribe(ctx, graphql.QueryParams{Query: tt.query})
+			assert.Error(t, err)
+		})
+	}
+
+	// Subscriptions are not executed as queries
+	result := svc.Do(ctx, graphql.QueryParams{Query: `subscription { eventUpdated { id } }`})
+	assert.True(t, result.HasErrors())
+}
+
+func TestIsKeepaliveTransition(t *testing.T) {
+	history := func(statuses ...uint32) []corev2.CheckHistory {
+		h := []corev2.CheckHistory{}
+		for _, status := range statuses {
+			h = append(h, corev2.CheckHistory{Status: status})
+		}
+		return h
+	}
+
+	tests := []struct {
+		name    string
+		check   string
+		history []corev2.CheckHistory
+		want    bool
+	}{
+		{name: "failing", check: corev2.KeepaliveCheckName, history: history(0, 2), want: true},
+		{name: "resumed", check: corev2.KeepaliveCheckName, history: history(2, 2, 0), want: true},
+		{name: "still passing", check: corev2.KeepaliveCheckName, history: history(0, 0)},
+		{name: "first keepalive", check: corev2.KeepaliveCheckName, history: history(0)},
+		{name: "not a keepalive", check: "check-cpu", history: history(0, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := corev2.FixtureEvent("foo", tt.check)
+			event.Check.History = tt.history
+			assert.Equal(t, tt.want, isKeepaliveTransition(event))
+		})
+	}
+}
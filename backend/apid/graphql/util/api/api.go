@@ -107,22 +107,15 @@ func UnwrapResource(r interface{}) interface{} {
 // WrapResource safely wraps the given resource in a type wrapper
 func WrapResource(r interface{}) types.Wrapper {
 	switch r := r.(type) {
-	case corev3.Resource: // maybe we move this into the compat package
-		var tm types.TypeMeta
-		if getter, ok := r.(interface{ GetTypeMeta() types.TypeMeta }); ok {
-			tm = getter.GetTypeMeta()
-		}
-		var meta corev2.ObjectMeta
-		if r.GetMetadata() != nil {
-			meta = *r.GetMetadata()
-		}
-		return types.Wrapper{
-			TypeMeta:   tm,
-			ObjectMeta: meta,
-			Value:      r,
-		}
+	case *corev3.V2ResourceProxy:
+		return wrapV3Resource(r)
 	case corev2.Resource:
+		// The core/v2 resources also satisfy core/v3.Resource through their
+		// compatibility shims (api/core/v2/compat.go), so they must be matched
+		// before core/v3 resources to keep being wrapped as core/v2 resources.
 		return types.WrapResource(r)
+	case corev3.Resource: // maybe we move this into the compat package
+		return wrapV3Resource(r)
 	case *types.Wrapper:
 		if r == nil {
 			return types.Wrapper{}
@@ -134,6 +127,22 @@ func WrapResource(r interface{}) types.Wrapper {
 	panic("wrap error: unknown type")
 }
 
+func wrapV3Resource(r corev3.Resource) types.Wrapper {
+	var tm types.TypeMeta
+	if getter, ok := r.(interface{ GetTypeMeta() types.TypeMeta }); ok {
+		tm = getter.GetTypeMeta()
+	}
+	var meta corev2.ObjectMeta
+	if r.GetMetadata() != nil {
+		meta = *r.GetMetadata()
+	}
+	return types.Wrapper{
+		TypeMeta:   tm,
+		ObjectMeta: meta,
+		Value:      r,
+	}
+}
+
 // ToFetchErr produces a FetchErr from the given err; may return nil if the err
 // does not have a relavant err code.
 func ToFetchErr(err error) map[string]interface{} {
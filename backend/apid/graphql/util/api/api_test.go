@@ -315,6 +315,7 @@ func TestWrapResource(t *testing.T) {
 	v3resource := corev3.FixtureEntityConfig("name")
 	v2resource := corev2.FixtureAsset("name")
 	v2wrapped := types.WrapResource(v2resource)
+	v3proxy := corev3.V3ToV2Resource(v3resource).(*corev3.V2ResourceProxy)
 
 	tests := []struct {
 		name string
@@ -339,6 +340,15 @@ func TestWrapResource(t *testing.T) {
 				Value:      v2resource,
 			},
 		},
+		{
+			name: "corev3 proxy",
+			args: v3proxy,
+			want: types.Wrapper{
+				TypeMeta:   types.TypeMeta{APIVersion: "core/v3", Type: "EntityConfig"},
+				ObjectMeta: *v3resource.Metadata,
+				Value:      v3proxy,
+			},
+		},
 		{
 			name: "wrapped",
 			args: types.WrapResource(v2resource),
@@ -15,6 +15,11 @@ import (
 // CreateResource creates the resource given in the request body but only if it
 // does not already exist
 func (h Handlers) CreateResource(r *http.Request) (interface{}, error) {
+	dryRun, err := DryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
 	payload := reflect.New(reflect.TypeOf(h.Resource).Elem())
 	if err := json.NewDecoder(r.Body).Decode(payload.Interface()); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -35,6 +40,19 @@ func (h Handlers) CreateResource(r *http.Request) (interface{}, error) {
 		resource.SetObjectMeta(meta)
 	}
 
+	if dryRun {
+		if err := validate(resource); err != nil {
+			return nil, err
+		}
+		existing := reflect.New(reflect.TypeOf(h.Resource).Elem()).Interface().(corev2.Resource)
+		if err := h.Store.GetResource(r.Context(), meta.Name, existing); err == nil {
+			return nil, actions.NewErrorf(actions.AlreadyExistsErr)
+		} else if _, ok := err.(*store.ErrNotFound); !ok {
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+		return resource, nil
+	}
+
 	if err := h.Store.CreateResource(r.Context(), resource); err != nil {
 		switch err := err.(type) {
 		case *store.ErrAlreadyExists:
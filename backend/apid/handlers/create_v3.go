@@ -16,6 +16,11 @@ import (
 // CreateV3Resource creates the resource given in the request body but only if it
 // does not already exist
 func (h Handlers) CreateV3Resource(r *http.Request) (interface{}, error) {
+	dryRun, err := DryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
 	payload := reflect.New(reflect.TypeOf(h.V3Resource).Elem())
 	if err := json.NewDecoder(r.Body).Decode(payload.Interface()); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -41,6 +46,20 @@ func (h Handlers) CreateV3Resource(r *http.Request) (interface{}, error) {
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
 
+	if dryRun {
+		if err := validate(resource); err != nil {
+			return nil, err
+		}
+		exists, err := h.StoreV2.Exists(req)
+		if err != nil {
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+		if exists {
+			return nil, actions.NewErrorf(actions.AlreadyExistsErr)
+		}
+		return resource, nil
+	}
+
 	if err := h.StoreV2.CreateIfNotExists(req, wrapper); err != nil {
 		switch err := err.(type) {
 		case *store.ErrAlreadyExists:
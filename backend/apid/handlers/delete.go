@@ -3,20 +3,40 @@ package handlers
 import (
 	"net/http"
 	"net/url"
+	"reflect"
 
 	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/store"
 )
 
 // DeleteResource deletes the resources identified in the request path
 func (h Handlers) DeleteResource(r *http.Request) (interface{}, error) {
+	dryRun, err := DryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
 	params := mux.Vars(r)
 	name, err := url.PathUnescape(params["id"])
 	if err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
 
+	if dryRun {
+		existing := reflect.New(reflect.TypeOf(h.Resource).Elem()).Interface().(corev2.Resource)
+		if err := h.Store.GetResource(r.Context(), name, existing); err != nil {
+			switch err := err.(type) {
+			case *store.ErrNotFound:
+				return nil, actions.NewErrorf(actions.NotFound)
+			default:
+				return nil, actions.NewError(actions.InternalErr, err)
+			}
+		}
+		return nil, nil
+	}
+
 	if err := h.Store.DeleteResource(r.Context(), h.Resource.StorePrefix(), name); err != nil {
 		switch err := err.(type) {
 		case *store.ErrNotFound:
@@ -12,6 +12,11 @@ import (
 )
 
 func (h Handlers) DeleteV3Resource(r *http.Request) (interface{}, error) {
+	dryRun, err := DryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
 	params := mux.Vars(r)
 	name, err := url.PathUnescape(params["id"])
 	if err != nil {
@@ -23,6 +28,17 @@ func (h Handlers) DeleteV3Resource(r *http.Request) (interface{}, error) {
 	storeName := h.V3Resource.StoreName()
 
 	req := storev2.NewResourceRequest(ctx, namespace, name, storeName)
+	if dryRun {
+		exists, err := h.StoreV2.Exists(req)
+		if err != nil {
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+		if !exists {
+			return nil, actions.NewErrorf(actions.NotFound)
+		}
+		return nil, nil
+	}
+
 	if err := h.StoreV2.Delete(req); err != nil {
 		switch err := err.(type) {
 		case *store.ErrNotFound:
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/patch"
+)
+
+// DryRunParam is the query parameter that makes create, update, patch and
+// delete requests run without writing to the store.
+const DryRunParam = "dryRun"
+
+// DryRun returns whether the request asks for a dry run, in which case the
+// request is authorized, validated and defaulted, and patches are applied, but
+// the store is left untouched.
+func DryRun(r *http.Request) (bool, error) {
+	value := r.URL.Query().Get(DryRunParam)
+	if value == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, actions.NewError(actions.InvalidArgument, fmt.Errorf("invalid %s parameter: %q", DryRunParam, value))
+	}
+	return dryRun, nil
+}
+
+// validate validates the resource, the way the store does before writing it.
+func validate(resource interface{ Validate() error }) error {
+	if err := resource.Validate(); err != nil {
+		return actions.NewError(actions.InvalidArgument, err)
+	}
+	return nil
+}
+
+// applyPatch applies the patch to the stored resource, the way the store does
+// when patching it, but without writing the result.
+func applyPatch(key string, stored interface{ Validate() error }, patcher patch.Patcher, conditions *store.ETagCondition) error {
+	etag, err := store.ETag(stored)
+	if err != nil {
+		return err
+	}
+
+	if conditions != nil {
+		if !store.CheckIfMatch(conditions.IfMatch, etag) {
+			return &store.ErrPreconditionFailed{Key: key}
+		}
+		if !store.CheckIfNoneMatch(conditions.IfNoneMatch, etag) {
+			return &store.ErrPreconditionFailed{Key: key}
+		}
+	}
+
+	original, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	patched, err := patcher.Patch(original)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(patched, stored); err != nil {
+		return err
+	}
+	if err := stored.Validate(); err != nil {
+		return &store.ErrNotValid{Err: err}
+	}
+
+	// Special case for entities; the store keeps the per-entity subscription
+	if e, ok := stored.(*corev3.EntityConfig); ok {
+		e.Subscriptions = corev2.AddEntitySubscription(e.Metadata.Name, e.Subscriptions)
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	"github.com/sensu/sensu-go/testing/fixture"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    bool
+		wantErr bool
+	}{
+		{query: "", want: false},
+		{query: "?dryRun=true", want: true},
+		{query: "?dryRun=1", want: true},
+		{query: "?dryRun=false", want: false},
+		{query: "?dryRun=maybe", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodPost, "/"+tt.query, nil)
+			got, err := DryRun(r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DryRun() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func errCode(t *testing.T, err error) actions.ErrCode {
+	t.Helper()
+	actionErr, ok := err.(actions.Error)
+	require.True(t, ok, "unexpected error %v", err)
+	return actionErr.Code
+}
+
+func TestHandlers_DryRunCreateResource(t *testing.T) {
+	check := corev2.FixtureCheckConfig("check-cpu")
+	invalid := corev2.FixtureCheckConfig("check-cpu")
+	invalid.Interval = 0
+
+	tests := []struct {
+		name      string
+		body      []byte
+		storeFunc func(*mockstore.MockStore)
+		wantErr   bool
+		wantCode  actions.ErrCode
+	}{
+		{
+			name: "valid resource",
+			body: marshal(t, check),
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "check-cpu", mock.Anything).Return(&store.ErrNotFound{})
+			},
+		},
+		{
+			name:     "invalid resource",
+			body:     marshal(t, invalid),
+			wantErr:  true,
+			wantCode: actions.InvalidArgument,
+		},
+		{
+			name: "already exists",
+			body: marshal(t, check),
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "check-cpu", mock.Anything).Return(nil)
+			},
+			wantErr:  true,
+			wantCode: actions.AlreadyExistsErr,
+		},
+		{
+			name: "store error",
+			body: marshal(t, check),
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "check-cpu", mock.Anything).Return(&store.ErrInternal{})
+			},
+			wantErr:  true,
+			wantCode: actions.InternalErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			if tt.storeFunc != nil {
+				tt.storeFunc(store)
+			}
+			h := Handlers{
+				Resource: &corev2.CheckConfig{},
+				Store:    store,
+			}
+
+			r, _ := http.NewRequest(http.MethodPost, "/?dryRun=true", bytes.NewReader(tt.body))
+			got, err := h.CreateResource(r)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, errCode(t, err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "check-cpu", got.(*corev2.CheckConfig).Name)
+			store.AssertNotCalled(t, "CreateResource", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHandlers_DryRunCreateOrUpdateResource(t *testing.T) {
+	h := Handlers{
+		Resource: &corev2.CheckConfig{},
+		Store:    &mockstore.MockStore{},
+	}
+
+	r, _ := http.NewRequest(http.MethodPut, "/?dryRun=true", bytes.NewReader(marshal(t, corev2.FixtureCheckConfig("check-cpu"))))
+	got, err := h.CreateOrUpdateResource(r)
+	require.NoError(t, err)
+	assert.Equal(t, "check-cpu", got.(*corev2.CheckConfig).Name)
+
+	invalid := corev2.FixtureCheckConfig("check-cpu")
+	invalid.Interval = 0
+	r, _ = http.NewRequest(http.MethodPut, "/?dryRun=true", bytes.NewReader(marshal(t, invalid)))
+	_, err = h.CreateOrUpdateResource(r)
+	require.Error(t, err)
+	assert.Equal(t, actions.InvalidArgument, errCode(t, err))
+}
+
+func TestHandlers_DryRunDeleteResource(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("GetResource", mock.Anything, "foo", mock.Anything).Return(nil)
+	s.On("GetResource", mock.Anything, "bar", mock.Anything).Return(&store.ErrNotFound{})
+	h := Handlers{
+		Resource: &fixture.Resource{},
+		Store:    s,
+	}
+
+	r, _ := http.NewRequest(http.MethodDelete, "/?dryRun=true", nil)
+	_, err := h.DeleteResource(mux.SetURLVars(r, map[string]string{"id": "foo"}))
+	assert.NoError(t, err)
+
+	_, err = h.DeleteResource(mux.SetURLVars(r, map[string]string{"id": "bar"}))
+	require.Error(t, err)
+	assert.Equal(t, actions.NotFound, errCode(t, err))
+
+	s.AssertNotCalled(t, "DeleteResource", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandlers_DryRunPatchResource(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("GetResource", mock.Anything, "check-cpu", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(2).(*corev2.CheckConfig) = *corev2.FixtureCheckConfig("check-cpu")
+	})
+	h := Handlers{
+		Resource: &corev2.CheckConfig{},
+		Store:    store,
+	}
+	vars := map[string]string{"id": "check-cpu", "namespace": "default"}
+
+	r, _ := http.NewRequest(http.MethodPatch, "/?dryRun=true", bytes.NewReader([]byte(`{"interval":30}`)))
+	got, err := h.PatchResource(mux.SetURLVars(r, vars))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(30), got.(*corev2.CheckConfig).Interval)
+
+	r, _ = http.NewRequest(http.MethodPatch, "/?dryRun=true", bytes.NewReader([]byte(`{"interval":0}`)))
+	_, err = h.PatchResource(mux.SetURLVars(r, vars))
+	require.Error(t, err)
+	assert.Equal(t, actions.InvalidArgument, errCode(t, err))
+
+	r, _ = http.NewRequest(http.MethodPatch, "/?dryRun=true", bytes.NewReader([]byte(`{"interval":30}`)))
+	r.Header.Set(ifMatchHeader, `"foo"`)
+	_, err = h.PatchResource(mux.SetURLVars(r, vars))
+	require.Error(t, err)
+	assert.Equal(t, actions.PreconditionFailed, errCode(t, err))
+
+	store.AssertNotCalled(t, "PatchResource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandlers_DryRunV3Resource(t *testing.T) {
+	entity := corev3.FixtureEntityConfig("foo")
+	wrapper, err := storev2.WrapResource(entity)
+	require.NoError(t, err)
+
+	store := &mockstore.V2MockStore{}
+	store.On("Exists", mock.MatchedBy(func(req storev2.ResourceRequest) bool { return req.Name == "foo" })).Return(true, nil)
+	store.On("Exists", mock.MatchedBy(func(req storev2.ResourceRequest) bool { return req.Name == "bar" })).Return(false, nil)
+	store.On("Get", mock.Anything).Return(wrapper, nil)
+	h := Handlers{
+		V3Resource: &corev3.EntityConfig{},
+		StoreV2:    store,
+	}
+
+	// create
+	r, _ := http.NewRequest(http.MethodPost, "/?dryRun=true", bytes.NewReader(marshal(t, corev3.FixtureEntityConfig("bar"))))
+	got, err := h.CreateV3Resource(r)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", got.(*corev3.EntityConfig).Metadata.Name)
+
+	r, _ = http.NewRequest(http.MethodPost, "/?dryRun=true", bytes.NewReader(marshal(t, entity)))
+	_, err = h.CreateV3Resource(r)
+	require.Error(t, err)
+	assert.Equal(t, actions.AlreadyExistsErr, errCode(t, err))
+
+	// update
+	r, _ = http.NewRequest(http.MethodPut, "/?dryRun=true", bytes.NewReader(marshal(t, entity)))
+	got, err = h.CreateOrUpdateV3Resource(r)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", got.(*corev3.EntityConfig).Metadata.Name)
+
+	// patch
+	r, _ = http.NewRequest(http.MethodPatch, "/?dryRun=true", bytes.NewReader([]byte(`{"entity_class":"proxy"}`)))
+	got, err = h.PatchResource(mux.SetURLVars(r, map[string]string{"id": "foo", "namespace": "default"}))
+	require.NoError(t, err)
+	assert.Equal(t, corev2.EntityProxyClass, got.(*corev3.EntityConfig).EntityClass)
+
+	// delete
+	r, _ = http.NewRequest(http.MethodDelete, "/?dryRun=true", nil)
+	_, err = h.DeleteV3Resource(mux.SetURLVars(r, map[string]string{"id": "foo"}))
+	assert.NoError(t, err)
+	_, err = h.DeleteV3Resource(mux.SetURLVars(r, map[string]string{"id": "bar"}))
+	require.Error(t, err)
+	assert.Equal(t, actions.NotFound, errCode(t, err))
+
+	store.AssertNotCalled(t, "CreateIfNotExists", mock.Anything, mock.Anything)
+	store.AssertNotCalled(t, "CreateOrUpdate", mock.Anything, mock.Anything)
+	store.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	store.AssertNotCalled(t, "Delete", mock.Anything)
+}
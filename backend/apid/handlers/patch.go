@@ -34,6 +34,11 @@ var acceptedContentTypes = []string{mergePatchContentType}
 
 // PatchResource patches a given resource, using the request body as the patch
 func (h Handlers) PatchResource(r *http.Request) (interface{}, error) {
+	dryRun, err := DryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
 	// Read the request body
 	body, err := ioutil.ReadAll(r.Body)
 	if err != nil {
@@ -85,15 +90,15 @@ func (h Handlers) PatchResource(r *http.Request) (interface{}, error) {
 	}
 
 	if h.Resource != nil {
-		return h.patchV2Resource(r.Context(), body, name, patcher, conditions)
+		return h.patchV2Resource(r.Context(), body, name, patcher, conditions, dryRun)
 	} else if h.V3Resource != nil {
-		return h.patchV3Resource(r.Context(), body, name, namespace, patcher, conditions)
+		return h.patchV3Resource(r.Context(), body, name, namespace, patcher, conditions, dryRun)
 	}
 
 	return nil, actions.NewError(actions.InvalidArgument, errors.New("no resource available"))
 }
 
-func (h Handlers) patchV2Resource(ctx context.Context, body []byte, name string, patcher patch.Patcher, conditions *store.ETagCondition) (interface{}, error) {
+func (h Handlers) patchV2Resource(ctx context.Context, body []byte, name string, patcher patch.Patcher, conditions *store.ETagCondition, dryRun bool) (interface{}, error) {
 	payload := reflect.New(reflect.TypeOf(h.Resource).Elem())
 	if err := json.Unmarshal(body, payload.Interface()); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -103,23 +108,22 @@ func (h Handlers) patchV2Resource(ctx context.Context, body []byte, name string,
 		return nil, actions.NewErrorf(actions.InvalidArgument)
 	}
 
-	if err := h.Store.PatchResource(ctx, resource, name, patcher, conditions); err != nil {
-		switch err := err.(type) {
-		case *store.ErrNotFound:
-			return nil, actions.NewError(actions.NotFound, err)
-		case *store.ErrNotValid:
-			return nil, actions.NewError(actions.InvalidArgument, err)
-		case *store.ErrPreconditionFailed:
-			return nil, actions.NewError(actions.PreconditionFailed, err)
-		default:
-			return nil, actions.NewError(actions.InternalErr, err)
+	var err error
+	if dryRun {
+		if err = h.Store.GetResource(ctx, name, resource); err == nil {
+			err = applyPatch(name, resource, patcher, conditions)
 		}
+	} else {
+		err = h.Store.PatchResource(ctx, resource, name, patcher, conditions)
+	}
+	if err != nil {
+		return nil, patchError(err)
 	}
 
 	return resource, nil
 }
 
-func (h Handlers) patchV3Resource(ctx context.Context, body []byte, name, namespace string, patcher patch.Patcher, conditions *store.ETagCondition) (interface{}, error) {
+func (h Handlers) patchV3Resource(ctx context.Context, body []byte, name, namespace string, patcher patch.Patcher, conditions *store.ETagCondition, dryRun bool) (interface{}, error) {
 	payload := reflect.New(reflect.TypeOf(h.V3Resource).Elem())
 	if err := json.Unmarshal(body, payload.Interface()); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -130,22 +134,17 @@ func (h Handlers) patchV3Resource(ctx context.Context, body []byte, name, namesp
 	}
 
 	req := storev2.NewResourceRequest(ctx, namespace, name, resource.StoreName())
+	if dryRun {
+		return h.dryRunPatchV3Resource(req, patcher, conditions)
+	}
+
 	w, err := wrap.ResourceWithoutValidation(resource)
 	if err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
 
 	if err := h.StoreV2.Patch(req, w, patcher, conditions); err != nil {
-		switch err := err.(type) {
-		case *store.ErrNotFound:
-			return nil, actions.NewError(actions.NotFound, err)
-		case *store.ErrNotValid:
-			return nil, actions.NewError(actions.InvalidArgument, err)
-		case *store.ErrPreconditionFailed:
-			return nil, actions.NewError(actions.PreconditionFailed, err)
-		default:
-			return nil, actions.NewError(actions.InternalErr, err)
-		}
+		return nil, patchError(err)
 	}
 
 	// Unwrap the updated resource
@@ -157,6 +156,35 @@ func (h Handlers) patchV3Resource(ctx context.Context, body []byte, name, namesp
 	return resource, nil
 }
 
+func (h Handlers) dryRunPatchV3Resource(req storev2.ResourceRequest, patcher patch.Patcher, conditions *store.ETagCondition) (interface{}, error) {
+	w, err := h.StoreV2.Get(req)
+	if err != nil {
+		return nil, patchError(err)
+	}
+	resource, err := w.Unwrap()
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	if err := applyPatch(req.Name, resource, patcher, conditions); err != nil {
+		return nil, patchError(err)
+	}
+	return resource, nil
+}
+
+// patchError converts a store error into an API error.
+func patchError(err error) error {
+	switch err := err.(type) {
+	case *store.ErrNotFound:
+		return actions.NewError(actions.NotFound, err)
+	case *store.ErrNotValid:
+		return actions.NewError(actions.InvalidArgument, err)
+	case *store.ErrPreconditionFailed:
+		return actions.NewError(actions.PreconditionFailed, err)
+	default:
+		return actions.NewError(actions.InternalErr, err)
+	}
+}
+
 func validatePatch(data []byte, vars map[string]string) error {
 	type body struct {
 		Metadata *corev2.ObjectMeta `json:"metadata"`
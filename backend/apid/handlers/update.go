@@ -15,6 +15,11 @@ import (
 // CreateOrUpdateResource creates or updates the resource given in the request
 // body, regardless of whether it already exists or not
 func (h Handlers) CreateOrUpdateResource(r *http.Request) (interface{}, error) {
+	dryRun, err := DryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
 	payload := reflect.New(reflect.TypeOf(h.Resource).Elem())
 	if err := json.NewDecoder(r.Body).Decode(payload.Interface()); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -35,6 +40,13 @@ func (h Handlers) CreateOrUpdateResource(r *http.Request) (interface{}, error) {
 		resource.SetObjectMeta(meta)
 	}
 
+	if dryRun {
+		if err := validate(resource); err != nil {
+			return nil, err
+		}
+		return resource, nil
+	}
+
 	if err := h.Store.CreateOrUpdateResource(r.Context(), resource); err != nil {
 		switch err := err.(type) {
 		case *store.ErrNotValid:
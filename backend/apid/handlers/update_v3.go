@@ -16,6 +16,11 @@ import (
 // CreateOrUpdateResource creates or updates the resource given in the request
 // body, regardless of whether it already exists or not
 func (h Handlers) CreateOrUpdateV3Resource(r *http.Request) (interface{}, error) {
+	dryRun, err := DryRun(r)
+	if err != nil {
+		return nil, err
+	}
+
 	payload := reflect.New(reflect.TypeOf(h.V3Resource).Elem())
 	if err := json.NewDecoder(r.Body).Decode(payload.Interface()); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -42,6 +47,13 @@ func (h Handlers) CreateOrUpdateV3Resource(r *http.Request) (interface{}, error)
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
 
+	if dryRun {
+		if err := validate(resource); err != nil {
+			return nil, err
+		}
+		return resource, nil
+	}
+
 	if err := h.StoreV2.CreateOrUpdate(req, wrapper); err != nil {
 		switch err := err.(type) {
 		case *store.ErrNotValid:
@@ -0,0 +1,268 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/sensu/sensu-go/backend/audit"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+)
+
+// Audit is an HTTP middleware that records the mutating requests in the audit
+// log. On the REST API, it must be executed after the AuthorizationAttributes
+// middleware, and before the Authorization middleware so the requests that
+// are not authorized are recorded too.
+type Audit struct {
+	Logger audit.Logger
+
+	// IncludeBodies records the request bodies, with their secrets redacted.
+	IncludeBodies bool
+
+	// Router is used to get the resources before and after the requests, when
+	// set. The resources are retrieved with the credentials of the user.
+	Router http.Handler
+
+	// GraphQL records the GraphQL mutations instead of the REST requests.
+	GraphQL bool
+}
+
+// Then middleware
+func (a Audit) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.Logger == nil || r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		entry := &audit.Entry{
+			Time:     time.Now(),
+			SourceIP: sourceIP(r),
+		}
+		if claims := jwt.GetClaimsFromContext(r.Context()); claims != nil {
+			entry.User = claims.Subject
+			entry.Groups = claims.Groups
+			entry.Impersonator = claims.Impersonator
+		}
+
+		var body []byte
+		if a.IncludeBodies || a.GraphQL {
+			body, r.Body = peekBody(r.Body, MaxBytesLimit)
+			if a.IncludeBodies {
+				entry.RequestBody = audit.Redact(body)
+			}
+		}
+
+		if a.GraphQL {
+			a.graphql(entry, body, next, w, r)
+			return
+		}
+
+		attrs := authorization.GetAttributes(r.Context())
+		if attrs != nil {
+			entry.Verb = attrs.Verb
+			entry.APIGroup = attrs.APIGroup
+			entry.APIVersion = attrs.APIVersion
+			entry.Resource = attrs.Resource
+			entry.Namespace = attrs.Namespace
+			entry.Name = attrs.ResourceName
+		}
+
+		// Get the resource before modifying or deleting it
+		diff := a.Router != nil && attrs != nil && attrs.ResourceName != "" && r.Method != http.MethodPost
+		var before json.RawMessage
+		if diff {
+			before = a.get(r)
+		}
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		entry.StatusCode = rec.status
+		entry.Outcome = audit.OutcomeFor(rec.status)
+
+		if diff && entry.Outcome == audit.OutcomeSuccess {
+			entry.Diff = &audit.Diff{Before: before}
+			if r.Method != http.MethodDelete {
+				entry.Diff.After = a.get(r)
+			}
+		}
+
+		a.Logger.Log(entry)
+	})
+}
+
+// graphql records the request if it contains mutations.
+func (a Audit) graphql(entry *audit.Entry, body []byte, next http.Handler, w http.ResponseWriter, r *http.Request) {
+	mutations := graphqlMutations(body)
+	if len(mutations) == 0 {
+		next.ServeHTTP(w, r)
+		return
+	}
+	entry.Verb = audit.VerbMutation
+	entry.Resource = audit.ResourceGraphQL
+	entry.Name = strings.Join(mutations, ",")
+
+	// GraphQL errors are returned with a 200 status code, so the response
+	// is inspected to determine the outcome
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK, body: &bytes.Buffer{}}
+	next.ServeHTTP(rec, r)
+	entry.StatusCode = rec.status
+	entry.Outcome = audit.OutcomeFor(rec.status)
+	if graphqlErrors(rec.body.Bytes()) {
+		entry.Outcome = audit.OutcomeFailure
+	}
+
+	a.Logger.Log(entry)
+}
+
+// get returns the resource of the request, with its secrets redacted, or nil
+// if it can't be retrieved.
+func (a Audit) get(r *http.Request) json.RawMessage {
+	req := r.Clone(r.Context())
+	req.Method = http.MethodGet
+	req.Body = http.NoBody
+	req.ContentLength = 0
+	req.URL.RawQuery = ""
+	rec := &statusRecorder{ResponseWriter: discardWriter{header: http.Header{}}, status: http.StatusOK, body: &bytes.Buffer{}}
+	a.Router.ServeHTTP(rec, req)
+	if rec.status != http.StatusOK {
+		return nil
+	}
+	return audit.Redact(rec.body.Bytes())
+}
+
+// peekBody reads up to limit bytes of the body, and returns them along with a
+// reader of the whole body.
+func peekBody(body io.ReadCloser, limit int64) ([]byte, io.ReadCloser) {
+	if body == nil || body == http.NoBody {
+		return nil, body
+	}
+	peeked, err := ioutil.ReadAll(io.LimitReader(body, limit))
+	if err != nil {
+		logger.WithError(err).Warning("could not read the request body")
+	}
+	return peeked, struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(peeked), body), body}
+}
+
+// sourceIP returns the address of the client.
+func sourceIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// graphqlMutations returns the names of the mutations of the GraphQL request
+// body, which contains one operation or a list of operations.
+func graphqlMutations(body []byte) []string {
+	var ops []struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &ops); err != nil {
+		ops = ops[:0]
+		var op struct {
+			Query string `json:"query"`
+		}
+		if err := json.Unmarshal(body, &op); err != nil {
+			return nil
+		}
+		ops = append(ops, op)
+	}
+
+	var mutations []string
+	for _, op := range ops {
+		doc, err := parser.Parse(parser.ParseParams{Source: op.Query})
+		if err != nil {
+			continue
+		}
+		for _, def := range doc.Definitions {
+			opDef, ok := def.(*ast.OperationDefinition)
+			if !ok || opDef.Operation != ast.OperationTypeMutation {
+				continue
+			}
+			if opDef.Name != nil && opDef.Name.Value != "" {
+				mutations = append(mutations, opDef.Name.Value)
+				continue
+			}
+			// Anonymous mutations are named after their fields
+			if opDef.SelectionSet == nil {
+				continue
+			}
+			for _, selection := range opDef.SelectionSet.Selections {
+				if field, ok := selection.(*ast.Field); ok && field.Name != nil {
+					mutations = append(mutations, field.Name.Value)
+				}
+			}
+		}
+	}
+	return mutations
+}
+
+// graphqlErrors returns whether the GraphQL response body, which contains
+// one result or a list of results, contains errors.
+func graphqlErrors(body []byte) bool {
+	type result struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	var results []result
+	if err := json.Unmarshal(body, &results); err != nil {
+		var r result
+		if err := json.Unmarshal(body, &r); err != nil {
+			return false
+		}
+		results = append(results, r)
+	}
+	for _, r := range results {
+		if len(r.Errors) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// statusRecorder records the status code of a response, and its body when
+// body is not nil.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	body   *bytes.Buffer
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.body != nil && int64(s.body.Len()) < MaxBytesLimit {
+		_, _ = s.body.Write(b)
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+// discardWriter is a response writer discarding the response.
+type discardWriter struct {
+	header http.Header
+}
+
+func (d discardWriter) Header() http.Header {
+	return d.header
+}
+
+func (d discardWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (d discardWriter) WriteHeader(int) {}
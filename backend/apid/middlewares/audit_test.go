@@ -0,0 +1,231 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/audit"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+)
+
+type memoryAuditLogger struct {
+	mu      sync.Mutex
+	entries []*audit.Entry
+}
+
+func (l *memoryAuditLogger) Log(entry *audit.Entry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+}
+
+func auditRequest(method, body string, attrs *authorization.Attributes) *http.Request {
+	req := httptest.NewRequest(method, "/api/core/v2/namespaces/default/checks/check-cpu", strings.NewReader(body))
+	claims := &corev2.Claims{Groups: []string{"cluster-admins"}}
+	claims.Subject = "admin"
+	ctx := jwt.SetClaimsIntoContext(req, claims)
+	if attrs != nil {
+		ctx = authorization.SetAttributes(ctx, attrs)
+	}
+	return req.WithContext(ctx)
+}
+
+func TestAuditREST(t *testing.T) {
+	attrs := &authorization.Attributes{
+		APIGroup:     "core",
+		APIVersion:   "v2",
+		Namespace:    "default",
+		Resource:     "checks",
+		ResourceName: "check-cpu",
+		Verb:         "update",
+	}
+	tests := []struct {
+		name          string
+		method        string
+		body          string
+		status        int
+		includeBodies bool
+		wantEntry     bool
+		wantOutcome   string
+		wantBody      string
+	}{
+		{
+			name:   "reads are not recorded",
+			method: http.MethodGet,
+			status: http.StatusOK,
+		},
+		{
+			name:        "successful update",
+			method:      http.MethodPut,
+			body:        `{"command":"check-cpu.sh"}`,
+			status:      http.StatusCreated,
+			wantEntry:   true,
+			wantOutcome: audit.OutcomeSuccess,
+		},
+		{
+			name:        "denied update",
+			method:      http.MethodPut,
+			status:      http.StatusForbidden,
+			wantEntry:   true,
+			wantOutcome: audit.OutcomeFailure,
+		},
+		{
+			name:          "request body is redacted",
+			method:        http.MethodPut,
+			body:          `{"command":"check-cpu.sh","env_vars":["API_TOKEN=abc"]}`,
+			status:        http.StatusCreated,
+			includeBodies: true,
+			wantEntry:     true,
+			wantOutcome:   audit.OutcomeSuccess,
+			wantBody:      `{"command":"check-cpu.sh","env_vars":["API_TOKEN=REDACTED"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &memoryAuditLogger{}
+			mware := Audit{Logger: logger, IncludeBodies: tt.includeBodies}
+			var received string
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				buf := make([]byte, 1024)
+				n, _ := r.Body.Read(buf)
+				received = string(buf[:n])
+				w.WriteHeader(tt.status)
+			})
+
+			w := httptest.NewRecorder()
+			mware.Then(handler).ServeHTTP(w, auditRequest(tt.method, tt.body, attrs))
+
+			if received != tt.body {
+				t.Errorf("handler received body %q, want %q", received, tt.body)
+			}
+			if !tt.wantEntry {
+				if len(logger.entries) != 0 {
+					t.Fatalf("got %d entries, want none", len(logger.entries))
+				}
+				return
+			}
+			if len(logger.entries) != 1 {
+				t.Fatalf("got %d entries, want 1", len(logger.entries))
+			}
+			entry := logger.entries[0]
+			if entry.User != "admin" || entry.Resource != "checks" || entry.Name != "check-cpu" || entry.Verb != "update" {
+				t.Errorf("unexpected entry: %+v", entry)
+			}
+			if entry.SourceIP != "192.0.2.1" {
+				t.Errorf("SourceIP = %q", entry.SourceIP)
+			}
+			if entry.Outcome != tt.wantOutcome || entry.StatusCode != tt.status {
+				t.Errorf("Outcome = %q (%d), want %q (%d)", entry.Outcome, entry.StatusCode, tt.wantOutcome, tt.status)
+			}
+			if got := string(entry.RequestBody); got != tt.wantBody {
+				t.Errorf("RequestBody = %s, want %s", got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestAuditDiff(t *testing.T) {
+	command := "check-cpu.sh"
+	router := mux.NewRouter()
+	mware := Audit{Logger: &memoryAuditLogger{}, Router: router}
+	router.HandleFunc("/api/core/v2/namespaces/default/checks/check-cpu", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"command":%q}`, command)
+	}).Methods(http.MethodGet)
+	router.Handle("/api/core/v2/namespaces/default/checks/check-cpu", mware.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		command = "check-cpu.sh -w 90"
+		w.WriteHeader(http.StatusCreated)
+	}))).Methods(http.MethodPut)
+
+	attrs := &authorization.Attributes{Resource: "checks", ResourceName: "check-cpu", Namespace: "default", Verb: "update"}
+	router.ServeHTTP(httptest.NewRecorder(), auditRequest(http.MethodPut, `{}`, attrs))
+
+	entries := mware.Logger.(*memoryAuditLogger).entries
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	diff := entries[0].Diff
+	if diff == nil {
+		t.Fatal("expected a diff")
+	}
+	if got, want := string(diff.Before), `{"command":"check-cpu.sh"}`; got != want {
+		t.Errorf("Before = %s, want %s", got, want)
+	}
+	if got, want := string(diff.After), `{"command":"check-cpu.sh -w 90"}`; got != want {
+		t.Errorf("After = %s, want %s", got, want)
+	}
+}
+
+func TestAuditGraphQL(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		response    string
+		wantEntry   bool
+		wantName    string
+		wantOutcome string
+	}{
+		{
+			name:     "queries are not recorded",
+			body:     `{"query":"query { viewer { user { username } } }"}`,
+			response: `{"data":{}}`,
+		},
+		{
+			name:        "named mutation",
+			body:        `{"query":"mutation DeleteCheck($id: ID!) { deleteCheck(input: {id: $id}) { deletedId } }","variables":{"id":"abc"}}`,
+			response:    `{"data":{}}`,
+			wantEntry:   true,
+			wantName:    "DeleteCheck",
+			wantOutcome: audit.OutcomeSuccess,
+		},
+		{
+			name:        "anonymous mutations in a batch",
+			body:        `[{"query":"query { viewer { user { username } } }"},{"query":"mutation { executeCheck(input: {id: \"abc\"}) { errors { code } } }"}]`,
+			response:    `[{"data":{}},{"data":null,"errors":[{"message":"denied"}]}]`,
+			wantEntry:   true,
+			wantName:    "executeCheck",
+			wantOutcome: audit.OutcomeFailure,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &memoryAuditLogger{}
+			mware := Audit{Logger: logger, GraphQL: true}
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = fmt.Fprint(w, tt.response)
+			})
+
+			w := httptest.NewRecorder()
+			mware.Then(handler).ServeHTTP(w, auditRequest(http.MethodPost, tt.body, nil))
+
+			if w.Body.String() != tt.response {
+				t.Errorf("response = %q, want %q", w.Body.String(), tt.response)
+			}
+			if !tt.wantEntry {
+				if len(logger.entries) != 0 {
+					t.Fatalf("got %d entries, want none", len(logger.entries))
+				}
+				return
+			}
+			if len(logger.entries) != 1 {
+				t.Fatalf("got %d entries, want 1", len(logger.entries))
+			}
+			entry := logger.entries[0]
+			if entry.Verb != audit.VerbMutation || entry.Resource != audit.ResourceGraphQL {
+				t.Errorf("unexpected entry: %+v", entry)
+			}
+			if entry.Name != tt.wantName {
+				t.Errorf("Name = %q, want %q", entry.Name, tt.wantName)
+			}
+			if entry.Outcome != tt.wantOutcome {
+				t.Errorf("Outcome = %q, want %q", entry.Outcome, tt.wantOutcome)
+			}
+		})
+	}
+}
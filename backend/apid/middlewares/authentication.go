@@ -5,11 +5,27 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authentication/session"
+	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/patch"
+)
+
+const (
+	// apiKeyLastUsedInterval is the minimum interval between two updates of
+	// the last use of an API key, so every request does not write to the
+	// store.
+	apiKeyLastUsedInterval = time.Minute
+
+	// apiKeyLastUsedTimeout is how long recording the last use of an API key
+	// can take.
+	apiKeyLastUsedTimeout = 10 * time.Second
 )
 
 // Authentication is a HTTP middleware that enforces authentication
@@ -18,10 +34,21 @@ type Authentication struct {
 	// in the case where an access token was not present.
 	IgnoreUnauthorized bool
 	Store              store.Store
+
+	// Authorizer determines whether the authenticated users are allowed to
+	// impersonate the users and groups given by the Impersonate-User and
+	// Impersonate-Group headers. Impersonation is denied if it is nil.
+	Authorizer authorization.Authorizer
+
+	// Denylist holds the revoked access tokens and sessions, which are
+	// rejected before they expire. No token is rejected if it is nil.
+	Denylist *session.Denylist
 }
 
 // Then middleware
 func (a Authentication) Then(next http.Handler) http.Handler {
+	next = a.impersonate(next)
+	uses := newAPIKeyUses(a.Store)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		authHeader, ok := r.Header["Authorization"]
@@ -38,8 +65,15 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 					SimpleLogger{}.Then(errorWriter{err: actionErr}.Then(next)).ServeHTTP(w, r.WithContext(ctx))
 					return
 				}
+				claims := token.Claims.(*corev2.Claims)
+				if a.Denylist != nil && a.Denylist.IsRevoked(claims) {
+					logger.WithField("user", claims.Subject).Warn("revoked token")
+					actionErr := actions.NewErrorf(actions.Unauthenticated, "invalid credentials")
+					SimpleLogger{}.Then(errorWriter{err: actionErr}.Then(next)).ServeHTTP(w, r.WithContext(ctx))
+					return
+				}
 				// Set the claims into the request context
-				ctx = jwt.SetClaimsIntoContext(r, token.Claims.(*corev2.Claims))
+				ctx = jwt.SetClaimsIntoContext(r, claims)
 				next.ServeHTTP(w, r.WithContext(ctx))
 				return
 			}
@@ -47,7 +81,7 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 			// if the auth header contains Key, continue with api key auth
 			if strings.HasPrefix(headerString, "Key ") {
 				headerString = strings.TrimPrefix(headerString, "Key ")
-				claims, err := extractAPIKeyClaims(ctx, headerString, a.Store)
+				claims, err := extractAPIKeyClaims(ctx, headerString, a.Store, uses)
 				if err != nil {
 					logger.WithError(err).Warn("invalid api key")
 					actionErr := actions.NewErrorf(actions.Unauthenticated, "invalid credentials")
@@ -74,7 +108,7 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 	})
 }
 
-func extractAPIKeyClaims(ctx context.Context, key string, store store.Store) (*corev2.Claims, error) {
+func extractAPIKeyClaims(ctx context.Context, key string, store store.Store, uses *apiKeyUses) (*corev2.Claims, error) {
 	var claims *corev2.Claims
 	// retrieve the APIKey based on the key provided
 	apiKey := &corev2.APIKey{
@@ -86,6 +120,11 @@ func extractAPIKeyClaims(ctx context.Context, key string, store store.Store) (*c
 		return claims, err
 	}
 
+	now := time.Now()
+	if apiKey.IsExpired(now) {
+		return claims, fmt.Errorf("api key %s expired", apiKey.Name)
+	}
+
 	// retrieve the sensu user associated with the key provided
 	user, err := store.GetUser(ctx, apiKey.Username)
 	if err != nil {
@@ -98,11 +137,15 @@ func extractAPIKeyClaims(ctx context.Context, key string, store store.Store) (*c
 		return claims, fmt.Errorf("user %s not found", apiKey.Username)
 	}
 
-	// inject the username and groups into standard jwt claims
+	uses.Record(apiKey, now)
+
+	// inject the username, groups and restrictions of the key into standard
+	// jwt claims
 	claims = &corev2.Claims{
 		StandardClaims: corev2.StandardClaims(user.Username),
 		Groups:         user.Groups,
 		APIKey:         true,
+		Scope:          apiKey.Scope(),
 	}
 
 	return claims, nil
@@ -118,3 +161,50 @@ func (e errorWriter) Then(next http.Handler) http.Handler {
 		writeErr(w, e.err)
 	})
 }
+
+// apiKeyUses records the last use of the API keys in the background, at most
+// once per apiKeyLastUsedInterval for each key.
+type apiKeyUses struct {
+	store store.ResourceStore
+
+	mu       sync.Mutex
+	recorded map[string]int64
+	wg       sync.WaitGroup
+}
+
+func newAPIKeyUses(store store.ResourceStore) *apiKeyUses {
+	return &apiKeyUses{store: store, recorded: make(map[string]int64)}
+}
+
+// Record records the use of the API key at the given time, unless it was
+// recorded less than apiKeyLastUsedInterval ago. The key is patched rather
+// than replaced, so a key deleted in the meantime is not created again.
+func (u *apiKeyUses) Record(apiKey *corev2.APIKey, now time.Time) {
+	interval := int64(apiKeyLastUsedInterval / time.Second)
+	if now.Unix()-apiKey.LastUsedAt < interval {
+		return
+	}
+	u.mu.Lock()
+	if now.Unix()-u.recorded[apiKey.Name] < interval {
+		u.mu.Unlock()
+		return
+	}
+	for name, recorded := range u.recorded {
+		if now.Unix()-recorded >= interval {
+			delete(u.recorded, name)
+		}
+	}
+	u.recorded[apiKey.Name] = now.Unix()
+	u.mu.Unlock()
+
+	u.wg.Add(1)
+	go func() {
+		defer u.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), apiKeyLastUsedTimeout)
+		defer cancel()
+		patcher := &patch.Merge{MergePatch: []byte(fmt.Sprintf(`{"last_used_at":%d}`, now.Unix()))}
+		if err := u.store.PatchResource(ctx, &corev2.APIKey{}, apiKey.Name, patcher, nil); err != nil {
+			logger.WithError(err).Warn("could not record the last use of the api key")
+		}
+	}()
+}
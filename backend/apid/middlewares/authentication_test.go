@@ -1,14 +1,17 @@
 package middlewares
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authentication/session"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -45,6 +48,37 @@ func TestMiddlewareJWT(t *testing.T) {
 	assert.Equal(t, http.StatusOK, res.StatusCode)
 }
 
+func TestMiddlewareRevokedJWT(t *testing.T) {
+	mware := Authentication{
+		Denylist: session.NewDenylistFromResources(corev2.FixtureRevokedToken("revoked")),
+	}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		sessionID string
+		want      int
+	}{
+		{name: "valid session", sessionID: "valid", want: http.StatusOK},
+		{name: "revoked session", sessionID: "revoked", want: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := corev2.FixtureClaims("foo", nil)
+			claims.SessionID = tt.sessionID
+			_, tokenString, _ := jwt.AccessToken(claims)
+
+			req, _ := http.NewRequest("GET", server.URL, nil)
+			req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tokenString))
+
+			res, err := http.DefaultClient.Do(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, res.StatusCode)
+		})
+	}
+}
+
 func TestMiddlewareInvalidJWT(t *testing.T) {
 	mware := Authentication{}
 	server := httptest.NewServer(mware.Then(testHandler()))
@@ -86,6 +120,7 @@ func TestMiddlewareValidAPIKey(t *testing.T) {
 	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
 	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	store.On("GetUser", mock.Anything, mock.Anything).Return(&corev2.User{}, nil)
+	store.On("PatchResource", mock.Anything, mock.AnythingOfType("*v2.APIKey"), key.Name, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	client := &http.Client{}
 	req, _ := http.NewRequest("GET", server.URL, nil)
@@ -95,6 +130,80 @@ func TestMiddlewareValidAPIKey(t *testing.T) {
 	assert.Equal(t, http.StatusOK, res.StatusCode)
 }
 
+func TestAPIKeyUsesRecord(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("PatchResource", mock.Anything, mock.AnythingOfType("*v2.APIKey"), "foo", mock.Anything, mock.Anything).Return(nil)
+	uses := newAPIKeyUses(store)
+	now := time.Now()
+
+	// The use is recorded once per interval, even if the key read from the
+	// store was not updated yet
+	uses.Record(corev2.FixtureAPIKey("foo", "admin"), now)
+	uses.Record(corev2.FixtureAPIKey("foo", "admin"), now.Add(time.Second))
+	uses.wg.Wait()
+	store.AssertNumberOfCalls(t, "PatchResource", 1)
+
+	uses.Record(corev2.FixtureAPIKey("foo", "admin"), now.Add(apiKeyLastUsedInterval))
+	uses.wg.Wait()
+	store.AssertNumberOfCalls(t, "PatchResource", 2)
+
+	// The use is not recorded if the key was used less than an interval ago
+	recent := corev2.FixtureAPIKey("bar", "admin")
+	recent.LastUsedAt = now.Unix()
+	uses.Record(recent, now)
+	uses.wg.Wait()
+	store.AssertNumberOfCalls(t, "PatchResource", 2)
+}
+
+func TestMiddlewareExpiredAPIKey(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Authentication{
+		Store: store,
+	}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		apiKey := args[2].(*corev2.APIKey)
+		apiKey.Username = "admin"
+		apiKey.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+	}).Return(nil)
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Add("Authorization", fmt.Sprintf("Key %s", key.Name))
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func TestExtractAPIKeyClaimsScope(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		apiKey := args[2].(*corev2.APIKey)
+		apiKey.Username = "admin"
+		apiKey.Namespaces = []string{"dev"}
+		apiKey.LastUsedAt = time.Now().Unix()
+	}).Return(nil)
+	store.On("GetUser", mock.Anything, "admin").Return(&corev2.User{Username: "admin", Groups: []string{"cluster-admins"}}, nil)
+
+	uses := newAPIKeyUses(store)
+	claims, err := extractAPIKeyClaims(context.Background(), "174373d0-4aff-41d8-aa5f-084dfcad7dc7", store, uses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "admin", claims.Subject)
+	assert.True(t, claims.APIKey)
+	if assert.NotNil(t, claims.Scope) {
+		assert.Equal(t, []string{"dev"}, claims.Scope.Namespaces)
+	}
+
+	// The last use was recorded less than a minute ago
+	uses.wg.Wait()
+	store.AssertNotCalled(t, "PatchResource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestMiddlewareInvalidAPIKey(t *testing.T) {
 	store := &mockstore.MockStore{}
 	mware := Authentication{
@@ -1,17 +1,26 @@
 package middlewares
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"sync"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/authorization/rbac"
+	"github.com/sensu/sensu-go/backend/store"
 )
 
 // Authorization is an HTTP middleware that enforces authorization
 type Authorization struct {
 	Authorizer authorization.Authorizer
+
+	// Store is used to retrieve the labels of the stored objects, in order to
+	// evaluate the rules having a label selector. These rules never allow
+	// accessing a stored object if it is nil.
+	Store store.ResourceStore
 }
 
 func namespaceGetAttrs(attrs *authorization.Attributes) bool {
@@ -42,6 +51,8 @@ func (a Authorization) Then(next http.Handler) http.Handler {
 			return
 		}
 
+		attrs.Labels = a.labels(r, attrs)
+
 		authorized, err := a.Authorizer.Authorize(ctx, attrs)
 		if err != nil {
 			if _, ok := err.(rbac.ErrRoleNotFound); ok {
@@ -66,3 +77,96 @@ func (a Authorization) Then(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// labels returns the function retrieving the labels of the objects affected by
+// the request, which are only retrieved when a rule has a label selector.
+func (a Authorization) labels(r *http.Request, attrs *authorization.Attributes) authorization.ObjectLabels {
+	var (
+		once   sync.Once
+		labels []map[string]string
+		err    error
+	)
+	return func() ([]map[string]string, error) {
+		once.Do(func() {
+			labels, err = a.objectLabels(r, attrs)
+		})
+		return labels, err
+	}
+}
+
+// objectLabels returns the labels of the stored object for get, update and
+// delete requests, and the labels of the submitted object for create and
+// update requests.
+func (a Authorization) objectLabels(r *http.Request, attrs *authorization.Attributes) ([]map[string]string, error) {
+	var labels []map[string]string
+
+	var stored map[string]string
+	if attrs.Verb != "create" {
+		var found bool
+		var err error
+		stored, found, err = a.storedLabels(r.Context(), attrs)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			labels = append(labels, stored)
+		}
+	}
+
+	if attrs.Verb != "create" && attrs.Verb != "update" {
+		return labels, nil
+	}
+
+	var body []byte
+	body, r.Body = peekBody(r.Body, MaxBytesLimit)
+	var object struct {
+		Metadata *struct {
+			Labels map[string]*string `json:"labels"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(body, &object); err != nil {
+		return nil, err
+	}
+
+	// The labels of a patched object are the stored labels merged with the
+	// labels of the patch
+	submitted := map[string]string{}
+	if r.Method == http.MethodPatch {
+		for key, value := range stored {
+			submitted[key] = value
+		}
+	}
+	if object.Metadata != nil {
+		for key, value := range object.Metadata.Labels {
+			if value == nil {
+				delete(submitted, key)
+				continue
+			}
+			submitted[key] = *value
+		}
+	}
+
+	return append(labels, submitted), nil
+}
+
+// storedLabels returns the labels of the stored core/v2 object named by the
+// request, and whether it was found.
+func (a Authorization) storedLabels(ctx context.Context, attrs *authorization.Attributes) (map[string]string, bool, error) {
+	if a.Store == nil || attrs.ResourceName == "" || attrs.APIGroup != "core" || attrs.APIVersion != "v2" {
+		return nil, false, nil
+	}
+	resource, err := corev2.ResolveResourceByRBACName(attrs.Resource)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	ctx = store.NamespaceContext(ctx, attrs.Namespace)
+	if err := a.Store.GetResource(ctx, attrs.ResourceName, resource); err != nil {
+		if _, ok := err.(*store.ErrNotFound); ok {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return resource.GetObjectMeta().Labels, true, nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/types"
@@ -99,6 +100,20 @@ func (a AuthorizationAttributes) Then(next http.Handler) http.Handler {
 				attrs.Resource = types.LocalSelfUserResource
 			}
 		}
+
+		// Users can review their own permissions, but reviewing the permissions
+		// of another user requires the permission to view that user
+		if attrs.Resource == corev2.AccessReviewResource || attrs.Resource == corev2.PermissionsResource {
+			query := r.URL.Query()
+			attrs.Verb = "get"
+			if query.Get("user") == "" && len(query["group"]) == 0 {
+				attrs.Resource = types.LocalSelfUserResource
+				attrs.ResourceName = attrs.User.Username
+			} else {
+				attrs.Resource = "users"
+				attrs.ResourceName = query.Get("user")
+			}
+		}
 	})
 }
 
@@ -509,6 +509,45 @@ func TestLegacyAuthorizationAttributes(t *testing.T) {
 				Verb:         "update",
 			},
 		},
+		{
+			description: "Review its own access",
+			method:      "GET",
+			path:        "/api/core/v2/accessreview?verb=get&resource=checks",
+			expected: authorization.Attributes{
+				APIGroup:     "core",
+				APIVersion:   "v2",
+				Namespace:    "",
+				Resource:     types.LocalSelfUserResource,
+				ResourceName: "admin",
+				Verb:         "get",
+			},
+		},
+		{
+			description: "Review the access of another user",
+			method:      "GET",
+			path:        "/api/core/v2/accessreview?verb=get&resource=checks&user=foo",
+			expected: authorization.Attributes{
+				APIGroup:     "core",
+				APIVersion:   "v2",
+				Namespace:    "",
+				Resource:     "users",
+				ResourceName: "foo",
+				Verb:         "get",
+			},
+		},
+		{
+			description: "List the permissions of a group",
+			method:      "GET",
+			path:        "/api/core/v2/permissions?group=ops",
+			expected: authorization.Attributes{
+				APIGroup:     "core",
+				APIVersion:   "v2",
+				Namespace:    "",
+				Resource:     "users",
+				ResourceName: "",
+				Verb:         "get",
+			},
+		},
 	}
 
 	for _, tt := range cases {
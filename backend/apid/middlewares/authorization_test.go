@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"context"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -695,3 +696,120 @@ func TestRoleNotFound_GH4268(t *testing.T) {
 		t.Error(w.Body.String())
 	}
 }
+
+func TestAuthorizationLabelSelector(t *testing.T) {
+	st := new(mockstore.MockStore)
+	st.On("ListClusterRoleBindings", mock.Anything, mock.Anything).Return([]*corev2.ClusterRoleBinding{{
+		Subjects: []corev2.Subject{{Type: corev2.GroupType, Name: "team-a"}},
+		RoleRef:  corev2.RoleRef{Type: "ClusterRole", Name: "team-a"},
+	}}, nil)
+	st.On("ListRoleBindings", mock.Anything, mock.Anything).Return([]*corev2.RoleBinding{}, nil)
+	st.On("GetClusterRole", mock.Anything, "team-a").Return(&corev2.ClusterRole{Rules: []corev2.Rule{{
+		Verbs:         []string{corev2.VerbAll},
+		Resources:     []string{"checks"},
+		LabelSelector: map[string]string{"team": "a"},
+	}}}, nil)
+	setLabels := func(labels map[string]string) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			check := args.Get(2).(*corev2.CheckConfig)
+			check.Labels = labels
+		}
+	}
+	st.On("GetResource", mock.Anything, "check-a", mock.Anything).Return(nil).Run(setLabels(map[string]string{"team": "a"}))
+	st.On("GetResource", mock.Anything, "check-b", mock.Anything).Return(nil).Run(setLabels(map[string]string{"team": "b"}))
+	st.On("GetResource", mock.Anything, "new", mock.Anything).Return(&store.ErrNotFound{})
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedCode int
+	}{
+		{
+			name:         "get a matching object",
+			method:       http.MethodGet,
+			path:         "/api/core/v2/namespaces/default/checks/check-a",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "get an object not matching",
+			method:       http.MethodGet,
+			path:         "/api/core/v2/namespaces/default/checks/check-b",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "delete an object not matching",
+			method:       http.MethodDelete,
+			path:         "/api/core/v2/namespaces/default/checks/check-b",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "create a matching object",
+			method:       http.MethodPost,
+			path:         "/api/core/v2/namespaces/default/checks",
+			body:         `{"metadata":{"name":"new","labels":{"team":"a"}}}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "create an object not matching",
+			method:       http.MethodPost,
+			path:         "/api/core/v2/namespaces/default/checks",
+			body:         `{"metadata":{"name":"new"}}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "update the labels of a matching object",
+			method:       http.MethodPut,
+			path:         "/api/core/v2/namespaces/default/checks/check-a",
+			body:         `{"metadata":{"name":"check-a","labels":{"team":"b"}}}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "patch a matching object",
+			method:       http.MethodPatch,
+			path:         "/api/core/v2/namespaces/default/checks/check-a",
+			body:         `{"command":"true"}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "list",
+			method:       http.MethodGet,
+			path:         "/api/core/v2/namespaces/default/checks",
+			expectedCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, _ := ioutil.ReadAll(r.Body)
+				body = string(b)
+			})
+
+			w := httptest.NewRecorder()
+			r, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			claims := corev2.Claims{
+				StandardClaims: jwt.StandardClaims{Subject: "foo"},
+				Groups:         []string{"team-a"},
+			}
+			ctx := sensuJWT.SetClaimsIntoContext(r, &claims)
+
+			authorizationMiddleware := Authorization{Authorizer: &rbac.Authorizer{Store: st}, Store: st}
+			router := mux.NewRouter()
+			router.PathPrefix("/api/{group}/{version}/namespaces/{namespace}/{resource}/{id}").Handler(testHandler)
+			router.PathPrefix("/api/{group}/{version}/namespaces/{namespace}/{resource}").Handler(testHandler)
+			router.Use(Namespace{}.Then, AuthorizationAttributes{}.Then, authorizationMiddleware.Then)
+
+			router.ServeHTTP(w, r.WithContext(ctx))
+			assert.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+			if tt.expectedCode == http.StatusOK {
+				// The handler must still receive the whole body
+				assert.Equal(t, tt.body, body)
+			}
+		})
+	}
+}
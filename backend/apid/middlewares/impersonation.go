@@ -0,0 +1,110 @@
+package middlewares
+
+import (
+	"net/http"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ImpersonateUserHeader is the header used to impersonate a user.
+	ImpersonateUserHeader = "Impersonate-User"
+
+	// ImpersonateGroupHeader is the header used to impersonate a group. It
+	// can be repeated.
+	ImpersonateGroupHeader = "Impersonate-Group"
+)
+
+// impersonate replaces the claims of the authenticated user by those of the
+// user given by the impersonation headers, if the authenticated user is
+// allowed to impersonate that user and its groups. The user must exist and be
+// enabled. When no group is given, the groups of the stored user are
+// impersonated.
+func (a Authentication) impersonate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username := r.Header.Get(ImpersonateUserHeader)
+		groups := r.Header.Values(ImpersonateGroupHeader)
+		if username == "" && len(groups) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		claims := jwt.GetClaimsFromContext(ctx)
+		if claims == nil {
+			writeErr(w, actions.NewErrorf(actions.Unauthenticated, "impersonation requires authentication"))
+			return
+		}
+		if username == "" {
+			writeErr(w, actions.NewErrorf(actions.InvalidArgument, "the %s header is required to impersonate groups", ImpersonateUserHeader))
+			return
+		}
+		if a.Authorizer == nil {
+			writeErr(w, actions.NewErrorf(actions.PermissionDenied, "impersonation is not supported"))
+			return
+		}
+
+		// The impersonated user must exist and be enabled, even when its
+		// groups are given
+		user, err := a.Store.GetUser(ctx, username)
+		if err != nil {
+			writeErr(w, actions.NewError(actions.InternalErr, err))
+			return
+		}
+		if user == nil || user.Disabled {
+			writeErr(w, actions.NewErrorf(actions.InvalidArgument, "cannot impersonate the user %q", username))
+			return
+		}
+		if len(groups) == 0 {
+			groups = user.Groups
+		}
+
+		// The authenticated user must be allowed to impersonate the user and
+		// every group
+		caller := corev2.User{Username: claims.Subject, Groups: claims.Groups}
+		requests := []*authorization.Attributes{{
+			Verb:         corev2.VerbImpersonate,
+			Resource:     (&corev2.User{}).RBACName(),
+			ResourceName: username,
+			User:         caller,
+		}}
+		for _, group := range groups {
+			requests = append(requests, &authorization.Attributes{
+				Verb:         corev2.VerbImpersonate,
+				Resource:     corev2.GroupsResource,
+				ResourceName: group,
+				User:         caller,
+			})
+		}
+		for _, attrs := range requests {
+			authorized, err := a.Authorizer.Authorize(ctx, attrs)
+			if err != nil {
+				logger.WithError(err).Warning("unexpected error occurred during impersonation")
+				writeErr(w, actions.NewErrorf(actions.InternalErr, "unexpected error occurred during impersonation"))
+				return
+			}
+			if !authorized {
+				logger.WithFields(logrus.Fields{
+					"user":     claims.Subject,
+					"resource": attrs.Resource,
+					"name":     attrs.ResourceName,
+				}).Warning("impersonation denied")
+				writeErr(w, actions.NewErrorf(actions.PermissionDenied, "not allowed to impersonate %s %q", attrs.Resource, attrs.ResourceName))
+				return
+			}
+		}
+
+		// Authenticated users are part of the system:users group
+		impersonated := *claims
+		impersonated.Subject = username
+		impersonated.Groups = append(append([]string{}, groups...), "system:users")
+		impersonated.Provider = corev2.AuthProviderClaims{}
+		impersonated.Impersonator = claims.Subject
+
+		next.ServeHTTP(w, r.WithContext(jwt.SetClaimsIntoContext(r, &impersonated)))
+	})
+}
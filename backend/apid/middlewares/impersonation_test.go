@@ -0,0 +1,153 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/testing/mockauthorizer"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestImpersonation(t *testing.T) {
+	// admin can impersonate any user, and the dev group
+	allowed := func(attrs *authorization.Attributes) bool {
+		return attrs.User.Username == "admin" && attrs.Verb == corev2.VerbImpersonate &&
+			(attrs.Resource == "users" || (attrs.Resource == corev2.GroupsResource && attrs.ResourceName == "dev"))
+	}
+
+	tests := []struct {
+		name             string
+		authorizer       bool
+		unauthenticated  bool
+		user             string
+		groups           []string
+		wantStatus       int
+		wantSubject      string
+		wantGroups       []string
+		wantImpersonator string
+	}{
+		{
+			name:        "no impersonation",
+			authorizer:  true,
+			wantStatus:  http.StatusOK,
+			wantSubject: "admin",
+			wantGroups:  []string{"cluster-admins"},
+		},
+		{
+			name:             "groups of the stored user",
+			authorizer:       true,
+			user:             "bob",
+			wantStatus:       http.StatusOK,
+			wantSubject:      "bob",
+			wantGroups:       []string{"dev", "system:users"},
+			wantImpersonator: "admin",
+		},
+		{
+			name:             "explicit groups",
+			authorizer:       true,
+			user:             "carol",
+			groups:           []string{"dev"},
+			wantStatus:       http.StatusOK,
+			wantSubject:      "carol",
+			wantGroups:       []string{"dev", "system:users"},
+			wantImpersonator: "admin",
+		},
+		{
+			name:       "group not allowed",
+			authorizer: true,
+			user:       "carol",
+			groups:     []string{"dev", "ops"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "unknown user",
+			authorizer: true,
+			user:       "nobody",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "explicit groups of an unknown user",
+			authorizer: true,
+			user:       "nobody",
+			groups:     []string{"dev"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "explicit groups of a disabled user",
+			authorizer: true,
+			user:       "dave",
+			groups:     []string{"dev"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "groups without user",
+			authorizer: true,
+			groups:     []string{"dev"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "impersonation not supported",
+			user:       "bob",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:            "unauthenticated",
+			authorizer:      true,
+			unauthenticated: true,
+			user:            "bob",
+			wantStatus:      http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			store.On("GetUser", mock.Anything, "bob").Return(&corev2.User{Username: "bob", Groups: []string{"dev"}}, nil)
+			store.On("GetUser", mock.Anything, "carol").Return(&corev2.User{Username: "carol", Groups: []string{"ops"}}, nil)
+			store.On("GetUser", mock.Anything, "dave").Return(&corev2.User{Username: "dave", Disabled: true}, nil)
+			var nilUser *corev2.User
+			store.On("GetUser", mock.Anything, "nobody").Return(nilUser, nil)
+			mware := Authentication{IgnoreUnauthorized: true, Store: store}
+			if tt.authorizer {
+				authorizer := &mockauthorizer.Authorizer{}
+				authorizer.On("Authorize", mock.Anything, mock.MatchedBy(allowed)).Return(true, nil)
+				authorizer.On("Authorize", mock.Anything, mock.Anything).Return(false, nil)
+				mware.Authorizer = authorizer
+			}
+
+			var claims *corev2.Claims
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				claims = jwt.GetClaimsFromContext(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if !tt.unauthenticated {
+				_, token, _ := jwt.AccessToken(corev2.FixtureClaims("admin", []string{"cluster-admins"}))
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			}
+			if tt.user != "" {
+				req.Header.Set(ImpersonateUserHeader, tt.user)
+			}
+			for _, group := range tt.groups {
+				req.Header.Add(ImpersonateGroupHeader, group)
+			}
+			w := httptest.NewRecorder()
+			mware.Then(handler).ServeHTTP(w, req)
+
+			if !assert.Equal(t, tt.wantStatus, w.Code, w.Body.String()) || tt.wantStatus != http.StatusOK {
+				return
+			}
+			if assert.NotNil(t, claims) {
+				assert.Equal(t, tt.wantSubject, claims.Subject)
+				assert.Equal(t, tt.wantGroups, claims.Groups)
+				assert.Equal(t, tt.wantImpersonator, claims.Impersonator)
+			}
+		})
+	}
+}
@@ -25,10 +25,11 @@ func (m SimpleLogger) Then(next http.Handler) http.Handler {
 		writerWithCapture := makeResponseWriterWithCapture(w)
 		next.ServeHTTP(writerWithCapture, r)
 
-		var user string
+		var user, impersonator string
 		claims := jwt.GetClaimsFromContext(r.Context())
 		if claims != nil {
 			user = claims.StandardClaims.Subject
+			impersonator = claims.Impersonator
 		}
 
 		duration := float64(time.Since(start)) / float64(time.Millisecond)
@@ -40,6 +41,9 @@ func (m SimpleLogger) Then(next http.Handler) http.Handler {
 			"method":   r.Method,
 			"user":     user,
 		})
+		if impersonator != "" {
+			logEntry = logEntry.WithField("impersonator", impersonator)
+		}
 		logEntry.Info("request completed")
 	})
 }
@@ -0,0 +1,109 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/quota"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// ResourceQuota is an HTTP middleware that rejects the creation of resources
+// that would exceed the resource quotas of their namespace. It must be
+// executed after the AuthorizationAttributes middleware.
+type ResourceQuota struct {
+	Enforcer *quota.Enforcer
+	Store    store.Store
+}
+
+// Then middleware
+func (q ResourceQuota) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if q.Enforcer == nil || (r.Method != http.MethodPost && r.Method != http.MethodPut) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		attrs := authorization.GetAttributes(ctx)
+		if attrs == nil || attrs.Namespace == "" || !isQuotaResource(attrs.Resource) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		err := q.Enforcer.Check(ctx, attrs.Namespace, attrs.Resource)
+		if err == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var exceeded *quota.ExceededError
+		if !errors.As(err, &exceeded) {
+			logger.WithError(err).Warning("unexpected error occurred during resource quota enforcement")
+			writeErr(w, actions.NewErrorf(
+				actions.InternalErr,
+				"unexpected error occurred during resource quota enforcement",
+			))
+			return
+		}
+
+		// Replacing an existing resource does not increase the usage
+		if r.Method == http.MethodPut && attrs.ResourceName != "" {
+			found, err := q.exists(ctx, attrs.Resource, attrs.ResourceName)
+			if err != nil {
+				writeErr(w, actions.NewError(actions.InternalErr, err))
+				return
+			}
+			if found {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		writeErr(w, actions.NewError(actions.QuotaExceeded, err))
+	})
+}
+
+func isQuotaResource(resource string) bool {
+	for _, r := range corev2.QuotaResources {
+		if r == resource && r != corev2.EventsPerMinuteQuota {
+			return true
+		}
+	}
+	return false
+}
+
+// exists returns whether the resource of the given type and name exists in
+// the namespace of the context.
+func (q ResourceQuota) exists(ctx context.Context, resource, name string) (bool, error) {
+	var (
+		found bool
+		err   error
+	)
+	switch resource {
+	case corev2.EntitiesResource:
+		var entity *corev2.Entity
+		entity, err = q.Store.GetEntityByName(ctx, name)
+		found = entity != nil
+	case corev2.ChecksResource:
+		var check *corev2.CheckConfig
+		check, err = q.Store.GetCheckConfigByName(ctx, name)
+		found = check != nil
+	case corev2.SilencedResource:
+		var silenced *corev2.Silenced
+		silenced, err = q.Store.GetSilencedEntryByName(ctx, name)
+		found = silenced != nil
+	case corev2.AssetsResource:
+		var asset *corev2.Asset
+		asset, err = q.Store.GetAssetByName(ctx, name)
+		found = asset != nil
+	}
+	if _, ok := err.(*store.ErrNotFound); ok {
+		return false, nil
+	}
+	return found, err
+}
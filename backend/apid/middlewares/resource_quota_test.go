@@ -0,0 +1,105 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/quota"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+type quotaCounter map[string]int64
+
+func (c quotaCounter) Count(ctx context.Context, namespace, resource string) (int64, error) {
+	return c[resource], nil
+}
+
+func TestResourceQuota(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		resource   string
+		id         string
+		storeFunc  func(*mockstore.MockStore)
+		wantStatus int
+	}{
+		{
+			name:       "under the limit",
+			method:     http.MethodPost,
+			resource:   corev2.EntitiesResource,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "limit reached",
+			method:     http.MethodPost,
+			resource:   corev2.ChecksResource,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:     "replacing an existing resource",
+			method:   http.MethodPut,
+			resource: corev2.ChecksResource,
+			id:       "check-cpu",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetCheckConfigByName", mock.Anything, "check-cpu").Return(corev2.FixtureCheckConfig("check-cpu"), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:     "creating a resource with put",
+			method:   http.MethodPut,
+			resource: corev2.ChecksResource,
+			id:       "check-cpu",
+			storeFunc: func(s *mockstore.MockStore) {
+				var check *corev2.CheckConfig
+				s.On("GetCheckConfigByName", mock.Anything, "check-cpu").Return(check, nil)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "reads are not limited",
+			method:     http.MethodGet,
+			resource:   corev2.ChecksResource,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "resource not limited",
+			method:     http.MethodPost,
+			resource:   corev2.HandlersResource,
+			wantStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockstore.MockStore{}
+			s.On("ListResources", mock.Anything, "resourcequotas", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				list := args[2].(*[]*corev2.ResourceQuota)
+				*list = []*corev2.ResourceQuota{corev2.FixtureResourceQuota("limits", "default")}
+			}).Return(nil)
+			if tt.storeFunc != nil {
+				tt.storeFunc(s)
+			}
+			counter := quotaCounter{corev2.EntitiesResource: 10, corev2.ChecksResource: 100}
+			mware := ResourceQuota{Enforcer: quota.NewEnforcer(s, counter), Store: s}
+
+			attrs := &authorization.Attributes{
+				Namespace:    "default",
+				Resource:     tt.resource,
+				ResourceName: tt.id,
+			}
+			req, _ := http.NewRequest(tt.method, "/", nil)
+			req = req.WithContext(authorization.SetAttributes(req.Context(), attrs))
+			w := httptest.NewRecorder()
+			mware.Then(testHandler()).ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
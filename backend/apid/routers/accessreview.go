@@ -0,0 +1,141 @@
+package routers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/authorization/rbac"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// Query parameters of the access review routes.
+const (
+	AccessReviewUserParam         = "user"
+	AccessReviewGroupParam        = "group"
+	AccessReviewVerbParam         = "verb"
+	AccessReviewResourceParam     = "resource"
+	AccessReviewResourceNameParam = "name"
+	AccessReviewNamespaceParam    = "namespace"
+	AccessReviewLabelParam        = "label"
+)
+
+// AccessReviewRouter handles requests for /accessreview and /permissions,
+// which review the permissions of the current user, or of the user given by
+// the user and group query parameters.
+type AccessReviewRouter struct {
+	authorizer *rbac.Authorizer
+	store      store.Store
+}
+
+// NewAccessReviewRouter instantiates a new router for reviewing permissions.
+func NewAccessReviewRouter(store store.Store) *AccessReviewRouter {
+	return &AccessReviewRouter{
+		authorizer: &rbac.Authorizer{Store: store},
+		store:      store,
+	}
+}
+
+// Mount the AccessReviewRouter to a parent Router
+func (r *AccessReviewRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/{resource:"+corev2.AccessReviewResource+"}", actionHandler(r.review)).Methods(http.MethodGet)
+	parent.HandleFunc("/{resource:"+corev2.PermissionsResource+"}", actionHandler(r.permissions)).Methods(http.MethodGet)
+}
+
+// review determines whether the user is allowed to perform the request given
+// by the query parameters.
+func (r *AccessReviewRouter) review(req *http.Request) (interface{}, error) {
+	query := req.URL.Query()
+	attrs := &authorization.Attributes{
+		Verb:         query.Get(AccessReviewVerbParam),
+		Resource:     query.Get(AccessReviewResourceParam),
+		ResourceName: query.Get(AccessReviewResourceNameParam),
+		Namespace:    query.Get(AccessReviewNamespaceParam),
+	}
+	if attrs.Verb == "" || attrs.Resource == "" {
+		return nil, actions.NewErrorf(actions.InvalidArgument, "the verb and resource must be specified")
+	}
+
+	// The labels of the object, given as key=value, are checked against the
+	// label selectors of the rules
+	var labels map[string]string
+	for _, label := range query[AccessReviewLabelParam] {
+		parts := strings.SplitN(label, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, actions.NewErrorf(actions.InvalidArgument, "invalid label %q, must be key=value", label)
+		}
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[parts[0]] = parts[1]
+	}
+	if labels != nil {
+		attrs.Labels = func() ([]map[string]string, error) {
+			return []map[string]string{labels}, nil
+		}
+	}
+
+	user, err := r.subject(req)
+	if err != nil {
+		return nil, err
+	}
+	attrs.User = *user
+
+	review, err := r.authorizer.Review(req.Context(), attrs)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	review.Labels = labels
+	return review, nil
+}
+
+// permissions returns the effective permissions of the user, optionally
+// limited to a namespace.
+func (r *AccessReviewRouter) permissions(req *http.Request) (interface{}, error) {
+	user, err := r.subject(req)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := req.URL.Query().Get(AccessReviewNamespaceParam)
+	permissions, err := r.authorizer.Permissions(req.Context(), *user, namespace)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	return permissions, nil
+}
+
+// subject returns the user whose permissions are reviewed. It is the user given
+// by the query parameters, if any, or the current user. When a username is
+// given without groups, the groups of the stored user are used.
+func (r *AccessReviewRouter) subject(req *http.Request) (*corev2.User, error) {
+	query := req.URL.Query()
+	username := query.Get(AccessReviewUserParam)
+	groups := query[AccessReviewGroupParam]
+
+	if username == "" && len(groups) == 0 {
+		claims := jwt.GetClaimsFromContext(req.Context())
+		if claims == nil {
+			return nil, actions.NewErrorf(actions.Unauthenticated)
+		}
+		return &corev2.User{Username: claims.Subject, Groups: claims.Groups}, nil
+	}
+
+	if username != "" && len(groups) == 0 {
+		user, err := r.store.GetUser(req.Context(), username)
+		if err != nil {
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+		if user == nil {
+			return nil, actions.NewErrorf(actions.NotFound, "user %q not found", username)
+		}
+		// Authenticated users are part of the system:users group
+		groups = append(append([]string{}, user.Groups...), "system:users")
+	}
+
+	return &corev2.User{Username: username, Groups: groups}, nil
+}
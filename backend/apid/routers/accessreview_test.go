@@ -0,0 +1,193 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+func accessReviewStore() *mockstore.MockStore {
+	s := &mockstore.MockStore{}
+	s.On("ListClusterRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.ClusterRoleBinding{{
+			ObjectMeta: corev2.NewObjectMeta("viewers", ""),
+			RoleRef:    corev2.RoleRef{Type: "ClusterRole", Name: "view"},
+			Subjects:   []corev2.Subject{{Type: corev2.GroupType, Name: "ops"}},
+		}}, nil)
+	s.On("ListRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.RoleBinding{}, nil)
+	s.On("GetClusterRole", mock.Anything, "view").
+		Return(&corev2.ClusterRole{Rules: []corev2.Rule{
+			{Verbs: []string{"get", "list"}, Resources: []string{"*"}},
+		}}, nil)
+	s.On("GetUser", mock.Anything, "bob").
+		Return(&corev2.User{Username: "bob", Groups: []string{"ops"}}, nil)
+	var nilUser *corev2.User
+	s.On("GetUser", mock.Anything, "nobody").Return(nilUser, nil)
+	return s
+}
+
+func TestAccessReviewRouter(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		wantStatus  int
+		wantAllowed bool
+		wantUser    string
+	}{
+		{
+			name:        "current user is allowed",
+			path:        "/accessreview?verb=get&resource=checks&namespace=default",
+			wantStatus:  http.StatusOK,
+			wantAllowed: true,
+			wantUser:    "alice",
+		},
+		{
+			name:       "current user is denied",
+			path:       "/accessreview?verb=delete&resource=checks&namespace=default",
+			wantStatus: http.StatusOK,
+			wantUser:   "alice",
+		},
+		{
+			name:        "stored user",
+			path:        "/accessreview?verb=list&resource=events&namespace=default&user=bob",
+			wantStatus:  http.StatusOK,
+			wantAllowed: true,
+			wantUser:    "bob",
+		},
+		{
+			name:       "user and groups",
+			path:       "/accessreview?verb=list&resource=events&namespace=default&user=carol&group=dev",
+			wantStatus: http.StatusOK,
+			wantUser:   "carol",
+		},
+		{
+			name:       "unknown user",
+			path:       "/accessreview?verb=list&resource=events&user=nobody",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "invalid label",
+			path:       "/accessreview?verb=get&resource=checks&namespace=default&label=team",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing verb",
+			path:       "/accessreview?resource=events",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := mux.NewRouter()
+			NewAccessReviewRouter(accessReviewStore()).Mount(router)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			claims := &corev2.Claims{Groups: []string{"ops"}}
+			claims.Subject = "alice"
+			req = req.WithContext(jwt.SetClaimsIntoContext(req, claims))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var review corev2.AccessReview
+			if err := json.Unmarshal(w.Body.Bytes(), &review); err != nil {
+				t.Fatal(err)
+			}
+			if review.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", review.Allowed, tt.wantAllowed)
+			}
+			if review.User != tt.wantUser {
+				t.Errorf("User = %q, want %q", review.User, tt.wantUser)
+			}
+			if review.Allowed && (review.Binding == nil || review.Binding.Name != "viewers") {
+				t.Errorf("unexpected binding: %+v", review.Binding)
+			}
+		})
+	}
+}
+
+func TestPermissionsRouter(t *testing.T) {
+	router := mux.NewRouter()
+	NewAccessReviewRouter(accessReviewStore()).Mount(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/permissions?user=bob", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", w.Code, w.Body.String())
+	}
+	var permissions corev2.EffectivePermissions
+	if err := json.Unmarshal(w.Body.Bytes(), &permissions); err != nil {
+		t.Fatal(err)
+	}
+	if permissions.User != "bob" || len(permissions.Permissions) != 1 {
+		t.Fatalf("unexpected permissions: %+v", permissions)
+	}
+	if got := permissions.Permissions[0].Binding.RoleRef.Name; got != "view" {
+		t.Errorf("role = %q, want view", got)
+	}
+}
+
+func TestAccessReviewRouterLabels(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("ListClusterRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.ClusterRoleBinding{{
+			ObjectMeta: corev2.NewObjectMeta("dev-viewers", ""),
+			RoleRef:    corev2.RoleRef{Type: "ClusterRole", Name: "dev-view"},
+			Subjects:   []corev2.Subject{{Type: corev2.GroupType, Name: "ops"}},
+		}}, nil)
+	s.On("ListRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.RoleBinding{}, nil)
+	s.On("GetClusterRole", mock.Anything, "dev-view").
+		Return(&corev2.ClusterRole{Rules: []corev2.Rule{
+			{Verbs: []string{"get"}, Resources: []string{"checks"}, LabelSelector: map[string]string{"team": "dev"}},
+		}}, nil)
+
+	tests := []struct {
+		path        string
+		wantAllowed bool
+	}{
+		{path: "/accessreview?verb=get&resource=checks&namespace=default"},
+		{path: "/accessreview?verb=get&resource=checks&namespace=default&label=team=ops"},
+		{path: "/accessreview?verb=get&resource=checks&namespace=default&label=team=dev&label=region=eu", wantAllowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			router := mux.NewRouter()
+			NewAccessReviewRouter(s).Mount(router)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			claims := &corev2.Claims{Groups: []string{"ops"}}
+			claims.Subject = "alice"
+			req = req.WithContext(jwt.SetClaimsIntoContext(req, claims))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d: %s", w.Code, w.Body.String())
+			}
+			var review corev2.AccessReview
+			if err := json.Unmarshal(w.Body.Bytes(), &review); err != nil {
+				t.Fatal(err)
+			}
+			if review.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", review.Allowed, tt.wantAllowed)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// AggregatesRouter handles requests for /aggregates
+type AggregatesRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewAggregatesRouter instantiates new router for controlling aggregate resources
+func NewAggregatesRouter(store store.ResourceStore) *AggregatesRouter {
+	return &AggregatesRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.Aggregate{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the AggregatesRouter to a parent Router
+func (r *AggregatesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:aggregates}",
+	}
+
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.AggregateFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:aggregates}", corev2.AggregateFields)
+	routes.Patch(r.handlers.PatchResource)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Del(r.handlers.DeleteResource)
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/store"
 )
 
@@ -44,7 +46,8 @@ func (r *APIKeysRouter) Mount(parent *mux.Router) {
 	routes.Get(r.handlers.GetResource)
 	routes.List(r.handlers.ListResources, corev2.APIKeyFields)
 	parent.HandleFunc(routes.PathPrefix, r.create).Methods(http.MethodPost)
-	routes.Patch(r.handlers.PatchResource)
+	routes.Patch(r.patch)
+	routes.Put(r.createOrReplace)
 }
 
 func (r *APIKeysRouter) create(w http.ResponseWriter, req *http.Request) {
@@ -63,14 +66,28 @@ func (r *APIKeysRouter) create(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	// set/overwrite the key id and created_at time
+	// a key created with a scoped key can't go beyond that scope
+	apikey.NormalizeRules()
+	if err := restrictAPIKey(req, apikey); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	now := time.Now()
+	if apikey.IsExpired(now) {
+		http.Error(w, errors.New("expiry must be in the future").Error(), http.StatusBadRequest)
+		return
+	}
+
+	// set/overwrite the key id, created_at and last_used_at times
 	key, err := uuid.NewRandom()
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 	apikey.Name = key.String()
-	apikey.CreatedAt = time.Now().Unix()
+	apikey.CreatedAt = now.Unix()
+	apikey.LastUsedAt = 0
 	newBytes, err := json.Marshal(apikey)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -88,3 +105,72 @@ func (r *APIKeysRouter) create(w http.ResponseWriter, req *http.Request) {
 	w.Header().Set("Location", fmt.Sprintf("%s/%s", req.URL.String(), apikey.Name))
 	w.WriteHeader(http.StatusCreated)
 }
+
+// createOrReplace stores an API key under its existing name, so that keys can
+// be restored from a backup without being reissued. The name of an API key is
+// its secret, so only the random names issued by create are accepted, and the
+// key of another user can't be replaced.
+func (r *APIKeysRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	apikey := &corev2.APIKey{}
+	if err := json.Unmarshal(body, apikey); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	// the key must have been issued by create, with a random uuid
+	if key, err := uuid.Parse(apikey.Name); err != nil || key.Version() != 4 || key.Variant() != uuid.RFC4122 {
+		return nil, actions.NewErrorf(actions.InvalidArgument, "api key name must be a random uuid issued by the backend")
+	}
+
+	// an existing key can only be replaced for the same user
+	ctx := store.NamespaceContext(req.Context(), "")
+	existing := &corev2.APIKey{}
+	if err := r.store.GetResource(ctx, apikey.Name, existing); err == nil {
+		if existing.Username != apikey.Username {
+			return nil, actions.NewErrorf(actions.PermissionDenied, "api key belongs to another user")
+		}
+	} else if _, ok := err.(*store.ErrNotFound); !ok {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+
+	// validate that the user exists
+	if user, err := r.store.GetUser(req.Context(), apikey.Username); err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	} else if user == nil {
+		return nil, actions.NewErrorf(actions.InvalidArgument, "user does not exist")
+	}
+
+	// a key stored with a scoped key can't go beyond that scope
+	apikey.NormalizeRules()
+	if err := restrictAPIKey(req, apikey); err != nil {
+		return nil, actions.NewError(actions.PermissionDenied, err)
+	}
+	if body, err = json.Marshal(apikey); err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return r.handlers.CreateOrUpdateResource(req)
+}
+
+// patch patches an API key. The scope of an API key can't be verified after
+// a patch, so API keys can't be patched with a scoped API key.
+func (r *APIKeysRouter) patch(req *http.Request) (interface{}, error) {
+	if claims := jwt.GetClaimsFromContext(req.Context()); claims != nil && claims.Scope != nil {
+		return nil, actions.NewErrorf(actions.PermissionDenied, "api keys can't be patched with a scoped api key")
+	}
+	return r.handlers.PatchResource(req)
+}
+
+// restrictAPIKey restricts the API key to the scope of the API key
+// authenticating the request, if any.
+func restrictAPIKey(req *http.Request, apikey *corev2.APIKey) error {
+	claims := jwt.GetClaimsFromContext(req.Context())
+	if claims == nil {
+		return nil
+	}
+	return apikey.RestrictTo(claims.Scope)
+}
@@ -2,13 +2,16 @@ package routers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -63,6 +66,38 @@ func TestPostAPIKey(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, res.StatusCode)
 }
 
+func TestPostAPIKeyExpired(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("CreateResource", mock.Anything, mock.Anything).Return(nil, nil)
+	s.On("GetUser", mock.Anything, mock.Anything).Return(corev2.FixtureUser("admin"), nil)
+	router := NewAPIKeysRouter(s)
+	parentRouter := mux.NewRouter()
+	router.Mount(parentRouter)
+	server := httptest.NewServer(parentRouter)
+	defer server.Close()
+
+	// Prepare the HTTP request
+	fixture := corev2.FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "admin")
+	fixture.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+	payload, err := json.Marshal(fixture)
+	assert.NoError(t, err)
+	client := new(http.Client)
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/apikeys", bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Perform the HTTP request
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	s.AssertNotCalled(t, "CreateResource", mock.Anything, mock.Anything)
+}
+
 func TestPostAPIKeyInvalidUser(t *testing.T) {
 	s := &mockstore.MockStore{}
 	var user *corev2.User
@@ -93,3 +128,128 @@ func TestPostAPIKeyInvalidUser(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
 }
+
+func TestPutAPIKey(t *testing.T) {
+	const name = "226f9e06-9d54-45c6-a9f6-4206bfa7ccf6"
+	tests := []struct {
+		name           string
+		key            string
+		user           *corev2.User
+		existing       *corev2.APIKey
+		wantStatusCode int
+	}{
+		{
+			name:           "the key is stored under its name",
+			key:            name,
+			user:           corev2.FixtureUser("admin"),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "the key of the same user is replaced",
+			key:            name,
+			user:           corev2.FixtureUser("admin"),
+			existing:       corev2.FixtureAPIKey(name, "admin"),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "the key of another user is not replaced",
+			key:            name,
+			user:           corev2.FixtureUser("admin"),
+			existing:       corev2.FixtureAPIKey(name, "alice"),
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "the name must be a random uuid",
+			key:            "00000000-0000-0000-0000-000000000000",
+			user:           corev2.FixtureUser("admin"),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "the user must exist",
+			key:            name,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockstore.MockStore{}
+			s.On("GetUser", mock.Anything, "admin").Return(tt.user, nil)
+			getCall := s.On("GetResource", mock.Anything, tt.key, mock.AnythingOfType("*v2.APIKey"))
+			if tt.existing != nil {
+				getCall.Run(func(args mock.Arguments) {
+					*args[2].(*corev2.APIKey) = *tt.existing
+				}).Return(nil)
+			} else {
+				getCall.Return(&store.ErrNotFound{Key: tt.key})
+			}
+			s.On("CreateOrUpdateResource", mock.Anything, mock.MatchedBy(func(key *corev2.APIKey) bool {
+				return key.Name == tt.key
+			})).Return(nil)
+			router := NewAPIKeysRouter(s)
+			parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+			router.Mount(parentRouter)
+
+			fixture := corev2.FixtureAPIKey(tt.key, "admin")
+			payload, err := json.Marshal(fixture)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPut, fixture.URIPath(), bytes.NewReader(payload))
+			rr := httptest.NewRecorder()
+			parentRouter.ServeHTTP(rr, req)
+			assert.Equal(t, tt.wantStatusCode, rr.Code, rr.Body.String())
+			if tt.wantStatusCode != http.StatusCreated {
+				s.AssertNotCalled(t, "CreateOrUpdateResource", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestPostAPIKeyScoped(t *testing.T) {
+	var created *corev2.APIKey
+	s := &mockstore.MockStore{}
+	s.On("CreateResource", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = args[1].(*corev2.APIKey)
+	}).Return(nil, nil)
+	s.On("GetUser", mock.Anything, mock.Anything).Return(corev2.FixtureUser("admin"), nil)
+	router := NewAPIKeysRouter(s)
+	parentRouter := mux.NewRouter()
+	router.Mount(parentRouter)
+
+	// The request is authenticated with a scoped api key
+	claims := corev2.FixtureClaims("admin", nil)
+	claims.Scope = &corev2.APIKeyScope{
+		Namespaces: []string{"dev"},
+		Rules: []corev2.Rule{
+			{Verbs: []string{"create"}, Resources: []string{"apikeys"}},
+			{Verbs: []string{"get", "list"}, Resources: []string{corev2.ResourceAll}},
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), corev2.ClaimsKey, claims)
+		parentRouter.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	defer server.Close()
+
+	post := func(key *corev2.APIKey) int {
+		payload, err := json.Marshal(key)
+		assert.NoError(t, err)
+		res, err := http.Post(server.URL+"/apikeys", "application/json", bytes.NewReader(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		return res.StatusCode
+	}
+
+	// A key without restrictions inherits the scope
+	fixture := corev2.FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "admin")
+	assert.Equal(t, http.StatusCreated, post(fixture))
+	if assert.NotNil(t, created) {
+		assert.Equal(t, claims.Scope.Namespaces, created.Namespaces)
+		assert.Equal(t, claims.Scope.Rules, created.Rules)
+	}
+
+	// A key going beyond the scope is rejected
+	fixture.Rules = []corev2.Rule{{Verbs: []string{"get,delete"}, Resources: []string{"checks"}}}
+	assert.Equal(t, http.StatusForbidden, post(fixture))
+	s.AssertNumberOfCalls(t, "CreateResource", 1)
+}
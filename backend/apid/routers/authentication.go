@@ -3,6 +3,7 @@ package routers
 import (
 	"context"
 	"encoding/json"
+	"net"
 	"net/http"
 
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
@@ -10,6 +11,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/api"
 	"github.com/sensu/sensu-go/backend/authentication"
+	"github.com/sensu/sensu-go/backend/authentication/session"
 	"github.com/sensu/sensu-go/backend/store"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -47,7 +49,11 @@ func (a *AuthenticationRouter) login(w http.ResponseWriter, r *http.Request) {
 	// issuer URL
 	ctx := context.WithValue(r.Context(), jwt.IssuerURLKey, issuerURL(r))
 
-	client := api.NewAuthenticationClient(a.authenticator)
+	// Record the client in the session
+	ctx = context.WithValue(ctx, jwt.ClientIPKey, clientIP(r))
+	ctx = context.WithValue(ctx, jwt.UserAgentKey, r.UserAgent())
+
+	client := api.NewAuthenticationClient(a.authenticator, a.store)
 	tokens, err := client.CreateAccessToken(ctx, username, password)
 	if err != nil {
 		if err == corev2.ErrUnauthorized {
@@ -76,8 +82,11 @@ func (a *AuthenticationRouter) test(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	client := api.NewAuthenticationClient(a.authenticator)
-	err := client.TestCreds(r.Context(), username, password)
+	// Count the failed attempts of the source IP, like logins do
+	ctx := context.WithValue(r.Context(), jwt.ClientIPKey, clientIP(r))
+
+	client := api.NewAuthenticationClient(a.authenticator, a.store)
+	err := client.TestCreds(ctx, username, password)
 	if err == nil {
 		return
 	}
@@ -90,7 +99,7 @@ func (a *AuthenticationRouter) test(w http.ResponseWriter, r *http.Request) {
 
 // logout handles the logout flow
 func (a *AuthenticationRouter) logout(w http.ResponseWriter, r *http.Request) {
-	client := api.NewAuthenticationClient(a.authenticator)
+	client := api.NewAuthenticationClient(a.authenticator, a.store)
 	if err := client.Logout(r.Context()); err == nil {
 		return
 	}
@@ -100,7 +109,7 @@ func (a *AuthenticationRouter) logout(w http.ResponseWriter, r *http.Request) {
 
 // token handles logic for issuing new access tokens
 func (a *AuthenticationRouter) token(w http.ResponseWriter, r *http.Request) {
-	client := api.NewAuthenticationClient(a.authenticator)
+	client := api.NewAuthenticationClient(a.authenticator, a.store)
 
 	// Determine the URL that serves this request so it can be later used as the
 	// issuer URL
@@ -113,6 +122,11 @@ func (a *AuthenticationRouter) token(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if err == session.ErrSessionRevoked {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
 		logger.WithError(err).Info("unexpected error while authorizing refresh token")
 		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
 		return
@@ -136,3 +150,12 @@ func issuerURL(r *http.Request) string {
 	}
 	return issuerURL
 }
+
+// clientIP returns the address of the client, without its port.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/authentication/providers/basic"
 	realStore "github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockstore"
@@ -52,13 +53,30 @@ func TestLoginSuccessful(t *testing.T) {
 	store.
 		On("AuthenticateUser", mock.Anything, "foo", "P@ssw0rd!").
 		Return(user, nil)
+	var session *corev2.Session
+	store.
+		On("CreateResource", mock.Anything, mock.AnythingOfType("*v2.Session")).
+		Run(func(args mock.Arguments) { session = args[1].(*corev2.Session) }).
+		Return(nil)
+	store.
+		On("ListResources", mock.Anything, corev2.SessionsResource, mock.Anything, mock.Anything).
+		Return(nil)
 
 	req, _ := http.NewRequest(http.MethodGet, "/auth", nil)
 	req.SetBasicAuth("foo", "P@ssw0rd!")
+	req.RemoteAddr = "10.0.0.1:4242"
+	req.Header.Set("User-Agent", "sensuctl")
 
 	res := processRequest(a, req)
 	assert.Equal(t, http.StatusOK, res.Code)
 
+	// The session records the client
+	if assert.NotNil(t, session) {
+		assert.Equal(t, "foo", session.Username)
+		assert.Equal(t, "10.0.0.1", session.ClientIP)
+		assert.Equal(t, "sensuctl", session.UserAgent)
+	}
+
 	// We should have the access token
 	body := res.Body.Bytes()
 	response := &types.Tokens{}
@@ -68,6 +86,12 @@ func TestLoginSuccessful(t *testing.T) {
 	assert.NotEmpty(t, response.Access)
 	assert.NotZero(t, response.ExpiresAt)
 	assert.NotEmpty(t, response.Refresh)
+
+	// The access token is bound to the session
+	token, err := jwt.ValidateToken(response.Access)
+	if assert.NoError(t, err) && session != nil {
+		assert.Equal(t, session.Name, token.Claims.(*corev2.Claims).SessionID)
+	}
 }
 
 func TestTestNoCredentials(t *testing.T) {
@@ -112,7 +136,10 @@ func TestTestSuccessful(t *testing.T) {
 	assert.Equal(t, http.StatusOK, res.Code)
 }
 
-func authenticationRouter(store realStore.Store) *AuthenticationRouter {
+func authenticationRouter(store *mockstore.MockStore) *AuthenticationRouter {
+	// No password policy is configured
+	store.On("GetResource", mock.Anything, corev2.DefaultPasswordPolicyName, mock.AnythingOfType("*v2.PasswordPolicy")).
+		Return(&realStore.ErrNotFound{})
 	authenticator := &authentication.Authenticator{}
 	provider := &basic.Provider{Store: store, ObjectMeta: corev2.ObjectMeta{Name: basic.Type}}
 	authenticator.AddProvider(provider)
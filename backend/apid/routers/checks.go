@@ -6,12 +6,16 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/ringv2"
+	"github.com/sensu/sensu-go/backend/schedulerd"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -27,16 +31,23 @@ type checkController interface {
 type ChecksRouter struct {
 	controller checkController
 	handlers   handlers.Handlers
+	previewer  *schedulerd.Previewer
+	auth       authorization.Authorizer
 }
 
 // NewChecksRouter instantiates new router for controlling check resources
-func NewChecksRouter(store store.Store, getter types.QueueGetter) *ChecksRouter {
+func NewChecksRouter(store store.Store, getter types.QueueGetter, pool *ringv2.RingPool, auth authorization.Authorizer) *ChecksRouter {
 	return &ChecksRouter{
+		auth:       auth,
 		controller: actions.NewCheckController(store, getter),
 		handlers: handlers.Handlers{
 			Resource: &corev2.CheckConfig{},
 			Store:    store,
 		},
+		previewer: &schedulerd.Previewer{
+			EntityStore: store,
+			RingPool:    pool,
+		},
 	}
 }
 
@@ -56,8 +67,9 @@ func (r *ChecksRouter) Mount(parent *mux.Router) {
 	routes.Put(r.handlers.CreateOrUpdateResource)
 
 	// Custom
-	routes.Path("{id}/hooks/{type}", r.addCheckHook).Methods(http.MethodPut)
-	routes.Path("{id}/hooks/{type}/hook/{hook}", r.removeCheckHook).Methods(http.MethodDelete)
+	routes.Path("{id}/hooks/{type}", rejectDryRun(r.addCheckHook)).Methods(http.MethodPut)
+	routes.Path("{id}/hooks/{type}/hook/{hook}", rejectDryRun(r.removeCheckHook)).Methods(http.MethodDelete)
+	routes.Path("{id}/schedule", r.schedule).Methods(http.MethodGet)
 
 	// handlefunc returns a custom status and response
 	parent.HandleFunc(path.Join(routes.PathPrefix, "{id}/execute"), r.adhocRequest).Methods(http.MethodPost)
@@ -97,6 +109,69 @@ func (r *ChecksRouter) removeCheckHook(req *http.Request) (interface{}, error) {
 	return nil, err
 }
 
+// schedule returns the upcoming executions of a check and the entities it
+// would currently be executed on, without executing it.
+func (r *ChecksRouter) schedule(req *http.Request) (interface{}, error) {
+	count := schedulerd.DefaultPreviewExecutions
+	if value := req.URL.Query().Get("executions"); value != "" {
+		var err error
+		count, err = strconv.Atoi(value)
+		if err != nil || count < 1 {
+			return nil, actions.NewErrorf(actions.InvalidArgument, "executions must be a positive integer")
+		}
+		if count > schedulerd.MaxPreviewExecutions {
+			return nil, actions.NewErrorf(actions.InvalidArgument, "executions must not exceed %d", schedulerd.MaxPreviewExecutions)
+		}
+	}
+
+	resource, err := r.handlers.GetResource(req)
+	if err != nil {
+		return nil, err
+	}
+	check, ok := resource.(*corev2.CheckConfig)
+	if !ok {
+		return nil, actions.NewErrorf(actions.InternalErr)
+	}
+	schedule, err := r.previewer.Preview(req.Context(), check, time.Now(), count)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	visible, err := r.entitiesVisible(req.Context())
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	if !visible {
+		// Only the number of entities is returned to the viewers who can't
+		// list them
+		schedule.Agents = nil
+		schedule.ProxyEntities = nil
+		schedule.RoundRobin = nil
+	}
+	return schedule, nil
+}
+
+// entitiesVisible returns whether the viewer is allowed to list all the
+// entities of the namespace, and therefore to see their names in a schedule
+// preview.
+func (r *ChecksRouter) entitiesVisible(ctx context.Context) (bool, error) {
+	attrs := authorization.GetAttributes(ctx)
+	if attrs == nil || r.auth == nil {
+		return false, nil
+	}
+	entityAttrs := *attrs
+	entityAttrs.Verb = "list"
+	entityAttrs.Resource = corev2.EntitiesResource
+	entityAttrs.ResourceName = ""
+	entityAttrs.LabelSelectors = nil
+	authorized, err := r.auth.Authorize(ctx, &entityAttrs)
+	if err != nil {
+		return false, err
+	}
+	// Label selectors restrict the entities the viewer can see, and they
+	// can't be evaluated against the names of the preview
+	return authorized && entityAttrs.LabelSelectors == nil, nil
+}
+
 func (r *ChecksRouter) adhocRequest(w http.ResponseWriter, req *http.Request) {
 	adhocReq := corev2.AdhocRequest{}
 	if err := UnmarshalBody(req, &adhocReq); err != nil {
@@ -7,12 +7,16 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/schedulerd"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockqueue"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/testing/testutil"
@@ -71,10 +75,13 @@ func TestHttpApiChecksAdhocRequest(t *testing.T) {
 func TestChecksRouter(t *testing.T) {
 	// Setup the router
 	s := &mockstore.MockStore{}
-	router := ChecksRouter{handlers: handlers.Handlers{
-		Resource: &corev2.CheckConfig{},
-		Store:    s,
-	}}
+	router := ChecksRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.CheckConfig{},
+			Store:    s,
+		},
+		previewer: &schedulerd.Previewer{EntityStore: s},
+	}
 	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
 	router.Mount(parentRouter)
 
@@ -87,11 +94,141 @@ func TestChecksRouter(t *testing.T) {
 	tests = append(tests, createTestCases(empty)...)
 	tests = append(tests, updateTestCases(fixture)...)
 	tests = append(tests, deleteTestCases(fixture)...)
+	tests = append(tests, []routerTestCase{
+		{
+			name:           "it returns 400 for an invalid number of executions",
+			method:         http.MethodGet,
+			path:           fixture.URIPath() + "/schedule?executions=-1",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "it returns 400 for too many executions",
+			method:         http.MethodGet,
+			path:           fixture.URIPath() + "/schedule?executions=101",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "it returns 404 when previewing a missing check",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/schedule",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.CheckConfig")).
+					Return(&store.ErrNotFound{}).
+					Once()
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "it returns 500 if the entities can't be fetched for the preview",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/schedule",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.CheckConfig")).
+					Run(func(args mock.Arguments) {
+						*args.Get(2).(*corev2.CheckConfig) = *fixture
+					}).
+					Return(nil).
+					Once()
+				s.On("GetEntities", mock.Anything, mock.Anything).
+					Return([]*corev2.Entity(nil), &store.ErrInternal{}).
+					Once()
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "it previews the check schedule",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/schedule?executions=3",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.CheckConfig")).
+					Run(func(args mock.Arguments) {
+						*args.Get(2).(*corev2.CheckConfig) = *fixture
+					}).
+					Return(nil).
+					Once()
+				s.On("GetEntities", mock.Anything, mock.Anything).
+					Return([]*corev2.Entity{corev2.FixtureEntity("entity")}, nil).
+					Once()
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}...)
 	for _, tt := range tests {
 		run(t, tt, parentRouter, s)
 	}
 }
 
+func TestChecksRouterScheduleEntities(t *testing.T) {
+	tests := []struct {
+		name       string
+		auth       authorization.Authorizer
+		wantAgents []string
+	}{
+		{
+			name:       "viewers allowed to list entities see their names",
+			auth:       resourceAuthorizer{"checks": "get", "entities": "list"},
+			wantAgents: []string{"entity"},
+		},
+		{
+			name: "viewers not allowed to list entities only see their number",
+			auth: resourceAuthorizer{"checks": "get", "entities": "get"},
+		},
+		{
+			name: "viewers without authorization only see the number of entities",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := corev2.FixtureCheckConfig("foo")
+			check.Subscriptions = []string{"linux"}
+			entity := corev2.FixtureEntity("entity")
+			entity.Subscriptions = []string{"linux"}
+
+			s := &mockstore.MockStore{}
+			s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.CheckConfig")).
+				Run(func(args mock.Arguments) {
+					*args.Get(2).(*corev2.CheckConfig) = *check
+				}).
+				Return(nil)
+			s.On("GetEntities", mock.Anything, mock.Anything).
+				Return([]*corev2.Entity{entity}, nil)
+			router := ChecksRouter{
+				handlers: handlers.Handlers{
+					Resource: &corev2.CheckConfig{},
+					Store:    s,
+				},
+				previewer: &schedulerd.Previewer{EntityStore: s},
+				auth:      tt.auth,
+			}
+
+			ctx := context.Background()
+			if tt.auth != nil {
+				ctx = authorization.SetAttributes(ctx, &authorization.Attributes{
+					APIGroup:     "core",
+					APIVersion:   "v2",
+					Namespace:    "default",
+					Resource:     "checks",
+					ResourceName: "foo",
+					Verb:         "get",
+				})
+			}
+			req := httptest.NewRequest(http.MethodGet, check.URIPath()+"/schedule", nil).WithContext(ctx)
+			req = mux.SetURLVars(req, map[string]string{"namespace": "default", "id": "foo"})
+			result, err := router.schedule(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			schedule := result.(*corev2.CheckSchedule)
+			if got, want := schedule.AgentCount, 1; got != want {
+				t.Errorf("bad agent count: got %d, want %d", got, want)
+			}
+			if !reflect.DeepEqual(schedule.Agents, tt.wantAgents) {
+				t.Errorf("bad agents: got %v, want %v", schedule.Agents, tt.wantAgents)
+			}
+		})
+	}
+}
+
 func TestChecksRouterCustomRoutes(t *testing.T) {
 	type controllerFunc func(*mockCheckController)
 
@@ -31,7 +31,9 @@ type EntityController interface {
 	Find(ctx context.Context, id string) (*corev2.Entity, error)
 	List(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error)
 	Create(ctx context.Context, entity corev2.Entity) error
+	ValidateCreate(ctx context.Context, entity corev2.Entity) error
 	CreateOrReplace(ctx context.Context, entity corev2.Entity) error
+	ValidateCreateOrReplace(ctx context.Context, entity corev2.Entity) error
 }
 
 // NewEntitiesRouter instantiates new router for controlling entities resources
@@ -62,7 +64,7 @@ func (r *EntitiesRouter) Mount(parent *mux.Router) {
 		EventStore:  r.eventStore,
 	}
 
-	routes.Del(deleter.Delete)
+	routes.Del(r.delete(deleter))
 	routes.Get(r.find)
 	routes.List(r.controller.List, corev2.EntityFields)
 	routes.ListAllNamespaces(r.controller.List, "/{resource:entities}", corev2.EntityFields)
@@ -80,16 +82,47 @@ func (r *EntitiesRouter) find(req *http.Request) (interface{}, error) {
 	return r.controller.Find(req.Context(), id)
 }
 
+// delete deletes the entity and its events, or only makes sure it exists on
+// dry runs.
+func (r *EntitiesRouter) delete(deleter actions.EntityDeleter) actionHandlerFunc {
+	return func(req *http.Request) (interface{}, error) {
+		dryRun, err := handlers.DryRun(req)
+		if err != nil {
+			return nil, err
+		}
+		if !dryRun {
+			return deleter.Delete(req)
+		}
+		id, err := url.PathUnescape(mux.Vars(req)["id"])
+		if err != nil {
+			return nil, actions.NewError(actions.InvalidArgument, err)
+		}
+		_, err = r.controller.Find(req.Context(), id)
+		return nil, err
+	}
+}
+
 func (r *EntitiesRouter) create(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	entity := corev2.Entity{}
 	if err := UnmarshalBody(req, &entity); err != nil {
 		return nil, err
 	}
-	err := r.controller.Create(req.Context(), entity)
+	if dryRun {
+		return entity, r.controller.ValidateCreate(req.Context(), entity)
+	}
+	err = r.controller.Create(req.Context(), entity)
 	return entity, err
 }
 
 func (r *EntitiesRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	entity := corev2.Entity{}
 	if err := UnmarshalBody(req, &entity); err != nil {
 		return nil, err
@@ -99,5 +132,8 @@ func (r *EntitiesRouter) createOrReplace(req *http.Request) (interface{}, error)
 		return nil, actions.NewError(actions.AlreadyExistsErr, errors.New("entity is managed by its agent"))
 	}
 
+	if dryRun {
+		return entity, r.controller.ValidateCreateOrReplace(req.Context(), entity)
+	}
 	return entity, r.controller.CreateOrReplace(req.Context(), entity)
 }
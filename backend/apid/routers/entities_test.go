@@ -2,6 +2,7 @@ package routers
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -36,6 +37,16 @@ func (m *mockEntitiesController) CreateOrReplace(ctx context.Context, entity cor
 	return args.Error(0)
 }
 
+func (m *mockEntitiesController) ValidateCreate(ctx context.Context, entity corev2.Entity) error {
+	args := m.Called(ctx, entity)
+	return args.Error(0)
+}
+
+func (m *mockEntitiesController) ValidateCreateOrReplace(ctx context.Context, entity corev2.Entity) error {
+	args := m.Called(ctx, entity)
+	return args.Error(0)
+}
+
 func TestEntitiesRouter(t *testing.T) {
 	// Setup the router
 	controller := new(mockEntitiesController)
@@ -72,3 +83,36 @@ func TestEntitiesRouter(t *testing.T) {
 		run(t, tt, parentRouter, s)
 	}
 }
+
+func TestEntitiesRouterDryRun(t *testing.T) {
+	controller := new(mockEntitiesController)
+	controller.On("Find", mock.Anything, "foo").Return(corev2.FixtureEntity("foo"), nil)
+	controller.On("ValidateCreateOrReplace", mock.Anything, mock.Anything).Return(nil)
+	s := new(mockstore.MockStore)
+	router := NewEntitiesRouter(s, new(storetest.Store), s)
+	router.controller = controller
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	fixture := corev2.FixtureEntity("foo")
+	tests := []routerTestCase{
+		{
+			name:           "it only looks up the entity to delete",
+			method:         http.MethodDelete,
+			path:           fixture.URIPath() + "?dryRun=true",
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name:           "it only validates the entity to update",
+			method:         http.MethodPut,
+			path:           fixture.URIPath() + "?dryRun=true",
+			body:           marshal(fixture),
+			wantStatusCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		run(t, tt, parentRouter, s)
+	}
+	controller.AssertNotCalled(t, "CreateOrReplace", mock.Anything, mock.Anything)
+	s.AssertNotCalled(t, "DeleteEntityByName", mock.Anything, mock.Anything)
+}
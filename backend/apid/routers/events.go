@@ -5,32 +5,43 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 )
 
 // EventsRouter handles requests for /events
 type EventsRouter struct {
-	controller eventController
+	controller   eventController
+	bus          messaging.MessageBus
+	auth         authorization.Authorizer
+	watchTimeout time.Duration
 }
 
 // eventController represents the controller needs of the EventsRouter.
 type eventController interface {
 	CreateOrReplace(ctx context.Context, check *corev2.Event) error
+	ValidateCreateOrReplace(ctx context.Context, check *corev2.Event) error
 	Delete(ctx context.Context, entity, check string) error
+	ValidateDelete(ctx context.Context, entity, check string) error
 	Get(ctx context.Context, entity, check string) (*corev2.Event, error)
 	List(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error)
 }
 
-// NewEventsRouter instantiates new events controller
-func NewEventsRouter(store store.EventStore, bus messaging.MessageBus) *EventsRouter {
+// NewEventsRouter instantiates new events controller. Event streams are ended
+// by the server after watchTimeout, if it is positive.
+func NewEventsRouter(store store.EventStore, bus messaging.MessageBus, auth authorization.Authorizer, watchTimeout time.Duration) *EventsRouter {
 	return &EventsRouter{
-		controller: actions.NewEventController(store, bus),
+		controller:   actions.NewEventController(store, bus),
+		bus:          bus,
+		auth:         auth,
+		watchTimeout: watchTimeout,
 	}
 }
 
@@ -41,6 +52,10 @@ func (r *EventsRouter) Mount(parent *mux.Router) {
 		PathPrefix: "/namespaces/{namespace}/{resource:events}",
 	}
 
+	// Stream events rather than listing them when watching
+	parent.HandleFunc(routes.PathPrefix, r.watch).Methods(http.MethodGet).Queries(WatchParam, "true")
+	parent.HandleFunc("/{resource:events}", r.watch).Methods(http.MethodGet).Queries(WatchParam, "true")
+
 	routes.Post(r.create)
 	routes.List(r.controller.List, corev2.EventFields)
 	routes.ListAllNamespaces(r.controller.List, "/{resource:events}", corev2.EventFields)
@@ -63,13 +78,24 @@ func (r *EventsRouter) get(req *http.Request) (interface{}, error) {
 }
 
 func (r *EventsRouter) delete(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	params := actions.QueryParams(mux.Vars(req))
 	entity := url.PathEscape(params["entity"])
 	check := url.PathEscape(params["check"])
+	if dryRun {
+		return nil, r.controller.ValidateDelete(req.Context(), entity, check)
+	}
 	return nil, r.controller.Delete(req.Context(), entity, check)
 }
 
 func (r *EventsRouter) create(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	event := &corev2.Event{}
 	if err := UnmarshalBody(req, event); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -80,11 +106,21 @@ func (r *EventsRouter) create(req *http.Request) (interface{}, error) {
 		return nil, err
 	}
 
-	err := r.controller.CreateOrReplace(req.Context(), event)
+	if dryRun {
+		if err := r.controller.ValidateCreateOrReplace(req.Context(), event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+	err = r.controller.CreateOrReplace(req.Context(), event)
 	return nil, err
 }
 
 func (r *EventsRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	event := &corev2.Event{}
 	if err := UnmarshalBody(req, event); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -95,7 +131,13 @@ func (r *EventsRouter) createOrReplace(req *http.Request) (interface{}, error) {
 		return nil, err
 	}
 
-	err := r.controller.CreateOrReplace(req.Context(), event)
+	if dryRun {
+		if err := r.controller.ValidateCreateOrReplace(req.Context(), event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+	err = r.controller.CreateOrReplace(req.Context(), event)
 	return nil, err
 }
 
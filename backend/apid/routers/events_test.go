@@ -27,6 +27,14 @@ func (m *mockEventController) Delete(ctx context.Context, entity, check string)
 	return m.Called(ctx, entity, check).Error(0)
 }
 
+func (m *mockEventController) ValidateCreateOrReplace(ctx context.Context, check *corev2.Event) error {
+	return m.Called(ctx, check).Error(0)
+}
+
+func (m *mockEventController) ValidateDelete(ctx context.Context, entity, check string) error {
+	return m.Called(ctx, entity, check).Error(0)
+}
+
 func (m *mockEventController) Get(ctx context.Context, entity, check string) (*corev2.Event, error) {
 	args := m.Called(ctx, entity, check)
 	return args.Get(0).(*corev2.Event), args.Error(1)
@@ -153,6 +161,18 @@ func TestEventsRouter(t *testing.T) {
 			},
 			wantStatusCode: http.StatusCreated,
 		},
+		{
+			name:   "it only validates the event to create on a dry run",
+			method: http.MethodPost,
+			path:   empty.URIPath() + "?dryRun=true",
+			body:   marshal(fixture),
+			controllerFunc: func(c *mockEventController) {
+				c.On("ValidateCreateOrReplace", mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		//
 		// PUT
 		//
@@ -377,6 +397,28 @@ func TestEventsRouter(t *testing.T) {
 			},
 			wantStatusCode: http.StatusNoContent,
 		},
+		{
+			name:   "it returns 404 if the event to delete on a dry run does not exist",
+			method: http.MethodDelete,
+			path:   fixture.URIPath() + "?dryRun=true",
+			controllerFunc: func(c *mockEventController) {
+				c.On("ValidateDelete", mock.Anything, "foo", "check-cpu").
+					Return(actions.NewErrorf(actions.NotFound)).
+					Once()
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "it only validates the event to delete on a dry run",
+			method: http.MethodDelete,
+			path:   fixture.URIPath() + "?dryRun=true",
+			controllerFunc: func(c *mockEventController) {
+				c.On("ValidateDelete", mock.Anything, "foo", "check-cpu").
+					Return(nil).
+					Once()
+			},
+			wantStatusCode: http.StatusNoContent,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
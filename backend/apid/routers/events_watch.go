@@ -0,0 +1,273 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/tracing"
+)
+
+const (
+	// WatchParam is the query parameter that turns an events listing into a
+	// stream of server-sent events. Only the events processed by the backend
+	// serving the request are streamed, since they are read from its message
+	// bus: the events processed by the other backends of a cluster are not.
+	WatchParam = "watch"
+
+	// WatchEventType is the server-sent event type of streamed events.
+	WatchEventType = "event"
+
+	// WatchDroppedType is the server-sent event type that reports the number of
+	// events dropped because the client could not keep up.
+	WatchDroppedType = "dropped"
+
+	// watchBufferSize is the number of events buffered for a client before
+	// events are dropped.
+	watchBufferSize = 100
+)
+
+// WatchDropped is the payload of the dropped server-sent event.
+type WatchDropped struct {
+	Count uint64 `json:"count"`
+}
+
+// eventWatcher receives events from the message bus. The bus blocks until its
+// subscribers receive a message, so events are relayed to a buffer and
+// dropped when the client is too slow to keep up.
+type eventWatcher struct {
+	receiver chan interface{}
+	events   chan *corev2.Event
+	dropped  uint64
+}
+
+func newEventWatcher() *eventWatcher {
+	return &eventWatcher{
+		receiver: make(chan interface{}),
+		events:   make(chan *corev2.Event, watchBufferSize),
+	}
+}
+
+// Receiver returns the channel the message bus sends events to.
+func (e *eventWatcher) Receiver() chan<- interface{} {
+	return e.receiver
+}
+
+// relay buffers the events that match the filter, until the receiver is
+// closed. It never blocks the message bus.
+func (e *eventWatcher) relay(ctx context.Context, filter *eventFilter) {
+	for msg := range e.receiver {
+		event, ok := msg.(*corev2.Event)
+		if !ok || !filter.Matches(ctx, event) {
+			continue
+		}
+		select {
+		case e.events <- tracing.StrippedEvent(event):
+		default:
+			atomic.AddUint64(&e.dropped, 1)
+		}
+	}
+}
+
+// eventFilter determines whether a watched event is sent to the client.
+type eventFilter struct {
+	namespace     string
+	fieldSelector *selector.Selector
+	labelSelector *selector.Selector
+	auth          authorization.Authorizer
+	attrs         *authorization.Attributes
+
+	// authorized caches the authorization decision of each namespace, it is
+	// only accessed by the relay of the watcher
+	authorized map[string]namespaceAuthorization
+}
+
+// namespaceAuthorization is the authorization to list the events of a
+// namespace. When the client is only allowed to list the events matching the
+// label selectors of its rules, selectors holds them.
+type namespaceAuthorization struct {
+	authorized bool
+	selectors  authorization.LabelSelectors
+}
+
+func newEventFilter(req *http.Request, auth authorization.Authorizer) (*eventFilter, error) {
+	filter := &eventFilter{
+		namespace:  corev2.ContextNamespace(req.Context()),
+		auth:       auth,
+		attrs:      authorization.GetAttributes(req.Context()),
+		authorized: map[string]namespaceAuthorization{},
+	}
+	query := req.URL.Query()
+	if s := query.Get("fieldSelector"); s != "" {
+		sel, err := selector.ParseFieldSelector(s)
+		if err != nil {
+			return nil, actions.NewError(actions.InvalidArgument, fmt.Errorf("invalid field selector: %s", err))
+		}
+		filter.fieldSelector = sel
+	}
+	if s := query.Get("labelSelector"); s != "" {
+		sel, err := selector.ParseLabelSelector(s)
+		if err != nil {
+			return nil, actions.NewError(actions.InvalidArgument, fmt.Errorf("invalid label selector: %s", err))
+		}
+		filter.labelSelector = sel
+	}
+	return filter, nil
+}
+
+// Matches returns whether the event is in the watched namespace, the client is
+// allowed to list events of its namespace, and the event matches the
+// selectors. When the client is only allowed to list the events matching the
+// label selectors of its rules, the event must match one of them too.
+func (f *eventFilter) Matches(ctx context.Context, event *corev2.Event) bool {
+	if f.namespace != "" && event.Namespace != f.namespace {
+		return false
+	}
+	auth := f.authorize(ctx, event.Namespace)
+	if !auth.authorized {
+		return false
+	}
+	if f.fieldSelector != nil && !f.fieldSelector.Matches(corev2.EventFields(event)) {
+		return false
+	}
+	// The label selectors of the rules match the labels of the event only,
+	// as they do when listing events
+	if auth.selectors != nil && !auth.selectors.Matches(event.Labels) {
+		return false
+	}
+	if f.labelSelector != nil && !f.labelSelector.Matches(eventLabels(event)) {
+		return false
+	}
+	return true
+}
+
+func (f *eventFilter) authorize(ctx context.Context, namespace string) namespaceAuthorization {
+	if f.auth == nil || f.attrs == nil {
+		return namespaceAuthorization{authorized: true}
+	}
+	if auth, ok := f.authorized[namespace]; ok {
+		return auth
+	}
+	attrs := *f.attrs
+	attrs.Namespace = namespace
+	attrs.Verb = "list"
+	attrs.Resource = corev2.EventsResource
+	attrs.ResourceName = ""
+	attrs.LabelSelectors = nil
+	authorized, err := f.auth.Authorize(ctx, &attrs)
+	if err != nil {
+		logger.WithError(err).WithField("namespace", namespace).Error("could not authorize watched events")
+		// Do not cache errors, the next event will be authorized again
+		return namespaceAuthorization{}
+	}
+	auth := namespaceAuthorization{authorized: authorized, selectors: attrs.LabelSelectors}
+	f.authorized[namespace] = auth
+	return auth
+}
+
+// eventLabels returns the labels of the event, its entity and its check, the
+// same labels that label selectors match when listing events.
+func eventLabels(event *corev2.Event) map[string]string {
+	labels := map[string]string{}
+	for k, v := range event.Labels {
+		labels[k] = v
+	}
+	if event.Entity != nil {
+		for k, v := range event.Entity.Labels {
+			labels[k] = v
+		}
+	}
+	if event.Check != nil {
+		for k, v := range event.Check.Labels {
+			labels[k] = v
+		}
+	}
+	return labels
+}
+
+// watch streams the events processed by this backend as server-sent events,
+// until the client disconnects or the stream times out. The events processed
+// by the other backends of a cluster are not streamed. Clients are expected to
+// reconnect when the stream ends.
+func (r *EventsRouter) watch(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		WriteError(w, actions.NewErrorf(actions.InternalErr, "streaming is not supported"))
+		return
+	}
+	if r.bus == nil {
+		WriteError(w, actions.NewErrorf(actions.InternalErr, "event streaming is not available"))
+		return
+	}
+
+	filter, err := newEventFilter(req, r.auth)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	ctx := req.Context()
+	if r.watchTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.watchTimeout)
+		defer cancel()
+	}
+
+	watcher := newEventWatcher()
+	consumer := fmt.Sprintf("apid-events-watch-%s", uuid.New().String())
+	subscription, err := r.bus.Subscribe(messaging.TopicEvent, consumer, watcher)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	defer func() {
+		if err := subscription.Cancel(); err != nil {
+			logger.WithError(err).Error("could not cancel events watch subscription")
+		}
+		// The bus tolerates sends to closed receivers, closing it stops the
+		// relay without blocking a send that raced the cancellation
+		close(watcher.receiver)
+	}()
+	go watcher.relay(ctx, filter)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	var reported uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-watcher.events:
+			if dropped := atomic.LoadUint64(&watcher.dropped); dropped > reported {
+				if err := writeServerSentEvent(w, WatchDroppedType, WatchDropped{Count: dropped - reported}); err != nil {
+					return
+				}
+				reported = dropped
+			}
+			if err := writeServerSentEvent(w, WatchEventType, event); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func writeServerSentEvent(w http.ResponseWriter, eventType string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventType, b)
+	return err
+}
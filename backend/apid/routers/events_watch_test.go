@@ -0,0 +1,206 @@
+package routers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type namespaceAuthorizer map[string]bool
+
+func (a namespaceAuthorizer) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	return a[attrs.Namespace], nil
+}
+
+// selectorAuthorizer only allows listing the objects matching its label
+// selectors, like a rule with a label selector.
+type selectorAuthorizer authorization.LabelSelectors
+
+func (a selectorAuthorizer) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	attrs.LabelSelectors = authorization.LabelSelectors(a)
+	return true, nil
+}
+
+func TestEventFilter(t *testing.T) {
+	event := corev2.FixtureEvent("foo", "check-cpu")
+	event.Check.Status = 2
+	event.Entity.Labels = map[string]string{"region": "west"}
+
+	labeled := corev2.FixtureEvent("foo", "check-cpu")
+	labeled.Labels = map[string]string{"region": "west"}
+
+	dev := corev2.FixtureEvent("foo", "check-cpu")
+	dev.Namespace = "dev"
+	dev.Entity.Namespace = "dev"
+	dev.Check.Namespace = "dev"
+
+	tests := []struct {
+		name      string
+		namespace string
+		query     string
+		auth      authorization.Authorizer
+		event     *corev2.Event
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:  "no filter",
+			event: event,
+			want:  true,
+		},
+		{
+			name:      "other namespace",
+			namespace: "default",
+			event:     dev,
+			want:      false,
+		},
+		{
+			name:  "unauthorized namespace",
+			auth:  namespaceAuthorizer{"default": true},
+			event: dev,
+			want:  false,
+		},
+		{
+			name:  "authorized namespace",
+			auth:  namespaceAuthorizer{"default": true},
+			event: event,
+			want:  true,
+		},
+		{
+			name:  "rule label selector matches",
+			auth:  selectorAuthorizer{{"region": "west"}},
+			event: labeled,
+			want:  true,
+		},
+		{
+			name:  "rule label selector ignores the entity labels",
+			auth:  selectorAuthorizer{{"region": "west"}},
+			event: event,
+			want:  false,
+		},
+		{
+			name:  "rule label selector does not match",
+			auth:  selectorAuthorizer{{"region": "west"}},
+			event: dev,
+			want:  false,
+		},
+		{
+			name:  "field selector matches",
+			query: "fieldSelector=" + url.QueryEscape(`event.check.status != "0"`),
+			event: event,
+			want:  true,
+		},
+		{
+			name:  "field selector does not match",
+			query: "fieldSelector=" + "event.entity.name+%3D%3D+bar",
+			event: event,
+			want:  false,
+		},
+		{
+			name:  "label selector matches",
+			query: "labelSelector=" + "region+%3D%3D+west",
+			event: event,
+			want:  true,
+		},
+		{
+			name:  "label selector does not match",
+			query: "labelSelector=" + "region+%3D%3D+west",
+			event: dev,
+			want:  false,
+		},
+		{
+			name:    "invalid selector",
+			query:   "fieldSelector=" + "event.check.status+%3D%3D",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/events?"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), corev2.NamespaceKey, tt.namespace)
+			ctx = authorization.SetAttributes(ctx, &authorization.Attributes{})
+			filter, err := newEventFilter(req.WithContext(ctx), tt.auth)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter.Matches(ctx, tt.event))
+		})
+	}
+}
+
+func TestEventsRouterWatch(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	router := &EventsRouter{controller: &mockEventController{}, bus: bus, watchTimeout: 5 * time.Second}
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+	server := httptest.NewServer(parentRouter)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + corev2.URLPrefix + "/events?watch=true&fieldSelector=event.entity.name+%3D%3D+foo")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// Keep publishing events, since the subscription may not exist yet
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for ctx.Err() == nil {
+			_ = bus.Publish(messaging.TopicEvent, corev2.FixtureEvent("bar", "check-cpu"))
+			_ = bus.Publish(messaging.TopicEvent, corev2.FixtureEvent("foo", "check-cpu"))
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	scanner := bufio.NewScanner(resp.Body)
+	var eventType string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "event: ") {
+			eventType = strings.TrimPrefix(line, "event: ")
+			continue
+		}
+		if !strings.HasPrefix(line, "data: ") || eventType != WatchEventType {
+			continue
+		}
+		var event corev2.Event
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+		assert.Equal(t, "foo", event.Entity.Name)
+		return
+	}
+	t.Fatalf("stream ended without events: %v", scanner.Err())
+}
+
+func TestEventsRouterWatchInvalidSelector(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+
+	router := &EventsRouter{controller: &mockEventController{}, bus: bus}
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	req := httptest.NewRequest(http.MethodGet, corev2.URLPrefix+"/namespaces/default/events?watch=true&labelSelector=region+%3D%3D", nil)
+	rr := httptest.NewRecorder()
+	parentRouter.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
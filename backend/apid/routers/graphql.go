@@ -11,6 +11,7 @@ import (
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authentication/session"
 	"github.com/sensu/sensu-go/graphql"
 )
 
@@ -26,11 +27,16 @@ type GraphQLService interface {
 type GraphQLRouter struct {
 	Service GraphQLService
 	Timeout time.Duration
+
+	// Denylist holds the revoked access tokens and sessions, which are
+	// rejected by the subscriptions and close their connections
+	Denylist *session.Denylist
 }
 
 // Mount the GraphQLRouter to a parent Router
 func (r *GraphQLRouter) Mount(parent *mux.Router) {
 	parent.HandleFunc("/graphql", actionHandler(r.query)).Methods(http.MethodPost)
+	parent.HandleFunc("/graphql", r.subscribe).Methods(http.MethodGet)
 }
 
 func (r *GraphQLRouter) query(req *http.Request) (interface{}, error) {
@@ -76,11 +82,15 @@ func (r *GraphQLRouter) query(req *http.Request) (interface{}, error) {
 		// Extract query and variables
 		query, _ := op["query"].(string)
 		queryVars, _ := op["variables"].(map[string]interface{})
+		opName, _ := op["operationName"].(string)
 		skipValidate, _ := op["skip_validation"].(bool)
+		extensions, _ := op["extensions"].(map[string]interface{})
 
 		// Execute given query
 		result := r.Service.Do(ctx, graphql.QueryParams{
+			OperationName:  opName,
 			Query:          query,
+			QueryHash:      persistedQueryHash(extensions),
 			Variables:      queryVars,
 			SkipValidation: skipValidate,
 		})
@@ -113,3 +123,11 @@ func (r *GraphQLRouter) query(req *http.Request) (interface{}, error) {
 	}
 	return results[0], nil
 }
+
+// persistedQueryHash returns the hash of the persisted query given by the
+// extensions of an operation, as sent by the Apollo clients.
+func persistedQueryHash(extensions map[string]interface{}) string {
+	persistedQuery, _ := extensions["persistedQuery"].(map[string]interface{})
+	hash, _ := persistedQuery["sha256Hash"].(string)
+	return hash
+}
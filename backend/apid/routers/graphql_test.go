@@ -6,8 +6,10 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/testutil"
 	"github.com/sensu/sensu-go/backend/apid/graphql"
+	sensugraphql "github.com/sensu/sensu-go/graphql"
 )
 
 func setupRequest(method string, path string, payload interface{}) (*http.Request, error) {
@@ -72,3 +74,55 @@ func TestHttpGraphQLBatchRequest(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestHttpGraphQLPersistedQuery(t *testing.T) {
+	query := "query Typename { __typename }"
+	service, err := graphql.NewService(graphql.ServiceConfig{
+		PersistedQueries: sensugraphql.NewPersistedQueries(query),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router := &GraphQLRouter{Service: service}
+	tests := []struct {
+		name    string
+		body    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "hash",
+			body: map[string]interface{}{
+				"operationName": "Typename",
+				"extensions": map[string]interface{}{
+					"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": sensugraphql.QueryHash(query)},
+				},
+			},
+		},
+		{
+			name: "persisted query",
+			body: map[string]interface{}{"query": query},
+		},
+		{
+			name:    "not persisted",
+			body:    map[string]interface{}{"query": "{ __typename }"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := setupRequest(http.MethodPost, "/graphql", tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result, err := router.query(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			errs := result.(map[string]interface{})["errors"].([]gqlerrors.FormattedError)
+			if got := len(errs) > 0; got != tt.wantErr {
+				t.Errorf("errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
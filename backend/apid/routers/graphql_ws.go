@@ -0,0 +1,375 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authentication/session"
+	"github.com/sensu/sensu-go/graphql"
+)
+
+// GraphQLWSProtocol is the websocket subprotocol of GraphQL subscriptions,
+// the protocol of the graphql-ws library.
+//
+// https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+const GraphQLWSProtocol = "graphql-transport-ws"
+
+// Message types of the graphql-ws protocol
+const (
+	graphqlWSConnectionInit = "connection_init"
+	graphqlWSConnectionAck  = "connection_ack"
+	graphqlWSPing           = "ping"
+	graphqlWSPong           = "pong"
+	graphqlWSSubscribe      = "subscribe"
+	graphqlWSNext           = "next"
+	graphqlWSError          = "error"
+	graphqlWSComplete       = "complete"
+)
+
+// Close codes of the graphql-ws protocol
+const (
+	graphqlWSBadRequest        = 4400
+	graphqlWSUnauthorized      = 4401
+	graphqlWSForbidden         = 4403
+	graphqlWSInitTimeout       = 4408
+	graphqlWSSubscriberExists  = 4409
+	graphqlWSTooManyInitialise = 4429
+	graphqlWSTooManyRequests   = 4429
+)
+
+var (
+	errGraphQLWSRevoked = errors.New("revoked token")
+
+	// graphqlWSConnectionInitTimeout is the time given to clients to
+	// initialise the connection.
+	graphqlWSConnectionInitTimeout = 10 * time.Second
+
+	// graphqlWSMaxSubscriptions is the maximum number of subscriptions
+	// running concurrently on a connection.
+	graphqlWSMaxSubscriptions = 100
+
+	// graphqlWSNow returns the current time, against which the expiration of
+	// the access tokens is checked.
+	graphqlWSNow = time.Now
+
+	graphqlWSUpgrader = websocket.Upgrader{
+		Subprotocols: []string{GraphQLWSProtocol},
+	}
+)
+
+// GraphQLSubscriptionService executes GraphQL subscription operations.
+type GraphQLSubscriptionService interface {
+	Subscribe(context.Context, graphql.QueryParams) (<-chan *graphql.Result, error)
+}
+
+// graphqlWSMessage is a message of the graphql-ws protocol.
+type graphqlWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// graphqlWSSubscribePayload is the payload of the subscribe message.
+type graphqlWSSubscribePayload struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+	Extensions    map[string]interface{} `json:"extensions"`
+}
+
+// graphqlWSInitPayload is the payload of the connection_init message. Clients
+// that cannot set the headers of the websocket handshake, such as browsers,
+// authenticate with the access token given in the payload.
+type graphqlWSInitPayload struct {
+	Authorization string `json:"Authorization"`
+}
+
+// graphqlWSConn is a websocket connection serving GraphQL subscriptions.
+type graphqlWSConn struct {
+	conn     *websocket.Conn
+	service  GraphQLSubscriptionService
+	denylist *session.Denylist
+	ctx      context.Context
+	req      *http.Request
+
+	// writeMu serializes the writes of the subscriptions
+	writeMu sync.Mutex
+
+	// subscriptions holds the cancel functions of the running subscriptions,
+	// by id
+	subscriptions map[string]context.CancelFunc
+	mu            sync.Mutex
+	wg            sync.WaitGroup
+}
+
+// subscribe serves GraphQL subscriptions over websocket, using the graphql-ws
+// protocol.
+func (r *GraphQLRouter) subscribe(w http.ResponseWriter, req *http.Request) {
+	service, ok := r.Service.(GraphQLSubscriptionService)
+	if !ok {
+		http.Error(w, "subscriptions are not supported", http.StatusNotImplemented)
+		return
+	}
+
+	// Upgrade replies to the client on failure
+	conn, err := graphqlWSUpgrader.Upgrade(w, req, nil)
+	if err != nil {
+		logger.WithError(err).Warn("could not upgrade graphql subscription connection")
+		return
+	}
+	defer conn.Close()
+
+	if conn.Subprotocol() != GraphQLWSProtocol {
+		closeGraphQLWS(conn, websocket.CloseProtocolError, fmt.Sprintf("subprotocol %s is required", GraphQLWSProtocol))
+		return
+	}
+
+	// The write timeout of the server does not apply to long-lived
+	// subscriptions
+	if err := conn.UnderlyingConn().SetDeadline(time.Time{}); err != nil {
+		logger.WithError(err).Warn("could not clear graphql subscription connection deadline")
+	}
+
+	ctx, cancel := context.WithCancel(context.WithValue(req.Context(), corev2.NamespaceKey, ""))
+	defer cancel()
+
+	c := &graphqlWSConn{
+		conn:          conn,
+		service:       service,
+		denylist:      r.Denylist,
+		ctx:           ctx,
+		req:           req,
+		subscriptions: map[string]context.CancelFunc{},
+	}
+	c.serve()
+	cancel()
+	c.wg.Wait()
+}
+
+// serve reads the messages of the client until the connection is closed.
+func (c *graphqlWSConn) serve() {
+	initialised := false
+	_ = c.conn.SetReadDeadline(time.Now().Add(graphqlWSConnectionInitTimeout))
+	for {
+		var msg graphqlWSMessage
+		if err := c.conn.ReadJSON(&msg); err != nil {
+			if !initialised {
+				if netErr, ok := err.(interface{ Timeout() bool }); ok && netErr.Timeout() {
+					c.close(graphqlWSInitTimeout, "Connection initialisation timeout")
+					return
+				}
+			}
+			if _, ok := err.(*websocket.CloseError); !ok {
+				logger.WithError(err).Debug("graphql subscription connection closed")
+			}
+			return
+		}
+
+		switch msg.Type {
+		case graphqlWSConnectionInit:
+			if initialised {
+				c.close(graphqlWSTooManyInitialise, "Too many initialisation requests")
+				return
+			}
+			if err := c.authenticate(msg.Payload); err != nil {
+				logger.WithError(err).Warn("invalid token")
+				c.close(graphqlWSForbidden, "Forbidden")
+				return
+			}
+			initialised = true
+			_ = c.conn.SetReadDeadline(time.Time{})
+			c.write(graphqlWSMessage{Type: graphqlWSConnectionAck})
+			if claims := jwt.GetClaimsFromContext(c.ctx); claims != nil {
+				c.wg.Add(1)
+				go c.watchToken(c.ctx, claims)
+			}
+		case graphqlWSPing:
+			c.write(graphqlWSMessage{Type: graphqlWSPong})
+		case graphqlWSPong:
+		case graphqlWSSubscribe:
+			if !initialised {
+				c.close(graphqlWSUnauthorized, "Unauthorized")
+				return
+			}
+			if msg.ID == "" {
+				c.close(graphqlWSBadRequest, "Subscribe message requires an id")
+				return
+			}
+			var payload graphqlWSSubscribePayload
+			if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+				c.close(graphqlWSBadRequest, fmt.Sprintf("Invalid subscribe payload: %s", err))
+				return
+			}
+			if c.running() >= graphqlWSMaxSubscriptions {
+				c.close(graphqlWSTooManyRequests, "Too many subscriptions")
+				return
+			}
+			if !c.start(msg.ID, payload) {
+				c.close(graphqlWSSubscriberExists, fmt.Sprintf("Subscriber for %s already exists", msg.ID))
+				return
+			}
+		case graphqlWSComplete:
+			c.stop(msg.ID)
+		default:
+			c.close(graphqlWSBadRequest, fmt.Sprintf("Invalid message type %q", msg.Type))
+			return
+		}
+	}
+}
+
+// authenticate sets the claims of the access token given in the payload of
+// the connection_init message into the context, unless the handshake request
+// was already authenticated.
+func (c *graphqlWSConn) authenticate(raw json.RawMessage) error {
+	if jwt.GetClaimsFromContext(c.ctx) != nil || len(raw) == 0 {
+		return nil
+	}
+	var payload graphqlWSInitPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return err
+	}
+	if payload.Authorization == "" {
+		return nil
+	}
+	token, err := jwt.ValidateToken(strings.TrimPrefix(payload.Authorization, "Bearer "))
+	if err != nil {
+		return err
+	}
+	claims := token.Claims.(*corev2.Claims)
+	if c.denylist != nil && c.denylist.IsRevoked(claims) {
+		return errGraphQLWSRevoked
+	}
+	c.ctx = jwt.SetClaimsIntoContext(c.req.WithContext(c.ctx), claims)
+	return nil
+}
+
+// watchToken closes the connection, and with it the subscriptions, once the
+// access token of the claims expires, or it or its session is revoked.
+func (c *graphqlWSConn) watchToken(ctx context.Context, claims *corev2.Claims) {
+	defer c.wg.Done()
+	var expired <-chan time.Time
+	if claims.ExpiresAt > 0 {
+		timer := time.NewTimer(time.Unix(claims.ExpiresAt, 0).Sub(graphqlWSNow()))
+		defer timer.Stop()
+		expired = timer.C
+	}
+	for {
+		var updated <-chan struct{}
+		if c.denylist != nil {
+			updated = c.denylist.Updated()
+			if c.denylist.IsRevoked(claims) {
+				logger.WithField("user", claims.Subject).Info("revoked token, closing graphql subscription connection")
+				c.close(graphqlWSForbidden, "Forbidden")
+				// Closing the connection stops the reads of serve
+				_ = c.conn.Close()
+				return
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-expired:
+			logger.WithField("user", claims.Subject).Info("expired token, closing graphql subscription connection")
+			c.close(graphqlWSUnauthorized, "Token expired")
+			_ = c.conn.Close()
+			return
+		case <-updated:
+		}
+	}
+}
+
+// running returns the number of running subscriptions.
+func (c *graphqlWSConn) running() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.subscriptions)
+}
+
+// start starts the subscription with the given id, and returns false if the
+// id is already used.
+func (c *graphqlWSConn) start(id string, payload graphqlWSSubscribePayload) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.subscriptions[id]; ok {
+		return false
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	results, err := c.service.Subscribe(ctx, graphql.QueryParams{
+		Query:         payload.Query,
+		QueryHash:     persistedQueryHash(payload.Extensions),
+		Variables:     payload.Variables,
+		OperationName: payload.OperationName,
+	})
+	if err != nil {
+		cancel()
+		errs, _ := json.Marshal([]map[string]string{{"message": err.Error()}})
+		c.write(graphqlWSMessage{ID: id, Type: graphqlWSError, Payload: errs})
+		return true
+	}
+	c.subscriptions[id] = cancel
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		for result := range results {
+			b, err := json.Marshal(result)
+			if err != nil {
+				logger.WithError(err).Error("could not encode graphql subscription result")
+				continue
+			}
+			c.write(graphqlWSMessage{ID: id, Type: graphqlWSNext, Payload: b})
+		}
+		// The subscription is completed by the server unless the client
+		// completed it
+		c.mu.Lock()
+		_, running := c.subscriptions[id]
+		delete(c.subscriptions, id)
+		c.mu.Unlock()
+		cancel()
+		if running && c.ctx.Err() == nil {
+			c.write(graphqlWSMessage{ID: id, Type: graphqlWSComplete})
+		}
+	}()
+	return true
+}
+
+// stop stops the subscription with the given id, if it is running.
+func (c *graphqlWSConn) stop(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cancel, ok := c.subscriptions[id]; ok {
+		cancel()
+		delete(c.subscriptions, id)
+	}
+}
+
+func (c *graphqlWSConn) write(msg graphqlWSMessage) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.conn.WriteJSON(msg); err != nil {
+		logger.WithError(err).Debug("could not write graphql subscription message")
+	}
+}
+
+func (c *graphqlWSConn) close(code int, reason string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	closeGraphQLWS(c.conn, code, reason)
+}
+
+func closeGraphQLWS(conn *websocket.Conn, code int, reason string) {
+	msg := websocket.FormatCloseMessage(code, reason)
+	if err := conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second)); err != nil {
+		logger.WithError(err).Debug("could not close graphql subscription connection")
+	}
+}
@@ -0,0 +1,231 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authentication/session"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSubscriptionService struct {
+	results []*graphql.Result
+	err     error
+	claims  chan *corev2.Claims
+
+	// running keeps the subscriptions running until they are stopped
+	running bool
+}
+
+func (s *mockSubscriptionService) Do(context.Context, graphql.QueryParams) *graphql.Result {
+	return &graphql.Result{}
+}
+
+func (s *mockSubscriptionService) Subscribe(ctx context.Context, p graphql.QueryParams) (<-chan *graphql.Result, error) {
+	s.claims <- jwt.GetClaimsFromContext(ctx)
+	if s.err != nil {
+		return nil, s.err
+	}
+	results := make(chan *graphql.Result, len(s.results))
+	for _, result := range s.results {
+		results <- result
+	}
+	if s.running {
+		go func() {
+			<-ctx.Done()
+			close(results)
+		}()
+		return results, nil
+	}
+	close(results)
+	return results, nil
+}
+
+func dialGraphQLWS(t *testing.T, service GraphQLService) *websocket.Conn {
+	t.Helper()
+	router := mux.NewRouter()
+	denylist := session.NewDenylistFromResources(corev2.FixtureRevokedToken("revoked"))
+	(&GraphQLRouter{Service: service, Denylist: denylist}).Mount(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	dialer := websocket.Dialer{Subprotocols: []string{GraphQLWSProtocol}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/graphql", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func readGraphQLWS(t *testing.T, conn *websocket.Conn) graphqlWSMessage {
+	t.Helper()
+	var msg graphqlWSMessage
+	require.NoError(t, conn.ReadJSON(&msg))
+	return msg
+}
+
+func TestGraphQLRouterSubscribe(t *testing.T) {
+	service := &mockSubscriptionService{
+		results: []*graphql.Result{{Data: map[string]interface{}{"eventUpdated": map[string]interface{}{"id": "1"}}}},
+		claims:  make(chan *corev2.Claims, 1),
+	}
+	conn := dialGraphQLWS(t, service)
+
+	_, token, err := jwt.AccessToken(corev2.FixtureClaims("alice", nil))
+	require.NoError(t, err)
+	init, _ := json.Marshal(graphqlWSInitPayload{Authorization: "Bearer " + token})
+	require.NoError(t, conn.WriteJSON(graphqlWSMessage{Type: graphqlWSConnectionInit, Payload: init}))
+	assert.Equal(t, graphqlWSConnectionAck, readGraphQLWS(t, conn).Type)
+
+	require.NoError(t, conn.WriteJSON(graphqlWSMessage{Type: graphqlWSPing}))
+	assert.Equal(t, graphqlWSPong, readGraphQLWS(t, conn).Type)
+
+	payload, _ := json.Marshal(graphqlWSSubscribePayload{Query: "subscription { eventUpdated { id } }"})
+	require.NoError(t, conn.WriteJSON(graphqlWSMessage{ID: "1", Type: graphqlWSSubscribe, Payload: payload}))
+
+	next := readGraphQLWS(t, conn)
+	assert.Equal(t, graphqlWSNext, next.Type)
+	assert.Equal(t, "1", next.ID)
+	assert.JSONEq(t, `{"data":{"eventUpdated":{"id":"1"}}}`, string(next.Payload))
+
+	complete := readGraphQLWS(t, conn)
+	assert.Equal(t, graphqlWSComplete, complete.Type)
+	assert.Equal(t, "1", complete.ID)
+
+	claims := <-service.claims
+	if assert.NotNil(t, claims) {
+		assert.Equal(t, "alice", claims.Subject)
+	}
+}
+
+func TestGraphQLRouterSubscribeError(t *testing.T) {
+	service := &mockSubscriptionService{
+		err:    errors.New("subscription operation expected"),
+		claims: make(chan *corev2.Claims, 1),
+	}
+	conn := dialGraphQLWS(t, service)
+
+	require.NoError(t, conn.WriteJSON(graphqlWSMessage{Type: graphqlWSConnectionInit}))
+	assert.Equal(t, graphqlWSConnectionAck, readGraphQLWS(t, conn).Type)
+
+	payload, _ := json.Marshal(graphqlWSSubscribePayload{Query: "query { viewer { user { username } } }"})
+	require.NoError(t, conn.WriteJSON(graphqlWSMessage{ID: "1", Type: graphqlWSSubscribe, Payload: payload}))
+
+	msg := readGraphQLWS(t, conn)
+	assert.Equal(t, graphqlWSError, msg.Type)
+	assert.JSONEq(t, `[{"message":"subscription operation expected"}]`, string(msg.Payload))
+	assert.Nil(t, <-service.claims)
+}
+
+func TestGraphQLRouterSubscribeClose(t *testing.T) {
+	subscribe, _ := json.Marshal(graphqlWSSubscribePayload{Query: "subscription { eventUpdated { id } }"})
+	badToken, _ := json.Marshal(graphqlWSInitPayload{Authorization: "Bearer invalid"})
+	claims := corev2.FixtureClaims("alice", nil)
+	claims.SessionID = "revoked"
+	_, token, err := jwt.AccessToken(claims)
+	require.NoError(t, err)
+	revokedToken, _ := json.Marshal(graphqlWSInitPayload{Authorization: "Bearer " + token})
+
+	tests := []struct {
+		name     string
+		messages []graphqlWSMessage
+		wantCode int
+	}{
+		{
+			name:     "subscribe before init",
+			messages: []graphqlWSMessage{{ID: "1", Type: graphqlWSSubscribe, Payload: subscribe}},
+			wantCode: graphqlWSUnauthorized,
+		},
+		{
+			name:     "invalid token",
+			messages: []graphqlWSMessage{{Type: graphqlWSConnectionInit, Payload: badToken}},
+			wantCode: graphqlWSForbidden,
+		},
+		{
+			name:     "revoked token",
+			messages: []graphqlWSMessage{{Type: graphqlWSConnectionInit, Payload: revokedToken}},
+			wantCode: graphqlWSForbidden,
+		},
+		{
+			name: "init twice",
+			messages: []graphqlWSMessage{
+				{Type: graphqlWSConnectionInit},
+				{Type: graphqlWSConnectionInit},
+			},
+			wantCode: graphqlWSTooManyInitialise,
+		},
+		{
+			name:     "unknown message",
+			messages: []graphqlWSMessage{{Type: "start"}},
+			wantCode: graphqlWSBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := dialGraphQLWS(t, &mockSubscriptionService{claims: make(chan *corev2.Claims, 1)})
+			for _, msg := range tt.messages {
+				require.NoError(t, conn.WriteJSON(msg))
+			}
+			assert.Equal(t, tt.wantCode, readGraphQLWSClose(t, conn))
+		})
+	}
+}
+
+// readGraphQLWSClose reads the messages of the connection until it is closed,
+// and returns the close code.
+func readGraphQLWSClose(t *testing.T, conn *websocket.Conn) int {
+	t.Helper()
+	for {
+		var msg graphqlWSMessage
+		err := conn.ReadJSON(&msg)
+		if err == nil {
+			continue
+		}
+		closeErr, ok := err.(*websocket.CloseError)
+		require.True(t, ok, "unexpected error: %v", err)
+		return closeErr.Code
+	}
+}
+
+func TestGraphQLRouterSubscribeTooMany(t *testing.T) {
+	defer func(max int) { graphqlWSMaxSubscriptions = max }(graphqlWSMaxSubscriptions)
+	graphqlWSMaxSubscriptions = 2
+
+	service := &mockSubscriptionService{claims: make(chan *corev2.Claims, 3), running: true}
+	conn := dialGraphQLWS(t, service)
+	require.NoError(t, conn.WriteJSON(graphqlWSMessage{Type: graphqlWSConnectionInit}))
+	assert.Equal(t, graphqlWSConnectionAck, readGraphQLWS(t, conn).Type)
+
+	payload, _ := json.Marshal(graphqlWSSubscribePayload{Query: "subscription { eventUpdated { id } }"})
+	for _, id := range []string{"1", "2", "3"} {
+		require.NoError(t, conn.WriteJSON(graphqlWSMessage{ID: id, Type: graphqlWSSubscribe, Payload: payload}))
+	}
+	assert.Equal(t, graphqlWSTooManyRequests, readGraphQLWSClose(t, conn))
+}
+
+func TestGraphQLRouterSubscribeExpiredToken(t *testing.T) {
+	defer func(now func() time.Time) { graphqlWSNow = now }(graphqlWSNow)
+	graphqlWSNow = func() time.Time {
+		return time.Now().Add(jwt.AccessTokenExpiration())
+	}
+
+	service := &mockSubscriptionService{claims: make(chan *corev2.Claims, 1), running: true}
+	conn := dialGraphQLWS(t, service)
+
+	_, token, err := jwt.AccessToken(corev2.FixtureClaims("alice", nil))
+	require.NoError(t, err)
+	init, _ := json.Marshal(graphqlWSInitPayload{Authorization: "Bearer " + token})
+	require.NoError(t, conn.WriteJSON(graphqlWSMessage{Type: graphqlWSConnectionInit, Payload: init}))
+	assert.Equal(t, graphqlWSConnectionAck, readGraphQLWS(t, conn).Type)
+	assert.Equal(t, graphqlWSUnauthorized, readGraphQLWSClose(t, conn))
+}
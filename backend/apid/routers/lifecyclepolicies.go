@@ -0,0 +1,71 @@
+package routers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/lifecycled"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// LifecyclePoliciesRouter handles requests for /lifecyclepolicies
+type LifecyclePoliciesRouter struct {
+	handlers handlers.Handlers
+	reaper   *lifecycled.Reaper
+}
+
+// NewLifecyclePoliciesRouter instantiates new router for controlling
+// lifecycle policy resources
+func NewLifecyclePoliciesRouter(store store.Store, events store.EventStore) *LifecyclePoliciesRouter {
+	return &LifecyclePoliciesRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.LifecyclePolicy{},
+			Store:    store,
+		},
+		reaper: &lifecycled.Reaper{
+			EntityStore: store,
+			EventStore:  events,
+		},
+	}
+}
+
+// Mount the LifecyclePoliciesRouter to a parent Router
+func (r *LifecyclePoliciesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:lifecyclepolicies}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.LifecyclePolicyFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:lifecyclepolicies}", corev2.LifecyclePolicyFields)
+	routes.Patch(r.handlers.PatchResource)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+
+	// Custom
+	routes.Path("{id}/report", r.report).Methods(http.MethodGet)
+}
+
+// report returns the entities the lifecycle policy would currently reap,
+// without deregistering them.
+func (r *LifecyclePoliciesRouter) report(req *http.Request) (interface{}, error) {
+	resource, err := r.handlers.GetResource(req)
+	if err != nil {
+		return nil, err
+	}
+	policy, ok := resource.(*corev2.LifecyclePolicy)
+	if !ok {
+		return nil, actions.NewErrorf(actions.InternalErr)
+	}
+	report, err := r.reaper.Report(req.Context(), policy, time.Now())
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	return report, nil
+}
@@ -0,0 +1,76 @@
+package routers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLifecyclePoliciesRouter(t *testing.T) {
+	s := &mockstore.MockStore{}
+	router := NewLifecyclePoliciesRouter(s, s)
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	empty := &corev2.LifecyclePolicy{}
+	fixture := corev2.FixtureLifecyclePolicy("foo", "bar")
+
+	tests := []routerTestCase{}
+	tests = append(tests, getTestCases(fixture)...)
+	tests = append(tests, listTestCases(empty)...)
+	tests = append(tests, createTestCases(empty)...)
+	tests = append(tests, updateTestCases(fixture)...)
+	tests = append(tests, deleteTestCases(fixture)...)
+	tests = append(tests, []routerTestCase{
+		{
+			name:   "it returns 404 when reporting on a missing policy",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/report",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.LifecyclePolicy")).
+					Return(&store.ErrNotFound{}).
+					Once()
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "it returns 500 if the entities can't be fetched for the report",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/report",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.LifecyclePolicy")).
+					Return(nil).
+					Once()
+				s.On("GetEntities", mock.Anything, mock.Anything).
+					Return([]*corev2.Entity(nil), &store.ErrInternal{}).
+					Once()
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "it reports the stale entities",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/report",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.LifecyclePolicy")).
+					Return(nil).
+					Once()
+				s.On("GetEntities", mock.Anything, mock.Anything).
+					Return([]*corev2.Entity{corev2.FixtureEntity("entity")}, nil).
+					Once()
+				s.On("GetEvents", mock.Anything, mock.Anything).
+					Return([]*corev2.Event{}, nil).
+					Once()
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}...)
+	for _, tt := range tests {
+		run(t, tt, parentRouter, s)
+	}
+}
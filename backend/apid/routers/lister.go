@@ -9,6 +9,7 @@ import (
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/store"
 )
 
@@ -42,7 +43,18 @@ func List(list ListControllerFunc, fields FieldsFunc) http.HandlerFunc {
 			pred.Subcollection = subcollection
 		}
 
-		results, err := list(r.Context(), pred)
+		// Only keep the resources allowed by the label selectors of the rules
+		// which authorized the request, if any, while still filling the page
+		var selectors authorization.LabelSelectors
+		if attrs := authorization.GetAttributes(r.Context()); attrs != nil {
+			selectors = attrs.LabelSelectors
+		}
+		var results []corev2.Resource
+		err := selectors.List(pred, &results, func(resources interface{}) error {
+			page, err := list(r.Context(), pred)
+			*resources.(*[]corev2.Resource) = page
+			return err
+		})
 		if err != nil {
 			WriteError(w, err)
 			return
@@ -11,6 +11,7 @@ import (
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/middlewares"
+	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -36,6 +37,7 @@ func TestList(t *testing.T) {
 		expectedLen            int
 		expectedPred           *store.SelectionPredicate
 		expectedStatus         int
+		labelSelectors         authorization.LabelSelectors
 	}{
 		{
 			name:           "list without pagination",
@@ -70,6 +72,18 @@ func TestList(t *testing.T) {
 			expectedStatus:         http.StatusOK,
 			expectedContinueHeader: "YmFy",
 		},
+		{
+			name: "label selectors",
+			path: "/foo",
+			results: []corev2.Resource{
+				&corev2.CheckConfig{ObjectMeta: corev2.ObjectMeta{Name: "a", Labels: map[string]string{"team": "a"}}},
+				&corev2.CheckConfig{ObjectMeta: corev2.ObjectMeta{Name: "b", Labels: map[string]string{"team": "b"}}},
+			},
+			expectedLen:    1,
+			expectedPred:   &store.SelectionPredicate{},
+			expectedStatus: http.StatusOK,
+			labelSelectors: authorization.LabelSelectors{{"team": "a"}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -89,6 +103,7 @@ func TestList(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
+			r = r.WithContext(authorization.SetAttributes(r.Context(), &authorization.Attributes{LabelSelectors: tt.labelSelectors}))
 			w := httptest.NewRecorder()
 
 			router := mux.NewRouter()
@@ -53,6 +53,9 @@ func (r *NamespacesRouter) Mount(parent *mux.Router) {
 	routes.Post(r.create)
 	routes.Patch(r.handlers.PatchResource)
 	routes.Put(r.update)
+
+	// Custom
+	routes.Path("{id}/clone", r.clone).Methods(http.MethodPost)
 }
 
 func (r *NamespacesRouter) list(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
@@ -69,6 +72,10 @@ func (r *NamespacesRouter) list(ctx context.Context, pred *store.SelectionPredic
 }
 
 func (r *NamespacesRouter) create(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	ctx := req.Context()
 	var ns corev2.Namespace
 	if err := json.NewDecoder(req.Body).Decode(&ns); err != nil {
@@ -85,6 +92,15 @@ func (r *NamespacesRouter) create(req *http.Request) (interface{}, error) {
 	if err := ns.Validate(); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
+	if dryRun {
+		var existing corev2.Namespace
+		if err := r.store.GetResource(ctx, ns.Name, &existing); err == nil {
+			return nil, actions.NewErrorf(actions.AlreadyExistsErr)
+		} else if _, ok := err.(*store.ErrNotFound); !ok {
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+		return &ns, nil
+	}
 	client := api.NewNamespaceClient(r.store, r.namespaceStore, r.auth, r.storev2)
 	if err := client.CreateNamespace(ctx, &ns); err != nil {
 		switch err := err.(type) {
@@ -100,6 +116,10 @@ func (r *NamespacesRouter) create(req *http.Request) (interface{}, error) {
 }
 
 func (r *NamespacesRouter) update(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	ctx := req.Context()
 	var ns corev2.Namespace
 	if err := json.NewDecoder(req.Body).Decode(&ns); err != nil {
@@ -116,6 +136,9 @@ func (r *NamespacesRouter) update(req *http.Request) (interface{}, error) {
 	if err := ns.Validate(); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
+	if dryRun {
+		return &ns, nil
+	}
 	client := api.NewNamespaceClient(r.store, r.namespaceStore, r.auth, r.storev2)
 	if err := client.UpdateNamespace(ctx, &ns); err != nil {
 		switch err := err.(type) {
@@ -128,13 +151,75 @@ func (r *NamespacesRouter) update(req *http.Request) (interface{}, error) {
 	return nil, nil
 }
 
+// clone copies the resources of a namespace to another namespace.
+func (r *NamespacesRouter) clone(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
+	source, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	var clone corev2.NamespaceClone
+	if err := json.NewDecoder(req.Body).Decode(&clone); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	if err := clone.Validate(); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	if clone.Destination == source {
+		return nil, actions.NewErrorf(actions.InvalidArgument, "the source and destination namespaces must differ")
+	}
+
+	client := api.NewNamespaceClient(r.store, r.namespaceStore, r.auth, r.storev2)
+	cloneNamespace := client.CloneNamespace
+	if dryRun {
+		cloneNamespace = client.CloneNamespaceDryRun
+	}
+	result, err := cloneNamespace(req.Context(), source, &clone)
+	if err != nil {
+		if err == authorization.ErrUnauthorized {
+			return nil, actions.NewError(actions.PermissionDenied, err)
+		}
+		switch err := err.(type) {
+		case *store.ErrNotFound:
+			return nil, actions.NewErrorf(actions.NotFound)
+		case *store.ErrNotValid, *api.TemplateError:
+			return nil, actions.NewError(actions.InvalidArgument, err)
+		default:
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+	}
+	return result, nil
+}
+
 func (r *NamespacesRouter) delete(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	params := mux.Vars(req)
 	name, err := url.PathUnescape(params["id"])
 	if err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
 
+	if dryRun {
+		// Only the existence of the namespace is checked; the store makes
+		// sure it is empty when deleting it
+		var existing corev2.Namespace
+		if err := r.store.GetResource(req.Context(), name, &existing); err != nil {
+			switch err.(type) {
+			case *store.ErrNotFound:
+				return nil, actions.NewErrorf(actions.NotFound)
+			default:
+				return nil, actions.NewError(actions.InternalErr, err)
+			}
+		}
+		return nil, nil
+	}
+
 	client := api.NewNamespaceClient(r.store, r.namespaceStore, r.auth, r.storev2)
 	if err := client.DeleteNamespace(req.Context(), name); err != nil {
 		switch err := err.(type) {
@@ -2,7 +2,10 @@ package routers
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -137,6 +140,89 @@ func TestNamespaceRouterList(t *testing.T) {
 	}
 }
 
+func TestNamespacesRouterClone(t *testing.T) {
+	tests := []struct {
+		name           string
+		source         string
+		body           string
+		wantStatusCode int
+		wantResources  []corev2.ClonedResource
+	}{
+		{
+			name:           "resources are cloned",
+			source:         "default",
+			body:           `{"destination": "dev", "types": ["Handler"], "name_template": "{{ .values.team }}-{{ .name }}", "values": {"team": "ops"}}`,
+			wantStatusCode: http.StatusOK,
+			wantResources: []corev2.ClonedResource{
+				{Type: "Handler", Source: "slack", Name: "ops-slack", Status: corev2.CloneCreated},
+			},
+		},
+		{
+			name:           "missing template values",
+			source:         "default",
+			body:           `{"destination": "dev", "types": ["Handler"], "name_template": "{{ .values.team }}-{{ .name }}"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid type",
+			source:         "default",
+			body:           `{"destination": "dev", "types": ["Entity"]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "same namespaces",
+			source:         "dev",
+			body:           `{"destination": "dev"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "missing source",
+			source:         "missing",
+			body:           `{"destination": "dev", "types": ["Handler"]}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockstore.MockStore{}
+			s.On("GetResource", mock.Anything, "missing", mock.Anything).Return(&store.ErrNotFound{})
+			s.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			s.On("ListResources", mock.Anything, "handlers", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				*args[2].(*[]*corev2.Handler) = []*corev2.Handler{corev2.FixtureHandler("slack")}
+			}).Return(nil)
+			s.On("CreateResource", mock.Anything, mock.Anything).Return(nil)
+
+			authorizer := &mockauthorizer.Authorizer{}
+			authorizer.On("Authorize", mock.Anything, mock.Anything).Return(true, nil)
+
+			router := NewNamespacesRouter(s, s, authorizer, new(mockstore.V2MockStore))
+			parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+			parentRouter.Use(mockedClaims)
+			router.Mount(parentRouter)
+
+			req := httptest.NewRequest(http.MethodPost, corev2.URLPrefix+"/namespaces/"+tt.source+"/clone", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+			parentRouter.ServeHTTP(rr, req)
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+			if tt.wantResources == nil {
+				return
+			}
+			var result corev2.NamespaceCloneResult
+			if err := json.NewDecoder(rr.Body).Decode(&result); err != nil {
+				t.Fatal(err)
+			}
+			if result.Source != tt.source || result.Destination != "dev" {
+				t.Errorf("cloned %q to %q", result.Source, result.Destination)
+			}
+			if len(result.Resources) != len(tt.wantResources) || result.Resources[0] != tt.wantResources[0] {
+				t.Errorf("cloned resources = %v, want %v", result.Resources, tt.wantResources)
+			}
+		})
+	}
+}
+
 func mockedClaims(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), corev2.ClaimsKey, corev2.FixtureClaims("foo", []string{"cluster-admins"}))
@@ -0,0 +1,38 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// PasswordPoliciesRouter handles requests for PasswordPolicies.
+type PasswordPoliciesRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewPasswordPoliciesRouter instantiates a new router for PasswordPolicies.
+func NewPasswordPoliciesRouter(store store.ResourceStore) *PasswordPoliciesRouter {
+	return &PasswordPoliciesRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.PasswordPolicy{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the PasswordPoliciesRouter on the given parent Router
+func (r *PasswordPoliciesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/{resource:passwordpolicies}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.PasswordPolicyFields)
+	routes.Patch(r.handlers.PatchResource)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+}
@@ -0,0 +1,69 @@
+package routers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/quota"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// ResourceQuotasRouter handles requests for /resourcequotas
+type ResourceQuotasRouter struct {
+	handlers handlers.Handlers
+	enforcer *quota.Enforcer
+}
+
+// NewResourceQuotasRouter instantiates new router for controlling resource
+// quota resources
+func NewResourceQuotasRouter(store store.Store, enforcer *quota.Enforcer) *ResourceQuotasRouter {
+	return &ResourceQuotasRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.ResourceQuota{},
+			Store:    store,
+		},
+		enforcer: enforcer,
+	}
+}
+
+// Mount the ResourceQuotasRouter to a parent Router
+func (r *ResourceQuotasRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:resourcequotas}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.ResourceQuotaFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:resourcequotas}", corev2.ResourceQuotaFields)
+	routes.Patch(r.handlers.PatchResource)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+
+	// Custom
+	routes.Path("{id}/usage", r.usage).Methods(http.MethodGet)
+}
+
+// usage returns the usage of the resources limited by the resource quota.
+func (r *ResourceQuotasRouter) usage(req *http.Request) (interface{}, error) {
+	if r.enforcer == nil {
+		return nil, actions.NewErrorf(actions.InternalErr, "resource quotas are not enforced")
+	}
+	resource, err := r.handlers.GetResource(req)
+	if err != nil {
+		return nil, err
+	}
+	quota, ok := resource.(*corev2.ResourceQuota)
+	if !ok {
+		return nil, actions.NewErrorf(actions.InternalErr)
+	}
+	usage, err := r.enforcer.Usage(req.Context(), quota)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	return usage, nil
+}
@@ -0,0 +1,68 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/quota"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+type quotaCounter map[string]int64
+
+func (c quotaCounter) Count(ctx context.Context, namespace, resource string) (int64, error) {
+	return c[resource], nil
+}
+
+func TestResourceQuotasRouter(t *testing.T) {
+	s := &mockstore.MockStore{}
+	enforcer := quota.NewEnforcer(s, quotaCounter{corev2.EntitiesResource: 12})
+	router := NewResourceQuotasRouter(s, enforcer)
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	empty := &corev2.ResourceQuota{}
+	fixture := corev2.FixtureResourceQuota("foo", "bar")
+
+	tests := []routerTestCase{}
+	tests = append(tests, getTestCases(fixture)...)
+	tests = append(tests, listTestCases(empty)...)
+	tests = append(tests, createTestCases(empty)...)
+	tests = append(tests, updateTestCases(fixture)...)
+	tests = append(tests, deleteTestCases(fixture)...)
+	tests = append(tests, []routerTestCase{
+		{
+			name:   "it returns 404 when reporting the usage of a missing quota",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/usage",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.ResourceQuota")).
+					Return(&store.ErrNotFound{}).
+					Once()
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "it reports the usage of the quota",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/usage",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.ResourceQuota")).
+					Run(func(args mock.Arguments) {
+						*args[2].(*corev2.ResourceQuota) = *fixture
+					}).
+					Return(nil).
+					Once()
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}...)
+	for _, tt := range tests {
+		run(t, tt, parentRouter, s)
+	}
+}
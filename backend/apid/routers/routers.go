@@ -10,6 +10,7 @@ import (
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	corev3 "github.com/sensu/sensu-go/api/core/v3"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -119,6 +120,8 @@ func HTTPStatusFromCode(code actions.ErrCode) int {
 		return http.StatusPreconditionFailed
 	case actions.DeadlineExceeded:
 		return http.StatusGatewayTimeout
+	case actions.QuotaExceeded:
+		return http.StatusForbidden
 	}
 
 	logger.WithField("code", code).Error("unknown error code")
@@ -172,6 +175,21 @@ func listHandler(fn listHandlerFunc) http.HandlerFunc {
 
 type actionHandlerFunc func(r *http.Request) (interface{}, error)
 
+// rejectDryRun wraps an action that does not support dry runs, so that dry run
+// requests are rejected instead of being applied.
+func rejectDryRun(fn actionHandlerFunc) actionHandlerFunc {
+	return func(r *http.Request) (interface{}, error) {
+		dryRun, err := handlers.DryRun(r)
+		if err != nil {
+			return nil, err
+		}
+		if dryRun {
+			return nil, actions.NewErrorf(actions.InvalidArgument, "dry run is not supported by this endpoint")
+		}
+		return fn(r)
+	}
+}
+
 type listHandlerFunc func(w http.ResponseWriter, req *http.Request) (interface{}, error)
 
 //
@@ -134,6 +134,29 @@ func Test_actionHandler(t *testing.T) {
 	}
 }
 
+func Test_rejectDryRun(t *testing.T) {
+	called := false
+	action := rejectDryRun(func(r *http.Request) (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+
+	_, err := action(newRequest(t, http.MethodPost, "/foo?dryRun=true", nil))
+	if err == nil {
+		t.Error("expected dry run to be rejected")
+	}
+	if called {
+		t.Error("action should not be called on dry run")
+	}
+
+	if _, err := action(newRequest(t, http.MethodPost, "/foo", nil)); err != nil {
+		t.Fatal(err)
+	}
+	if !called {
+		t.Error("action should be called")
+	}
+}
+
 func Test_listHandler(t *testing.T) {
 	type args struct {
 		fn listHandlerFunc
@@ -0,0 +1,62 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/authentication/session"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// SessionsRouter handles requests for /sessions. Sessions are created when
+// users log in, and can only be listed and revoked.
+type SessionsRouter struct {
+	handlers handlers.Handlers
+	store    store.ResourceStore
+}
+
+// NewSessionsRouter instantiates new router for controlling session resources.
+func NewSessionsRouter(store store.Store) *SessionsRouter {
+	return &SessionsRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.Session{},
+			Store:    store,
+		},
+		store: store,
+	}
+}
+
+// Mount the SessionsRouter to a parent Router.
+func (r *SessionsRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/{resource:sessions}",
+	}
+
+	routes.Del(rejectDryRun(r.revoke))
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.SessionFields)
+}
+
+// revoke deletes the session, and revokes its access tokens.
+func (r *SessionsRouter) revoke(req *http.Request) (interface{}, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	if err := session.Revoke(req.Context(), r.store, id); err != nil {
+		switch err := err.(type) {
+		case *store.ErrNotFound:
+			return nil, actions.NewErrorf(actions.NotFound)
+		default:
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+	}
+
+	return nil, nil
+}
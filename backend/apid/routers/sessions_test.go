@@ -0,0 +1,85 @@
+package routers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSessionsRouter(t *testing.T) {
+	empty := &corev2.Session{}
+	fixture := corev2.FixtureSession("abc", "foo")
+
+	tests := []routerTestCase{}
+	tests = append(tests, getTestCases(fixture)...)
+	tests = append(tests, listTestCases(empty)...)
+	for _, tt := range tests {
+		s := &mockstore.MockStore{}
+		router := NewSessionsRouter(s)
+		parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+		router.Mount(parentRouter)
+		run(t, tt, parentRouter, s)
+	}
+}
+
+func TestSessionsRouterRevoke(t *testing.T) {
+	fixture := corev2.FixtureSession("abc", "foo")
+
+	tests := []routerTestCase{
+		{
+			name:   "it returns 404 if the session does not exist",
+			method: http.MethodDelete,
+			path:   fixture.URIPath(),
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "abc", mock.AnythingOfType("*v2.Session")).
+					Return(&store.ErrNotFound{})
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "it returns 500 if the store returns an error",
+			method: http.MethodDelete,
+			path:   fixture.URIPath(),
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "abc", mock.AnythingOfType("*v2.Session")).
+					Return(errors.New("error"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:           "it rejects dry runs",
+			method:         http.MethodDelete,
+			path:           fixture.URIPath() + "?" + handlers.DryRunParam + "=true",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "it revokes the session",
+			method: http.MethodDelete,
+			path:   fixture.URIPath(),
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "abc", mock.AnythingOfType("*v2.Session")).
+					Run(func(args mock.Arguments) {
+						*args[2].(*corev2.Session) = *fixture
+					}).Return(nil)
+				s.On("CreateOrUpdateResource", mock.Anything, mock.AnythingOfType("*v2.RevokedToken")).Return(nil)
+				s.On("ListResources", mock.Anything, corev2.RevokedTokensResource, mock.Anything, mock.Anything).Return(nil)
+				s.On("DeleteResource", mock.Anything, corev2.SessionsResource, "abc").Return(nil)
+			},
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		s := &mockstore.MockStore{}
+		router := NewSessionsRouter(s)
+		parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+		router.Mount(parentRouter)
+		run(t, tt, parentRouter, s)
+	}
+}
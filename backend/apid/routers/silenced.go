@@ -21,7 +21,9 @@ type SilencedRouter struct {
 // silencedController represents the controller needs of the SilencedRouter.
 type silencedController interface {
 	Create(ctx context.Context, entry *corev2.Silenced) error
+	ValidateCreate(ctx context.Context, entry *corev2.Silenced) error
 	CreateOrReplace(ctx context.Context, entry *corev2.Silenced) error
+	ValidateCreateOrReplace(ctx context.Context, entry *corev2.Silenced) error
 	List(ctx context.Context, sub, check string) ([]*corev2.Silenced, error)
 	Get(ctx context.Context, name string) (*corev2.Silenced, error)
 }
@@ -67,6 +69,10 @@ func (r *SilencedRouter) get(req *http.Request) (interface{}, error) {
 }
 
 func (r *SilencedRouter) create(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	entry := &corev2.Silenced{}
 	if err := UnmarshalBody(req, entry); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -76,11 +82,21 @@ func (r *SilencedRouter) create(req *http.Request) (interface{}, error) {
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
 
-	err := r.controller.Create(req.Context(), entry)
+	if dryRun {
+		if err := r.controller.ValidateCreate(req.Context(), entry); err != nil {
+			return nil, err
+		}
+		return entry, nil
+	}
+	err = r.controller.Create(req.Context(), entry)
 	return nil, err
 }
 
 func (r *SilencedRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	entry := &corev2.Silenced{}
 	if err := UnmarshalBody(req, entry); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -90,7 +106,13 @@ func (r *SilencedRouter) createOrReplace(req *http.Request) (interface{}, error)
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
 
-	err := r.controller.CreateOrReplace(req.Context(), entry)
+	if dryRun {
+		if err := r.controller.ValidateCreateOrReplace(req.Context(), entry); err != nil {
+			return nil, err
+		}
+		return entry, nil
+	}
+	err = r.controller.CreateOrReplace(req.Context(), entry)
 	return nil, err
 }
 
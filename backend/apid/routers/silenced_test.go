@@ -44,6 +44,14 @@ func (m *mockSilencedController) CreateOrReplace(ctx context.Context, entry *cor
 	return m.Called(ctx, entry).Error(0)
 }
 
+func (m *mockSilencedController) ValidateCreate(ctx context.Context, entry *corev2.Silenced) error {
+	return m.Called(ctx, entry).Error(0)
+}
+
+func (m *mockSilencedController) ValidateCreateOrReplace(ctx context.Context, entry *corev2.Silenced) error {
+	return m.Called(ctx, entry).Error(0)
+}
+
 func (m *mockSilencedController) List(ctx context.Context, sub, check string) ([]*corev2.Silenced, error) {
 	args := m.Called(ctx, sub, check)
 	return args.Get(0).([]*corev2.Silenced), args.Error(1)
@@ -113,6 +121,18 @@ func TestSilencedRouterCustomRoutes(t *testing.T) {
 			},
 			wantStatusCode: http.StatusCreated,
 		},
+		{
+			name:   "it only validates the silenced entry to create on a dry run",
+			method: http.MethodPost,
+			path:   empty.URIPath() + "?dryRun=true",
+			body:   marshal(fixture),
+			controllerFunc: func(c *mockSilencedController) {
+				c.On("ValidateCreate", mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		{
 			name:           "it returns 400 if the payload to update is not decodable",
 			method:         http.MethodPut,
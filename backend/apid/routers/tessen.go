@@ -6,6 +6,8 @@ import (
 
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
 )
 
 // TessenController represents the controller needs of the TessenRouter.
@@ -38,12 +40,22 @@ func (r *TessenRouter) Mount(parent *mux.Router) {
 }
 
 func (r *TessenRouter) createOrUpdate(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	obj := &corev2.TessenConfig{}
 	if err := UnmarshalBody(req, &obj); err != nil {
 		return nil, err
 	}
+	if dryRun {
+		if err := obj.Validate(); err != nil {
+			return nil, actions.NewError(actions.InvalidArgument, err)
+		}
+		return obj, nil
+	}
 
-	err := r.controller.CreateOrUpdate(req.Context(), obj)
+	err = r.controller.CreateOrUpdate(req.Context(), obj)
 	return obj, err
 }
 
@@ -9,15 +9,22 @@ import (
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/store"
 )
 
 // UserController represents the controller needs of the UsersRouter.
 type UserController interface {
 	List(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error)
+	ListWithPasswordHashes(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error)
 	Get(ctx context.Context, name string) (*corev2.User, error)
 	Create(ctx context.Context, user *corev2.User) error
+	ValidateCreate(ctx context.Context, user *corev2.User) error
 	CreateOrReplace(ctx context.Context, user *corev2.User) error
+	ValidateCreateOrReplace(ctx context.Context, user *corev2.User) error
+	UpdatePassword(ctx context.Context, user *corev2.User) error
+	ValidateUpdatePassword(ctx context.Context, user *corev2.User) error
 	Disable(ctx context.Context, name string) error
 	Enable(ctx context.Context, name string) error
 	AddGroup(ctx context.Context, name string, group string) error
@@ -26,15 +33,21 @@ type UserController interface {
 	AuthenticateUser(ctx context.Context, username, password string) (*corev2.User, error)
 }
 
+// IncludePasswordHashParam is the query parameter that includes the password
+// hashes of users when listing them, so they can be backed up and restored.
+const IncludePasswordHashParam = "includePasswordHash"
+
 // UsersRouter handles requests for /users
 type UsersRouter struct {
 	controller UserController
+	auth       authorization.Authorizer
 }
 
 // NewUsersRouter instantiates new router for controlling user resources
-func NewUsersRouter(store store.Store) *UsersRouter {
+func NewUsersRouter(store store.Store, auth authorization.Authorizer) *UsersRouter {
 	return &UsersRouter{
 		controller: actions.NewUserController(store),
+		auth:       auth,
 	}
 }
 
@@ -44,23 +57,54 @@ func (r *UsersRouter) Mount(parent *mux.Router) {
 		Router:     parent,
 		PathPrefix: "/{resource:users}",
 	}
+	parent.HandleFunc(routes.PathPrefix, listerHandler(r.listWithPasswordHashes, corev2.UserFields)).
+		Methods(http.MethodGet).
+		Queries(IncludePasswordHashParam, "true")
 	routes.List(r.controller.List, corev2.UserFields)
 	routes.Get(r.get)
 	routes.Post(r.create)
-	routes.Del(r.disable)
+	routes.Del(r.findOnDryRun(r.disable))
 	routes.Put(r.createOrReplace)
 
 	// Custom
-	routes.Path("{id}/{subresource:reinstate}", r.reinstate).Methods(http.MethodPut)
-	routes.Path("{id}/{subresource:groups}", r.removeAllGroups).Methods(http.MethodDelete)
-	routes.Path("{id}/{subresource:groups}/{user-group-name}", r.addGroup).Methods(http.MethodPut)
-	routes.Path("{id}/{subresource:groups}/{user-group-name}", r.removeGroup).Methods(http.MethodDelete)
+	routes.Path("{id}/{subresource:reinstate}", r.findOnDryRun(r.reinstate)).Methods(http.MethodPut)
+	routes.Path("{id}/{subresource:groups}", r.findOnDryRun(r.removeAllGroups)).Methods(http.MethodDelete)
+	routes.Path("{id}/{subresource:groups}/{user-group-name}", r.findOnDryRun(r.addGroup)).Methods(http.MethodPut)
+	routes.Path("{id}/{subresource:groups}/{user-group-name}", r.findOnDryRun(r.removeGroup)).Methods(http.MethodDelete)
 
 	// Password change & reset
 	routes.Path("{id}/{subresource:password}", r.updatePassword).Methods(http.MethodPut)
 	routes.Path("{id}/{subresource:reset_password}", r.resetPassword).Methods(http.MethodPut)
 }
 
+// findOnDryRun wraps an action modifying an existing user, so that dry run
+// requests only make sure the user exists instead of being applied.
+func (r *UsersRouter) findOnDryRun(fn actionHandlerFunc) actionHandlerFunc {
+	return func(req *http.Request) (interface{}, error) {
+		dryRun, err := handlers.DryRun(req)
+		if err != nil {
+			return nil, err
+		}
+		if !dryRun {
+			return fn(req)
+		}
+		id, err := url.PathUnescape(mux.Vars(req)["id"])
+		if err != nil {
+			return nil, err
+		}
+		_, err = r.controller.Get(req.Context(), id)
+		return nil, err
+	}
+}
+
+// withoutPassword removes the password and its hash from the user returned by
+// a dry run.
+func withoutPassword(user *corev2.User) *corev2.User {
+	user.Password = ""
+	user.PasswordHash = ""
+	return user
+}
+
 func (r *UsersRouter) get(req *http.Request) (interface{}, error) {
 	params := mux.Vars(req)
 	id, err := url.PathUnescape(params["id"])
@@ -76,17 +120,54 @@ func (r *UsersRouter) get(req *http.Request) (interface{}, error) {
 	return user, err
 }
 
+// listWithPasswordHashes lists the users with their password hashes, so they
+// can be backed up. Reading the hashes requires the dedicated permission to
+// list the passwordhashes resource.
+func (r *UsersRouter) listWithPasswordHashes(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+	attrs := authorization.GetAttributes(ctx)
+	if attrs == nil || r.auth == nil {
+		return nil, actions.NewErrorf(actions.PermissionDenied, "listing password hashes requires authorization")
+	}
+	hashesAttrs := *attrs
+	hashesAttrs.Verb = "list"
+	hashesAttrs.Resource = corev2.PasswordHashesResource
+	hashesAttrs.ResourceName = ""
+	hashesAttrs.LabelSelectors = nil
+	authorized, err := r.auth.Authorize(ctx, &hashesAttrs)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	if !authorized || hashesAttrs.LabelSelectors != nil {
+		return nil, actions.NewErrorf(actions.PermissionDenied, "listing password hashes requires the permission to list %s", corev2.PasswordHashesResource)
+	}
+	return r.controller.ListWithPasswordHashes(ctx, pred)
+}
+
 func (r *UsersRouter) create(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	user := &corev2.User{}
 	if err := UnmarshalBody(req, user); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
 	}
 
-	err := r.controller.Create(req.Context(), user)
+	if dryRun {
+		if err := r.controller.ValidateCreate(req.Context(), user); err != nil {
+			return nil, err
+		}
+		return withoutPassword(user), nil
+	}
+	err = r.controller.Create(req.Context(), user)
 	return nil, err
 }
 
 func (r *UsersRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	user := &corev2.User{}
 	if err := UnmarshalBody(req, user); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -102,7 +183,13 @@ func (r *UsersRouter) createOrReplace(req *http.Request) (interface{}, error) {
 			))
 	}
 
-	err := r.controller.CreateOrReplace(req.Context(), user)
+	if dryRun {
+		if err := r.controller.ValidateCreateOrReplace(req.Context(), user); err != nil {
+			return nil, err
+		}
+		return withoutPassword(user), nil
+	}
+	err = r.controller.CreateOrReplace(req.Context(), user)
 	return nil, err
 }
 
@@ -128,6 +215,10 @@ func (r *UsersRouter) reinstate(req *http.Request) (interface{}, error) {
 
 // updatePassword updates a user password by requiring the current password
 func (r *UsersRouter) updatePassword(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	params := map[string]string{}
 	if err := UnmarshalBody(req, &params); err != nil {
 		return nil, err
@@ -146,15 +237,23 @@ func (r *UsersRouter) updatePassword(req *http.Request) (interface{}, error) {
 	}
 
 	// Remove any old password hash and set the new password hash. The controller
-	// will set the resulting hash in both fields before storing it.
-	user.Password = ""
+	// will set the resulting hash in both fields before storing it. The
+	// cleartext password is optional, but required by a password policy.
+	user.Password = params["new_password"]
 	user.PasswordHash = params["password_hash"]
-	err = r.controller.CreateOrReplace(req.Context(), user)
+	if dryRun {
+		return nil, r.controller.ValidateUpdatePassword(req.Context(), user)
+	}
+	err = r.controller.UpdatePassword(req.Context(), user)
 	return nil, err
 }
 
 // resetPassword updates a user password without any kind of verification
 func (r *UsersRouter) resetPassword(req *http.Request) (interface{}, error) {
+	dryRun, err := handlers.DryRun(req)
+	if err != nil {
+		return nil, err
+	}
 	params := map[string]string{}
 	if err := UnmarshalBody(req, &params); err != nil {
 		return nil, err
@@ -171,8 +270,12 @@ func (r *UsersRouter) resetPassword(req *http.Request) (interface{}, error) {
 		return nil, err
 	}
 
+	user.Password = params["new_password"]
 	user.PasswordHash = params["password_hash"]
-	err = r.controller.CreateOrReplace(req.Context(), user)
+	if dryRun {
+		return nil, r.controller.ValidateUpdatePassword(req.Context(), user)
+	}
+	err = r.controller.UpdatePassword(req.Context(), user)
 	return nil, err
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/stretchr/testify/mock"
 )
@@ -36,11 +37,32 @@ func (m *mockUserController) CreateOrReplace(ctx context.Context, user *corev2.U
 	return m.Called(ctx, user).Error(0)
 }
 
+func (m *mockUserController) UpdatePassword(ctx context.Context, user *corev2.User) error {
+	return m.Called(ctx, user).Error(0)
+}
+
+func (m *mockUserController) ValidateCreate(ctx context.Context, user *corev2.User) error {
+	return m.Called(ctx, user).Error(0)
+}
+
+func (m *mockUserController) ValidateCreateOrReplace(ctx context.Context, user *corev2.User) error {
+	return m.Called(ctx, user).Error(0)
+}
+
+func (m *mockUserController) ValidateUpdatePassword(ctx context.Context, user *corev2.User) error {
+	return m.Called(ctx, user).Error(0)
+}
+
 func (m *mockUserController) List(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
 	args := m.Called(ctx, pred)
 	return args.Get(0).([]corev2.Resource), args.Error(1)
 }
 
+func (m *mockUserController) ListWithPasswordHashes(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+	args := m.Called(ctx, pred)
+	return args.Get(0).([]corev2.Resource), args.Error(1)
+}
+
 func (m *mockUserController) Get(ctx context.Context, name string) (*corev2.User, error) {
 	args := m.Called(ctx, name)
 	if args.Get(0) == nil {
@@ -176,6 +198,52 @@ func TestUsersRouter(t *testing.T) {
 			},
 			wantStatusCode: http.StatusCreated,
 		},
+		{
+			name:   "it only validates the user to create on a dry run",
+			method: http.MethodPost,
+			path:   empty.URIPath() + "?dryRun=true",
+			body:   marshal(fixture),
+			controllerFunc: func(c *mockUserController) {
+				c.On("ValidateCreate", mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "it only validates the user to update on a dry run",
+			method: http.MethodPut,
+			path:   fixture.URIPath() + "?dryRun=true",
+			body:   marshal(fixture),
+			controllerFunc: func(c *mockUserController) {
+				c.On("ValidateCreateOrReplace", mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "it only looks up the user to disable on a dry run",
+			method: http.MethodDelete,
+			path:   fixture.URIPath() + "?dryRun=true",
+			controllerFunc: func(c *mockUserController) {
+				c.On("Get", mock.Anything, "foo").
+					Return(fixture, nil).
+					Once()
+			},
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name:   "it returns 404 if the user to disable on a dry run does not exist",
+			method: http.MethodDelete,
+			path:   fixture.URIPath() + "?dryRun=true",
+			controllerFunc: func(c *mockUserController) {
+				c.On("Get", mock.Anything, "foo").
+					Return(nil, actions.NewErrorf(actions.NotFound)).
+					Once()
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
 		{
 			name:           "it returns 400 if the payload to update is not decodable",
 			method:         http.MethodPut,
@@ -215,7 +283,7 @@ func TestUsersRouter(t *testing.T) {
 			wantStatusCode: http.StatusCreated,
 		},
 		{
-			name:   "it only pass the password hash to CreateOrReplace when updating a password",
+			name:   "it only pass the password hash to UpdatePassword when updating a password",
 			method: http.MethodPut,
 			path:   path.Join(fixture.URIPath(), "password"),
 			body:   []byte(`{"password":"P@ssw0rd!","password_hash":"$2a$10$PdP2LURUHv7PylQtu8haL.8ZBSr5fjDmWXacNGWL6juiR4fRaRSNS"}`),
@@ -223,18 +291,54 @@ func TestUsersRouter(t *testing.T) {
 				c.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything).
 					Return(&corev2.User{Username: "foo", Password: "password_hash", PasswordHash: "password_hash"}, nil).
 					Once()
-				c.On("CreateOrReplace", mock.Anything, mock.Anything).
+				c.On("UpdatePassword", mock.Anything, mock.Anything).
 					Return(nil).
 					Once().
 					Run(func(args mock.Arguments) {
 						user := args.Get(1).(*corev2.User)
 						if user.Password != "" {
-							t.Fatal("only the password hash should be passed to the CreateOrReplace controller")
+							t.Fatal("only the password hash should be passed to the UpdatePassword controller")
 						}
 					})
 			},
 			wantStatusCode: http.StatusCreated,
 		},
+		{
+			name:   "it passes the new cleartext password to UpdatePassword for the password policy",
+			method: http.MethodPut,
+			path:   path.Join(fixture.URIPath(), "password"),
+			body:   []byte(`{"password":"P@ssw0rd!","new_password":"Sup3rSecretPassw0rd","password_hash":"$2a$10$PdP2LURUHv7PylQtu8haL.8ZBSr5fjDmWXacNGWL6juiR4fRaRSNS"}`),
+			controllerFunc: func(c *mockUserController) {
+				c.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything).
+					Return(&corev2.User{Username: "foo", Password: "password_hash", PasswordHash: "password_hash"}, nil).
+					Once()
+				c.On("UpdatePassword", mock.Anything, mock.Anything).
+					Return(nil).
+					Once().
+					Run(func(args mock.Arguments) {
+						user := args.Get(1).(*corev2.User)
+						if user.Password != "Sup3rSecretPassw0rd" {
+							t.Fatal("the new cleartext password should be passed to the UpdatePassword controller")
+						}
+					})
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:   "it returns 400 if the new password violates the password policy",
+			method: http.MethodPut,
+			path:   path.Join(fixture.URIPath(), "reset_password"),
+			body:   []byte(`{"new_password":"short","password_hash":"$2a$10$PdP2LURUHv7PylQtu8haL.8ZBSr5fjDmWXacNGWL6juiR4fRaRSNS"}`),
+			controllerFunc: func(c *mockUserController) {
+				c.On("Get", mock.Anything, mock.Anything).
+					Return(&corev2.User{Username: "foo"}, nil).
+					Once()
+				c.On("UpdatePassword", mock.Anything, mock.Anything).
+					Return(actions.NewErrorf(actions.InvalidArgument)).
+					Once()
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -270,3 +374,52 @@ func TestUsersRouter(t *testing.T) {
 		})
 	}
 }
+
+// resourceAuthorizer allows the verbs of each resource
+type resourceAuthorizer map[string]string
+
+func (a resourceAuthorizer) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	verb, ok := a[attrs.Resource]
+	return ok && (verb == attrs.Verb || verb == "*"), nil
+}
+
+func TestUsersRouterListWithPasswordHashes(t *testing.T) {
+	tests := []struct {
+		name           string
+		auth           authorization.Authorizer
+		wantStatusCode int
+	}{
+		{
+			name:           "viewers allowed to list the password hashes read them",
+			auth:           resourceAuthorizer{"users": "list", "passwordhashes": "list"},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "viewers allowed to update users can't read the hashes",
+			auth:           resourceAuthorizer{"users": "*"},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "viewers without authorization can't read the hashes",
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := &mockUserController{}
+			controller.On("ListWithPasswordHashes", mock.Anything, mock.Anything).
+				Return([]corev2.Resource{corev2.FixtureUser("foo")}, nil)
+			router := UsersRouter{controller: controller, auth: tt.auth}
+			parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+			router.Mount(parentRouter)
+
+			req := httptest.NewRequest(http.MethodGet, corev2.URLPrefix+"/users?includePasswordHash=true", nil)
+			ctx := authorization.SetAttributes(req.Context(), &authorization.Attributes{Verb: "list", Resource: "users"})
+			rr := httptest.NewRecorder()
+			parentRouter.ServeHTTP(rr, req.WithContext(ctx))
+			if rr.Code != tt.wantStatusCode {
+				t.Errorf("status code = %d, want %d: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Package audit records the mutating operations performed through the API.
+package audit
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// OutcomeSuccess is the outcome of an operation that succeeded.
+	OutcomeSuccess = "success"
+
+	// OutcomeFailure is the outcome of an operation that failed, including
+	// the operations that were not authorized.
+	OutcomeFailure = "failure"
+
+	// VerbMutation is the verb of GraphQL mutations.
+	VerbMutation = "mutation"
+
+	// ResourceGraphQL is the resource of GraphQL mutations.
+	ResourceGraphQL = "graphql"
+)
+
+// Entry is an entry of the audit log, describing an operation.
+type Entry struct {
+	// Time is the time at which the operation was received.
+	Time time.Time `json:"time"`
+
+	// User is the name of the user who performed the operation.
+	User string `json:"user"`
+
+	// Groups are the groups of the user.
+	Groups []string `json:"groups,omitempty"`
+
+	// Impersonator is the name of the user who impersonated the user, if any.
+	Impersonator string `json:"impersonator,omitempty"`
+
+	// SourceIP is the address of the client.
+	SourceIP string `json:"source_ip"`
+
+	// Verb is the verb of the operation, e.g. create, update, delete or
+	// mutation.
+	Verb string `json:"verb"`
+
+	// APIGroup and APIVersion are the API group and version of the resource.
+	APIGroup   string `json:"api_group,omitempty"`
+	APIVersion string `json:"api_version,omitempty"`
+
+	// Resource is the type of the resource, e.g. checks.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the resource.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource. For GraphQL mutations, it is the name
+	// of the operation.
+	Name string `json:"name,omitempty"`
+
+	// Outcome is the outcome of the operation, success or failure.
+	Outcome string `json:"outcome"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"status_code"`
+
+	// RequestBody is the body of the request, with its secrets redacted. It
+	// is only recorded when configured.
+	RequestBody json.RawMessage `json:"request_body,omitempty"`
+
+	// Diff contains the resource before and after the operation. It is only
+	// recorded when configured.
+	Diff *Diff `json:"diff,omitempty"`
+}
+
+// Diff contains a resource before and after an operation, with its secrets
+// redacted. Before is empty for resources created by the operation, and
+// After is empty for resources deleted by the operation.
+type Diff struct {
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// Logger records audit entries.
+type Logger interface {
+	Log(*Entry)
+}
+
+// OutcomeFor returns the outcome of an operation given the status code of
+// its response.
+func OutcomeFor(statusCode int) string {
+	if statusCode >= 200 && statusCode < 400 {
+		return OutcomeSuccess
+	}
+	return OutcomeFailure
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"syscall"
+
+	"github.com/sensu/sensu-go/backend/logging"
+	"github.com/sensu/sensu-go/backend/messaging"
+)
+
+// FileLogger records audit entries in a dedicated file, as JSON lines. The
+// file is reopened when the backend receives a SIGHUP, so it can be rotated by
+// tools such as logrotate.
+type FileLogger struct {
+	path         string
+	bus          messaging.MessageBus
+	notify       chan interface{}
+	writer       *logging.RotateWriter
+	subscription *messaging.Subscription
+	errChan      chan error
+
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewFileLogger opens the audit log file at the given path.
+func NewFileLogger(path string, bus messaging.MessageBus) (*FileLogger, error) {
+	notify := make(chan interface{}, 1)
+	writer, err := logging.NewRotateWriter(path, notify)
+	if err != nil {
+		return nil, fmt.Errorf("could not open the audit log: %v", err)
+	}
+	return &FileLogger{
+		path:    path,
+		bus:     bus,
+		notify:  notify,
+		writer:  writer,
+		errChan: make(chan error, 1),
+		encoder: json.NewEncoder(writer),
+	}, nil
+}
+
+// Start subscribes the logger to SIGHUP.
+func (f *FileLogger) Start() error {
+	consumerName := fmt.Sprintf("auditlogger://%s", f.path)
+	subscription, err := f.bus.Subscribe(messaging.SignalTopic(syscall.SIGHUP), consumerName, f)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe audit logger to SIGHUP: %v", err)
+	}
+	f.subscription = &subscription
+	return nil
+}
+
+// Stop closes the audit log file.
+func (f *FileLogger) Stop() error {
+	if f.subscription != nil {
+		_ = f.subscription.Cancel()
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.writer.Close()
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (f *FileLogger) Err() <-chan error {
+	return f.errChan
+}
+
+// Name returns the daemon name.
+func (f *FileLogger) Name() string {
+	return "audit"
+}
+
+// Receiver implements messaging.Subscriber
+func (f *FileLogger) Receiver() chan<- interface{} {
+	return f.notify
+}
+
+// Log writes the entry to the audit log file.
+func (f *FileLogger) Log(entry *Entry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.encoder.Encode(entry); err != nil {
+		logger.WithError(err).Error("could not write the audit log entry")
+	}
+}
@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+)
+
+func TestFileLogger(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = bus.Stop() }()
+
+	// Providing an invalid path should return an error
+	if _, err := NewFileLogger("/", bus); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.log")
+	l, err := NewFileLogger(path, bus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = l.Stop() }()
+
+	l.Log(&Entry{User: "admin", Verb: "create", Resource: "checks", Outcome: OutcomeSuccess})
+
+	// Rotate the file
+	rotated := filepath.Join(dir, "audit.log.1")
+	if err := os.Rename(path, rotated); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Publish(messaging.SignalTopic(syscall.SIGHUP), syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := os.Stat(path); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the audit log was not reopened")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	l.Log(&Entry{User: "admin", Verb: "delete", Resource: "checks", Outcome: OutcomeFailure})
+
+	for file, verb := range map[string]string{rotated: "create", path: "delete"} {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scanner := bufio.NewScanner(f)
+		if !scanner.Scan() {
+			t.Fatalf("%s is empty", file)
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatal(err)
+		}
+		if entry.Verb != verb {
+			t.Errorf("%s: verb = %q, want %q", file, entry.Verb, verb)
+		}
+		_ = f.Close()
+	}
+}
@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Redacted replaces the secrets of the recorded bodies.
+const Redacted = "REDACTED"
+
+// sensitiveKeys are the substrings of the keys whose values are redacted.
+var sensitiveKeys = []string{
+	"password",
+	"secret",
+	"token",
+	"private_key",
+	"api_key",
+	"apikey",
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact returns the JSON document with the values of its sensitive keys,
+// e.g. password or token, and of its sensitive environment variables
+// replaced. It returns nil if the document is not valid JSON.
+func Redact(body []byte) json.RawMessage {
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(redactValue(doc))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			switch {
+			case isSensitive(key):
+				v[key] = Redacted
+			case key == "env_vars":
+				v[key] = redactEnvVars(value)
+			default:
+				v[key] = redactValue(value)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+	return v
+}
+
+// redactEnvVars redacts the values of the sensitive environment variables,
+// given in the KEY=value form.
+func redactEnvVars(v interface{}) interface{} {
+	vars, ok := v.([]interface{})
+	if !ok {
+		return redactValue(v)
+	}
+	for i, envVar := range vars {
+		s, ok := envVar.(string)
+		if !ok {
+			continue
+		}
+		if key := strings.SplitN(s, "=", 2)[0]; isSensitive(key) {
+			vars[i] = key + "=" + Redacted
+		}
+	}
+	return vars
+}
@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "invalid json",
+			body: "username=admin",
+			want: "",
+		},
+		{
+			name: "sensitive keys",
+			body: `{"username":"admin","password":"P@ssw0rd!","metadata":{"name":"admin"}}`,
+			want: `{"username":"admin","password":"REDACTED","metadata":{"name":"admin"}}`,
+		},
+		{
+			name: "nested sensitive keys",
+			body: `[{"spec":{"access_token":"abc","refresh_token":"def","client_secret":"ghi"}}]`,
+			want: `[{"spec":{"access_token":"REDACTED","refresh_token":"REDACTED","client_secret":"REDACTED"}}]`,
+		},
+		{
+			name: "environment variables",
+			body: `{"env_vars":["PATH=/bin","SLACK_TOKEN=xoxb","DB_PASSWORD=hunter2"]}`,
+			want: `{"env_vars":["PATH=/bin","SLACK_TOKEN=REDACTED","DB_PASSWORD=REDACTED"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Redact([]byte(tt.body))
+			if tt.want == "" {
+				if got != nil {
+					t.Fatalf("Redact() = %s, want nil", got)
+				}
+				return
+			}
+			var gotDoc, wantDoc interface{}
+			if err := json.Unmarshal(got, &gotDoc); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &wantDoc); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(gotDoc, wantDoc) {
+				t.Errorf("Redact() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
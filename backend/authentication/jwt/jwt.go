@@ -23,6 +23,12 @@ type key int
 const (
 	// IssuerURLKey specifies the URL on which the JWT is issued
 	IssuerURLKey key = iota
+
+	// ClientIPKey specifies the address of the client the JWT is issued to
+	ClientIPKey
+
+	// UserAgentKey specifies the user agent of the client the JWT is issued to
+	UserAgentKey
 )
 
 var (
@@ -77,6 +83,11 @@ func AccessToken(claims *corev2.Claims) (*jwt.Token, string, error) {
 	return token, tokenString, nil
 }
 
+// AccessTokenExpiration returns the lifetime of the access tokens.
+func AccessTokenExpiration() time.Duration {
+	return defaultExpiration
+}
+
 // NewClaims creates new claim based on username
 func NewClaims(user *corev2.User) (*corev2.Claims, error) {
 	// Create a unique identifier for the token
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
 )
 
 // Type represents the type of the basic authentication provider
@@ -17,12 +19,27 @@ const Type = "basic"
 // to authenticate with empty username and password.
 var ErrEmptyUsernamePassword = errors.New("the username and the password must not be empty")
 
+// ErrLockedOut is the error returned by the provider when the user or the
+// source IP failed to authenticate too many times.
+var ErrLockedOut = errors.New("too many failed authentication attempts, try again later")
+
+// ErrPasswordExpired is the error returned by the provider when the password
+// of the user is older than the expiry of the password policy.
+var ErrPasswordExpired = errors.New("the password has expired and must be reset by an administrator")
+
 // Provider represents the basic internal authentication provider
 type Provider struct {
 	Store store.Store
 
+	// PasswordPolicies caches the password policy of the cluster, so it is
+	// not read from the store on every login. The policy is read from the
+	// store when it is nil.
+	PasswordPolicies *cache.Resource
+
 	// ObjectMeta contains the name, namespace, labels and annotations
 	corev2.ObjectMeta `json:"metadata"`
+
+	lockout lockout
 }
 
 // Authenticate a user, with the provided credentials, against the Sensu store
@@ -31,10 +48,42 @@ func (p *Provider) Authenticate(ctx context.Context, username, password string)
 		return nil, ErrEmptyUsernamePassword
 	}
 
+	policy, err := p.passwordPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	ip, _ := ctx.Value(jwt.ClientIPKey).(string)
+	if p.lockout.IsLocked(username, ip, now) {
+		return nil, ErrLockedOut
+	}
+
 	user, err := p.Store.AuthenticateUser(ctx, username, password)
 	if err != nil {
+		switch err.(type) {
+		case *store.ErrNotFound, *store.ErrNotValid:
+			p.lockout.Fail(
+				username, ip, policy.MaxFailedAttempts, policy.MaxFailedAttemptsPerIP,
+				policy.LockoutDurationTime(), now,
+			)
+		}
 		return nil, err
 	}
+	p.lockout.Succeed(username)
+
+	if policy.IsPasswordExpired(user.PasswordChangedAt, now) {
+		return nil, ErrPasswordExpired
+	}
+	if policy.PasswordExpiry > 0 && user.PasswordChangedAt == 0 {
+		// The password was set before the expiry was configured, so start
+		// counting from now on. This happens once per user, and the login
+		// does not depend on it.
+		user.PasswordChangedAt = now.Unix()
+		if err := p.Store.UpdateUser(user); err != nil {
+			logger.WithError(err).WithField("user", user.Username).Error("could not start the expiry of the password")
+		}
+	}
 
 	claims, err := jwt.NewClaims(user)
 	if err != nil {
@@ -71,6 +120,29 @@ func (p *Provider) Refresh(ctx context.Context, claims *corev2.Claims) (*corev2.
 	return newClaims, nil
 }
 
+// passwordPolicy returns the password policy of the cluster, or an empty
+// policy if none is configured.
+func (p *Provider) passwordPolicy(ctx context.Context) (*corev2.PasswordPolicy, error) {
+	if p.PasswordPolicies != nil {
+		for _, value := range p.PasswordPolicies.Get("") {
+			policy, ok := value.Resource.(*corev2.PasswordPolicy)
+			if ok && policy.Name == corev2.DefaultPasswordPolicyName {
+				return policy, nil
+			}
+		}
+		return &corev2.PasswordPolicy{}, nil
+	}
+	ctx = context.WithValue(ctx, corev2.NamespaceKey, "")
+	policy := &corev2.PasswordPolicy{}
+	if err := p.Store.GetResource(ctx, corev2.DefaultPasswordPolicyName, policy); err != nil {
+		if _, ok := err.(*store.ErrNotFound); ok {
+			return &corev2.PasswordPolicy{}, nil
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
 // GetObjectMeta returns the provider metadata
 func (p *Provider) GetObjectMeta() corev2.ObjectMeta {
 	return p.ObjectMeta
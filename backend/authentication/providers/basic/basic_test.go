@@ -0,0 +1,155 @@
+package basic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockPasswordPolicy(s *mockstore.MockStore, policy *corev2.PasswordPolicy) {
+	call := s.On("GetResource", mock.Anything, corev2.DefaultPasswordPolicyName, mock.AnythingOfType("*v2.PasswordPolicy"))
+	if policy == nil {
+		call.Return(&store.ErrNotFound{})
+		return
+	}
+	call.Run(func(args mock.Arguments) {
+		*args[2].(*corev2.PasswordPolicy) = *policy
+	}).Return(nil)
+}
+
+func TestAuthenticate(t *testing.T) {
+	s := &mockstore.MockStore{}
+	mockPasswordPolicy(s, nil)
+	user := corev2.FixtureUser("foo")
+	s.On("AuthenticateUser", mock.Anything, "foo", "P@ssw0rd!").Return(user, nil)
+	s.On("AuthenticateUser", mock.Anything, "foo", "wrong").
+		Return((*corev2.User)(nil), &store.ErrNotValid{Err: errors.New("wrong password")})
+
+	p := &Provider{Store: s, ObjectMeta: corev2.ObjectMeta{Name: Type}}
+
+	_, err := p.Authenticate(context.Background(), "", "")
+	assert.Equal(t, ErrEmptyUsernamePassword, err)
+
+	_, err = p.Authenticate(context.Background(), "foo", "wrong")
+	assert.Error(t, err)
+
+	claims, err := p.Authenticate(context.Background(), "foo", "P@ssw0rd!")
+	require.NoError(t, err)
+	assert.Equal(t, "foo", claims.Provider.UserID)
+}
+
+func TestAuthenticateLockout(t *testing.T) {
+	s := &mockstore.MockStore{}
+	mockPasswordPolicy(s, &corev2.PasswordPolicy{
+		ObjectMeta:             corev2.NewObjectMeta(corev2.DefaultPasswordPolicyName, ""),
+		MaxFailedAttempts:      3,
+		MaxFailedAttemptsPerIP: 5,
+		LockoutDuration:        60,
+	})
+	s.On("AuthenticateUser", mock.Anything, mock.Anything, "wrong").
+		Return((*corev2.User)(nil), &store.ErrNotValid{Err: errors.New("wrong password")})
+	s.On("AuthenticateUser", mock.Anything, "foo", "P@ssw0rd!").Return(corev2.FixtureUser("foo"), nil)
+	s.On("AuthenticateUser", mock.Anything, "bar", "P@ssw0rd!").Return(corev2.FixtureUser("bar"), nil)
+
+	p := &Provider{Store: s, ObjectMeta: corev2.ObjectMeta{Name: Type}}
+	ctx := context.WithValue(context.Background(), jwt.ClientIPKey, "10.0.0.1")
+
+	// The user is locked out after 3 failed attempts, even with the right
+	// password
+	for i := 0; i < 3; i++ {
+		_, err := p.Authenticate(ctx, "foo", "wrong")
+		assert.NotEqual(t, ErrLockedOut, err)
+	}
+	_, err := p.Authenticate(ctx, "foo", "P@ssw0rd!")
+	assert.Equal(t, ErrLockedOut, err)
+
+	// Another user can still log in from another IP
+	otherCtx := context.WithValue(context.Background(), jwt.ClientIPKey, "10.0.0.2")
+	_, err = p.Authenticate(otherCtx, "bar", "P@ssw0rd!")
+	assert.NoError(t, err)
+
+	// The IP is locked out after 5 failed attempts, whatever the user
+	for i := 0; i < 2; i++ {
+		_, err := p.Authenticate(ctx, "baz", "wrong")
+		assert.NotEqual(t, ErrLockedOut, err)
+	}
+	_, err = p.Authenticate(ctx, "bar", "P@ssw0rd!")
+	assert.Equal(t, ErrLockedOut, err)
+}
+
+func TestAuthenticatePasswordExpiry(t *testing.T) {
+	s := &mockstore.MockStore{}
+	mockPasswordPolicy(s, &corev2.PasswordPolicy{
+		ObjectMeta:     corev2.NewObjectMeta(corev2.DefaultPasswordPolicyName, ""),
+		PasswordExpiry: 30,
+	})
+	expired := corev2.FixtureUser("expired")
+	expired.PasswordChangedAt = time.Now().Add(-31 * 24 * time.Hour).Unix()
+	s.On("AuthenticateUser", mock.Anything, "expired", mock.Anything).Return(expired, nil)
+	legacy := corev2.FixtureUser("legacy")
+	s.On("AuthenticateUser", mock.Anything, "legacy", mock.Anything).Return(legacy, nil)
+	s.On("UpdateUser", legacy).Return(nil)
+
+	p := &Provider{Store: s, ObjectMeta: corev2.ObjectMeta{Name: Type}}
+
+	_, err := p.Authenticate(context.Background(), "expired", "P@ssw0rd!")
+	assert.Equal(t, ErrPasswordExpired, err)
+
+	// The expiry of the passwords set before the policy starts at the next
+	// login
+	_, err = p.Authenticate(context.Background(), "legacy", "P@ssw0rd!")
+	require.NoError(t, err)
+	assert.NotZero(t, legacy.PasswordChangedAt)
+	s.AssertCalled(t, "UpdateUser", legacy)
+}
+
+func TestAuthenticatePasswordPolicyCache(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("AuthenticateUser", mock.Anything, "foo", "wrong").
+		Return((*corev2.User)(nil), &store.ErrNotValid{Err: errors.New("wrong password")})
+	policy := &corev2.PasswordPolicy{
+		ObjectMeta:        corev2.NewObjectMeta(corev2.DefaultPasswordPolicyName, ""),
+		MaxFailedAttempts: 1,
+		LockoutDuration:   60,
+	}
+	policies := cache.NewFromResources([]corev2.Resource{policy}, false)
+
+	p := &Provider{Store: s, PasswordPolicies: policies, ObjectMeta: corev2.ObjectMeta{Name: Type}}
+
+	// The policy is read from the cache rather than the store
+	_, err := p.Authenticate(context.Background(), "foo", "wrong")
+	assert.NotEqual(t, ErrLockedOut, err)
+	_, err = p.Authenticate(context.Background(), "foo", "wrong")
+	assert.Equal(t, ErrLockedOut, err)
+	s.AssertNotCalled(t, "GetResource", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLockoutExpires(t *testing.T) {
+	var l lockout
+	now := time.Now()
+
+	l.Fail("foo", "", 1, 0, time.Minute, now)
+	assert.True(t, l.IsLocked("foo", "", now))
+	assert.False(t, l.IsLocked("foo", "", now.Add(time.Minute)))
+
+	// Failed attempts older than the lockout duration are not counted
+	l.Fail("bar", "", 2, 0, time.Minute, now)
+	l.Fail("bar", "", 2, 0, time.Minute, now.Add(2*time.Minute))
+	assert.False(t, l.IsLocked("bar", "", now.Add(2*time.Minute)))
+
+	// A successful authentication resets the count of the user
+	l.Fail("baz", "", 2, 0, time.Minute, now)
+	l.Succeed("baz")
+	l.Fail("baz", "", 2, 0, time.Minute, now)
+	assert.False(t, l.IsLocked("baz", "", now))
+}
@@ -0,0 +1,100 @@
+package basic
+
+import (
+	"sync"
+	"time"
+)
+
+// lockout counts the failed authentications of users and source IPs, so they
+// can be locked out once they reach the maximum number of attempts of the
+// password policy. The zero value is ready to use.
+//
+// The counts are kept in memory by each backend and are not shared across the
+// cluster: behind a load balancer, a client can fail up to the maximum number
+// of attempts on every backend, and the counts are lost when a backend
+// restarts. Storing them would put a write on every failed login, which is
+// what an attacker controls.
+type lockout struct {
+	mu    sync.Mutex
+	users map[string]*attempts
+	ips   map[string]*attempts
+}
+
+type attempts struct {
+	count int
+	// first is the time of the first failed attempt counted
+	first time.Time
+	// lockedUntil is the time until which the attempts are rejected
+	lockedUntil time.Time
+}
+
+// isLocked returns whether the key is locked out at the given time.
+func isLocked(m map[string]*attempts, key string, now time.Time) bool {
+	a, ok := m[key]
+	return ok && now.Before(a.lockedUntil)
+}
+
+// record counts a failed attempt for the key, and locks it out for the
+// duration once the maximum number of attempts within that duration is
+// reached.
+func record(m map[string]*attempts, key string, max uint32, duration time.Duration, now time.Time) {
+	a, ok := m[key]
+	if !ok || now.Sub(a.first) >= duration {
+		a = &attempts{first: now}
+		m[key] = a
+	}
+	a.count++
+	if a.count >= int(max) {
+		a.lockedUntil = now.Add(duration)
+		a.count = 0
+		a.first = now
+	}
+}
+
+// prune forgets the keys which are not locked out anymore and whose failed
+// attempts are too old to be counted.
+func prune(m map[string]*attempts, duration time.Duration, now time.Time) {
+	for key, a := range m {
+		if !now.Before(a.lockedUntil) && now.Sub(a.first) >= duration {
+			delete(m, key)
+		}
+	}
+}
+
+// IsLocked returns whether the user or the source IP is locked out.
+func (l *lockout) IsLocked(username, ip string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if isLocked(l.users, username, now) {
+		return true
+	}
+	return ip != "" && isLocked(l.ips, ip, now)
+}
+
+// Fail records a failed authentication of the user from the source IP,
+// according to the limits of the password policy.
+func (l *lockout) Fail(username, ip string, maxPerUser, maxPerIP uint32, duration time.Duration, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.users == nil {
+		l.users = make(map[string]*attempts)
+		l.ips = make(map[string]*attempts)
+	}
+	prune(l.users, duration, now)
+	prune(l.ips, duration, now)
+	if maxPerUser > 0 {
+		record(l.users, username, maxPerUser, duration, now)
+	}
+	if maxPerIP > 0 && ip != "" {
+		record(l.ips, ip, maxPerIP, duration, now)
+	}
+}
+
+// Succeed forgets the failed authentications of the user. The failures of the
+// source IP are kept, so a successful login does not let an attacker reset
+// its own count.
+func (l *lockout) Succeed(username string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.users, username)
+}
@@ -0,0 +1,99 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store/cache"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Denylist holds the revoked tokens, so the access tokens can be rejected
+// before they expire. It is kept up to date by watching the store.
+type Denylist struct {
+	mu      sync.RWMutex
+	revoked map[string]int64
+	updated chan struct{}
+	now     func() time.Time
+}
+
+// NewDenylist creates a denylist of the revoked tokens of the store.
+func NewDenylist(ctx context.Context, client clientv3.KV) (*Denylist, error) {
+	revoked, err := cache.New(ctx, client, &corev2.RevokedToken{}, false)
+	if err != nil {
+		return nil, err
+	}
+	d := newDenylist(revoked.GetAll())
+
+	go func() {
+		updates := revoked.Watch(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-updates:
+				d.update(revoked.GetAll())
+			}
+		}
+	}()
+
+	return d, nil
+}
+
+// NewDenylistFromResources creates a denylist of the given revoked tokens.
+// This function should only be used for testing purpose.
+func NewDenylistFromResources(tokens ...*corev2.RevokedToken) *Denylist {
+	values := make([]cache.Value, 0, len(tokens))
+	for _, token := range tokens {
+		values = append(values, cache.Value{Resource: token})
+	}
+	return newDenylist(values)
+}
+
+func newDenylist(values []cache.Value) *Denylist {
+	d := &Denylist{now: time.Now, updated: make(chan struct{})}
+	d.update(values)
+	return d
+}
+
+func (d *Denylist) update(values []cache.Value) {
+	revoked := make(map[string]int64, len(values))
+	for _, value := range values {
+		if token, ok := value.Resource.(*corev2.RevokedToken); ok {
+			revoked[token.Name] = token.ExpiresAt
+		}
+	}
+	d.mu.Lock()
+	d.revoked = revoked
+	close(d.updated)
+	d.updated = make(chan struct{})
+	d.mu.Unlock()
+}
+
+// Updated returns a channel that is closed the next time the revoked tokens
+// are updated, so that long-lived connections can check whether their access
+// token was revoked since they were authenticated.
+func (d *Denylist) Updated() <-chan struct{} {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.updated
+}
+
+// IsRevoked returns whether the access token of the claims, or its session,
+// was revoked.
+func (d *Denylist) IsRevoked(claims *corev2.Claims) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	now := d.now().Unix()
+	for _, id := range []string{claims.Id, claims.SessionID} {
+		if id == "" {
+			continue
+		}
+		if expiresAt, ok := d.revoked[id]; ok && now < expiresAt {
+			return true
+		}
+	}
+	return false
+}
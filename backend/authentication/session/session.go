@@ -0,0 +1,183 @@
+// Package session manages the sessions of the users logged in, and the
+// revocation of their access tokens.
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// ErrSessionRevoked is returned when refreshing the access token of a session
+// which does not exist anymore, or is expired.
+var ErrSessionRevoked = errors.New("session revoked")
+
+// Expiration is how long a session lasts without its access token being
+// refreshed. Refresh tokens do not expire by themselves, so they can't be used
+// once their session expired.
+const Expiration = 7 * 24 * time.Hour
+
+// clusterContext returns a context without namespace, since the sessions and
+// revoked tokens are cluster-wide.
+func clusterContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, corev2.NamespaceKey, "")
+}
+
+// Create stores a new session, named after the identifier of its refresh
+// token, and deletes the expired sessions.
+func Create(ctx context.Context, st store.ResourceStore, refreshClaims *corev2.Claims, clientIP, userAgent string) (*corev2.Session, error) {
+	ctx = clusterContext(ctx)
+	now := time.Now()
+	session := &corev2.Session{
+		ObjectMeta: corev2.NewObjectMeta(refreshClaims.Id, ""),
+		Username:   refreshClaims.Subject,
+		IssuedAt:   now.Unix(),
+		ClientIP:   clientIP,
+		UserAgent:  userAgent,
+		ExpiresAt:  now.Add(Expiration).Unix(),
+	}
+	if err := st.CreateResource(ctx, session); err != nil {
+		return nil, err
+	}
+
+	sessions := []*corev2.Session{}
+	if err := st.ListResources(ctx, corev2.SessionsResource, &sessions, &store.SelectionPredicate{}); err != nil {
+		logger.WithError(err).Warn("could not list the sessions")
+		return session, nil
+	}
+	for _, expired := range sessions {
+		if !expired.IsExpired(now) {
+			continue
+		}
+		if err := st.DeleteResource(ctx, corev2.SessionsResource, expired.Name); err != nil {
+			if _, ok := err.(*store.ErrNotFound); !ok {
+				logger.WithError(err).Warn("could not delete an expired session")
+			}
+		}
+	}
+	return session, nil
+}
+
+// Refresh records the refresh of the access token of the session of the
+// refresh token, and extends the session. ErrSessionRevoked is returned if the
+// session does not exist or is expired. The refresh tokens issued before the
+// sessions were introduced have no session, so they are rejected.
+func Refresh(ctx context.Context, st store.ResourceStore, refreshClaims *corev2.Claims) (*corev2.Session, error) {
+	ctx = clusterContext(ctx)
+	session := &corev2.Session{}
+	if err := st.GetResource(ctx, refreshClaims.Id, session); err != nil {
+		if _, ok := err.(*store.ErrNotFound); ok {
+			return nil, ErrSessionRevoked
+		}
+		return nil, err
+	}
+	if session.Username != refreshClaims.Subject {
+		return nil, ErrSessionRevoked
+	}
+	now := time.Now()
+	if session.IsExpired(now) {
+		if err := st.DeleteResource(ctx, corev2.SessionsResource, session.Name); err != nil {
+			if _, ok := err.(*store.ErrNotFound); !ok {
+				logger.WithError(err).Warn("could not delete an expired session")
+			}
+		}
+		return nil, ErrSessionRevoked
+	}
+
+	session.RefreshedAt = now.Unix()
+	session.ExpiresAt = now.Add(Expiration).Unix()
+	if err := st.CreateOrUpdateResource(ctx, session); err != nil {
+		logger.WithError(err).Warn("could not record the refresh of the session")
+	}
+	return session, nil
+}
+
+// Revoke deletes the session with the given identifier, and revokes the
+// access tokens issued for it.
+func Revoke(ctx context.Context, st store.ResourceStore, id string) error {
+	ctx = clusterContext(ctx)
+	session := &corev2.Session{}
+	if err := st.GetResource(ctx, id, session); err != nil {
+		return err
+	}
+
+	// The access tokens of the session expire at the latest one lifetime
+	// after the session is revoked
+	revoked := &corev2.RevokedToken{
+		ObjectMeta: corev2.NewObjectMeta(id, ""),
+		Username:   session.Username,
+		ExpiresAt:  time.Now().Add(jwt.AccessTokenExpiration()).Unix(),
+	}
+	if err := revoke(ctx, st, revoked); err != nil {
+		return err
+	}
+
+	return st.DeleteResource(ctx, corev2.SessionsResource, id)
+}
+
+// RevokeUser revokes all the sessions of the user.
+func RevokeUser(ctx context.Context, st store.ResourceStore, username string) error {
+	ctx = clusterContext(ctx)
+	sessions := []*corev2.Session{}
+	if err := st.ListResources(ctx, corev2.SessionsResource, &sessions, &store.SelectionPredicate{}); err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if session.Username != username {
+			continue
+		}
+		if err := Revoke(ctx, st, session.Name); err != nil {
+			if _, ok := err.(*store.ErrNotFound); ok {
+				// The session was revoked concurrently
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeToken revokes the access token of the claims until it expires.
+func RevokeToken(ctx context.Context, st store.ResourceStore, claims *corev2.Claims) error {
+	if claims.Id == "" || claims.ExpiresAt == 0 {
+		// The token can't be told apart from the other tokens, or never
+		// expires
+		return nil
+	}
+	revoked := &corev2.RevokedToken{
+		ObjectMeta: corev2.NewObjectMeta(claims.Id, ""),
+		Username:   claims.Subject,
+		ExpiresAt:  claims.ExpiresAt,
+	}
+	return revoke(clusterContext(ctx), st, revoked)
+}
+
+// revoke stores the revoked token, and deletes the revoked tokens which are
+// expired, so the denylist does not grow forever.
+func revoke(ctx context.Context, st store.ResourceStore, revoked *corev2.RevokedToken) error {
+	if err := st.CreateOrUpdateResource(ctx, revoked); err != nil {
+		return err
+	}
+
+	tokens := []*corev2.RevokedToken{}
+	if err := st.ListResources(ctx, corev2.RevokedTokensResource, &tokens, &store.SelectionPredicate{}); err != nil {
+		logger.WithError(err).Warn("could not list the revoked tokens")
+		return nil
+	}
+	now := time.Now()
+	for _, token := range tokens {
+		if !token.IsExpired(now) {
+			continue
+		}
+		if err := st.DeleteResource(ctx, corev2.RevokedTokensResource, token.Name); err != nil {
+			if _, ok := err.(*store.ErrNotFound); !ok {
+				logger.WithError(err).Warn("could not delete an expired revoked token")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func getSession(s *mockstore.MockStore, session *corev2.Session) {
+	s.On("GetResource", mock.Anything, session.Name, mock.AnythingOfType("*v2.Session")).Run(func(args mock.Arguments) {
+		*args[2].(*corev2.Session) = *session
+	}).Return(nil)
+}
+
+func listRevokedTokens(s *mockstore.MockStore, tokens ...*corev2.RevokedToken) {
+	s.On("ListResources", mock.Anything, corev2.RevokedTokensResource, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		*args[2].(*[]*corev2.RevokedToken) = tokens
+	}).Return(nil)
+}
+
+func TestCreate(t *testing.T) {
+	expired := corev2.FixtureSession("expired", "foo")
+	expired.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+
+	s := &mockstore.MockStore{}
+	s.On("CreateResource", mock.Anything, mock.AnythingOfType("*v2.Session")).Return(nil)
+	s.On("ListResources", mock.Anything, corev2.SessionsResource, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		*args[2].(*[]*corev2.Session) = []*corev2.Session{corev2.FixtureSession("abc", "foo"), expired}
+	}).Return(nil)
+	s.On("DeleteResource", mock.Anything, corev2.SessionsResource, "expired").Return(nil)
+
+	claims := &corev2.Claims{StandardClaims: corev2.StandardClaims("foo")}
+	claims.Id = "abc"
+	session, err := Create(context.Background(), s, claims, "10.0.0.1", "sensuctl")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", session.Name)
+	assert.Equal(t, "foo", session.Username)
+	assert.Equal(t, "10.0.0.1", session.ClientIP)
+	assert.Equal(t, "sensuctl", session.UserAgent)
+	assert.NotZero(t, session.IssuedAt)
+	assert.False(t, session.IsExpired(time.Now()))
+	require.NoError(t, session.Validate())
+
+	// The expired sessions are deleted
+	s.AssertCalled(t, "DeleteResource", mock.Anything, corev2.SessionsResource, "expired")
+	s.AssertNotCalled(t, "DeleteResource", mock.Anything, corev2.SessionsResource, "abc")
+}
+
+func TestRefresh(t *testing.T) {
+	s := &mockstore.MockStore{}
+	getSession(s, corev2.FixtureSession("abc", "foo"))
+	s.On("GetResource", mock.Anything, "def", mock.Anything).Return(&store.ErrNotFound{Key: "def"})
+	s.On("CreateOrUpdateResource", mock.Anything, mock.AnythingOfType("*v2.Session")).Return(nil)
+
+	claims := &corev2.Claims{StandardClaims: corev2.StandardClaims("foo")}
+	claims.Id = "abc"
+	session, err := Refresh(context.Background(), s, claims)
+	require.NoError(t, err)
+	assert.NotZero(t, session.RefreshedAt)
+	assert.True(t, session.ExpiresAt > time.Now().Add(Expiration-time.Minute).Unix())
+
+	// The session must belong to the user of the refresh token
+	claims.Subject = "bar"
+	_, err = Refresh(context.Background(), s, claims)
+	assert.Equal(t, ErrSessionRevoked, err)
+
+	claims.Id = "def"
+	claims.Subject = "foo"
+	_, err = Refresh(context.Background(), s, claims)
+	assert.Equal(t, ErrSessionRevoked, err)
+}
+
+func TestRefreshExpired(t *testing.T) {
+	expired := corev2.FixtureSession("abc", "foo")
+	expired.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+
+	s := &mockstore.MockStore{}
+	getSession(s, expired)
+	s.On("DeleteResource", mock.Anything, corev2.SessionsResource, "abc").Return(nil)
+
+	claims := &corev2.Claims{StandardClaims: corev2.StandardClaims("foo")}
+	claims.Id = "abc"
+	_, err := Refresh(context.Background(), s, claims)
+	assert.Equal(t, ErrSessionRevoked, err)
+	s.AssertCalled(t, "DeleteResource", mock.Anything, corev2.SessionsResource, "abc")
+	s.AssertNotCalled(t, "CreateOrUpdateResource", mock.Anything, mock.Anything)
+}
+
+func TestRevoke(t *testing.T) {
+	s := &mockstore.MockStore{}
+	getSession(s, corev2.FixtureSession("abc", "foo"))
+	expired := corev2.FixtureRevokedToken("old")
+	expired.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+	listRevokedTokens(s, expired, corev2.FixtureRevokedToken("recent"))
+	s.On("CreateOrUpdateResource", mock.Anything, mock.MatchedBy(func(token *corev2.RevokedToken) bool {
+		return token.Name == "abc" && token.Username == "foo" && token.ExpiresAt > time.Now().Unix()
+	})).Return(nil)
+	s.On("DeleteResource", mock.Anything, corev2.RevokedTokensResource, "old").Return(nil)
+	s.On("DeleteResource", mock.Anything, corev2.SessionsResource, "abc").Return(nil)
+
+	require.NoError(t, Revoke(context.Background(), s, "abc"))
+	s.AssertExpectations(t)
+}
+
+func TestRevokeUser(t *testing.T) {
+	s := &mockstore.MockStore{}
+	sessions := []*corev2.Session{
+		corev2.FixtureSession("abc", "foo"),
+		corev2.FixtureSession("def", "bar"),
+	}
+	s.On("ListResources", mock.Anything, corev2.SessionsResource, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		*args[2].(*[]*corev2.Session) = sessions
+	}).Return(nil)
+	getSession(s, sessions[0])
+	listRevokedTokens(s)
+	s.On("CreateOrUpdateResource", mock.Anything, mock.AnythingOfType("*v2.RevokedToken")).Return(nil)
+	s.On("DeleteResource", mock.Anything, corev2.SessionsResource, "abc").Return(nil)
+
+	require.NoError(t, RevokeUser(context.Background(), s, "foo"))
+	s.AssertExpectations(t)
+	s.AssertNotCalled(t, "DeleteResource", mock.Anything, corev2.SessionsResource, "def")
+}
+
+func TestRevokeToken(t *testing.T) {
+	s := &mockstore.MockStore{}
+	listRevokedTokens(s)
+	s.On("CreateOrUpdateResource", mock.Anything, mock.MatchedBy(func(token *corev2.RevokedToken) bool {
+		return token.Name == "abc" && token.ExpiresAt == 42
+	})).Return(nil)
+
+	claims := corev2.FixtureClaims("foo", nil)
+	require.NoError(t, RevokeToken(context.Background(), s, claims))
+	s.AssertNotCalled(t, "CreateOrUpdateResource", mock.Anything, mock.Anything)
+
+	claims.Id = "abc"
+	claims.ExpiresAt = 42
+	require.NoError(t, RevokeToken(context.Background(), s, claims))
+	s.AssertExpectations(t)
+}
+
+func TestDenylist(t *testing.T) {
+	expired := corev2.FixtureRevokedToken("expired")
+	expired.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+	denylist := NewDenylistFromResources(corev2.FixtureRevokedToken("token"), corev2.FixtureRevokedToken("session"), expired)
+
+	tests := []struct {
+		name      string
+		id        string
+		sessionID string
+		want      bool
+	}{
+		{name: "not revoked", id: "other", sessionID: "other"},
+		{name: "api key"},
+		{name: "token revoked", id: "token", want: true},
+		{name: "session revoked", id: "other", sessionID: "session", want: true},
+		{name: "revocation expired", id: "expired"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := corev2.FixtureClaims("foo", nil)
+			claims.Id = tt.id
+			claims.SessionID = tt.sessionID
+			assert.Equal(t, tt.want, denylist.IsRevoked(claims))
+		})
+	}
+}
+
+func TestDenylistUpdated(t *testing.T) {
+	denylist := NewDenylistFromResources()
+	claims := corev2.FixtureClaims("foo", nil)
+	claims.SessionID = "session"
+
+	updated := denylist.Updated()
+	assert.False(t, denylist.IsRevoked(claims))
+	denylist.update([]cache.Value{{Resource: corev2.FixtureRevokedToken("session")}})
+	select {
+	case <-updated:
+	default:
+		t.Fatal("expected the update to be notified")
+	}
+	assert.True(t, denylist.IsRevoked(claims))
+	assert.NotEqual(t, updated, denylist.Updated())
+}
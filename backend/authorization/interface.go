@@ -27,6 +27,16 @@ type Attributes struct {
 	ResourceName string
 	User         types.User
 	Verb         string
+
+	// Labels returns the labels of the objects affected by the request, which
+	// are needed to evaluate the rules having a label selector. It is nil if
+	// the labels are unknown.
+	Labels ObjectLabels
+
+	// LabelSelectors is set by the authorizer when a list request is only
+	// allowed by rules having a label selector. The listed objects must then
+	// be filtered with these selectors.
+	LabelSelectors LabelSelectors
 }
 
 // GetAttributes returns the authorization attributes stored in the given
@@ -0,0 +1,99 @@
+package authorization
+
+import (
+	"reflect"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// ObjectLabels returns the labels of the objects affected by a request.
+type ObjectLabels func() ([]map[string]string, error)
+
+// LabelSelectors represents the label selectors of the rules allowing a
+// request.
+type LabelSelectors []map[string]string
+
+// Matches returns whether the labels match any of the label selectors
+func (s LabelSelectors) Matches(labels map[string]string) bool {
+	for _, selector := range s {
+		if (corev2.Rule{LabelSelector: selector}).LabelSelectorMatches(labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter removes from the slice pointed to by resources the objects whose
+// labels do not match any of the label selectors. The objects without
+// metadata are removed too. It does nothing if there are no label selectors.
+func (s LabelSelectors) Filter(resources interface{}) {
+	if s == nil {
+		return
+	}
+	value := reflect.ValueOf(resources)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Slice {
+		return
+	}
+	slice := value.Elem()
+
+	n := 0
+	for i := 0; i < slice.Len(); i++ {
+		labels, ok := objectLabels(slice.Index(i).Interface())
+		if !ok || !s.Matches(labels) {
+			continue
+		}
+		slice.Index(n).Set(slice.Index(i))
+		n++
+	}
+	slice.Set(slice.Slice(0, n))
+}
+
+// List lists the resources into the slice pointed to by resources with the
+// list function, and only keeps those matching the label selectors. When the
+// predicate has a limit, it keeps listing until the page is full or all the
+// resources were listed, so the filtering does not shorten the pages.
+func (s LabelSelectors) List(pred *store.SelectionPredicate, resources interface{}, list func(interface{}) error) error {
+	value := reflect.ValueOf(resources)
+	if s == nil || pred == nil || value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Slice {
+		if err := list(resources); err != nil {
+			return err
+		}
+		s.Filter(resources)
+		return nil
+	}
+
+	limit := pred.Limit
+	defer func() { pred.Limit = limit }()
+
+	page := reflect.MakeSlice(value.Elem().Type(), 0, 0)
+	for {
+		// Never ask for more resources than missing from the page, so the
+		// continue token of the predicate stays valid
+		batch := reflect.New(value.Elem().Type())
+		if err := list(batch.Interface()); err != nil {
+			return err
+		}
+		s.Filter(batch.Interface())
+		page = reflect.AppendSlice(page, batch.Elem())
+		if limit <= 0 || pred.Continue == "" || int64(page.Len()) >= limit {
+			break
+		}
+		pred.Limit = limit - int64(page.Len())
+	}
+	value.Elem().Set(page)
+	return nil
+}
+
+// objectLabels returns the labels of a core/v2 or core/v3 resource
+func objectLabels(object interface{}) (map[string]string, bool) {
+	switch object := object.(type) {
+	case interface{ GetMetadata() *corev2.ObjectMeta }:
+		if meta := object.GetMetadata(); meta != nil {
+			return meta.Labels, true
+		}
+	case corev2.Resource:
+		return object.GetObjectMeta().Labels, true
+	}
+	return nil, false
+}
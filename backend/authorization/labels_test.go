@@ -0,0 +1,106 @@
+package authorization
+
+import (
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+func TestLabelSelectorsFilter(t *testing.T) {
+	checkA := corev2.FixtureCheckConfig("a")
+	checkA.Labels = map[string]string{"team": "a"}
+	checkB := corev2.FixtureCheckConfig("b")
+	checkB.Labels = map[string]string{"team": "b"}
+	checkC := corev2.FixtureCheckConfig("c")
+
+	selectors := LabelSelectors{{"team": "a"}}
+
+	checks := []*corev2.CheckConfig{checkA, checkB, checkC}
+	selectors.Filter(&checks)
+	if len(checks) != 1 || checks[0] != checkA {
+		t.Errorf("unexpected checks: %v", checks)
+	}
+
+	resources := []corev2.Resource{checkA, checkB}
+	LabelSelectors(nil).Filter(&resources)
+	if len(resources) != 2 {
+		t.Errorf("unexpected resources: %v", resources)
+	}
+
+	entityA := corev3.FixtureEntityConfig("a")
+	entityA.Metadata.Labels = map[string]string{"team": "a"}
+	entityB := corev3.FixtureEntityConfig("b")
+	entities := []*corev3.EntityConfig{entityA, entityB}
+	selectors.Filter(&entities)
+	if len(entities) != 1 || entities[0] != entityA {
+		t.Errorf("unexpected entities: %v", entities)
+	}
+}
+
+func TestLabelSelectorsList(t *testing.T) {
+	var stored []*corev2.CheckConfig
+	for i, team := range []string{"a", "b", "b", "a", "b", "a", "a"} {
+		check := corev2.FixtureCheckConfig(string(rune('c' + i)))
+		check.Labels = map[string]string{"team": team}
+		stored = append(stored, check)
+	}
+
+	// list simulates a paginated store, whose continue token is the index of
+	// the next check
+	var calls int
+	list := func(pred *store.SelectionPredicate) func(interface{}) error {
+		return func(resources interface{}) error {
+			calls++
+			start := 0
+			if pred.Continue != "" {
+				start = int(pred.Continue[0] - '0')
+			}
+			end := len(stored)
+			if pred.Limit > 0 && start+int(pred.Limit) < end {
+				end = start + int(pred.Limit)
+			}
+			*resources.(*[]*corev2.CheckConfig) = append([]*corev2.CheckConfig{}, stored[start:end]...)
+			pred.Continue = ""
+			if end < len(stored) {
+				pred.Continue = string(rune('0' + end))
+			}
+			return nil
+		}
+	}
+
+	selectors := LabelSelectors{{"team": "a"}}
+
+	// The pages are filled despite the filtering
+	pred := &store.SelectionPredicate{Limit: 2}
+	var checks []*corev2.CheckConfig
+	if err := selectors.List(pred, &checks, list(pred)); err != nil {
+		t.Fatal(err)
+	}
+	if len(checks) != 2 || checks[0] != stored[0] || checks[1] != stored[3] {
+		t.Errorf("unexpected first page: %v", checks)
+	}
+	if pred.Limit != 2 || pred.Continue != "4" {
+		t.Errorf("unexpected predicate: %+v", pred)
+	}
+	if err := selectors.List(pred, &checks, list(pred)); err != nil {
+		t.Fatal(err)
+	}
+	if len(checks) != 2 || checks[0] != stored[5] || checks[1] != stored[6] {
+		t.Errorf("unexpected second page: %v", checks)
+	}
+	if pred.Continue != "" {
+		t.Errorf("unexpected continue token: %q", pred.Continue)
+	}
+
+	// Without label selectors, the resources are listed once
+	calls = 0
+	pred = &store.SelectionPredicate{Limit: 2}
+	if err := LabelSelectors(nil).List(pred, &checks, list(pred)); err != nil {
+		t.Fatal(err)
+	}
+	if len(checks) != 2 || calls != 1 {
+		t.Errorf("unexpected checks: %v", checks)
+	}
+}
@@ -138,8 +138,18 @@ func (a *Authorizer) Authorize(ctx context.Context, attrs *authorization.Attribu
 		})
 	}
 
+	// Requests authenticated with a restricted API key must also be allowed by
+	// the restrictions of the key
+	if claims, ok := ctx.Value(corev2.ClaimsKey).(*corev2.Claims); ok && claims.Scope != nil && attrs != nil {
+		if !claims.Scope.Allows(attrs.Namespace, attrs.Verb, attrs.Resource, attrs.ResourceName) {
+			logger.Debug("request not allowed by the api key scope")
+			return false, nil
+		}
+	}
+
 	var (
 		authorized bool
+		selectors  authorization.LabelSelectors
 		visitErr   error
 	)
 
@@ -164,6 +174,11 @@ func (a *Authorizer) Authorize(ctx context.Context, attrs *authorization.Attribu
 		}
 
 		allowed, reason := ruleAllows(attrs, rule)
+		if allowed && attrs.Verb == "list" && len(rule.LabelSelector) > 0 {
+			// Keep looking for a rule allowing all the objects
+			selectors = append(selectors, rule.LabelSelector)
+			return true
+		}
 		if allowed {
 			roleRef := binding.GetRoleRef()
 			name := roleRef.GetName()
@@ -176,6 +191,15 @@ func (a *Authorizer) Authorize(ctx context.Context, attrs *authorization.Attribu
 		return true
 	})
 
+	if attrs != nil {
+		attrs.LabelSelectors = nil
+		if !authorized && len(selectors) > 0 && visitErr == nil {
+			logger.Debug("list request authorized for the objects matching the label selectors")
+			authorized = true
+			attrs.LabelSelectors = selectors
+		}
+	}
+
 	if !authorized {
 		logger.Debug("unauthorized request")
 	}
@@ -244,5 +268,28 @@ func ruleAllows(attrs *authorization.Attributes, rule corev2.Rule) (bool, string
 		return false, "forbidden resource name"
 	}
 
+	// Lists are allowed by rules having a label selector, but the listed
+	// objects are filtered afterwards with the selector
+	if len(rule.LabelSelector) == 0 || attrs.Verb == "list" {
+		return true, ""
+	}
+
+	if attrs.Labels == nil {
+		return false, "unknown labels"
+	}
+	objects, err := attrs.Labels()
+	if err != nil {
+		logger.WithError(err).Warning("could not retrieve the labels of the objects")
+		return false, "unknown labels"
+	}
+	if len(objects) == 0 {
+		return false, "unknown labels"
+	}
+	for _, labels := range objects {
+		if !rule.LabelSelectorMatches(labels) {
+			return false, "forbidden labels"
+		}
+	}
+
 	return true, ""
 }
@@ -3,6 +3,7 @@ package rbac
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -302,6 +303,115 @@ func TestAuthorize(t *testing.T) {
 	}
 }
 
+func TestAuthorizeAPIKeyScope(t *testing.T) {
+	st := &mockstore.MockStore{}
+	st.On("ListClusterRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.ClusterRoleBinding{{
+			RoleRef: corev2.RoleRef{Type: "ClusterRole", Name: "cluster-admin"},
+			Subjects: []corev2.Subject{
+				{Type: corev2.UserType, Name: "foo"},
+			},
+		}}, nil)
+	st.On("GetClusterRole", mock.Anything, "cluster-admin").
+		Return(&corev2.ClusterRole{Rules: []corev2.Rule{
+			{Verbs: []string{"*"}, Resources: []string{"*"}},
+		}}, nil)
+	a := &Authorizer{Store: st}
+
+	claims := &corev2.Claims{
+		Scope: &corev2.APIKeyScope{
+			Namespaces: []string{"dev"},
+			Rules: []corev2.Rule{
+				{Verbs: []string{"get", "list"}, Resources: []string{"*"}},
+			},
+		},
+	}
+	ctx := context.WithValue(context.Background(), corev2.ClaimsKey, claims)
+
+	tests := []struct {
+		name  string
+		attrs *authorization.Attributes
+		want  bool
+	}{
+		{
+			name:  "allowed by the scope",
+			attrs: &authorization.Attributes{Namespace: "dev", Verb: "get", Resource: "checks", User: corev2.User{Username: "foo"}},
+			want:  true,
+		},
+		{
+			name:  "namespace outside of the scope",
+			attrs: &authorization.Attributes{Namespace: "prod", Verb: "get", Resource: "checks", User: corev2.User{Username: "foo"}},
+			want:  false,
+		},
+		{
+			name:  "verb outside of the scope",
+			attrs: &authorization.Attributes{Namespace: "dev", Verb: "delete", Resource: "checks", User: corev2.User{Username: "foo"}},
+			want:  false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := a.Authorize(ctx, tc.attrs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("Authorizer.Authorize() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeListLabelSelectors(t *testing.T) {
+	st := &mockstore.MockStore{}
+	st.On("ListClusterRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.ClusterRoleBinding{{
+			RoleRef: corev2.RoleRef{Type: "ClusterRole", Name: "team-a"},
+			Subjects: []corev2.Subject{
+				{Type: corev2.UserType, Name: "foo"},
+			},
+		}}, nil)
+	st.On("GetClusterRole", mock.Anything, "team-a").
+		Return(&corev2.ClusterRole{Rules: []corev2.Rule{
+			{Verbs: []string{"list"}, Resources: []string{"checks"}, LabelSelector: map[string]string{"team": "a"}},
+			{Verbs: []string{"list"}, Resources: []string{"handlers"}},
+		}}, nil)
+	st.On("ListRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.RoleBinding{}, nil)
+	a := &Authorizer{Store: st}
+
+	tests := []struct {
+		name          string
+		resource      string
+		wantSelectors authorization.LabelSelectors
+	}{
+		{
+			name:          "allowed by a label selector",
+			resource:      "checks",
+			wantSelectors: authorization.LabelSelectors{{"team": "a"}},
+		},
+		{
+			name:     "allowed without label selector",
+			resource: "handlers",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			attrs := &authorization.Attributes{Namespace: "dev", Verb: "list", Resource: tc.resource, User: corev2.User{Username: "foo"}}
+			got, err := a.Authorize(context.Background(), attrs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got {
+				t.Fatal("Authorizer.Authorize() = false, want true")
+			}
+			if !reflect.DeepEqual(attrs.LabelSelectors, tc.wantSelectors) {
+				t.Errorf("LabelSelectors = %v, want %v", attrs.LabelSelectors, tc.wantSelectors)
+			}
+		})
+	}
+}
+
 func TestMatchesUser(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -418,6 +528,80 @@ func TestRuleAllows(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "unknown labels",
+			attrs: &authorization.Attributes{
+				Verb:     "update",
+				Resource: "checks",
+			},
+			rule: corev2.Rule{
+				Verbs:         []string{"update"},
+				Resources:     []string{"checks"},
+				LabelSelector: map[string]string{"team": "a"},
+			},
+			want: false,
+		},
+		{
+			name: "labels do not match",
+			attrs: &authorization.Attributes{
+				Verb:     "update",
+				Resource: "checks",
+				Labels: func() ([]map[string]string, error) {
+					return []map[string]string{{"team": "a"}, {"team": "b"}}, nil
+				},
+			},
+			rule: corev2.Rule{
+				Verbs:         []string{"update"},
+				Resources:     []string{"checks"},
+				LabelSelector: map[string]string{"team": "a"},
+			},
+			want: false,
+		},
+		{
+			name: "labels match",
+			attrs: &authorization.Attributes{
+				Verb:     "update",
+				Resource: "checks",
+				Labels: func() ([]map[string]string, error) {
+					return []map[string]string{{"team": "a", "tier": "1"}}, nil
+				},
+			},
+			rule: corev2.Rule{
+				Verbs:         []string{"update"},
+				Resources:     []string{"checks"},
+				LabelSelector: map[string]string{"team": "a"},
+			},
+			want: true,
+		},
+		{
+			name: "labels cannot be retrieved",
+			attrs: &authorization.Attributes{
+				Verb:     "update",
+				Resource: "checks",
+				Labels: func() ([]map[string]string, error) {
+					return nil, errors.New("error")
+				},
+			},
+			rule: corev2.Rule{
+				Verbs:         []string{"update"},
+				Resources:     []string{"checks"},
+				LabelSelector: map[string]string{"team": "a"},
+			},
+			want: false,
+		},
+		{
+			name: "list with a label selector",
+			attrs: &authorization.Attributes{
+				Verb:     "list",
+				Resource: "checks",
+			},
+			rule: corev2.Rule{
+				Verbs:         []string{"list"},
+				Resources:     []string{"checks"},
+				LabelSelector: map[string]string{"team": "a"},
+			},
+			want: true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
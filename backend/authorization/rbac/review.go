@@ -0,0 +1,123 @@
+package rbac
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// Review determines whether a request is allowed based on its attributes, and
+// returns the binding which allows it. Unlike Authorize, a binding referencing
+// a missing role does not fail the review, since it grants nothing.
+func (a *Authorizer) Review(ctx context.Context, attrs *authorization.Attributes) (*corev2.AccessReview, error) {
+	review := &corev2.AccessReview{
+		User:         attrs.User.Username,
+		Groups:       attrs.User.Groups,
+		Verb:         attrs.Verb,
+		Resource:     attrs.Resource,
+		ResourceName: attrs.ResourceName,
+		Namespace:    attrs.Namespace,
+	}
+
+	// The role bindings are listed in the namespace of the request
+	ctx = store.NamespaceContext(ctx, attrs.Namespace)
+
+	var visitErr error
+	a.VisitRulesFor(ctx, attrs, func(binding RoleBinding, rule corev2.Rule, err error) bool {
+		if err != nil {
+			switch err.(type) {
+			case *store.ErrNotFound, ErrRoleNotFound:
+				logger.WithError(err).Debug("ignoring binding during access review")
+				return true
+			default:
+				visitErr = err
+				return false
+			}
+		}
+		if allowed, _ := ruleAllows(attrs, rule); allowed {
+			review.Allowed = true
+			review.Binding = bindingReference(binding)
+			return false
+		}
+		return true
+	})
+
+	return review, visitErr
+}
+
+// Permissions returns the rules granted to the user by the
+// ClusterRoleBindings, and by the RoleBindings of the given namespace, or of
+// all the namespaces if it is empty.
+func (a *Authorizer) Permissions(ctx context.Context, user corev2.User, namespace string) (*corev2.EffectivePermissions, error) {
+	permissions := &corev2.EffectivePermissions{
+		User:        user.Username,
+		Groups:      user.Groups,
+		Permissions: []corev2.Permission{},
+	}
+
+	clusterRoleBindings, err := a.Store.ListClusterRoleBindings(ctx, &store.SelectionPredicate{})
+	if err != nil {
+		if _, ok := err.(*store.ErrNotFound); !ok {
+			return nil, err
+		}
+	}
+	for _, binding := range clusterRoleBindings {
+		if err := a.addPermission(ctx, permissions, user, binding); err != nil {
+			return nil, err
+		}
+	}
+
+	roleBindings, err := a.Store.ListRoleBindings(store.NamespaceContext(ctx, namespace), &store.SelectionPredicate{})
+	if err != nil {
+		if _, ok := err.(*store.ErrNotFound); !ok {
+			return nil, err
+		}
+	}
+	for _, binding := range roleBindings {
+		ctx := store.NamespaceContext(ctx, binding.Namespace)
+		if err := a.addPermission(ctx, permissions, user, binding); err != nil {
+			return nil, err
+		}
+	}
+
+	return permissions, nil
+}
+
+// addPermission adds the rules granted by the binding to the permissions, if
+// the binding matches the user.
+func (a *Authorizer) addPermission(ctx context.Context, permissions *corev2.EffectivePermissions, user corev2.User, binding RoleBinding) error {
+	if !matchesUser(user, binding.GetSubjects()) {
+		return nil
+	}
+	rules, err := a.getRoleReferenceRules(ctx, binding.GetRoleRef())
+	if err != nil {
+		if _, ok := err.(ErrRoleNotFound); ok {
+			logger.WithError(err).Warning("rbac configuration error")
+			return nil
+		}
+		return err
+	}
+	permissions.Permissions = append(permissions.Permissions, corev2.Permission{
+		Binding: *bindingReference(binding),
+		Rules:   rules,
+	})
+	return nil
+}
+
+// bindingReference returns a reference to the binding.
+func bindingReference(binding RoleBinding) *corev2.BindingReference {
+	ref := &corev2.BindingReference{
+		Name:      binding.GetObjectMeta().Name,
+		Namespace: binding.GetObjectMeta().Namespace,
+		RoleRef:   binding.GetRoleRef(),
+	}
+	switch binding.(type) {
+	case *corev2.ClusterRoleBinding:
+		ref.Type = "ClusterRoleBinding"
+	case *corev2.RoleBinding:
+		ref.Type = "RoleBinding"
+	}
+	return ref
+}
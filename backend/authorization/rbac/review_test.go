@@ -0,0 +1,135 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+func reviewStore() *mockstore.MockStore {
+	s := &mockstore.MockStore{}
+	s.On("ListClusterRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.ClusterRoleBinding{
+			{
+				ObjectMeta: corev2.NewObjectMeta("viewers", ""),
+				RoleRef:    corev2.RoleRef{Type: "ClusterRole", Name: "view"},
+				Subjects:   []corev2.Subject{{Type: corev2.GroupType, Name: "ops"}},
+			},
+			{
+				ObjectMeta: corev2.NewObjectMeta("broken", ""),
+				RoleRef:    corev2.RoleRef{Type: "ClusterRole", Name: "missing"},
+				Subjects:   []corev2.Subject{{Type: corev2.GroupType, Name: "ops"}},
+			},
+		}, nil)
+	s.On("ListRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.RoleBinding{
+			{
+				ObjectMeta: corev2.NewObjectMeta("dev-editors", "dev"),
+				RoleRef:    corev2.RoleRef{Type: "Role", Name: "edit"},
+				Subjects:   []corev2.Subject{{Type: corev2.UserType, Name: "foo"}},
+			},
+		}, nil)
+	s.On("GetClusterRole", mock.Anything, "view").
+		Return(&corev2.ClusterRole{Rules: []corev2.Rule{
+			{Verbs: []string{"get", "list"}, Resources: []string{"*"}},
+		}}, nil)
+	var missing *corev2.ClusterRole
+	s.On("GetClusterRole", mock.Anything, "missing").Return(missing, nil)
+	s.On("GetRole", mock.Anything, "edit").
+		Return(&corev2.Role{Rules: []corev2.Rule{
+			{Verbs: []string{"*"}, Resources: []string{"checks"}},
+			{Verbs: []string{"update"}, Resources: []string{"handlers"}, LabelSelector: map[string]string{"team": "dev"}},
+		}}, nil)
+	return s
+}
+
+func TestReview(t *testing.T) {
+	user := corev2.User{Username: "foo", Groups: []string{"ops"}}
+	tests := []struct {
+		name        string
+		attrs       *authorization.Attributes
+		wantAllowed bool
+		wantBinding string
+	}{
+		{
+			name:        "allowed by a cluster role binding",
+			attrs:       &authorization.Attributes{Namespace: "prod", Verb: "get", Resource: "checks", User: user},
+			wantAllowed: true,
+			wantBinding: "viewers",
+		},
+		{
+			name:        "allowed by a role binding",
+			attrs:       &authorization.Attributes{Namespace: "dev", Verb: "delete", Resource: "checks", User: user},
+			wantAllowed: true,
+			wantBinding: "dev-editors",
+		},
+		{
+			name:  "denied",
+			attrs: &authorization.Attributes{Namespace: "dev", Verb: "delete", Resource: "handlers", User: user},
+		},
+		{
+			name: "allowed by a label selector",
+			attrs: &authorization.Attributes{Namespace: "dev", Verb: "update", Resource: "handlers", User: user,
+				Labels: func() ([]map[string]string, error) {
+					return []map[string]string{{"team": "dev"}}, nil
+				}},
+			wantAllowed: true,
+			wantBinding: "dev-editors",
+		},
+		{
+			name: "denied by a label selector",
+			attrs: &authorization.Attributes{Namespace: "dev", Verb: "update", Resource: "handlers", User: user,
+				Labels: func() ([]map[string]string, error) {
+					return []map[string]string{{"team": "ops"}}, nil
+				}},
+		},
+		{
+			name:  "label selector without labels",
+			attrs: &authorization.Attributes{Namespace: "dev", Verb: "update", Resource: "handlers", User: user},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Authorizer{Store: reviewStore()}
+			review, err := a.Review(context.Background(), tt.attrs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if review.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", review.Allowed, tt.wantAllowed)
+			}
+			var binding string
+			if review.Binding != nil {
+				binding = review.Binding.Name
+			}
+			if binding != tt.wantBinding {
+				t.Errorf("Binding = %q, want %q", binding, tt.wantBinding)
+			}
+		})
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	a := &Authorizer{Store: reviewStore()}
+	permissions, err := a.Permissions(context.Background(), corev2.User{Username: "foo", Groups: []string{"ops"}}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(permissions.Permissions), 2; got != want {
+		t.Fatalf("got %d permissions, want %d", got, want)
+	}
+	if got := permissions.Permissions[0].Binding; got.Type != "ClusterRoleBinding" || got.Name != "viewers" {
+		t.Errorf("unexpected binding: %+v", got)
+	}
+	if got := permissions.Permissions[1].Binding; got.Type != "RoleBinding" || got.Name != "dev-editors" || got.Namespace != "dev" {
+		t.Errorf("unexpected binding: %+v", got)
+	}
+	if got := permissions.Permissions[1].Rules; len(got) != 2 || got[0].Resources[0] != "checks" {
+		t.Errorf("unexpected rules: %+v", got)
+	}
+}
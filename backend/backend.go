@@ -21,20 +21,24 @@ import (
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/asset"
 	"github.com/sensu/sensu-go/backend/agentd"
+	"github.com/sensu/sensu-go/backend/aggregated"
 	"github.com/sensu/sensu-go/backend/api"
 	"github.com/sensu/sensu-go/backend/apid"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/graphql"
 	"github.com/sensu/sensu-go/backend/apid/routers"
+	"github.com/sensu/sensu-go/backend/audit"
 	"github.com/sensu/sensu-go/backend/authentication"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/authentication/providers/basic"
+	"github.com/sensu/sensu-go/backend/authentication/session"
 	"github.com/sensu/sensu-go/backend/authorization/rbac"
 	"github.com/sensu/sensu-go/backend/daemon"
 	"github.com/sensu/sensu-go/backend/etcd"
 	"github.com/sensu/sensu-go/backend/eventd"
 	"github.com/sensu/sensu-go/backend/keepalived"
 	"github.com/sensu/sensu-go/backend/licensing"
+	"github.com/sensu/sensu-go/backend/lifecycled"
 	"github.com/sensu/sensu-go/backend/liveness"
 	"github.com/sensu/sensu-go/backend/logging"
 	"github.com/sensu/sensu-go/backend/messaging"
@@ -44,16 +48,20 @@ import (
 	"github.com/sensu/sensu-go/backend/pipeline/mutator"
 	"github.com/sensu/sensu-go/backend/pipelined"
 	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/backend/quota"
 	"github.com/sensu/sensu-go/backend/ringv2"
 	"github.com/sensu/sensu-go/backend/schedulerd"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
 	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
 	"github.com/sensu/sensu-go/backend/store/postgres"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
 	etcdstorev2 "github.com/sensu/sensu-go/backend/store/v2/etcdstore"
 	"github.com/sensu/sensu-go/backend/tessend"
+	"github.com/sensu/sensu-go/backend/tracing"
 	"github.com/sensu/sensu-go/command"
+	sensugraphql "github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/metrics"
 	"github.com/sensu/sensu-go/system"
 )
@@ -264,6 +272,20 @@ func Initialize(ctx context.Context, client *clientv3.Client, db *pgxpool.Pool,
 	backendID := etcd.NewBackendIDGetter(ctx, client)
 	b.Daemons = append(b.Daemons, backendID)
 
+	// Initialize the export of the traces, started first so the spans of the
+	// other daemons are exported
+	if config.TracingEndpoint != "" {
+		exporter, err := tracing.NewExporter(tracing.Config{
+			Endpoint:    config.TracingEndpoint,
+			Insecure:    config.TracingInsecure,
+			SampleRatio: config.TracingSampleRatio,
+		})
+		if err != nil {
+			return nil, err
+		}
+		b.Daemons = append(b.Daemons, exporter)
+	}
+
 	// Initialize an etcd getter
 	queueGetter := queue.EtcdGetter{Client: client, BackendIDGetter: backendID}
 
@@ -377,6 +399,15 @@ func Initialize(ctx context.Context, client *clientv3.Client, db *pgxpool.Pool,
 	pipelineDaemon.AddAdapter(&b.PipelineAdapterV1)
 	b.Daemons = append(b.Daemons, pipelineDaemon)
 
+	// Initialize the resource quotas enforcer
+	quotas := quota.NewEnforcer(b.Store, &quota.StoreCounter{
+		Store: b.StoreV2,
+		Etcd:  &quota.EtcdCounter{Client: client},
+	})
+	if err := prometheus.Register(&quota.Collector{Enforcer: quotas}); err != nil {
+		logger.WithError(err).Warn("could not register the resource quotas collector")
+	}
+
 	// Initialize eventd
 	event, err := eventd.New(
 		ctx,
@@ -393,6 +424,8 @@ func Initialize(ctx context.Context, client *clientv3.Client, db *pgxpool.Pool,
 			LogBufferSize:       b.Cfg.EventLogBufferSize,
 			LogBufferWait:       b.Cfg.EventLogBufferWait,
 			LogParallelEncoders: b.Cfg.EventLogParallelEncoders,
+			Quotas:              quotas,
+			RetryStore:          b.Store,
 		},
 	)
 	if err != nil {
@@ -452,11 +485,40 @@ func Initialize(ctx context.Context, client *clientv3.Client, db *pgxpool.Pool,
 	}
 	b.Daemons = append(b.Daemons, keepalive)
 
+	// Initialize lifecycled
+	lifecycle, err := lifecycled.New(ctx, lifecycled.Config{
+		Client:       client,
+		Store:        b.Store,
+		EventStore:   b.EventStore,
+		Bus:          bus,
+		StoreTimeout: 2 * time.Minute,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error initializing %s: %s", lifecycle.Name(), err)
+	}
+	b.Daemons = append(b.Daemons, lifecycle)
+
+	// Initialize aggregated
+	aggregate, err := aggregated.New(ctx, aggregated.Config{
+		Client:     client,
+		EventStore: b.EventStore,
+		Bus:        bus,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error initializing %s: %s", aggregate.Name(), err)
+	}
+	b.Daemons = append(b.Daemons, aggregate)
+
 	// Prepare the authentication providers
+	passwordPolicies, err := cache.New(ctx, client, &corev2.PasswordPolicy{}, false)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing the password policy cache: %s", err)
+	}
 	authenticator := &authentication.Authenticator{}
 	provider := &basic.Provider{
-		ObjectMeta: corev2.ObjectMeta{Name: basic.Type},
-		Store:      b.Store,
+		ObjectMeta:       corev2.ObjectMeta{Name: basic.Type},
+		Store:            b.Store,
+		PasswordPolicies: passwordPolicies,
 	}
 	authenticator.AddProvider(provider)
 
@@ -492,6 +554,13 @@ func Initialize(ctx context.Context, client *clientv3.Client, db *pgxpool.Pool,
 	b.HealthRouter = routers.NewHealthRouter(actions.NewHealthController(b.Store, client.Cluster, b.EtcdClientTLSConfig))
 
 	// Initialize GraphQL service
+	var persistedQueries sensugraphql.PersistedQueries
+	if config.GraphQLPersistedQueriesFile != "" {
+		persistedQueries, err = sensugraphql.LoadPersistedQueries(config.GraphQLPersistedQueriesFile)
+		if err != nil {
+			return nil, err
+		}
+	}
 	b.GraphQLService, err = graphql.NewService(graphql.ServiceConfig{
 		AssetClient:       api.NewAssetClient(b.Store, auth),
 		CheckClient:       api.NewCheckClient(b.Store, actions.NewCheckController(b.Store, queueGetter), auth),
@@ -509,11 +578,38 @@ func Initialize(ctx context.Context, client *clientv3.Client, db *pgxpool.Pool,
 		VersionController: actions.NewVersionController(clusterVersion),
 		MetricGatherer:    prometheus.DefaultGatherer,
 		GenericClient:     &api.GenericClient{Store: b.Store, Auth: auth},
+		Bus:               bus,
+		ResourceWatcher:   b.StoreV2,
+		Authorizer:        auth,
+		QueryLimits: sensugraphql.QueryLimits{
+			MaxDepth:      config.GraphQLMaxDepth,
+			MaxComplexity: config.GraphQLMaxComplexity,
+			Rate:          config.GraphQLRateLimit,
+			Burst:         config.GraphQLRateBurst,
+		},
+		PersistedQueries: persistedQueries,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing graphql.Service: %s", err)
 	}
 
+	// Initialize the audit log
+	var auditLogger audit.Logger
+	if config.AuditLogFile != "" {
+		fileLogger, err := audit.NewFileLogger(config.AuditLogFile, bus)
+		if err != nil {
+			return nil, err
+		}
+		b.Daemons = append(b.Daemons, fileLogger)
+		auditLogger = fileLogger
+	}
+
+	// Initialize the denylist of the revoked access tokens
+	denylist, err := session.NewDenylist(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing the token denylist: %s", err)
+	}
+
 	// Initialize apid
 	b.APIDConfig = apid.Config{
 		ListenAddress:       config.APIListenAddress,
@@ -532,6 +628,12 @@ func Initialize(ctx context.Context, client *clientv3.Client, db *pgxpool.Pool,
 		ClusterVersion:      clusterVersion,
 		GraphQLService:      b.GraphQLService,
 		HealthRouter:        b.HealthRouter,
+		RingPool:            b.RingPool,
+		Quotas:              quotas,
+		Denylist:            denylist,
+		AuditLogger:         auditLogger,
+		AuditIncludeBodies:  config.AuditLogIncludeBodies,
+		AuditIncludeDiff:    config.AuditLogIncludeDiff,
 	}
 	newApi, err := apid.New(b.APIDConfig)
 	if err != nil {
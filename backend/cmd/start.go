@@ -30,6 +30,7 @@ import (
 	"github.com/sensu/sensu-go/backend"
 	"github.com/sensu/sensu-go/backend/etcd"
 	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/sensu/sensu-go/backend/tracing"
 	"github.com/sensu/sensu-go/util/path"
 	stringsutil "github.com/sensu/sensu-go/util/strings"
 	"github.com/sirupsen/logrus"
@@ -120,8 +121,45 @@ const (
 	// flagEventLogParallelEncoders used to indicate parallel encoders should be used for event logging
 	flagEventLogParallelEncoders = "event-log-parallel-encoders"
 
+	// flagAuditLogFile indicates the path to the audit log file
+	flagAuditLogFile = "audit-log-file"
+
+	// flagAuditLogIncludeBodies indicates whether the request bodies are recorded in the audit log
+	flagAuditLogIncludeBodies = "audit-log-include-bodies"
+
+	// flagAuditLogIncludeDiff indicates whether the resources before and after the requests are recorded in the audit log
+	flagAuditLogIncludeDiff = "audit-log-include-diff"
+
+	// flagTracingOTLPEndpoint is the address of the OTLP receiver the spans are exported to
+	flagTracingOTLPEndpoint = "tracing-otlp-endpoint"
+
+	// flagTracingOTLPInsecure disables the transport security of the connection to the OTLP receiver
+	flagTracingOTLPInsecure = "tracing-otlp-insecure"
+
+	// flagTracingSampleRatio is the ratio of the sampled traces
+	flagTracingSampleRatio = "tracing-sample-ratio"
+
+	// flagGraphQLMaxDepth is the maximum depth of the GraphQL queries
+	flagGraphQLMaxDepth = "graphql-max-depth"
+
+	// flagGraphQLMaxComplexity is the maximum complexity of the GraphQL queries
+	flagGraphQLMaxComplexity = "graphql-max-complexity"
+
+	// flagGraphQLRateLimit is the complexity of the GraphQL queries a user can spend per second
+	flagGraphQLRateLimit = "graphql-rate-limit"
+
+	// flagGraphQLRateBurst is the complexity of the GraphQL queries a user can spend at once
+	flagGraphQLRateBurst = "graphql-rate-burst"
+
+	// flagGraphQLPersistedQueriesFile is the path to the file of the only GraphQL queries executed
+	flagGraphQLPersistedQueriesFile = "graphql-persisted-queries-file"
+
 	// Default values
 
+	// defaultGraphQLRateBurst is the default complexity of the GraphQL queries
+	// a user can spend at once, enough for the queries of the web UI
+	defaultGraphQLRateBurst = 10000
+
 	// Start command usage template
 	startUsageTemplate = `Usage:{{if .Runnable}}
   {{.UseLine}}{{end}}{{if .HasAvailableSubCommands}}
@@ -239,6 +277,17 @@ func StartCommand(initialize InitializeFunc) *cobra.Command {
 				EventLogBufferWait:             viper.GetDuration(flagEventLogBufferWait),
 				EventLogFile:                   viper.GetString(flagEventLogFile),
 				EventLogParallelEncoders:       viper.GetBool(flagEventLogParallelEncoders),
+				AuditLogFile:                   viper.GetString(flagAuditLogFile),
+				AuditLogIncludeBodies:          viper.GetBool(flagAuditLogIncludeBodies),
+				AuditLogIncludeDiff:            viper.GetBool(flagAuditLogIncludeDiff),
+				TracingEndpoint:                viper.GetString(flagTracingOTLPEndpoint),
+				TracingInsecure:                viper.GetBool(flagTracingOTLPInsecure),
+				TracingSampleRatio:             viper.GetFloat64(flagTracingSampleRatio),
+				GraphQLMaxDepth:                viper.GetInt(flagGraphQLMaxDepth),
+				GraphQLMaxComplexity:           viper.GetInt(flagGraphQLMaxComplexity),
+				GraphQLRateLimit:               rate.Limit(viper.GetFloat64(flagGraphQLRateLimit)),
+				GraphQLRateBurst:               viper.GetInt(flagGraphQLRateBurst),
+				GraphQLPersistedQueriesFile:    viper.GetString(flagGraphQLPersistedQueriesFile),
 
 				Store: backend.StoreConfig{
 					ConfigurationStore: configStore,
@@ -528,6 +577,17 @@ func handleConfig(cmd *cobra.Command, arguments []string, server bool) error {
 		viper.SetDefault(flagEventLogBufferSize, 100000)
 		viper.SetDefault(flagEventLogFile, "")
 		viper.SetDefault(flagEventLogParallelEncoders, false)
+		viper.SetDefault(flagAuditLogFile, "")
+		viper.SetDefault(flagAuditLogIncludeBodies, false)
+		viper.SetDefault(flagAuditLogIncludeDiff, false)
+		viper.SetDefault(flagTracingOTLPEndpoint, "")
+		viper.SetDefault(flagTracingOTLPInsecure, false)
+		viper.SetDefault(flagTracingSampleRatio, tracing.DefaultSampleRatio)
+		viper.SetDefault(flagGraphQLMaxDepth, 0)
+		viper.SetDefault(flagGraphQLMaxComplexity, 0)
+		viper.SetDefault(flagGraphQLRateLimit, 0)
+		viper.SetDefault(flagGraphQLRateBurst, defaultGraphQLRateBurst)
+		viper.SetDefault(flagGraphQLPersistedQueriesFile, "")
 	}
 
 	// Etcd defaults
@@ -660,6 +720,20 @@ func flagSet(server bool) *pflag.FlagSet {
 		// event back-pressure could stop the backend and its agent sessions from
 		// producing and processing new events and possibly lead to a crash.
 		_ = flagSet.String(flagEventLogBufferWait, "10ms", "full buffer wait time")
+
+		_ = flagSet.String(flagAuditLogFile, "", "path to the audit log file of the mutating API requests, disabled if empty")
+		_ = flagSet.Bool(flagAuditLogIncludeBodies, false, "record the request bodies in the audit log, with their secrets redacted")
+		_ = flagSet.Bool(flagAuditLogIncludeDiff, false, "record the resources before and after the requests in the audit log")
+
+		_ = flagSet.String(flagTracingOTLPEndpoint, "", "address of the OTLP gRPC receiver the traces are exported to, in the host:port form, disabled if empty")
+		_ = flagSet.Bool(flagTracingOTLPInsecure, false, "disable the transport security of the connection to the OTLP receiver")
+		_ = flagSet.Float64(flagTracingSampleRatio, tracing.DefaultSampleRatio, "ratio of the sampled traces, between 0 and 1")
+
+		_ = flagSet.Int(flagGraphQLMaxDepth, 0, "maximum depth of the GraphQL queries, unlimited if 0")
+		_ = flagSet.Int(flagGraphQLMaxComplexity, 0, "maximum complexity of the GraphQL queries, unlimited if 0")
+		_ = flagSet.Float64(flagGraphQLRateLimit, 0, "complexity of the GraphQL queries a user can spend per second, unlimited if 0")
+		_ = flagSet.Int(flagGraphQLRateBurst, defaultGraphQLRateBurst, "complexity of the GraphQL queries a user can spend at once")
+		_ = flagSet.String(flagGraphQLPersistedQueriesFile, "", "path to the JSON file of the persisted GraphQL queries by SHA-256 hash, the only queries executed if set")
 	}
 
 	flagSet.SetOutput(ioutil.Discard)
@@ -123,5 +123,19 @@ type Config struct {
 	EventLogFile             string
 	EventLogParallelEncoders bool
 
+	AuditLogFile          string
+	AuditLogIncludeBodies bool
+	AuditLogIncludeDiff   bool
+
+	TracingEndpoint    string
+	TracingInsecure    bool
+	TracingSampleRatio float64
+
+	GraphQLMaxDepth             int
+	GraphQLMaxComplexity        int
+	GraphQLRateLimit            rate.Limit
+	GraphQLRateBurst            int
+	GraphQLPersistedQueriesFile string
+
 	Store StoreConfig
 }
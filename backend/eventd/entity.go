@@ -6,14 +6,17 @@ import (
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/quota"
 	"github.com/sensu/sensu-go/backend/store"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
 	metricspkg "github.com/sensu/sensu-go/metrics"
 )
 
 // createProxyEntity creates a proxy entity for the given event if the entity
-// does not exist already and returns the entity created
-func createProxyEntity(event *corev2.Event, s storev2.Interface) (fErr error) {
+// does not exist already and returns the entity created. The creation of the
+// proxy entity is rejected if it would exceed the entities quota of the
+// namespace, unless quotas is nil.
+func createProxyEntity(event *corev2.Event, s storev2.Interface, quotas *quota.Enforcer) (fErr error) {
 	entityName := event.Entity.Name
 	namespace := event.Entity.Namespace
 
@@ -97,6 +100,12 @@ func createProxyEntity(event *corev2.Event, s storev2.Interface) (fErr error) {
 	} else if err != nil {
 		switch err.(type) {
 		case *store.ErrNotFound:
+			if quotas != nil {
+				if err := quotas.Check(context.Background(), namespace, corev2.EntitiesResource); err != nil {
+					return err
+				}
+			}
+
 			// If the entity does not exist, create a proxy entity
 			if event.Check.ProxyEntityName != "" {
 				// Create a brand new entity since we can't rely on the provided
@@ -10,9 +10,11 @@ import (
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/quota"
 	"github.com/sensu/sensu-go/backend/store"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
 	"github.com/sensu/sensu-go/backend/store/v2/storetest"
+	"github.com/sensu/sensu-go/testing/mockstore"
 )
 
 func TestCreateProxyEntity(t *testing.T) {
@@ -235,7 +237,7 @@ func TestCreateProxyEntity(t *testing.T) {
 			}
 			defer store.AssertExpectations(t)
 
-			if err := createProxyEntity(tt.event, store); (err != nil) != tt.wantErr {
+			if err := createProxyEntity(tt.event, store, nil); (err != nil) != tt.wantErr {
 				t.Errorf("createProxyEntity() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
@@ -248,3 +250,38 @@ func TestCreateProxyEntity(t *testing.T) {
 		})
 	}
 }
+
+type entityCounter int64
+
+func (c entityCounter) Count(ctx context.Context, namespace, resource string) (int64, error) {
+	return int64(c), nil
+}
+
+func TestCreateProxyEntityQuotaExceeded(t *testing.T) {
+	var nilWrapper storev2.Wrapper
+	event := &corev2.Event{
+		Check: &corev2.Check{
+			ProxyEntityName: "bar",
+		},
+		Entity: corev2.FixtureEntity("foo"),
+	}
+
+	s := &storetest.Store{}
+	config := corev3.FixtureEntityConfig("bar")
+	configReq := storev2.NewResourceRequestFromResource(context.Background(), config)
+	s.On("Get", configReq).Return(nilWrapper, &store.ErrNotFound{})
+	defer s.AssertExpectations(t)
+
+	resources := &mockstore.MockStore{}
+	resources.On("ListResources", mock.Anything, "resourcequotas", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		list := args[2].(*[]*corev2.ResourceQuota)
+		*list = []*corev2.ResourceQuota{corev2.FixtureResourceQuota("limits", "default")}
+	}).Return(nil)
+	quotas := quota.NewEnforcer(resources, entityCounter(1000))
+
+	err := createProxyEntity(event, s, quotas)
+	var exceeded *quota.ExceededError
+	if !errors.As(err, &exceeded) {
+		t.Fatalf("createProxyEntity() error = %v, want an ExceededError", err)
+	}
+}
@@ -12,16 +12,19 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel/trace"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	corev3 "github.com/sensu/sensu-go/api/core/v3"
 	"github.com/sensu/sensu-go/backend/keepalived"
 	"github.com/sensu/sensu-go/backend/liveness"
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/quota"
 	"github.com/sensu/sensu-go/backend/silenced"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/backend/store/cache"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	"github.com/sensu/sensu-go/backend/tracing"
 	metricspkg "github.com/sensu/sensu-go/metrics"
 	utillogging "github.com/sensu/sensu-go/util/logging"
 )
@@ -219,6 +222,8 @@ type Eventd struct {
 	logBufferSize       int
 	logBufferWait       time.Duration
 	logParallelEncoders bool
+	quotas              *quota.Enforcer
+	retryStore          store.ResourceStore
 }
 
 // DEPRECATED: use cache.Cache instead
@@ -244,6 +249,8 @@ type Config struct {
 	LogBufferSize       int
 	LogBufferWait       time.Duration
 	LogParallelEncoders bool
+	Quotas              *quota.Enforcer
+	RetryStore          store.ResourceStore
 }
 
 // New creates a new Eventd.
@@ -278,6 +285,8 @@ func New(ctx context.Context, c Config, opts ...Option) (*Eventd, error) {
 		logBufferSize:       c.LogBufferSize,
 		logBufferWait:       c.LogBufferWait,
 		logParallelEncoders: c.LogParallelEncoders,
+		quotas:              c.Quotas,
+		retryStore:          c.RetryStore,
 		Logger:              NoopLogger{},
 	}
 
@@ -452,7 +461,7 @@ func eventKey(event *corev2.Event) string {
 	return path.Join(event.Entity.Namespace, event.Check.Name, event.Entity.Name)
 }
 
-func (e *Eventd) publishEventWithDuration(event *corev2.Event) (fErr error) {
+func (e *Eventd) publishEventWithDuration(ctx context.Context, event *corev2.Event) (fErr error) {
 	begin := time.Now()
 	defer func() {
 		duration := time.Since(begin)
@@ -469,12 +478,16 @@ func (e *Eventd) publishEventWithDuration(event *corev2.Event) (fErr error) {
 			Observe(float64(duration) / float64(time.Millisecond))
 	}()
 
+	// The trace context of the event is propagated to pipelined
+	tracing.InjectEvent(ctx, event)
 	return e.bus.Publish(messaging.TopicEvent, event)
 }
 
 func (e *Eventd) updateEventWithDuration(ctx context.Context, event *corev2.Event) (fEvent, fPrevEvent *corev2.Event, fErr error) {
+	ctx, span := tracing.Tracer().Start(ctx, "eventd.update_event")
 	begin := time.Now()
 	defer func() {
+		tracing.EndSpan(span, fErr)
 		duration := time.Since(begin)
 		status := metricspkg.StatusLabelSuccess
 		if fErr != nil {
@@ -520,6 +533,15 @@ func (e *Eventd) handleMessage(msg interface{}) (fEvent *corev2.Event, fErr erro
 		return event, fmt.Errorf("received non-Event on event channel: %v", msg)
 	}
 
+	ctx, span := tracing.Tracer().Start(tracing.ExtractEvent(context.Background(), event), "eventd.handle_event",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(tracing.EventAttributes(event)...),
+	)
+	defer func() {
+		tracing.EndSpan(span, fErr)
+	}()
+	tracing.StripEvent(event)
+
 	fields := utillogging.EventFields(event, false)
 	logger.WithFields(fields).Info("eventd received event")
 
@@ -529,6 +551,15 @@ func (e *Eventd) handleMessage(msg interface{}) (fEvent *corev2.Event, fErr erro
 		return event, err
 	}
 
+	// Drop the event if the namespace exceeds its events per minute quota.
+	// Keepalives are never dropped, so entities are not deregistered.
+	if e.quotas != nil && !(event.HasCheck() && event.Check.Name == corev2.KeepaliveCheckName) {
+		if err := e.quotas.AllowEvent(context.Background(), event.Entity.Namespace); err != nil {
+			EventsProcessed.WithLabelValues(EventsProcessedLabelError, EventsProcessedTypeLabelUnknown).Inc()
+			return event, err
+		}
+	}
+
 	if event.HasMetrics() {
 		MetricPointsProcessed.Add(float64(len(event.Metrics.Points)))
 	}
@@ -538,14 +569,14 @@ func (e *Eventd) handleMessage(msg interface{}) (fEvent *corev2.Event, fErr erro
 	if !event.HasCheck() {
 		e.Logger.Println(event)
 		EventsProcessed.WithLabelValues(EventsProcessedLabelSuccess, EventsProcessedTypeLabelMetrics).Inc()
-		return event, e.publishEventWithDuration(event)
+		return event, e.publishEventWithDuration(ctx, event)
 	}
 
-	ctx := context.WithValue(context.Background(), corev2.NamespaceKey, event.Entity.Namespace)
+	ctx = context.WithValue(ctx, corev2.NamespaceKey, event.Entity.Namespace)
 
 	// Create a proxy entity if required and update the event's entity with it,
 	// but only if the event's entity is not an agent.
-	if err := createProxyEntity(event, e.store); err != nil {
+	if err := createProxyEntity(event, e.store, e.quotas); err != nil {
 		EventsProcessed.WithLabelValues(EventsProcessedLabelError, EventsProcessedTypeLabelCheck).Inc()
 		return event, err
 	}
@@ -615,7 +646,21 @@ NOTTL:
 
 	EventsProcessed.WithLabelValues(EventsProcessedLabelSuccess, EventsProcessedTypeLabelCheck).Inc()
 
-	return event, e.publishEventWithDuration(event)
+	// The event is stored but not handled while its check is being retried
+	retrying, err := e.updateRetryState(ctx, event, prevEvent)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("error updating check retry state")
+	}
+	if retrying {
+		logger.WithFields(logrus.Fields{
+			"check":     event.Check.Name,
+			"entity":    event.Entity.Name,
+			"namespace": event.Entity.Namespace,
+		}).Debug("check is being retried, not handling event")
+		return event, nil
+	}
+
+	return event, e.publishEventWithDuration(ctx, event)
 }
 
 func (e *Eventd) alive(key string, prev liveness.State, leader bool) (bury bool) {
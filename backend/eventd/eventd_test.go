@@ -538,6 +538,7 @@ func TestEventd_handleMessage(t *testing.T) {
 	type storeFunc func(*storetest.Store)
 
 	var nilEvent *corev2.Event
+	errNotFound := &store.ErrNotFound{}
 
 	newEntityConfig := func() storev2.Wrapper {
 		entity := corev3.FixtureEntityConfig("foo")
@@ -610,6 +611,136 @@ func TestEventd_handleMessage(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "events are not published while their check is retried",
+			event: corev2.Event{
+				Check:  corev2.FixtureCheck("check-cpu"),
+				Entity: corev2.FixtureEntity("foo"),
+			},
+			cacheFunc: func(c *mockcache.MockCache) {
+				c.On("Get", "default").Once().Return([]cache.Value{})
+			},
+			eventStoreFunc: func(store *mockstore.MockStore) {
+				event := corev2.FixtureEvent("foo", "check-cpu")
+				event.Check.MaxRetries = 2
+				event.Check.RetryInterval = 10
+				event.Check.Status = 2
+				store.On("UpdateEvent", mock.AnythingOfType("*v2.Event")).Return(event, nilEvent, nil)
+				store.On("GetResource", mock.Anything, "foo:check-cpu", mock.AnythingOfType("*v2.CheckRetryState")).
+					Return(errNotFound)
+				store.On("CreateOrUpdateResource", mock.Anything, mock.MatchedBy(func(state *corev2.CheckRetryState) bool {
+					return state.Retrying && state.Retries == 1
+				})).Once().Return(nil)
+			},
+			storeFunc: func(store *storetest.Store) {
+				store.On("Get", mock.Anything).Once().Return(
+					newEntityConfig(), nil,
+				)
+				store.On("Get", mock.Anything).Once().Return(
+					newEntityState(), nil,
+				)
+			},
+		},
+		{
+			name: "events are published once the retries of their check are exhausted",
+			event: corev2.Event{
+				Check:  corev2.FixtureCheck("check-cpu"),
+				Entity: corev2.FixtureEntity("foo"),
+			},
+			busFunc: func(bus *mockbus.MockBus) {
+				bus.On("Publish", messaging.TopicEvent, mock.Anything).Once().Return(nil)
+			},
+			cacheFunc: func(c *mockcache.MockCache) {
+				c.On("Get", "default").Once().Return([]cache.Value{})
+			},
+			eventStoreFunc: func(store *mockstore.MockStore) {
+				event := corev2.FixtureEvent("foo", "check-cpu")
+				event.Check.MaxRetries = 2
+				event.Check.RetryInterval = 10
+				event.Check.Status = 2
+				store.On("UpdateEvent", mock.AnythingOfType("*v2.Event")).Return(event, event, nil)
+				store.On("GetResource", mock.Anything, "foo:check-cpu", mock.AnythingOfType("*v2.CheckRetryState")).
+					Run(func(args mock.Arguments) {
+						state := args.Get(2).(*corev2.CheckRetryState)
+						*state = *corev2.NewCheckRetryState("foo", "check-cpu", "default")
+						state.Retries = 2
+						state.Retrying = true
+					}).Return(nil)
+				store.On("CreateOrUpdateResource", mock.Anything, mock.MatchedBy(func(state *corev2.CheckRetryState) bool {
+					return !state.Retrying && state.Retries == 2
+				})).Once().Return(nil)
+			},
+			storeFunc: func(store *storetest.Store) {
+				store.On("Get", mock.Anything).Once().Return(
+					newEntityConfig(), nil,
+				)
+				store.On("Get", mock.Anything).Once().Return(
+					newEntityState(), nil,
+				)
+			},
+		},
+		{
+			name: "the retry state of a check is dropped once it passes",
+			event: corev2.Event{
+				Check:  corev2.FixtureCheck("check-cpu"),
+				Entity: corev2.FixtureEntity("foo"),
+			},
+			busFunc: func(bus *mockbus.MockBus) {
+				bus.On("Publish", messaging.TopicEvent, mock.Anything).Once().Return(nil)
+			},
+			cacheFunc: func(c *mockcache.MockCache) {
+				c.On("Get", "default").Once().Return([]cache.Value{})
+			},
+			eventStoreFunc: func(store *mockstore.MockStore) {
+				event := corev2.FixtureEvent("foo", "check-cpu")
+				event.Check.MaxRetries = 2
+				event.Check.RetryInterval = 10
+				prevEvent := corev2.FixtureEvent("foo", "check-cpu")
+				prevEvent.Check.Status = 2
+				store.On("UpdateEvent", mock.AnythingOfType("*v2.Event")).Return(event, prevEvent, nil)
+				store.On("GetResource", mock.Anything, "foo:check-cpu", mock.AnythingOfType("*v2.CheckRetryState")).Return(nil)
+				store.On("DeleteResource", mock.Anything, corev2.CheckRetryStatesPrefix("check-cpu"), "foo:check-cpu").Once().Return(nil)
+			},
+			storeFunc: func(store *storetest.Store) {
+				store.On("Get", mock.Anything).Once().Return(
+					newEntityConfig(), nil,
+				)
+				store.On("Get", mock.Anything).Once().Return(
+					newEntityState(), nil,
+				)
+			},
+		},
+		{
+			name: "the retry state of a check is dropped once its retries are disabled",
+			event: corev2.Event{
+				Check:  corev2.FixtureCheck("check-cpu"),
+				Entity: corev2.FixtureEntity("foo"),
+			},
+			busFunc: func(bus *mockbus.MockBus) {
+				bus.On("Publish", messaging.TopicEvent, mock.Anything).Once().Return(nil)
+			},
+			cacheFunc: func(c *mockcache.MockCache) {
+				c.On("Get", "default").Once().Return([]cache.Value{})
+			},
+			eventStoreFunc: func(store *mockstore.MockStore) {
+				event := corev2.FixtureEvent("foo", "check-cpu")
+				event.Check.Status = 2
+				prevEvent := corev2.FixtureEvent("foo", "check-cpu")
+				prevEvent.Check.MaxRetries = 2
+				prevEvent.Check.RetryInterval = 10
+				prevEvent.Check.Status = 2
+				store.On("UpdateEvent", mock.AnythingOfType("*v2.Event")).Return(event, prevEvent, nil)
+				store.On("DeleteResource", mock.Anything, corev2.CheckRetryStatesPrefix("check-cpu"), "foo:check-cpu").Once().Return(nil)
+			},
+			storeFunc: func(store *storetest.Store) {
+				store.On("Get", mock.Anything).Once().Return(
+					newEntityConfig(), nil,
+				)
+				store.On("Get", mock.Anything).Once().Return(
+					newEntityState(), nil,
+				)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -640,10 +771,13 @@ func TestEventd_handleMessage(t *testing.T) {
 				wg:              &sync.WaitGroup{},
 				Logger:          NoopLogger{},
 				silencedCache:   cache,
+				retryStore:      eventStore,
 			}
 			if _, err := e.handleMessage(&tt.event); (err != nil) != tt.wantErr {
 				t.Errorf("Eventd.handleMessage() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			bus.AssertExpectations(t)
+			eventStore.AssertExpectations(t)
 		})
 	}
 }
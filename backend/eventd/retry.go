@@ -0,0 +1,61 @@
+package eventd
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// retriesEnabled returns true if the check is configured to be retried or to
+// back off while failing.
+func retriesEnabled(check *corev2.Check) bool {
+	return check.MaxRetries > 0 || check.MaxBackoffInterval > 0
+}
+
+// updateRetryState updates the retry state of the event's check on its
+// entity with the result of the check, and returns true if the check is
+// retried, in which case the event is not handled yet. The state only exists
+// while the check is failing, so passing checks are not looked up.
+func (e *Eventd) updateRetryState(ctx context.Context, event, prevEvent *corev2.Event) (bool, error) {
+	check := event.Check
+	failing := prevEvent != nil && prevEvent.HasCheck() && prevEvent.Check.Status != 0
+	if !retriesEnabled(check) {
+		if failing && retriesEnabled(prevEvent.Check) {
+			// The retries were disabled while the check was failing
+			return false, e.deleteRetryState(ctx, event)
+		}
+		return false, nil
+	}
+	if check.Status == 0 && !failing {
+		return false, nil
+	}
+
+	name := corev2.CheckRetryStateName(event.Entity.Name, check.Name)
+	state := &corev2.CheckRetryState{CheckName: check.Name}
+	if err := e.retryStore.GetResource(ctx, name, state); err != nil {
+		if _, ok := err.(*store.ErrNotFound); !ok {
+			return false, err
+		}
+		if check.Status == 0 {
+			return false, nil
+		}
+		state = corev2.NewCheckRetryState(event.Entity.Name, check.Name, event.Entity.Namespace)
+	}
+
+	if !state.Update(check) {
+		return false, e.deleteRetryState(ctx, event)
+	}
+	return state.Retrying, e.retryStore.CreateOrUpdateResource(ctx, state)
+}
+
+// deleteRetryState deletes the retry state of the event's check on its
+// entity, if any.
+func (e *Eventd) deleteRetryState(ctx context.Context, event *corev2.Event) error {
+	name := corev2.CheckRetryStateName(event.Entity.Name, event.Check.Name)
+	err := e.retryStore.DeleteResource(ctx, corev2.CheckRetryStatesPrefix(event.Check.Name), name)
+	if _, ok := err.(*store.ErrNotFound); ok {
+		err = nil
+	}
+	return err
+}
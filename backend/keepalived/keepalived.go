@@ -56,6 +56,15 @@ const (
 
 	// KeepaliveCounterLabelDead represents a call to dead().
 	KeepaliveCounterLabelDead = "dead"
+
+	// KeepaliveReconnectsAnnotation is the keepalive event annotation that
+	// counts how many times the agent reconnected to a backend. It is set by
+	// the agents.
+	KeepaliveReconnectsAnnotation = corev2.KeepaliveReconnectsAnnotation
+
+	// KeepaliveDisconnectReasonAnnotation is the keepalive event annotation
+	// that records why the entity was last disconnected.
+	KeepaliveDisconnectReasonAnnotation = corev2.KeepaliveDisconnectReasonAnnotation
 )
 
 var KeepalivesProcessed = prometheus.NewCounterVec(
@@ -488,9 +497,20 @@ func createKeepaliveEvent(rawEvent *corev2.Event) *corev2.Event {
 		Executed:  time.Now().Unix(),
 		Issued:    time.Now().Unix(),
 		Scheduler: corev2.EtcdScheduler,
+		// The flap thresholds are carried over so that eventd can run flap
+		// detection on keepalive events, just like it does for any check.
+		LowFlapThreshold:  check.LowFlapThreshold,
+		HighFlapThreshold: check.HighFlapThreshold,
 	}
+
+	meta := rawEvent.ObjectMeta
+	meta.Annotations = make(map[string]string, len(rawEvent.Annotations))
+	for k, v := range rawEvent.Annotations {
+		meta.Annotations[k] = v
+	}
+
 	keepaliveEvent := &corev2.Event{
-		ObjectMeta: rawEvent.ObjectMeta,
+		ObjectMeta: meta,
 		Timestamp:  time.Now().Unix(),
 		Entity:     rawEvent.Entity,
 		Check:      keepaliveCheck,
@@ -647,6 +667,7 @@ func (k *Keepalived) dead(key string, prev liveness.State, leader bool) bool {
 		event.Check.Status = 2
 	}
 	event.Check.Output = fmt.Sprintf("No keepalive sent from %s for %v seconds (>= %v)", event.Entity.Name, timeSinceLastSeen, timeout)
+	event.Annotations[KeepaliveDisconnectReasonAnnotation] = event.Check.Output
 
 	if err := k.bus.Publish(messaging.TopicEventRaw, event); err != nil {
 		lager.WithError(err).Error("error publishing event")
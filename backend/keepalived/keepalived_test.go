@@ -383,6 +383,12 @@ func TestCreateKeepaliveEvent(t *testing.T) {
 	assert.Equal(t, "default", keepaliveEvent.ObjectMeta.Namespace)
 	assert.NotEqual(t, int64(0), keepaliveEvent.Check.Issued)
 
+	event.Check.LowFlapThreshold = 20
+	event.Check.HighFlapThreshold = 40
+	keepaliveEvent = createKeepaliveEvent(event)
+	assert.Equal(t, uint32(20), keepaliveEvent.Check.LowFlapThreshold)
+	assert.Equal(t, uint32(40), keepaliveEvent.Check.HighFlapThreshold)
+
 	event.Check = nil
 	keepaliveEvent = createKeepaliveEvent(event)
 	assert.Equal(t, "keepalive", keepaliveEvent.Check.Name)
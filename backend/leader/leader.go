@@ -0,0 +1,86 @@
+// Package leader elects a single backend of the cluster to run a task.
+package leader
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+const (
+	// sessionTTL is the TTL, in seconds, of the lease backing an election.
+	sessionTTL = 15
+
+	// retryInterval is the time waited before campaigning again once the
+	// leadership is lost.
+	retryInterval = time.Second
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "leader",
+})
+
+// Path returns the etcd key of the election with the given name.
+func Path(name string) string {
+	return path.Join(etcdstore.EtcdRoot, "leader", name)
+}
+
+// Run campaigns for the leadership of the named election until ctx is
+// cancelled. Every time this backend is elected, fn is called with a context
+// that is cancelled once the leadership is lost; fn must return when its
+// context is done.
+func Run(ctx context.Context, client *clientv3.Client, name string, fn func(context.Context)) {
+	id := uuid.New().String()
+	for {
+		if err := lead(ctx, client, name, id, fn); err != nil && ctx.Err() == nil {
+			logger.WithError(err).WithField("election", name).Error("error while campaigning for leadership")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func lead(ctx context.Context, client *clientv3.Client, name, id string, fn func(context.Context)) error {
+	session, err := concurrency.NewSession(client, concurrency.WithTTL(sessionTTL), concurrency.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	election := concurrency.NewElection(session, Path(name))
+	if err := election.Campaign(ctx, id); err != nil {
+		return err
+	}
+	logger.WithFields(logrus.Fields{"election": name, "leader_id": id}).Info("elected leader")
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-session.Done():
+			logger.WithField("election", name).Warn("leadership lost")
+			cancel()
+		case <-leaderCtx.Done():
+		}
+	}()
+
+	fn(leaderCtx)
+
+	select {
+	case <-session.Done():
+		return nil
+	default:
+	}
+	resignCtx, resignCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer resignCancel()
+	return election.Resign(resignCtx)
+}
@@ -0,0 +1,56 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/etcd"
+)
+
+func TestRunSingleLeader(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+
+	client := e.NewEmbeddedClient()
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var leaders, maxLeaders int32
+	elected := make(chan struct{}, 2)
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Run(ctx, client, "test", func(ctx context.Context) {
+				n := atomic.AddInt32(&leaders, 1)
+				if n > atomic.LoadInt32(&maxLeaders) {
+					atomic.StoreInt32(&maxLeaders, n)
+				}
+				elected <- struct{}{}
+				<-ctx.Done()
+				atomic.AddInt32(&leaders, -1)
+			})
+		}()
+	}
+
+	select {
+	case <-elected:
+	case <-time.After(10 * time.Second):
+		t.Fatal("no leader elected")
+	}
+
+	// Give the other candidate some time to wrongly get elected
+	time.Sleep(500 * time.Millisecond)
+	if got := atomic.LoadInt32(&maxLeaders); got != 1 {
+		t.Fatalf("got %d concurrent leaders, want 1", got)
+	}
+
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,180 @@
+package lifecycled
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/keepalived"
+	"github.com/sensu/sensu-go/backend/leader"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// componentName identifies Lifecycled as the component/daemon implemented
+	// in this package.
+	componentName = "lifecycled"
+
+	// defaultTickInterval is the interval at which lifecycle policies are
+	// checked for a due evaluation.
+	defaultTickInterval = 10 * time.Second
+)
+
+// Lifecycled is the entity lifecycle daemon. A single backend of the cluster,
+// elected through etcd, periodically evaluates the lifecycle policies and
+// deregisters the stale entities they select.
+type Lifecycled struct {
+	store        store.ResourceStore
+	reaper       *Reaper
+	client       *clientv3.Client
+	tickInterval time.Duration
+	lastRuns     map[string]time.Time
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	errChan      chan error
+}
+
+// Option is a functional option.
+type Option func(*Lifecycled) error
+
+// Config configures Lifecycled.
+type Config struct {
+	Client       *clientv3.Client
+	Store        store.Store
+	EventStore   store.EventStore
+	Bus          messaging.MessageBus
+	StoreTimeout time.Duration
+}
+
+// New creates a new Lifecycled.
+func New(ctx context.Context, c Config, opts ...Option) (*Lifecycled, error) {
+	if c.StoreTimeout == 0 {
+		logger.Warn("StoreTimeout not set")
+		c.StoreTimeout = time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	silencedCache, err := cache.New(ctx, c.Client, &corev2.Silenced{}, false)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	l := &Lifecycled{
+		store: c.Store,
+		reaper: &Reaper{
+			EntityStore: c.Store,
+			EventStore:  c.EventStore,
+			Deregisterer: &keepalived.Deregistration{
+				EntityStore:   c.Store,
+				EventStore:    c.EventStore,
+				MessageBus:    c.Bus,
+				SilencedCache: silencedCache,
+				StoreTimeout:  c.StoreTimeout,
+			},
+		},
+		client:       c.Client,
+		tickInterval: defaultTickInterval,
+		lastRuns:     make(map[string]time.Time),
+		ctx:          ctx,
+		cancel:       cancel,
+		errChan:      make(chan error, 1),
+	}
+	for _, o := range opts {
+		if err := o(l); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// Start the Lifecycled daemon.
+func (l *Lifecycled) Start() error {
+	l.wg.Add(1)
+	go l.run()
+	return nil
+}
+
+// Stop the Lifecycled daemon.
+func (l *Lifecycled) Stop() error {
+	l.cancel()
+	l.wg.Wait()
+	close(l.errChan)
+	return nil
+}
+
+// Err returns a channel on which to listen for terminal errors.
+func (l *Lifecycled) Err() <-chan error {
+	return l.errChan
+}
+
+// Name returns the daemon name.
+func (l *Lifecycled) Name() string {
+	return componentName
+}
+
+// run evaluates the lifecycle policies while this backend leads the cluster.
+func (l *Lifecycled) run() {
+	defer l.wg.Done()
+	leader.Run(l.ctx, l.client, componentName, func(ctx context.Context) {
+		// Forget about previous evaluations, another backend might have been
+		// leading in the meantime.
+		l.lastRuns = make(map[string]time.Time)
+
+		ticker := time.NewTicker(l.tickInterval)
+		defer ticker.Stop()
+		for {
+			l.evaluate(ctx, time.Now())
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// evaluate runs the lifecycle policies that are due at the given time.
+func (l *Lifecycled) evaluate(ctx context.Context, now time.Time) {
+	policies := []*corev2.LifecyclePolicy{}
+	if err := l.store.ListResources(ctx, corev2.LifecyclePoliciesResource, &policies, &store.SelectionPredicate{}); err != nil {
+		logger.WithError(err).Error("error fetching lifecycle policies")
+		return
+	}
+
+	seen := make(map[string]struct{}, len(policies))
+	for _, policy := range policies {
+		key := policy.URIPath()
+		seen[key] = struct{}{}
+
+		interval := time.Duration(policy.Interval) * time.Second
+		if interval == 0 {
+			interval = corev2.DefaultLifecyclePolicyInterval * time.Second
+		}
+		if last, ok := l.lastRuns[key]; ok && now.Sub(last) < interval {
+			continue
+		}
+		l.lastRuns[key] = now
+
+		if _, err := l.reaper.Reap(ctx, policy, now); err != nil {
+			logger.WithError(err).WithFields(map[string]interface{}{
+				"namespace": policy.Namespace,
+				"policy":    policy.Name,
+			}).Error("error evaluating lifecycle policy")
+		}
+	}
+
+	// Drop the deleted policies
+	for key := range l.lastRuns {
+		if _, ok := seen[key]; !ok {
+			delete(l.lastRuns, key)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package lifecycled
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/keepalived"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// StaleEntity is an entity selected by a lifecycle policy.
+type StaleEntity struct {
+	// Name is the name of the entity.
+	Name string `json:"name"`
+
+	// EntityClass is the class of the entity.
+	EntityClass string `json:"entity_class"`
+
+	// LastSeen is the last time, in seconds since the epoch, the entity was
+	// seen, either by a keepalive or by an event.
+	LastSeen int64 `json:"last_seen"`
+
+	entity *corev2.Entity
+}
+
+// Report lists the stale entities selected by a lifecycle policy.
+type Report struct {
+	// Policy is the name of the lifecycle policy.
+	Policy string `json:"policy"`
+
+	// Namespace is the namespace of the lifecycle policy.
+	Namespace string `json:"namespace"`
+
+	// DryRun indicates whether the stale entities are only reported.
+	DryRun bool `json:"dry_run"`
+
+	// Entities are the stale entities.
+	Entities []StaleEntity `json:"entities"`
+}
+
+// Reaper evaluates lifecycle policies against the entities of their
+// namespace, and deregisters the stale ones.
+type Reaper struct {
+	EntityStore  store.EntityStore
+	EventStore   store.EventStore
+	Deregisterer keepalived.Deregisterer
+}
+
+// Report returns the entities that the given policy considers stale at the
+// given time, without deregistering them.
+func (r *Reaper) Report(ctx context.Context, policy *corev2.LifecyclePolicy, now time.Time) (*Report, error) {
+	ctx = store.NamespaceContext(ctx, policy.Namespace)
+
+	entities, err := r.EntityStore.GetEntities(ctx, &store.SelectionPredicate{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching entities: %s", err)
+	}
+
+	events, err := r.EventStore.GetEvents(ctx, &store.SelectionPredicate{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching events: %s", err)
+	}
+
+	// Proxy entities never receive keepalives, so the latest event of an
+	// entity also counts as a sighting. Keepalive events are not counted,
+	// since keepalived republishes them with the current time once the agent
+	// is dead.
+	lastEvents := make(map[string]int64, len(events))
+	for _, event := range events {
+		if !event.HasCheck() || event.Entity == nil {
+			continue
+		}
+		if event.Check.Name == corev2.KeepaliveCheckName {
+			continue
+		}
+		if event.Timestamp > lastEvents[event.Entity.Name] {
+			lastEvents[event.Entity.Name] = event.Timestamp
+		}
+	}
+
+	report := &Report{
+		Policy:    policy.Name,
+		Namespace: policy.Namespace,
+		DryRun:    policy.DryRun,
+		Entities:  []StaleEntity{},
+	}
+	deadline := now.Unix() - policy.StaleAfter
+	for _, entity := range entities {
+		if !policy.Matches(entity) {
+			continue
+		}
+		// Agents are seen through their keepalives only, the events of their
+		// checks stop with them
+		lastSeen := entity.LastSeen
+		if entity.EntityClass == corev2.EntityProxyClass && lastEvents[entity.Name] > lastSeen {
+			lastSeen = lastEvents[entity.Name]
+		}
+		// Entities that were never seen have just been created, and can't be
+		// deemed stale yet.
+		if lastSeen == 0 || lastSeen > deadline {
+			continue
+		}
+		report.Entities = append(report.Entities, StaleEntity{
+			Name:        entity.Name,
+			EntityClass: entity.EntityClass,
+			LastSeen:    lastSeen,
+			entity:      entity,
+		})
+	}
+	sort.Slice(report.Entities, func(i, j int) bool {
+		return report.Entities[i].Name < report.Entities[j].Name
+	})
+
+	return report, nil
+}
+
+// Reap deregisters the entities that the given policy considers stale at the
+// given time, unless the policy is a dry run. It returns the report of the
+// stale entities.
+func (r *Reaper) Reap(ctx context.Context, policy *corev2.LifecyclePolicy, now time.Time) (*Report, error) {
+	report, err := r.Report(ctx, policy, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stale := range report.Entities {
+		fields := map[string]interface{}{
+			"namespace": policy.Namespace,
+			"policy":    policy.Name,
+			"entity":    stale.Name,
+			"last_seen": stale.LastSeen,
+		}
+		if policy.DryRun {
+			logger.WithFields(fields).Info("dry run: entity would be deregistered by lifecycle policy")
+			continue
+		}
+		entity := stale.entity
+		if entity.Deregistration.Handler == "" {
+			entity.Deregistration.Handler = policy.DeregistrationHandler
+		}
+		if err := r.Deregisterer.Deregister(entity); err != nil {
+			logger.WithFields(fields).WithError(err).Error("error deregistering stale entity")
+			continue
+		}
+		logger.WithFields(fields).Info("stale entity deregistered by lifecycle policy")
+	}
+
+	return report, nil
+}
@@ -0,0 +1,171 @@
+package lifecycled
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDeregisterer struct {
+	mock.Mock
+}
+
+func (m *mockDeregisterer) Deregister(entity *corev2.Entity) error {
+	args := m.Called(entity)
+	return args.Error(0)
+}
+
+func fixtureProxyEntity(name string, lastSeen int64) *corev2.Entity {
+	entity := corev2.FixtureEntity(name)
+	entity.EntityClass = corev2.EntityProxyClass
+	entity.LastSeen = lastSeen
+	return entity
+}
+
+func TestReaperReport(t *testing.T) {
+	now := time.Unix(100000, 0)
+
+	// Seen recently through its last event
+	active := fixtureProxyEntity("active", 0)
+	activeEvent := corev2.FixtureEvent(active.Name, "check")
+	activeEvent.Timestamp = now.Unix() - 10
+
+	// Last event is too old
+	stale := fixtureProxyEntity("stale", 0)
+	staleEvent := corev2.FixtureEvent(stale.Name, "check")
+	staleEvent.Timestamp = now.Unix() - 5000
+
+	// Never seen at all
+	unseen := fixtureProxyEntity("unseen", 0)
+
+	// Stale agent, not managed by the policy
+	agent := corev2.FixtureEntity("agent")
+	agent.EntityClass = corev2.EntityAgentClass
+	agent.LastSeen = 1
+
+	st := &mockstore.MockStore{}
+	st.On("GetEntities", mock.Anything, mock.Anything).Return([]*corev2.Entity{active, stale, unseen, agent}, nil)
+	st.On("GetEvents", mock.Anything, mock.Anything).Return([]*corev2.Event{activeEvent, staleEvent}, nil)
+
+	reaper := &Reaper{EntityStore: st, EventStore: st}
+	policy := corev2.FixtureLifecyclePolicy("stale-proxies", "default")
+	policy.StaleAfter = 3600
+
+	report, err := reaper.Report(context.Background(), policy, now)
+	require.NoError(t, err)
+	assert.Equal(t, "stale-proxies", report.Policy)
+	assert.Equal(t, "default", report.Namespace)
+	require.Len(t, report.Entities, 1)
+	assert.Equal(t, "stale", report.Entities[0].Name)
+	assert.Equal(t, staleEvent.Timestamp, report.Entities[0].LastSeen)
+}
+
+func TestReaperReportDeadAgent(t *testing.T) {
+	now := time.Unix(100000, 0)
+
+	// keepalived republishes the keepalive of a dead agent with the current
+	// time, its last keepalive is older
+	dead := corev2.FixtureEntity("dead")
+	dead.EntityClass = corev2.EntityAgentClass
+	dead.Deregister = false
+	dead.LastSeen = now.Unix() - 5000
+	keepalive := corev2.FixtureEvent(dead.Name, corev2.KeepaliveCheckName)
+	keepalive.Check.Status = 2
+	keepalive.Timestamp = now.Unix()
+
+	alive := corev2.FixtureEntity("alive")
+	alive.EntityClass = corev2.EntityAgentClass
+	alive.LastSeen = now.Unix() - 10
+
+	// The events of proxy entities are sightings, but not their keepalives
+	proxy := fixtureProxyEntity("proxy", 0)
+	proxyEvent := corev2.FixtureEvent(proxy.Name, "check")
+	proxyEvent.Timestamp = now.Unix() - 5000
+	proxyKeepalive := corev2.FixtureEvent(proxy.Name, corev2.KeepaliveCheckName)
+	proxyKeepalive.Timestamp = now.Unix()
+
+	st := &mockstore.MockStore{}
+	st.On("GetEntities", mock.Anything, mock.Anything).Return([]*corev2.Entity{dead, alive, proxy}, nil)
+	st.On("GetEvents", mock.Anything, mock.Anything).Return([]*corev2.Event{keepalive, proxyEvent, proxyKeepalive}, nil)
+
+	reaper := &Reaper{EntityStore: st, EventStore: st}
+	policy := corev2.FixtureLifecyclePolicy("stale", "default")
+	policy.EntityClasses = []string{corev2.EntityAgentClass, corev2.EntityProxyClass}
+	policy.StaleAfter = 3600
+
+	report, err := reaper.Report(context.Background(), policy, now)
+	require.NoError(t, err)
+	require.Len(t, report.Entities, 2)
+	assert.Equal(t, "dead", report.Entities[0].Name)
+	assert.Equal(t, dead.LastSeen, report.Entities[0].LastSeen)
+	assert.Equal(t, "proxy", report.Entities[1].Name)
+	assert.Equal(t, proxyEvent.Timestamp, report.Entities[1].LastSeen)
+}
+
+func TestReaperReap(t *testing.T) {
+	now := time.Unix(100000, 0)
+
+	tests := []struct {
+		name          string
+		dryRun        bool
+		handler       string
+		wantReaped    bool
+		wantHandler   string
+		entityHandler string
+	}{
+		{
+			name:       "dry run",
+			dryRun:     true,
+			wantReaped: false,
+		},
+		{
+			name:        "policy handler",
+			handler:     "slack",
+			wantReaped:  true,
+			wantHandler: "slack",
+		},
+		{
+			name:          "entity handler has precedence",
+			handler:       "slack",
+			entityHandler: "pagerduty",
+			wantReaped:    true,
+			wantHandler:   "pagerduty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entity := fixtureProxyEntity("stale", now.Unix()-5000)
+			entity.Deregistration.Handler = tt.entityHandler
+
+			st := &mockstore.MockStore{}
+			st.On("GetEntities", mock.Anything, mock.Anything).Return([]*corev2.Entity{entity}, nil)
+			st.On("GetEvents", mock.Anything, mock.Anything).Return([]*corev2.Event{}, nil)
+
+			deregisterer := &mockDeregisterer{}
+			deregisterer.On("Deregister", mock.Anything).Return(nil)
+
+			reaper := &Reaper{EntityStore: st, EventStore: st, Deregisterer: deregisterer}
+			policy := corev2.FixtureLifecyclePolicy("stale-proxies", "default")
+			policy.StaleAfter = 3600
+			policy.DryRun = tt.dryRun
+			policy.DeregistrationHandler = tt.handler
+
+			report, err := reaper.Reap(context.Background(), policy, now)
+			require.NoError(t, err)
+			assert.Len(t, report.Entities, 1)
+			assert.Equal(t, tt.dryRun, report.DryRun)
+			if !tt.wantReaped {
+				deregisterer.AssertNotCalled(t, "Deregister", mock.Anything)
+				return
+			}
+			deregisterer.AssertCalled(t, "Deregister", entity)
+			assert.Equal(t, tt.wantHandler, entity.Deregistration.Handler)
+		})
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/tracing"
 	metricspkg "github.com/sensu/sensu-go/metrics"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -170,6 +172,13 @@ func (a *AdapterV1) Run(ctx context.Context, ref *corev2.ResourceReference, reso
 	}
 	ctx = context.WithValue(ctx, corev2.PipelineKey, pipeline.Name)
 
+	ctx, span := tracing.Tracer().Start(ctx, "pipeline.run",
+		trace.WithAttributes(tracing.PipelineKey.String(pipeline.Name)),
+	)
+	defer func() {
+		tracing.EndSpan(span, fErr)
+	}()
+
 	if len(pipeline.Workflows) < 1 {
 		return &ErrNoWorkflows{}
 	}
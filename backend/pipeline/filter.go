@@ -6,7 +6,9 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/tracing"
 	metricspkg "github.com/sensu/sensu-go/metrics"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -63,6 +65,13 @@ func (a *AdapterV1) processFilter(ctx context.Context, ref *corev2.ResourceRefer
 	}))
 	defer filterTimer.ObserveDuration()
 
+	ctx, span := tracing.Tracer().Start(ctx, "pipeline.filter",
+		trace.WithAttributes(tracing.ResourceKey.String(ref.ResourceID())),
+	)
+	defer func() {
+		tracing.EndSpan(span, fErr)
+	}()
+
 	filter, err := a.getFilterAdapterForResource(ctx, ref)
 	if err != nil {
 		return false, err
@@ -6,7 +6,9 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/tracing"
 	metricspkg "github.com/sensu/sensu-go/metrics"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -48,6 +50,13 @@ func (a *AdapterV1) processHandler(ctx context.Context, ref *corev2.ResourceRefe
 	}))
 	defer handlerTimer.ObserveDuration()
 
+	ctx, span := tracing.Tracer().Start(ctx, "pipeline.handler",
+		trace.WithAttributes(tracing.ResourceKey.String(ref.ResourceID())),
+	)
+	defer func() {
+		tracing.EndSpan(span, fErr)
+	}()
+
 	handler, err := a.getHandlerAdapterForResource(ctx, ref)
 	if err != nil {
 		return err
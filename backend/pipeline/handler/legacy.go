@@ -13,6 +13,7 @@ import (
 	"github.com/sensu/sensu-go/backend/licensing"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/tracing"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/util/environment"
 	utillogging "github.com/sensu/sensu-go/util/logging"
@@ -126,8 +127,10 @@ func (l *LegacyAdapter) pipeHandler(ctx context.Context, handler *corev2.Handler
 		secrets = append(secrets, substituted...)
 	}
 
-	// Prepare environment variables
-	env := environment.MergeEnvironments(os.Environ(), handler.EnvVars, secrets)
+	// Prepare environment variables, with the trace context of the handler
+	// span so the command can continue the trace
+	traceEnv := tracing.Environment(ctx)
+	env := environment.MergeEnvironments(os.Environ(), handler.EnvVars, secrets, traceEnv)
 
 	handlerExec := command.ExecutionRequest{}
 	handlerExec.Command = handler.Command
@@ -155,7 +158,7 @@ func (l *LegacyAdapter) pipeHandler(ctx context.Context, handler *corev2.Handler
 				return nil, err
 			}
 		} else {
-			handlerExec.Env = environment.MergeEnvironments(os.Environ(), assets.Env(), handler.EnvVars, secrets)
+			handlerExec.Env = environment.MergeEnvironments(os.Environ(), assets.Env(), handler.EnvVars, secrets, traceEnv)
 		}
 	}
 
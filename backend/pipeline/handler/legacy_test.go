@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func init() {
@@ -351,6 +352,41 @@ func TestLegacyAdapter_pipeHandler(t *testing.T) {
 			},
 			want: command.FixtureExecutionResponse(0, ""),
 		},
+		{
+			name: "the trace context is added to the execution environment",
+			fields: fields{
+				Executor: func() command.Executor {
+					ex := &mockexecutor.MockExecutor{}
+					ex.SetRequestFunc(func(_ context.Context, request command.ExecutionRequest) {
+						for _, env := range request.Env {
+							if env == "TRACEPARENT=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+								response := command.FixtureExecutionResponse(0, "")
+								ex.UnsafeReturn(response, nil)
+								return
+							}
+						}
+						response := command.FixtureExecutionResponse(1, "")
+						ex.UnsafeReturn(response, nil)
+					})
+					return ex
+				}(),
+			},
+			args: args{
+				ctx: func() context.Context {
+					traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+					spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+					return trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+						TraceID:    traceID,
+						SpanID:     spanID,
+						TraceFlags: trace.FlagsSampled,
+					}))
+				}(),
+				handler:     corev2.FixtureHandler("handler1"),
+				event:       corev2.FixtureEvent("entity1", "check1"),
+				mutatedData: []byte{},
+			},
+			want: command.FixtureExecutionResponse(0, ""),
+		},
 		// TODO: add a test here for when asset.GetAssets() returns errors. The
 		// asset.GetAssets() function does not currently return errors and
 		// only logs them.
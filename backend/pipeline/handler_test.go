@@ -36,6 +36,7 @@ func TestAdapterV1_processHandler(t *testing.T) {
 		{
 			name: "returns an error when getHandlerAdapterForResource() returns an error",
 			args: args{
+				ctx: context.Background(),
 				ref: &corev2.ResourceReference{
 					APIVersion: "core/v2",
 					Type:       "Handler",
@@ -57,6 +58,7 @@ func TestAdapterV1_processHandler(t *testing.T) {
 				}(),
 			},
 			args: args{
+				ctx: context.Background(),
 				ref: &corev2.ResourceReference{
 					APIVersion: "core/v2",
 					Type:       "Handler",
@@ -78,6 +80,7 @@ func TestAdapterV1_processHandler(t *testing.T) {
 				}(),
 			},
 			args: args{
+				ctx: context.Background(),
 				ref: &corev2.ResourceReference{
 					APIVersion: "core/v2",
 					Type:       "Handler",
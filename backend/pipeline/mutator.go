@@ -6,7 +6,9 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/tracing"
 	metricspkg "github.com/sensu/sensu-go/metrics"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -48,6 +50,13 @@ func (a *AdapterV1) processMutator(ctx context.Context, ref *corev2.ResourceRefe
 	}))
 	defer mutatorTimer.ObserveDuration()
 
+	ctx, span := tracing.Tracer().Start(ctx, "pipeline.mutator",
+		trace.WithAttributes(tracing.ResourceKey.String(ref.ResourceID())),
+	)
+	defer func() {
+		tracing.EndSpan(span, fErr)
+	}()
+
 	mutator, err := a.getMutatorAdapterForResource(ctx, ref)
 	if err != nil {
 		return nil, err
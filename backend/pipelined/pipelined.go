@@ -13,7 +13,9 @@ import (
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/pipeline"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/tracing"
 	metricspkg "github.com/sensu/sensu-go/metrics"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -220,6 +222,20 @@ func (p *Pipelined) handleMessage(ctx context.Context, msg interface{}) (hadPipe
 	// Add a legacy pipeline "reference" if msg is a
 	// corev2.Event & has handlers.
 	if event, ok := msg.(*corev2.Event); ok {
+		// Continue the trace of the event, as published by eventd
+		var span trace.Span
+		ctx, span = tracing.Tracer().Start(tracing.ExtractEvent(ctx, event), "pipelined.handle_event",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(tracing.EventAttributes(event)...),
+		)
+		defer func() {
+			tracing.EndSpan(span, fErr)
+		}()
+
+		// The trace context is not passed on to the handlers
+		event = tracing.StrippedEvent(event)
+		msg = event
+
 		if event.HasHandlers() {
 			pipelineRefs = append(pipelineRefs, pipeline.LegacyPipelineReference())
 		} else {
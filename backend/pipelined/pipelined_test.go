@@ -2,10 +2,12 @@
 package pipelined
 
 import (
+	"context"
 	"testing"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/tracing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -29,3 +31,40 @@ func TestPipelined(t *testing.T) {
 
 	assert.NoError(t, p.Stop())
 }
+
+// recordingAdapter records the resources of the pipelines it runs.
+type recordingAdapter struct {
+	resources []interface{}
+}
+
+func (a *recordingAdapter) Name() string { return "recording" }
+
+func (a *recordingAdapter) CanRun(*corev2.ResourceReference) bool { return true }
+
+func (a *recordingAdapter) Run(ctx context.Context, ref *corev2.ResourceReference, resource interface{}) error {
+	a.resources = append(a.resources, resource)
+	return nil
+}
+
+func TestHandleMessageStripsTraceContext(t *testing.T) {
+	adapter := &recordingAdapter{}
+	p, err := New(Config{})
+	require.NoError(t, err)
+	p.AddAdapter(adapter)
+
+	event := corev2.FixtureEvent("entity1", "check1")
+	event.Check.Handlers = []string{"slack"}
+	event.Annotations = map[string]string{
+		"foo":                                    "bar",
+		tracing.AnnotationPrefix + "traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	}
+
+	_, err = p.handleMessage(context.Background(), event)
+	require.NoError(t, err)
+	require.Len(t, adapter.resources, 1)
+	handled := adapter.resources[0].(*corev2.Event)
+	assert.Equal(t, map[string]string{"foo": "bar"}, handled.Annotations)
+
+	// The event shared with the other subscribers of the bus is left untouched
+	assert.Contains(t, event.Annotations, tracing.AnnotationPrefix+"traceparent")
+}
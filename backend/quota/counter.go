@@ -0,0 +1,77 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/etcd"
+	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Counter counts the resources of a namespace.
+type Counter interface {
+	// Count returns the number of resources of the namespace, e.g.
+	// corev2.EntitiesResource.
+	Count(ctx context.Context, namespace, resource string) (int64, error)
+}
+
+// EtcdCounter counts the resources of a namespace stored in etcd, without
+// listing them.
+type EtcdCounter struct {
+	Client *clientv3.Client
+}
+
+// storeNames are the store prefixes of the resources limited by quotas.
+var storeNames = map[string]string{
+	corev2.EntitiesResource: new(corev3.EntityConfig).StoreName(),
+	corev2.ChecksResource:   new(corev2.CheckConfig).StorePrefix(),
+	corev2.SilencedResource: new(corev2.Silenced).StorePrefix(),
+	corev2.AssetsResource:   new(corev2.Asset).StorePrefix(),
+}
+
+// Count returns the number of resources of the namespace.
+func (c *EtcdCounter) Count(ctx context.Context, namespace, resource string) (int64, error) {
+	storeName, ok := storeNames[resource]
+	if !ok {
+		return 0, fmt.Errorf("resources of type %q can't be counted", resource)
+	}
+	key := store.NewKeyBuilder(storeName).WithNamespace(namespace).Build("")
+	return etcd.Count(ctx, c.Client, key)
+}
+
+// countPageSize is the number of entity configs listed at once by the
+// StoreCounter.
+const countPageSize = 1000
+
+// StoreCounter counts the entity configs of a namespace through the v2 store,
+// which keeps them in postgres with the postgres state store, and the other
+// resources with the etcd counter.
+type StoreCounter struct {
+	Store storev2.Interface
+	Etcd  *EtcdCounter
+}
+
+// Count returns the number of resources of the namespace.
+func (c *StoreCounter) Count(ctx context.Context, namespace, resource string) (int64, error) {
+	if resource != corev2.EntitiesResource {
+		return c.Etcd.Count(ctx, namespace, resource)
+	}
+	req := storev2.NewResourceRequest(ctx, namespace, "", storeNames[resource])
+	req.UsePostgres = true
+	pred := &store.SelectionPredicate{Limit: countPageSize}
+	var count int64
+	for {
+		list, err := c.Store.List(req, pred)
+		if err != nil {
+			return 0, err
+		}
+		count += int64(list.Len())
+		if pred.Continue == "" || list.Len() < countPageSize {
+			return count, nil
+		}
+	}
+}
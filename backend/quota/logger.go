@@ -0,0 +1,7 @@
+package quota
+
+import "github.com/sirupsen/logrus"
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "quota",
+})
@@ -0,0 +1,131 @@
+package quota
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	// RejectionsCounterVec is the name of the prometheus counter vec used to
+	// count the requests and events rejected by quotas.
+	RejectionsCounterVec = "sensu_go_resource_quota_rejections"
+
+	// UsageGaugeVec is the name of the prometheus gauge vec reporting the
+	// usage of the resources limited by quotas.
+	UsageGaugeVec = "sensu_go_resource_quota_usage"
+
+	// LimitGaugeVec is the name of the prometheus gauge vec reporting the
+	// limits of the quotas.
+	LimitGaugeVec = "sensu_go_resource_quota_limit"
+
+	// DefaultCollectTTL is how long the usage of the quotas is cached by a
+	// collector, so scrapes do not count the resources every time.
+	DefaultCollectTTL = time.Minute
+
+	// collectTimeout is the timeout of the collection of the quotas usage.
+	collectTimeout = 10 * time.Second
+)
+
+var quotaRejections = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: RejectionsCounterVec,
+		Help: "The total number of requests and events rejected by resource quotas",
+	},
+	[]string{"namespace", "resource"},
+)
+
+var (
+	usageDesc = prometheus.NewDesc(
+		UsageGaugeVec,
+		"The usage of the resources limited by resource quotas",
+		[]string{"namespace", "quota", "resource"},
+		nil,
+	)
+	limitDesc = prometheus.NewDesc(
+		LimitGaugeVec,
+		"The limits of the resource quotas",
+		[]string{"namespace", "quota", "resource"},
+		nil,
+	)
+)
+
+func init() {
+	_ = prometheus.Register(quotaRejections)
+}
+
+// Collector is a prometheus collector reporting the usage and limits of the
+// resource quotas of all namespaces. Counting the resources lists them, so the
+// metrics are cached for TTL, or DefaultCollectTTL if it is zero.
+type Collector struct {
+	Enforcer *Enforcer
+	TTL      time.Duration
+
+	mu      sync.Mutex
+	metrics []prometheus.Metric
+	expires time.Time
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- usageDesc
+	ch <- limitDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	// Concurrent scrapes wait for the same collection
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.Enforcer.now()
+	if c.metrics == nil || !now.Before(c.expires) {
+		metrics, err := c.collect()
+		if err != nil {
+			logger.WithError(err).Error("could not list the resource quotas")
+			return
+		}
+		ttl := c.TTL
+		if ttl == 0 {
+			ttl = DefaultCollectTTL
+		}
+		c.metrics, c.expires = metrics, now.Add(ttl)
+	}
+	for _, metric := range c.metrics {
+		ch <- metric
+	}
+}
+
+// collect returns the usage and limits of the quotas of all namespaces.
+func (c *Collector) collect() ([]prometheus.Metric, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	// List the quotas of all namespaces
+	ctx = store.NamespaceContext(ctx, corev2.NamespaceTypeAll)
+	var quotas []*corev2.ResourceQuota
+	if err := c.Enforcer.store.ListResources(ctx, new(corev2.ResourceQuota).StorePrefix(), &quotas, &store.SelectionPredicate{}); err != nil {
+		return nil, err
+	}
+
+	metrics := []prometheus.Metric{}
+	for _, quota := range quotas {
+		usage, err := c.Enforcer.Usage(ctx, quota)
+		if err != nil {
+			logger.WithError(err).WithField("namespace", quota.Namespace).Error("could not compute the resource quota usage")
+			continue
+		}
+		for _, resource := range usage.Resources {
+			labels := []string{quota.Namespace, quota.Name, resource.Resource}
+			metrics = append(metrics,
+				prometheus.MustNewConstMetric(usageDesc, prometheus.GaugeValue, float64(resource.Used), labels...),
+				prometheus.MustNewConstMetric(limitDesc, prometheus.GaugeValue, float64(resource.Limit), labels...),
+			)
+		}
+	}
+	return metrics, nil
+}
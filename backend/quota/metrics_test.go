@@ -0,0 +1,42 @@
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+func collect(c *Collector) []prometheus.Metric {
+	ch := make(chan prometheus.Metric, 100)
+	c.Collect(ch)
+	close(ch)
+	var metrics []prometheus.Metric
+	for metric := range ch {
+		metrics = append(metrics, metric)
+	}
+	return metrics
+}
+
+func TestCollectorCachesMetrics(t *testing.T) {
+	quota := corev2.FixtureResourceQuota("limits", "default")
+	e, s := newEnforcer(fakeCounter{corev2.EntitiesResource: 12}, quota)
+	now := time.Unix(0, 0)
+	e.now = func() time.Time { return now }
+	c := &Collector{Enforcer: e}
+
+	for i := 0; i < 3; i++ {
+		// The usage and limit of the entities and checks
+		if got, want := len(collect(c)), 4; got != want {
+			t.Fatalf("got %d metrics, want %d", got, want)
+		}
+	}
+	s.AssertNumberOfCalls(t, "ListResources", 1)
+
+	now = now.Add(DefaultCollectTTL)
+	if got, want := len(collect(c)), 4; got != want {
+		t.Fatalf("got %d metrics, want %d", got, want)
+	}
+	s.AssertNumberOfCalls(t, "ListResources", 2)
+}
@@ -0,0 +1,210 @@
+// Package quota enforces the resource quotas of namespaces.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// DefaultCacheTTL is how long the quotas of a namespace are cached by an
+// enforcer.
+const DefaultCacheTTL = 10 * time.Second
+
+// ExceededError is returned when creating a resource would exceed a quota.
+type ExceededError struct {
+	Quota     string
+	Namespace string
+	Resource  string
+	Limit     uint32
+}
+
+func (e *ExceededError) Error() string {
+	if e.Resource == corev2.EventsPerMinuteQuota {
+		return fmt.Sprintf("resource quota %q of namespace %q exceeded: at most %d events per minute are allowed", e.Quota, e.Namespace, e.Limit)
+	}
+	return fmt.Sprintf("resource quota %q of namespace %q exceeded: at most %d %s are allowed", e.Quota, e.Namespace, e.Limit, e.Resource)
+}
+
+// Enforcer enforces the resource quotas of namespaces. When a namespace has
+// several quotas, the lowest limit of each resource applies.
+//
+// Quotas are soft limits. The resources are counted before being created, not
+// atomically with their creation, so concurrent creations can exceed a limit
+// by the number of requests in flight. The events per minute are counted in
+// memory by each backend, so a cluster processes up to the limit times its
+// number of backends.
+type Enforcer struct {
+	store   store.ResourceStore
+	counter Counter
+
+	// CacheTTL is how long the quotas of a namespace are cached.
+	CacheTTL time.Duration
+
+	now    func() time.Time
+	mu     sync.Mutex
+	quotas map[string]cachedQuotas
+	events map[string]*eventWindow
+}
+
+type cachedQuotas struct {
+	quotas  []*corev2.ResourceQuota
+	expires time.Time
+}
+
+// eventWindow counts the events of a namespace during a minute.
+type eventWindow struct {
+	minute int64
+	count  int64
+}
+
+// NewEnforcer creates an enforcer reading the quotas from the store, and
+// counting the resources with the counter.
+func NewEnforcer(store store.ResourceStore, counter Counter) *Enforcer {
+	return &Enforcer{
+		store:    store,
+		counter:  counter,
+		CacheTTL: DefaultCacheTTL,
+		now:      time.Now,
+		quotas:   make(map[string]cachedQuotas),
+		events:   make(map[string]*eventWindow),
+	}
+}
+
+// Quotas returns the resource quotas of the namespace.
+func (e *Enforcer) Quotas(ctx context.Context, namespace string) ([]*corev2.ResourceQuota, error) {
+	now := e.now()
+	e.mu.Lock()
+	cached, ok := e.quotas[namespace]
+	e.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.quotas, nil
+	}
+
+	ctx = store.NamespaceContext(ctx, namespace)
+	var quotas []*corev2.ResourceQuota
+	if err := e.store.ListResources(ctx, new(corev2.ResourceQuota).StorePrefix(), &quotas, &store.SelectionPredicate{}); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	e.quotas[namespace] = cachedQuotas{quotas: quotas, expires: now.Add(e.CacheTTL)}
+	e.mu.Unlock()
+	return quotas, nil
+}
+
+// limit returns the lowest limit of the quotas for the resource, and the
+// quota defining it, or nil if the resource is not limited.
+func limit(quotas []*corev2.ResourceQuota, resource string) (uint32, *corev2.ResourceQuota) {
+	var lowest uint32
+	var quota *corev2.ResourceQuota
+	for _, q := range quotas {
+		if l := q.Limit(resource); l > 0 && (quota == nil || l < lowest) {
+			lowest, quota = l, q
+		}
+	}
+	return lowest, quota
+}
+
+// Check returns an ExceededError if creating a resource of the given type in
+// the namespace would exceed one of its quotas. The check is not atomic with
+// the creation of the resource.
+func (e *Enforcer) Check(ctx context.Context, namespace, resource string) error {
+	quotas, err := e.Quotas(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	max, quota := limit(quotas, resource)
+	if quota == nil {
+		return nil
+	}
+	used, err := e.counter.Count(ctx, namespace, resource)
+	if err != nil {
+		return err
+	}
+	if used < int64(max) {
+		return nil
+	}
+	quotaRejections.WithLabelValues(namespace, resource).Inc()
+	return &ExceededError{Quota: quota.Name, Namespace: namespace, Resource: resource, Limit: max}
+}
+
+// AllowEvent counts an event of the namespace, and returns an ExceededError
+// if the namespace exceeds its events per minute quota. Events are counted
+// by each backend, so the limit applies per backend.
+func (e *Enforcer) AllowEvent(ctx context.Context, namespace string) error {
+	quotas, err := e.Quotas(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	max, quota := limit(quotas, corev2.EventsPerMinuteQuota)
+	if quota == nil {
+		return nil
+	}
+	if count := e.countEvent(namespace); count <= int64(max) {
+		return nil
+	}
+	quotaRejections.WithLabelValues(namespace, corev2.EventsPerMinuteQuota).Inc()
+	return &ExceededError{Quota: quota.Name, Namespace: namespace, Resource: corev2.EventsPerMinuteQuota, Limit: max}
+}
+
+// countEvent counts an event of the namespace in the current minute, and
+// returns the number of events of the minute.
+func (e *Enforcer) countEvent(namespace string) int64 {
+	minute := e.now().Unix() / 60
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	window, ok := e.events[namespace]
+	if !ok || window.minute != minute {
+		window = &eventWindow{minute: minute}
+		e.events[namespace] = window
+	}
+	window.count++
+	return window.count
+}
+
+// eventsThisMinute returns the number of events of the namespace counted in
+// the current minute.
+func (e *Enforcer) eventsThisMinute(namespace string) int64 {
+	minute := e.now().Unix() / 60
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if window, ok := e.events[namespace]; ok && window.minute == minute {
+		return window.count
+	}
+	return 0
+}
+
+// Usage returns the usage of the resources limited by the quota.
+func (e *Enforcer) Usage(ctx context.Context, quota *corev2.ResourceQuota) (*corev2.ResourceQuotaUsage, error) {
+	usage := &corev2.ResourceQuotaUsage{
+		Name:      quota.Name,
+		Namespace: quota.Namespace,
+		Resources: []corev2.ResourceUsage{},
+	}
+	for _, resource := range corev2.QuotaResources {
+		max := quota.Limit(resource)
+		if max == 0 {
+			continue
+		}
+		var used int64
+		if resource == corev2.EventsPerMinuteQuota {
+			used = e.eventsThisMinute(quota.Namespace)
+		} else {
+			var err error
+			if used, err = e.counter.Count(ctx, quota.Namespace, resource); err != nil {
+				return nil, err
+			}
+		}
+		usage.Resources = append(usage.Resources, corev2.ResourceUsage{
+			Resource: resource,
+			Used:     used,
+			Limit:    max,
+		})
+	}
+	return usage, nil
+}
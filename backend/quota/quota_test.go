@@ -0,0 +1,196 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	"github.com/sensu/sensu-go/backend/store/v2/storetest"
+	"github.com/sensu/sensu-go/backend/store/v2/wrap"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeCounter map[string]int64
+
+func (c fakeCounter) Count(ctx context.Context, namespace, resource string) (int64, error) {
+	return c[resource], nil
+}
+
+func newEnforcer(counter Counter, quotas ...*corev2.ResourceQuota) (*Enforcer, *mockstore.MockStore) {
+	s := &mockstore.MockStore{}
+	s.On("ListResources", mock.Anything, "resourcequotas", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		list := args[2].(*[]*corev2.ResourceQuota)
+		*list = quotas
+	}).Return(nil)
+	return NewEnforcer(s, counter), s
+}
+
+func TestEnforcerCheck(t *testing.T) {
+	strict := corev2.FixtureResourceQuota("strict", "default")
+	strict.MaxEntities = 10
+	strict.MaxChecks = 0
+
+	tests := []struct {
+		name      string
+		quotas    []*corev2.ResourceQuota
+		counter   fakeCounter
+		resource  string
+		wantLimit uint32
+	}{
+		{
+			name:     "no quota",
+			counter:  fakeCounter{corev2.EntitiesResource: 5000},
+			resource: corev2.EntitiesResource,
+		},
+		{
+			name:     "resource not limited",
+			quotas:   []*corev2.ResourceQuota{corev2.FixtureResourceQuota("limits", "default")},
+			counter:  fakeCounter{corev2.AssetsResource: 5000},
+			resource: corev2.AssetsResource,
+		},
+		{
+			name:     "under the limit",
+			quotas:   []*corev2.ResourceQuota{corev2.FixtureResourceQuota("limits", "default")},
+			counter:  fakeCounter{corev2.ChecksResource: 99},
+			resource: corev2.ChecksResource,
+		},
+		{
+			name:      "limit reached",
+			quotas:    []*corev2.ResourceQuota{corev2.FixtureResourceQuota("limits", "default")},
+			counter:   fakeCounter{corev2.ChecksResource: 100},
+			resource:  corev2.ChecksResource,
+			wantLimit: 100,
+		},
+		{
+			name:      "lowest limit applies",
+			quotas:    []*corev2.ResourceQuota{corev2.FixtureResourceQuota("limits", "default"), strict},
+			counter:   fakeCounter{corev2.EntitiesResource: 10},
+			resource:  corev2.EntitiesResource,
+			wantLimit: 10,
+		},
+		{
+			name:     "zero limit is ignored",
+			quotas:   []*corev2.ResourceQuota{corev2.FixtureResourceQuota("limits", "default"), strict},
+			counter:  fakeCounter{corev2.ChecksResource: 50},
+			resource: corev2.ChecksResource,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _ := newEnforcer(tt.counter, tt.quotas...)
+			err := e.Check(context.Background(), "default", tt.resource)
+			if tt.wantLimit == 0 {
+				if err != nil {
+					t.Fatalf("Check() error = %v", err)
+				}
+				return
+			}
+			var exceeded *ExceededError
+			if !errors.As(err, &exceeded) {
+				t.Fatalf("Check() error = %v, want an ExceededError", err)
+			}
+			if exceeded.Limit != tt.wantLimit {
+				t.Errorf("ExceededError.Limit = %d, want %d", exceeded.Limit, tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestEnforcerCachesQuotas(t *testing.T) {
+	e, s := newEnforcer(fakeCounter{}, corev2.FixtureResourceQuota("limits", "default"))
+	now := time.Unix(0, 0)
+	e.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if err := e.Check(context.Background(), "default", corev2.ChecksResource); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.AssertNumberOfCalls(t, "ListResources", 1)
+
+	now = now.Add(DefaultCacheTTL)
+	if err := e.Check(context.Background(), "default", corev2.ChecksResource); err != nil {
+		t.Fatal(err)
+	}
+	s.AssertNumberOfCalls(t, "ListResources", 2)
+}
+
+func TestEnforcerAllowEvent(t *testing.T) {
+	quota := corev2.FixtureResourceQuota("limits", "default")
+	quota.MaxEventsPerMinute = 2
+	e, _ := newEnforcer(fakeCounter{}, quota)
+	now := time.Unix(600, 0)
+	e.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := e.AllowEvent(ctx, "default"); err != nil {
+			t.Fatalf("event %d: %v", i, err)
+		}
+	}
+	if err := e.AllowEvent(ctx, "default"); err == nil {
+		t.Fatal("expected the third event to be rejected")
+	}
+
+	// The count is reset every minute
+	now = now.Add(time.Minute)
+	if err := e.AllowEvent(ctx, "default"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEnforcerUsage(t *testing.T) {
+	quota := corev2.FixtureResourceQuota("limits", "default")
+	quota.MaxEventsPerMinute = 60
+	e, _ := newEnforcer(fakeCounter{corev2.EntitiesResource: 12, corev2.ChecksResource: 3}, quota)
+	e.now = func() time.Time { return time.Unix(600, 0) }
+	ctx := context.Background()
+	if err := e.AllowEvent(ctx, "default"); err != nil {
+		t.Fatal(err)
+	}
+
+	usage, err := e.Usage(ctx, quota)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []corev2.ResourceUsage{
+		{Resource: corev2.EntitiesResource, Used: 12, Limit: 1000},
+		{Resource: corev2.ChecksResource, Used: 3, Limit: 100},
+		{Resource: corev2.EventsPerMinuteQuota, Used: 1, Limit: 60},
+	}
+	if len(usage.Resources) != len(want) {
+		t.Fatalf("got %d resources, want %d: %v", len(usage.Resources), len(want), usage.Resources)
+	}
+	for i := range want {
+		if usage.Resources[i] != want[i] {
+			t.Errorf("resource %d = %+v, want %+v", i, usage.Resources[i], want[i])
+		}
+	}
+}
+
+func TestStoreCounterPagesThroughEntities(t *testing.T) {
+	s := &storetest.Store{}
+	s.On("List", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		req := args[0].(storev2.ResourceRequest)
+		if req.Namespace != "default" || !req.UsePostgres {
+			t.Errorf("unexpected request: %#v", req)
+		}
+		args[1].(*store.SelectionPredicate).Continue = "next"
+	}).Return(make(wrap.List, countPageSize), nil).Once()
+	s.On("List", mock.Anything, mock.Anything).Return(make(wrap.List, 5), nil).Once()
+
+	counter := &StoreCounter{Store: s}
+	count, err := counter.Count(context.Background(), "default", corev2.EntitiesResource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := count, int64(countPageSize+5); got != want {
+		t.Errorf("bad count: got %d, want %d", got, want)
+	}
+	s.AssertExpectations(t)
+}
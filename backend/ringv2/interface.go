@@ -25,6 +25,14 @@ type Interface interface {
 	IsEmpty(ctx context.Context) (bool, error)
 }
 
+// Peeker is implemented by rings that can tell which items a subscription
+// will receive on its next trigger, without advancing the ring.
+type Peeker interface {
+	// Peek returns the items the next trigger of the subscription will
+	// produce. It returns no items if the ring is empty.
+	Peek(ctx context.Context, sub Subscription) ([]string, error)
+}
+
 // Subscription is configuration for the Subscribe method of a ring.
 type Subscription struct {
 	// Name is the unique name of this subscription. Typically, it would be the
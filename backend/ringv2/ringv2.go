@@ -367,6 +367,51 @@ func (r *Ring) Subscribe(ctx context.Context, sub Subscription) <-chan Event {
 	return r.Watch(ctx, sub.Name, sub.Items, sub.IntervalSchedule, sub.CronSchedule)
 }
 
+// Peek returns the items the next trigger of the subscription will produce,
+// without advancing the ring.
+func (r *Ring) Peek(ctx context.Context, sub Subscription) ([]string, error) {
+	if err := sub.Validate(); err != nil {
+		return nil, err
+	}
+	w := &watcher{
+		watcherKey: watcherKey{
+			name:     sub.Name,
+			values:   sub.Items,
+			interval: sub.IntervalSchedule,
+			cron:     sub.CronSchedule,
+		},
+		ring: r,
+	}
+	var resp *clientv3.GetResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = r.client.Get(ctx, w.triggerKey())
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get ring trigger: %s", err)
+	}
+
+	// The trigger holds the next item of the ring. Without trigger, the ring
+	// starts over from its first item.
+	var next *mvccpb.KeyValue
+	if len(resp.Kvs) > 0 && len(resp.Kvs[0].Value) > 0 {
+		next = resp.Kvs[0]
+	}
+	items, err := r.nextInRing(ctx, next, int64(sub.Items))
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	items = repeatKVs(items, sub.Items)
+	values := make([]string, len(items))
+	for i := range values {
+		values[i] = path.Base(string(items[i].Key))
+	}
+	return values, nil
+}
+
 func (w *watcher) getInterval() int {
 	if w.cron != nil {
 		now := time.Now()
@@ -489,3 +489,57 @@ func TestReWatch(t *testing.T) {
 
 	cancel()
 }
+
+func TestPeek(t *testing.T) {
+	t.Parallel()
+
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+
+	client := e.NewEmbeddedClient()
+	defer client.Close()
+
+	ring := New(client, t.Name())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub := Subscription{Name: "test", Items: 2, IntervalSchedule: 5}
+
+	got, err := ring.Peek(ctx, sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("bad peek on empty ring: got %v", got)
+	}
+
+	for _, value := range []string{"foo", "bar", "baz"} {
+		if err := ring.Add(ctx, value, 600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Without trigger, the ring starts from its first item
+	got, err = ring.Peek(ctx, sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"bar", "baz"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("bad peek: got %v, want %v", got, want)
+	}
+
+	wc := ring.Subscribe(ctx, sub)
+	for event := range wc {
+		if event.Type == EventTrigger {
+			break
+		}
+	}
+
+	got, err = ring.Peek(ctx, sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"foo", "bar"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("bad peek after trigger: got %v, want %v", got, want)
+	}
+}
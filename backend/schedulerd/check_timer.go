@@ -79,9 +79,15 @@ func (timerPtr *IntervalTimer) Stop() {
 
 // Calculate the first execution time using splay & interval
 func (timerPtr *IntervalTimer) calcInitialOffset() time.Duration {
-	now := uint64(time.Now().UnixNano())
-	offset := (timerPtr.splay - now) % uint64(timerPtr.interval)
-	logger.WithField("offset", time.Duration(offset)/time.Second).Debug("initial offset for interval timer (in seconds)")
+	offset := timerPtr.offsetFrom(time.Now())
+	logger.WithField("offset", offset/time.Second).Debug("initial offset for interval timer (in seconds)")
+	return offset
+}
+
+// offsetFrom returns the duration between the given time and the next
+// execution time given by the splay & interval
+func (timerPtr *IntervalTimer) offsetFrom(now time.Time) time.Duration {
+	offset := (timerPtr.splay - uint64(now.UnixNano())) % uint64(timerPtr.interval)
 	return time.Duration(offset) / time.Nanosecond
 }
 
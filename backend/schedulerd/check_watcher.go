@@ -147,6 +147,9 @@ func (c *CheckWatcher) handleWatchEvent(watchEvent store.WatchEventCheckConfig)
 			logger.WithError(err).Error("unable to start check scheduler")
 		}
 	case store.WatchUpdate:
+		if !retriesEnabled(check) {
+			c.deleteRetryStates(check)
+		}
 		// Interrupt the check scheduler, causing the check to execute and the timer to be reset.
 		logger.Info("check configs updated")
 		sched, ok := c.items[key]
@@ -171,6 +174,7 @@ func (c *CheckWatcher) handleWatchEvent(watchEvent store.WatchEventCheckConfig)
 			}
 		}
 	case store.WatchDelete:
+		c.deleteRetryStates(check)
 		// Call stop on the scheduler.
 		sched, ok := c.items[key]
 		if ok {
@@ -182,6 +186,16 @@ func (c *CheckWatcher) handleWatchEvent(watchEvent store.WatchEventCheckConfig)
 	}
 }
 
+// deleteRetryStates deletes the retry states of the check in the background,
+// when it is deleted or not retried anymore.
+func (c *CheckWatcher) deleteRetryStates(check *corev2.CheckConfig) {
+	go func() {
+		if err := deleteRetryStates(c.ctx, c.store, check); err != nil {
+			logger.WithError(err).Error("error deleting check retry states")
+		}
+	}()
+}
+
 func concatUniqueKey(args ...string) string {
 	return strings.Join(args, "-")
 }
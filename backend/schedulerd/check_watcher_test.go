@@ -34,6 +34,7 @@ func TestCheckWatcherSmoke(t *testing.T) {
 	st.On("GetCheckConfigByName", mock.Anything, "b").Return(checkB, nil)
 	st.On("GetAssets", mock.Anything, &store.SelectionPredicate{}).Return([]*corev2.Asset{}, nil)
 	st.On("GetHookConfigs", mock.Anything, &store.SelectionPredicate{}).Return([]*corev2.HookConfig{}, nil)
+	st.On("ListResources", mock.Anything, mock.Anything, mock.AnythingOfType("*[]*v2.CheckRetryState"), mock.Anything).Return(nil)
 
 	watcherChan := make(chan store.WatchEventCheckConfig)
 	st.On("GetCheckConfigWatcher", mock.Anything).Return((<-chan store.WatchEventCheckConfig)(watcherChan), nil)
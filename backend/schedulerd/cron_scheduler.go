@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	time "github.com/echlebek/timeproxy"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
@@ -16,6 +17,7 @@ import (
 // CronScheduler schedules checks to be executed on a cron schedule.
 type CronScheduler struct {
 	lastCronState          string
+	lastRetryState         time.Duration
 	check                  *corev2.CheckConfig
 	store                  store.Store
 	bus                    messaging.MessageBus
@@ -26,16 +28,18 @@ type CronScheduler struct {
 	entityCache            *cachev2.Resource
 	secretsProviderManager *secrets.ProviderManager
 	stopWg                 sync.WaitGroup
+	retrier                *retrier
 }
 
 // NewCronScheduler initializes a CronScheduler
 func NewCronScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, check *corev2.CheckConfig, cache *cachev2.Resource, secretsProviderManager *secrets.ProviderManager) *CronScheduler {
 	sched := &CronScheduler{
-		store:         store,
-		bus:           bus,
-		check:         check,
-		lastCronState: check.Cron,
-		interrupt:     make(chan *corev2.CheckConfig),
+		store:          store,
+		bus:            bus,
+		check:          check,
+		lastCronState:  check.Cron,
+		lastRetryState: retryInterval(check),
+		interrupt:      make(chan *corev2.CheckConfig),
 		logger: logger.WithFields(logrus.Fields{
 			"name":           check.Name,
 			"namespace":      check.Namespace,
@@ -43,6 +47,7 @@ func NewCronScheduler(ctx context.Context, store store.Store, bus messaging.Mess
 		}),
 		entityCache:            cache,
 		secretsProviderManager: secretsProviderManager,
+		retrier:                newRetrier(store),
 	}
 	sched.ctx, sched.cancel = context.WithCancel(ctx)
 	sched.ctx = corev2.SetContextFromResource(sched.ctx, check)
@@ -59,7 +64,7 @@ func (s *CronScheduler) schedule(timer *CronTimer, executor *CheckExecutor) {
 
 	s.logger.Debug("check is not subdued")
 
-	if err := executor.processCheck(s.ctx, s.check); err != nil {
+	if err := s.retrier.processCheck(s.ctx, executor, s.check); err != nil {
 		logger.Error(err)
 	}
 }
@@ -78,6 +83,11 @@ func (s *CronScheduler) start() {
 	executor := NewCheckExecutor(s.bus, s.check.Namespace, s.store, s.entityCache, s.secretsProviderManager)
 	timer.Start()
 
+	retryTicker := newRetryTicker(s.check)
+	if retryTicker != nil {
+		defer retryTicker.Stop()
+	}
+
 	for {
 		select {
 		case <-s.ctx.Done():
@@ -92,6 +102,11 @@ func (s *CronScheduler) start() {
 				return
 			}
 			continue
+		case <-retryTickerC(retryTicker):
+			if err := s.retrier.retry(s.ctx, executor, s.check, nil); err != nil {
+				s.logger.WithError(err).Error("error retrying check")
+			}
+			continue
 		case <-timer.C():
 		}
 		s.schedule(timer, executor)
@@ -120,6 +135,10 @@ func (s *CronScheduler) toggleSchedule() (stateChanged bool) {
 		s.logger.Info("cron schedule has changed")
 		return true
 	}
+	if s.lastRetryState != retryInterval(s.check) {
+		s.logger.Info("retry schedule has changed")
+		return true
+	}
 
 	s.logger.Debug("schedule unchanged")
 	return false
@@ -127,6 +146,7 @@ func (s *CronScheduler) toggleSchedule() (stateChanged bool) {
 
 func (s *CronScheduler) setLastState() {
 	s.lastCronState = s.check.Cron
+	s.lastRetryState = retryInterval(s.check)
 }
 
 func (s *CronScheduler) resetTimer(timer *CronTimer) {
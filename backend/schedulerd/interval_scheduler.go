@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	time "github.com/echlebek/timeproxy"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/secrets"
@@ -15,6 +16,7 @@ import (
 // IntervalScheduler schedules checks to be executed on a timer
 type IntervalScheduler struct {
 	lastIntervalState      uint32
+	lastRetryState         time.Duration
 	check                  *corev2.CheckConfig
 	store                  store.Store
 	bus                    messaging.MessageBus
@@ -25,6 +27,7 @@ type IntervalScheduler struct {
 	entityCache            *cachev2.Resource
 	secretsProviderManager *secrets.ProviderManager
 	stopWg                 sync.WaitGroup
+	retrier                *retrier
 }
 
 // NewIntervalScheduler initializes an IntervalScheduler
@@ -34,6 +37,7 @@ func NewIntervalScheduler(ctx context.Context, store store.Store, bus messaging.
 		bus:               bus,
 		check:             check,
 		lastIntervalState: check.Interval,
+		lastRetryState:    retryInterval(check),
 		interrupt:         make(chan *corev2.CheckConfig),
 		logger: logger.WithFields(logrus.Fields{
 			"name":           check.Name,
@@ -42,6 +46,7 @@ func NewIntervalScheduler(ctx context.Context, store store.Store, bus messaging.
 		}),
 		entityCache:            cache,
 		secretsProviderManager: secretsProviderManager,
+		retrier:                newRetrier(store),
 	}
 	sched.ctx, sched.cancel = context.WithCancel(ctx)
 	sched.ctx = corev2.SetContextFromResource(sched.ctx, check)
@@ -58,7 +63,7 @@ func (s *IntervalScheduler) schedule(timer CheckTimer, executor *CheckExecutor)
 
 	s.logger.Debug("check is not subdued")
 
-	if err := executor.processCheck(s.ctx, s.check); err != nil {
+	if err := s.retrier.processCheck(s.ctx, executor, s.check); err != nil {
 		logger.WithError(err).Error("error executing check")
 	}
 }
@@ -78,6 +83,11 @@ func (s *IntervalScheduler) start() {
 
 	timer.Start()
 
+	retryTicker := newRetryTicker(s.check)
+	if retryTicker != nil {
+		defer retryTicker.Stop()
+	}
+
 	for {
 		select {
 		case <-s.ctx.Done():
@@ -92,6 +102,11 @@ func (s *IntervalScheduler) start() {
 				return
 			}
 			continue
+		case <-retryTickerC(retryTicker):
+			if err := s.retrier.retry(s.ctx, executor, s.check, nil); err != nil {
+				s.logger.WithError(err).Error("error retrying check")
+			}
+			continue
 		case <-timer.C():
 		}
 		s.schedule(timer, executor)
@@ -122,6 +137,10 @@ func (s *IntervalScheduler) toggleSchedule() (stateChanged bool) {
 		s.logger.Info("interval schedule has changed")
 		return true
 	}
+	if s.lastRetryState != retryInterval(s.check) {
+		s.logger.Info("retry schedule has changed")
+		return true
+	}
 	s.logger.Info("check schedule has not changed")
 	return false
 }
@@ -129,6 +148,7 @@ func (s *IntervalScheduler) toggleSchedule() (stateChanged bool) {
 // Update the IntervalScheduler with the last schedule states
 func (s *IntervalScheduler) setLastState() {
 	s.lastIntervalState = s.check.Interval
+	s.lastRetryState = retryInterval(s.check)
 }
 
 // Reset timer
@@ -0,0 +1,153 @@
+package schedulerd
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	time "github.com/echlebek/timeproxy"
+	cron "github.com/robfig/cron/v3"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/ringv2"
+	"github.com/sensu/sensu-go/backend/store"
+	cachev2 "github.com/sensu/sensu-go/backend/store/cache/v2"
+	"github.com/sensu/sensu-go/types/dynamic"
+	stringsutil "github.com/sensu/sensu-go/util/strings"
+)
+
+// DefaultPreviewExecutions is the default number of upcoming executions
+// returned by a schedule preview.
+const DefaultPreviewExecutions = 5
+
+// MaxPreviewExecutions is the maximum number of upcoming executions returned
+// by a schedule preview.
+const MaxPreviewExecutions = 100
+
+// Previewer computes the schedule of checks without executing them.
+type Previewer struct {
+	EntityStore store.EntityStore
+	RingPool    *ringv2.RingPool
+}
+
+// Preview returns the next count executions of the check after now, whether
+// it is subdued, and the entities it would currently be executed on.
+func (p *Previewer) Preview(ctx context.Context, check *corev2.CheckConfig, now time.Time, count int) (*corev2.CheckSchedule, error) {
+	if count <= 0 {
+		count = DefaultPreviewExecutions
+	}
+	if count > MaxPreviewExecutions {
+		count = MaxPreviewExecutions
+	}
+	schedule := &corev2.CheckSchedule{
+		Check:     check.Name,
+		Namespace: check.Namespace,
+		Scheduler: GetSchedulerType(check).String(),
+		Subdued:   check.IsSubduedAt(now),
+		Agents:    []string{},
+	}
+
+	times, err := nextExecutions(check, now, count)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range times {
+		schedule.Executions = append(schedule.Executions, corev2.ScheduledExecution{
+			Time:    t.Unix(),
+			Subdued: check.IsSubduedAt(t),
+		})
+	}
+
+	entities, err := p.EntityStore.GetEntities(ctx, &store.SelectionPredicate{})
+	if err != nil {
+		return nil, err
+	}
+	values := make([]cachev2.Value, 0, len(entities))
+	for _, entity := range entities {
+		if entity.EntityClass != corev2.EntityProxyClass && len(stringsutil.Intersect(entity.Subscriptions, check.Subscriptions)) > 0 {
+			schedule.Agents = append(schedule.Agents, entity.Name)
+		}
+		config, _ := corev3.V2EntityToV3(entity)
+		values = append(values, cachev2.Value{Resource: config, Synth: dynamic.Synthesize(config)})
+	}
+	sort.Strings(schedule.Agents)
+	schedule.AgentCount = len(schedule.Agents)
+
+	if check.ProxyRequests != nil {
+		schedule.ProxyEntities = []string{}
+		for _, entity := range matchEntities(values, check.ProxyRequests) {
+			schedule.ProxyEntities = append(schedule.ProxyEntities, entity.Metadata.Name)
+		}
+		sort.Strings(schedule.ProxyEntities)
+		schedule.ProxyEntityCount = len(schedule.ProxyEntities)
+	}
+
+	if check.RoundRobin && p.RingPool != nil {
+		items := 1
+		if check.ProxyRequests != nil {
+			items = len(schedule.ProxyEntities)
+		}
+		if items > 0 {
+			schedule.RoundRobin, err = p.peekRings(ctx, check, items)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return schedule, nil
+}
+
+// peekRings returns, by subscription, the entities that will receive the next
+// execution of a round robin check.
+func (p *Previewer) peekRings(ctx context.Context, check *corev2.CheckConfig, items int) (map[string][]string, error) {
+	result := make(map[string][]string, len(check.Subscriptions))
+	for _, subscription := range check.Subscriptions {
+		ring := p.RingPool.Get(ringv2.Path(check.Namespace, subscription))
+		peeker, ok := ring.(ringv2.Peeker)
+		if !ok {
+			continue
+		}
+		values, err := peeker.Peek(ctx, ringv2.Subscription{
+			Name:             check.Name,
+			Items:            items,
+			IntervalSchedule: int(check.Interval),
+			CronSchedule:     check.Cron,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result[subscription] = values
+	}
+	return result, nil
+}
+
+// nextExecutions returns the next count execution times of the check after
+// now. Interval checks are executed at a splay derived from their name, so
+// their execution times are stable across backends and restarts.
+func nextExecutions(check *corev2.CheckConfig, now time.Time, count int) ([]time.Time, error) {
+	times := make([]time.Time, 0, count)
+	if check.Cron != "" {
+		schedule, err := cron.ParseStandard(check.Cron)
+		if err != nil {
+			return nil, err
+		}
+		next := now
+		for i := 0; i < count; i++ {
+			next = schedule.Next(next)
+			times = append(times, next)
+		}
+		return times, nil
+	}
+
+	if check.Interval == 0 {
+		return nil, errors.New("check has no interval or cron schedule")
+	}
+	timer := NewIntervalTimer(check.Name, uint(check.Interval))
+	next := now.Add(timer.offsetFrom(now))
+	for i := 0; i < count; i++ {
+		times = append(times, next)
+		next = next.Add(timer.interval)
+	}
+	return times, nil
+}
@@ -0,0 +1,71 @@
+package schedulerd
+
+import (
+	"context"
+	"testing"
+
+	time "github.com/echlebek/timeproxy"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextExecutions(t *testing.T) {
+	now := time.Date(2021, 1, 1, 10, 0, 30, 0, time.UTC)
+
+	check := corev2.FixtureCheckConfig("check")
+	check.Interval = 60
+	times, err := nextExecutions(check, now, 3)
+	require.NoError(t, err)
+	require.Len(t, times, 3)
+	assert.False(t, times[0].Before(now))
+	assert.True(t, times[0].Before(now.Add(time.Minute)))
+	assert.Equal(t, time.Minute, times[1].Sub(times[0]))
+	assert.Equal(t, time.Minute, times[2].Sub(times[1]))
+
+	// The splay is stable
+	later, err := nextExecutions(check, times[0].Add(time.Second), 1)
+	require.NoError(t, err)
+	assert.Equal(t, times[1], later[0])
+
+	check.Interval = 0
+	check.Cron = "*/15 * * * *"
+	times, err = nextExecutions(check, now, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2021, 1, 1, 10, 15, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 10, 30, 0, 0, time.UTC),
+	}, times)
+}
+
+func TestPreview(t *testing.T) {
+	agent := corev2.FixtureEntity("agent")
+	agent.Subscriptions = []string{"linux"}
+
+	other := corev2.FixtureEntity("other")
+	other.Subscriptions = []string{"windows"}
+
+	proxy := corev2.FixtureEntity("router")
+	proxy.EntityClass = corev2.EntityProxyClass
+
+	st := &mockstore.MockStore{}
+	st.On("GetEntities", mock.Anything, mock.Anything).Return([]*corev2.Entity{agent, other, proxy}, nil)
+
+	check := corev2.FixtureCheckConfig("check")
+	check.Subscriptions = []string{"linux"}
+	check.ProxyRequests = &corev2.ProxyRequests{
+		EntityAttributes: []string{`entity.entity_class == "proxy"`},
+	}
+
+	p := &Previewer{EntityStore: st}
+	schedule, err := p.Preview(context.Background(), check, time.Now(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, "check", schedule.Check)
+	assert.Equal(t, IntervalType.String(), schedule.Scheduler)
+	assert.False(t, schedule.Subdued)
+	assert.Len(t, schedule.Executions, DefaultPreviewExecutions)
+	assert.Equal(t, []string{"agent"}, schedule.Agents)
+	assert.Equal(t, []string{"router"}, schedule.ProxyEntities)
+}
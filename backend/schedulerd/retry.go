@@ -0,0 +1,306 @@
+package schedulerd
+
+import (
+	"context"
+
+	time "github.com/echlebek/timeproxy"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/store"
+	cachev2 "github.com/sensu/sensu-go/backend/store/cache/v2"
+	stringsutil "github.com/sensu/sensu-go/util/strings"
+	"github.com/sirupsen/logrus"
+)
+
+// retriesEnabled returns true if the check is configured to be retried or to
+// back off while failing.
+func retriesEnabled(check *corev2.CheckConfig) bool {
+	return check.MaxRetries > 0 || check.MaxBackoffInterval > 0
+}
+
+// retryInterval returns the interval at which the schedulers look for
+// failing entities to retry the check on, or 0 if the check is not retried.
+func retryInterval(check *corev2.CheckConfig) time.Duration {
+	if check.MaxRetries == 0 {
+		return 0
+	}
+	return time.Duration(check.RetryInterval) * time.Second
+}
+
+// newRetryTicker returns a ticker firing at the retry interval of the check,
+// or nil if the check is not retried.
+func newRetryTicker(check *corev2.CheckConfig) *time.Ticker {
+	if interval := retryInterval(check); interval > 0 {
+		return time.NewTicker(interval)
+	}
+	return nil
+}
+
+// retryTickerC returns the channel of the retry ticker, which blocks forever
+// if the check is not retried.
+func retryTickerC(ticker *time.Ticker) <-chan time.Time {
+	if ticker == nil {
+		return nil
+	}
+	return ticker.C
+}
+
+// retrier applies the retry states of a check to its executions. eventd keeps
+// a retry state for each entity failing a check configured for retries or
+// backoff, updated with the results of the check, and every scheduler reads
+// them: the failing entities are retried at the retry interval, and the
+// entities on which the check is not due are left out of the executions.
+type retrier struct {
+	store store.ResourceStore
+
+	// retried is the next execution of the last retry sent for each entity,
+	// so a retry is not sent again until its result is known.
+	retried map[string]int64
+
+	// missing are the entities with a retry state that were missing from the
+	// entity cache, whose state is deleted if they are still missing the next
+	// time, since the cache may lag behind the store.
+	missing map[string]struct{}
+}
+
+func newRetrier(store store.ResourceStore) *retrier {
+	return &retrier{store: store, retried: make(map[string]int64), missing: make(map[string]struct{})}
+}
+
+// states returns the retry states of the check, by entity name. The states of
+// the entities that were deleted are deleted.
+func (r *retrier) states(ctx context.Context, executor *CheckExecutor, check *corev2.CheckConfig) (map[string]*corev2.CheckRetryState, error) {
+	states := []*corev2.CheckRetryState{}
+	if err := r.store.ListResources(ctx, corev2.CheckRetryStatesPrefix(check.Name), &states, &store.SelectionPredicate{}); err != nil {
+		return nil, err
+	}
+	byEntity := make(map[string]*corev2.CheckRetryState, len(states))
+	for _, state := range states {
+		byEntity[state.EntityName] = state
+	}
+	if len(byEntity) == 0 {
+		return byEntity, nil
+	}
+
+	entities, err := executor.getEntities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[string]struct{}, len(entities))
+	for _, value := range entities {
+		if entity, ok := value.Resource.(*corev3.EntityConfig); ok {
+			exists[entity.Metadata.Name] = struct{}{}
+		}
+	}
+	for name, state := range byEntity {
+		if _, ok := exists[name]; ok {
+			delete(r.missing, name)
+			continue
+		}
+		delete(byEntity, name)
+		if _, ok := r.missing[name]; !ok {
+			r.missing[name] = struct{}{}
+			continue
+		}
+		delete(r.missing, name)
+		err := r.store.DeleteResource(ctx, state.StorePrefix(), state.Name)
+		if _, ok := err.(*store.ErrNotFound); err != nil && !ok {
+			logger.WithFields(logrus.Fields{
+				"check":     check.Name,
+				"entity":    name,
+				"namespace": check.Namespace,
+			}).WithError(err).Error("error deleting the retry state of a deleted entity")
+		}
+	}
+	return byEntity, nil
+}
+
+// deleteRetryStates deletes the retry states of the check, once it is deleted
+// or not retried anymore.
+func deleteRetryStates(ctx context.Context, st store.ResourceStore, check *corev2.CheckConfig) error {
+	ctx = store.NamespaceContext(ctx, check.Namespace)
+	prefix := corev2.CheckRetryStatesPrefix(check.Name)
+	states := []*corev2.CheckRetryState{}
+	if err := st.ListResources(ctx, prefix, &states, &store.SelectionPredicate{}); err != nil {
+		return err
+	}
+	for _, state := range states {
+		if err := st.DeleteResource(ctx, prefix, state.Name); err != nil {
+			if _, ok := err.(*store.ErrNotFound); !ok {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// heldBack returns the names of the entities on which the check is not due
+// at the given time, because they are retried or back off.
+func (r *retrier) heldBack(ctx context.Context, executor *CheckExecutor, check *corev2.CheckConfig, now time.Time) map[string]struct{} {
+	if !retriesEnabled(check) {
+		return nil
+	}
+	states, err := r.states(ctx, executor, check)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"check":     check.Name,
+			"namespace": check.Namespace,
+		}).WithError(err).Error("error fetching check retry states")
+		return nil
+	}
+	held := make(map[string]struct{})
+	for entity, state := range states {
+		if !state.IsDue(now.Unix()) {
+			held[entity] = struct{}{}
+		}
+	}
+	return held
+}
+
+// processCheck processes the check like processCheck, but leaves out the
+// entities on which the check is not due. The check requests are then sent
+// to each subscribed agent entity rather than to the subscriptions.
+func (r *retrier) processCheck(ctx context.Context, executor *CheckExecutor, check *corev2.CheckConfig) error {
+	held := r.heldBack(ctx, executor, check, time.Now())
+	if len(held) == 0 {
+		return processCheck(ctx, executor, check)
+	}
+
+	entities, err := executor.getEntities(ctx)
+	if err != nil {
+		return err
+	}
+	if check.ProxyRequests != nil {
+		matched := withoutEntities(matchEntities(entities, check.ProxyRequests), held)
+		if len(matched) == 0 {
+			return nil
+		}
+		return executor.publishProxyCheckRequests(matched, check)
+	}
+	for _, entity := range subscribedAgents(entities, check.Subscriptions) {
+		if _, ok := held[entity]; ok {
+			continue
+		}
+		if err := executor.executeOnEntity(check, entity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processRoundRobinCheck processes the round robin check like
+// processRoundRobinCheck, but leaves out the entities on which the check is
+// not due.
+func (r *retrier) processRoundRobinCheck(ctx context.Context, executor *CheckExecutor, check *corev2.CheckConfig, proxyEntities []*corev3.EntityConfig, agentEntities []string) error {
+	held := r.heldBack(ctx, executor, check, time.Now())
+	if len(held) == 0 {
+		return processRoundRobinCheck(ctx, executor, check, proxyEntities, agentEntities)
+	}
+
+	if check.ProxyRequests != nil {
+		// The proxy entities are paired with the agent entities
+		var proxies []*corev3.EntityConfig
+		var agents []string
+		for i, entity := range proxyEntities {
+			if _, ok := held[entity.Metadata.Name]; ok || i >= len(agentEntities) {
+				continue
+			}
+			proxies = append(proxies, entity)
+			agents = append(agents, agentEntities[i])
+		}
+		return processRoundRobinCheck(ctx, executor, check, proxies, agents)
+	}
+	agents := make([]string, 0, len(agentEntities))
+	for _, entity := range agentEntities {
+		if _, ok := held[entity]; !ok {
+			agents = append(agents, entity)
+		}
+	}
+	return processRoundRobinCheck(ctx, executor, check, proxyEntities, agents)
+}
+
+// retry sends the check requests of the failing entities whose retry is due.
+// The retries of proxy entities are executed by the given agent entities, or
+// published to the check subscriptions if there are none.
+func (r *retrier) retry(ctx context.Context, executor *CheckExecutor, check *corev2.CheckConfig, agentEntities []string) error {
+	states, err := r.states(ctx, executor, check)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	due := make(map[string]struct{})
+	for entity, state := range states {
+		if !state.Retrying || !state.IsDue(now) || r.retried[entity] == state.NextExecution {
+			continue
+		}
+		due[entity] = struct{}{}
+		r.retried[entity] = state.NextExecution
+	}
+	for entity := range r.retried {
+		if _, ok := states[entity]; !ok {
+			delete(r.retried, entity)
+		}
+	}
+	if len(due) == 0 || check.IsSubdued() {
+		return nil
+	}
+
+	if check.ProxyRequests == nil {
+		for entity := range due {
+			if err := executor.executeOnEntity(check, entity); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	entities, err := executor.getEntities(ctx)
+	if err != nil {
+		return err
+	}
+	var proxies []*corev3.EntityConfig
+	for _, entity := range matchEntities(entities, check.ProxyRequests) {
+		if _, ok := due[entity.Metadata.Name]; ok {
+			proxies = append(proxies, entity)
+		}
+	}
+	if len(proxies) == 0 {
+		return nil
+	}
+	if len(agentEntities) == 0 {
+		return executor.publishProxyCheckRequests(proxies, check)
+	}
+	agents := make([]string, len(proxies))
+	for i := range proxies {
+		agents[i] = agentEntities[i%len(agentEntities)]
+	}
+	return publishRoundRobinProxyCheckRequests(executor, check, proxies, agents)
+}
+
+// withoutEntities returns the entities whose name is not in the given set.
+func withoutEntities(entities []*corev3.EntityConfig, names map[string]struct{}) []*corev3.EntityConfig {
+	kept := make([]*corev3.EntityConfig, 0, len(entities))
+	for _, entity := range entities {
+		if _, ok := names[entity.Metadata.Name]; !ok {
+			kept = append(kept, entity)
+		}
+	}
+	return kept
+}
+
+// subscribedAgents returns the names of the agent entities that have one of
+// the given subscriptions.
+func subscribedAgents(entities []cachev2.Value, subscriptions []string) []string {
+	var agents []string
+	for _, value := range entities {
+		entity, ok := value.Resource.(*corev3.EntityConfig)
+		if !ok || entity.EntityClass != corev2.EntityAgentClass {
+			continue
+		}
+		if len(stringsutil.Intersect(entity.Subscriptions, subscriptions)) > 0 {
+			agents = append(agents, entity.Metadata.Name)
+		}
+	}
+	return agents
+}
@@ -0,0 +1,161 @@
+package schedulerd
+
+import (
+	"context"
+	"testing"
+
+	time "github.com/echlebek/timeproxy"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/secrets"
+	cachev2 "github.com/sensu/sensu-go/backend/store/cache/v2"
+	"github.com/sensu/sensu-go/testing/mockbus"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryTest(states ...*corev2.CheckRetryState) (*retrier, *CheckExecutor, *mockbus.MockBus) {
+	st := &mockstore.MockStore{}
+	st.On("DeleteResource", mock.Anything, corev2.CheckRetryStatesPrefix("check"), mock.Anything).Return(nil)
+	st.On("ListResources", mock.Anything, corev2.CheckRetryStatesPrefix("check"), mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(2).(*[]*corev2.CheckRetryState)
+			*list = states
+		}).Return(nil)
+	st.On("GetAssets", mock.Anything, mock.Anything).Return([]*corev2.Asset{}, nil)
+	st.On("GetHookConfigs", mock.Anything, mock.Anything).Return([]*corev2.HookConfig{}, nil)
+
+	entities := []corev3.Resource{
+		corev3.FixtureEntityConfig("a"),
+		corev3.FixtureEntityConfig("b"),
+		corev3.FixtureEntityConfig("c"),
+	}
+	other := corev3.FixtureEntityConfig("other")
+	other.Subscriptions = []string{"windows"}
+	entities = append(entities, other)
+
+	bus := &mockbus.MockBus{}
+	bus.On("Publish", mock.Anything, mock.AnythingOfType("*v2.CheckRequest")).Return(nil)
+
+	pm := secrets.NewProviderManager(&mockEventReceiver{})
+	executor := NewCheckExecutor(bus, "default", st, cachev2.NewFromResources(entities, true), pm)
+	return newRetrier(st), executor, bus
+}
+
+func retryState(entity string, retrying bool, nextExecution time.Time) *corev2.CheckRetryState {
+	state := corev2.FixtureCheckRetryState(entity, "check", "default")
+	state.Retrying = retrying
+	state.NextExecution = nextExecution.Unix()
+	return state
+}
+
+func retryCheck() *corev2.CheckConfig {
+	check := corev2.FixtureCheckConfig("check")
+	check.Subscriptions = []string{"linux"}
+	check.MaxRetries = 2
+	check.RetryInterval = 10
+	return check
+}
+
+func entityTopic(entity string) string {
+	return messaging.SubscriptionTopic("default", corev2.GetEntitySubscription(entity))
+}
+
+func TestRetrierProcessCheck(t *testing.T) {
+	now := time.Now()
+	r, executor, bus := newRetryTest(
+		retryState("a", true, now.Add(30*time.Second)),
+		retryState("b", false, now.Add(-time.Second)),
+	)
+
+	require.NoError(t, r.processCheck(defaultContext(), executor, retryCheck()))
+
+	// The entity being retried is left out, and the requests are sent to each
+	// subscribed agent entity instead
+	bus.AssertNumberOfCalls(t, "Publish", 2)
+	bus.AssertCalled(t, "Publish", entityTopic("b"), mock.Anything)
+	bus.AssertCalled(t, "Publish", entityTopic("c"), mock.Anything)
+}
+
+func TestRetrierProcessCheckWithoutHeldBackEntities(t *testing.T) {
+	r, executor, bus := newRetryTest(retryState("a", false, time.Now().Add(-time.Second)))
+
+	require.NoError(t, r.processCheck(defaultContext(), executor, retryCheck()))
+
+	bus.AssertNumberOfCalls(t, "Publish", 1)
+	bus.AssertCalled(t, "Publish", messaging.SubscriptionTopic("default", "linux"), mock.Anything)
+}
+
+func TestRetrierProcessRoundRobinCheck(t *testing.T) {
+	r, executor, bus := newRetryTest(retryState("a", true, time.Now().Add(30*time.Second)))
+
+	require.NoError(t, r.processRoundRobinCheck(defaultContext(), executor, retryCheck(), nil, []string{"a"}))
+	bus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+
+	require.NoError(t, r.processRoundRobinCheck(defaultContext(), executor, retryCheck(), nil, []string{"b"}))
+	bus.AssertCalled(t, "Publish", entityTopic("b"), mock.Anything)
+}
+
+func TestRetrierRetry(t *testing.T) {
+	now := time.Now()
+	r, executor, bus := newRetryTest(
+		retryState("a", true, now.Add(-time.Second)),
+		retryState("b", true, now.Add(30*time.Second)),
+		retryState("c", false, now.Add(-time.Second)),
+	)
+
+	// Only the entities whose retry is due are retried
+	require.NoError(t, r.retry(defaultContext(), executor, retryCheck(), nil))
+	bus.AssertNumberOfCalls(t, "Publish", 1)
+	bus.AssertCalled(t, "Publish", entityTopic("a"), mock.Anything)
+
+	// The retry is not sent again until its result updates the state
+	require.NoError(t, r.retry(defaultContext(), executor, retryCheck(), nil))
+	bus.AssertNumberOfCalls(t, "Publish", 1)
+}
+
+func TestRetrierWithoutRetries(t *testing.T) {
+	// The retry states are not read for checks without retries
+	r := newRetrier(&mockstore.MockStore{})
+	check := corev2.FixtureCheckConfig("check")
+	assert.Empty(t, r.heldBack(context.Background(), nil, check, time.Now()))
+}
+
+// defaultContext returns a context for the default namespace.
+func defaultContext() context.Context {
+	return context.WithValue(context.Background(), corev2.NamespaceKey, "default")
+}
+
+func TestRetrierDeletedEntities(t *testing.T) {
+	r, executor, _ := newRetryTest(
+		retryState("a", true, time.Now().Add(30*time.Second)),
+		retryState("deleted", true, time.Now().Add(30*time.Second)),
+	)
+	st := r.store.(*mockstore.MockStore)
+
+	// The state of a missing entity is only deleted if the entity is still
+	// missing the next time, since the entity cache may lag behind
+	held := r.heldBack(defaultContext(), executor, retryCheck(), time.Now())
+	assert.Equal(t, map[string]struct{}{"a": {}}, held)
+	st.AssertNotCalled(t, "DeleteResource", mock.Anything, mock.Anything, mock.Anything)
+
+	r.heldBack(defaultContext(), executor, retryCheck(), time.Now())
+	st.AssertCalled(t, "DeleteResource", mock.Anything, corev2.CheckRetryStatesPrefix("check"), "deleted:check")
+	st.AssertNumberOfCalls(t, "DeleteResource", 1)
+}
+
+func TestDeleteRetryStates(t *testing.T) {
+	st := &mockstore.MockStore{}
+	st.On("ListResources", mock.Anything, corev2.CheckRetryStatesPrefix("check"), mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(2).(*[]*corev2.CheckRetryState)
+			*list = []*corev2.CheckRetryState{retryState("a", true, time.Now())}
+		}).Return(nil)
+	st.On("DeleteResource", mock.Anything, corev2.CheckRetryStatesPrefix("check"), "a:check").Return(nil)
+
+	require.NoError(t, deleteRetryStates(context.Background(), st, retryCheck()))
+	st.AssertExpectations(t)
+}
@@ -46,6 +46,8 @@ type RoundRobinIntervalScheduler struct {
 	mu                     sync.Mutex
 	proxyEntities          []*corev3.EntityConfig
 	stopWg                 sync.WaitGroup
+	retrier                *retrier
+	lastAgents             []string
 }
 
 // NewRoundRobinIntervalScheduler initializes a RoundRobinIntervalScheduler
@@ -66,6 +68,7 @@ func NewRoundRobinIntervalScheduler(ctx context.Context, store store.Store, bus
 		cancels:     make(map[string]ringCancel),
 		executor:    NewCheckExecutor(bus, check.Namespace, store, cache, secretsProviderManager),
 		entityCache: cache,
+		retrier:     newRetrier(store),
 	}
 	sched.ctx, sched.cancel = context.WithCancel(ctx)
 	sched.ctx = corev2.SetContextFromResource(sched.ctx, check)
@@ -183,15 +186,38 @@ func (s *RoundRobinIntervalScheduler) start() {
 
 	entityWatcher := s.entityCache.Watch(s.ctx)
 
+	retryState := retryInterval(s.check)
+	retryTicker := newRetryTicker(s.check)
+	defer func() {
+		if retryTicker != nil {
+			retryTicker.Stop()
+		}
+	}()
+
 	for {
 		select {
 		case <-s.ctx.Done():
 			return
+		case <-retryTickerC(retryTicker):
+			s.mu.Lock()
+			agents := s.lastAgents
+			s.mu.Unlock()
+			if err := s.retrier.retry(s.ctx, s.executor, s.check, agents); err != nil {
+				s.logger.WithError(err).Error("error retrying check")
+			}
 		case check := <-s.interrupt:
 			s.check = check
 			if s.toggleSchedule() {
 				s.updateRings()
 			}
+			if interval := retryInterval(check); interval != retryState {
+				s.logger.Info("retry schedule has changed")
+				retryState = interval
+				if retryTicker != nil {
+					retryTicker.Stop()
+				}
+				retryTicker = newRetryTicker(check)
+			}
 		case <-entityWatcher:
 			if s.check.ProxyRequests != nil {
 				// The set of proxy entities to consider may have changed
@@ -209,7 +235,10 @@ func (s *RoundRobinIntervalScheduler) schedule(executor *CheckExecutor, proxyEnt
 
 	s.logger.Debug("check is not subdued")
 
-	if err := processRoundRobinCheck(s.ctx, executor, s.check, proxyEntities, agentEntities); err != nil {
+	// The agent entities are kept to execute the retries of proxy entities
+	s.lastAgents = agentEntities
+
+	if err := s.retrier.processRoundRobinCheck(s.ctx, executor, s.check, proxyEntities, agentEntities); err != nil {
 		logger.WithError(err).Error("error executing check")
 	}
 }
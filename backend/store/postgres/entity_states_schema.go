@@ -622,3 +622,85 @@ WHERE
 	namespace_id = (SELECT id FROM namespace) AND
 	name = $2;
 `
+
+const pollEntityStateQuery = `
+-- Looks for updates to the entity_states table
+-- since a specified timestamp
+--
+WITH ignored AS (
+	SELECT
+		$3::bigint,
+		$4::text,
+		$5::text,
+		$6::text,
+		$7::text,
+		$8::text,
+		$9::text,
+		$10::text,
+		$11::text,
+		$12::integer,
+		$13::text,
+		$14::text,
+		$15::text,
+		$16::text,
+		$17::text,
+		$18::text,
+		$19::text[],
+		$20::text[],
+		$21::text[],
+		$22::bigint,
+		$23::bigint,
+		$24::bigint,
+		$25::timestamptz,
+		$27::timestamptz
+), network AS (
+	SELECT
+		entities_networks.entity_id AS entity_id,
+		array_agg(entities_networks.name) AS names,
+		array_agg(entities_networks.mac) AS macs,
+		array_agg(entities_networks.addresses) AS addresses
+	FROM entities_networks
+	GROUP BY entities_networks.entity_id
+)
+SELECT
+	namespaces.name,
+	entity_states.name,
+	entity_states.last_seen,
+	entity_states.selectors,
+	entity_states.annotations,
+	entities_systems.hostname,
+	entities_systems.os,
+	entities_systems.platform,
+	entities_systems.platform_family,
+	entities_systems.platform_version,
+	entities_systems.arch,
+	entities_systems.arm_version,
+	entities_systems.libc_type,
+	entities_systems.vm_system,
+	entities_systems.vm_role,
+	entities_systems.cloud_provider,
+	entities_systems.float_type,
+	entities_systems.sensu_agent_version,
+	network.names,
+	network.macs,
+	network.addresses::text[],
+	entity_states.id,
+	entity_states.namespace_id,
+	entity_states.entity_config_id,
+	entity_states.created_at,
+	entity_states.updated_at,
+	entity_states.deleted_at
+FROM entity_states
+LEFT OUTER JOIN namespaces ON entity_states.namespace_id = namespaces.id
+JOIN entities_systems ON entity_states.id = entities_systems.entity_id
+LEFT OUTER JOIN network ON entity_states.id = network.entity_id
+WHERE entity_states.updated_at >= $26
+AND CASE
+		WHEN $1 <> '' THEN namespaces.name = $1
+		ELSE true
+	END
+AND CASE
+		WHEN $2 <> '' THEN entity_states.name = $2
+		ELSE TRUE
+	END
+`
@@ -386,6 +386,63 @@ func newEntityConfigPoller(req storev2.ResourceRequest, pool *pgxpool.Pool) (pol
 	return &entityConfigPoller{db: pool, req: req}, nil
 }
 
+type entityStatePoller struct {
+	db  *pgxpool.Pool
+	req storev2.ResourceRequest
+}
+
+func (e *entityStatePoller) Now(ctx context.Context) (time.Time, error) {
+	var now time.Time
+	row := e.db.QueryRow(ctx, "SELECT NOW();")
+	if err := row.Scan(&now); err != nil {
+		return now, &store.ErrInternal{Message: err.Error()}
+	}
+	return now, nil
+}
+
+func (e *entityStatePoller) Since(ctx context.Context, updatedSince time.Time) ([]poll.Row, error) {
+	wrapper := &EntityStateWrapper{
+		Namespace: e.req.Namespace,
+		Name:      e.req.Name,
+		UpdatedAt: updatedSince,
+	}
+	queryParams := wrapper.SQLParams()
+	rows, rerr := e.db.Query(ctx, pollEntityStateQuery, queryParams...)
+	if rerr != nil {
+		logger.Errorf("entity state since query failed with error %v", rerr)
+		return nil, &store.ErrInternal{Message: rerr.Error()}
+	}
+	defer rows.Close()
+	var since []poll.Row
+	for rows.Next() {
+		// Scan into a new wrapper, the rows keep a reference to it
+		wrapper := &EntityStateWrapper{}
+		if err := rows.Scan(wrapper.SQLParams()...); err != nil {
+			return nil, &store.ErrInternal{Message: err.Error()}
+		}
+		if err := rows.Err(); err != nil {
+			return nil, &store.ErrInternal{Message: err.Error()}
+		}
+		id := fmt.Sprintf("%s/%s", wrapper.Namespace, wrapper.Name)
+		pollResult := poll.Row{
+			Id:        id,
+			Resource:  wrapper,
+			CreatedAt: wrapper.CreatedAt,
+			UpdatedAt: wrapper.UpdatedAt,
+		}
+		if wrapper.DeletedAt.Valid {
+			pollResult.DeletedAt = &wrapper.DeletedAt.Time
+		}
+		since = append(since, pollResult)
+	}
+	return since, nil
+}
+
+func newEntityStatePoller(req storev2.ResourceRequest, pool *pgxpool.Pool) (poll.Table, error) {
+	return &entityStatePoller{db: pool, req: req}, nil
+}
+
 func init() {
 	registerWatchStoreOverride(entityConfigStoreName, newEntityConfigPoller)
+	registerWatchStoreOverride(entityStateStoreName, newEntityStatePoller)
 }
@@ -335,3 +335,10 @@ ALTER TABLE entity_states ADD COLUMN created_at timestamptz NOT NULL DEFAULT NOW
 ALTER TABLE entity_states ADD COLUMN updated_at timestamptz NOT NULL DEFAULT NOW();
 ALTER TABLE entity_states ADD COLUMN deleted_at timestamptz;
 `
+
+// Migration 16
+const addEntityStatesUpdatedAtTrigger = `
+CREATE TRIGGER refresh_entity_states_updated_at BEFORE UPDATE
+	ON entity_states FOR EACH ROW EXECUTE PROCEDURE
+	refresh_updated_at_column();
+`
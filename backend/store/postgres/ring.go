@@ -226,39 +226,54 @@ func (r *Ring) produce(ctx context.Context, sub ringv2.Subscription, ch chan rin
 func (r *Ring) doProduce(ctx context.Context, sub ringv2.Subscription) ringv2.Event {
 	logger := r.logger.WithField("check", sub.Name)
 	logger.Trace("doProduce()")
-	rows, err := r.db.Query(ctx, getRingEntitiesQuery, r.path, sub.Name, sub.Items)
+	values, err := getRingEntities(ctx, r.db, r.path, sub)
 	if err != nil {
 		return ringv2.Event{
 			Type: ringv2.EventError,
 			Err:  err,
 		}
 	}
-	defer rows.Close()
 	event := ringv2.Event{
 		Type:   ringv2.EventTrigger,
-		Values: make([]string, 0, sub.Items),
+		Values: values,
+	}
+	logger.Tracef("%#v\n", event)
+	return event
+}
+
+// querier is implemented by both the connection pool and transactions.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// getRingEntities returns the entities of the ring for the subscription,
+// starting at the pointer of the subscriber. The entities are repeated if
+// the ring holds fewer entities than the subscription asks for.
+func getRingEntities(ctx context.Context, db querier, path string, sub ringv2.Subscription) ([]string, error) {
+	rows, err := db.Query(ctx, getRingEntitiesQuery, path, sub.Name, sub.Items)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
+	values := make([]string, 0, sub.Items)
 	for rows.Next() {
-		logger.Trace("rows.Next()")
 		var entity string
 		if err := rows.Scan(&entity); err != nil {
-			return ringv2.Event{
-				Type: ringv2.EventError,
-				Err:  err,
-			}
+			return nil, err
 		}
-		logger.WithField("entity", entity).Trace("got an entity")
-		event.Values = append(event.Values, entity)
+		values = append(values, entity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
-	if len(event.Values) != 0 && len(event.Values) < sub.Items {
-		more := make([]string, 0, sub.Items-len(event.Values))
-		for i := len(event.Values); i < sub.Items; i++ {
-			more = append(more, event.Values[i%len(event.Values)])
+	if len(values) != 0 && len(values) < sub.Items {
+		more := make([]string, 0, sub.Items-len(values))
+		for i := len(values); i < sub.Items; i++ {
+			more = append(more, values[i%len(values)])
 		}
-		event.Values = append(event.Values, more...)
+		values = append(values, more...)
 	}
-	logger.Tracef("%#v\n", event)
-	return event
+	return values, nil
 }
 
 func (r *Ring) Remove(ctx context.Context, value string) error {
@@ -295,6 +310,42 @@ func (r *Ring) Add(ctx context.Context, value string, keepalive int64) (err erro
 	return nil
 }
 
+// Peek returns the entities the next trigger of the subscription will
+// produce, without advancing the ring. The ring is advanced with the same
+// query as the trigger, in a transaction that is rolled back once the
+// entities have been read.
+func (r *Ring) Peek(ctx context.Context, sub ringv2.Subscription) (values []string, err error) {
+	if err := sub.Validate(); err != nil {
+		return nil, err
+	}
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if txErr := tx.Rollback(context.Background()); txErr != nil && err == nil {
+			err = txErr
+		}
+	}()
+
+	// The subscriber does not exist yet if the check has never been scheduled
+	if _, err := tx.Exec(ctx, insertRingSubscriberQuery, r.path, sub.Name); err != nil {
+		return nil, err
+	}
+	// The interval is negative so the ring is advanced regardless of when it
+	// was last advanced.
+	row := tx.QueryRow(ctx, updateRingSubscribersQuery, r.path, sub.Name, sub.Items-1, "-1 hour")
+	var nextEntity string
+	if err := row.Scan(&nextEntity); err != nil {
+		if err == pgx.ErrNoRows {
+			// the ring is empty
+			return nil, nil
+		}
+		return nil, err
+	}
+	return getRingEntities(ctx, tx, r.path, sub)
+}
+
 func (r *Ring) IsEmpty(ctx context.Context) (bool, error) {
 	r.logger.Trace("ring.IsEmpty()")
 	row := r.db.QueryRow(ctx, getRingLengthQuery, r.path)
@@ -665,6 +665,85 @@ func TestRequestGreaterThanLenItems(t *testing.T) {
 	})
 }
 
+func TestPeek(t *testing.T) {
+	t.Parallel()
+	withPostgres(t, func(ctx context.Context, db *pgxpool.Pool, dsn string) {
+		listener := pq.NewListener(dsn, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+		defer func() { _ = listener.UnlistenAll() }()
+		defer func() { _ = listener.Close() }()
+		bus := NewBus(ctx, listener)
+		ring, err := NewRing(db, bus, ringName(t.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = ring.Close() }()
+
+		namespaceStore := NewNamespaceStore(db, nil)
+		entityStore := NewEntityStore(db, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sub := ringv2.Subscription{
+			Name:             "test",
+			Items:            3,
+			IntervalSchedule: 5,
+		}
+
+		if values, err := ring.Peek(ctx, sub); err != nil {
+			t.Fatal(err)
+		} else if len(values) != 0 {
+			t.Fatalf("bad values for empty ring: got %v", values)
+		}
+
+		items := []string{"byers", "frohike", "mulder", "scully", "skinner"}
+
+		for _, item := range items {
+			entity := corev2.FixtureEntity(item)
+			namespace := corev2.FixtureNamespace(entity.Namespace)
+			if err := namespaceStore.UpdateNamespace(ctx, namespace); err != nil {
+				t.Fatal(err)
+			}
+			if err := entityStore.UpdateEntity(ctx, entity); err != nil {
+				t.Fatal(err)
+			}
+			if err := ring.Add(ctx, item, 600); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		wc := ring.Subscribe(ctx, sub)
+		<-wc
+
+		// Peek returns the values of the next trigger, as many times as it is
+		// called, without advancing the ring
+		for i := 0; i < 3; i++ {
+			peeked, err := ring.Peek(ctx, sub)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := peeked, []string{"scully", "skinner", "byers"}; !reflect.DeepEqual(got, want) {
+				t.Fatalf("bad peeked values: got %v, want %v", got, want)
+			}
+		}
+
+		for i := 0; i < 2; i++ {
+			peeked, err := ring.Peek(ctx, sub)
+			if err != nil {
+				t.Fatal(err)
+			}
+			event := <-wc
+			if !reflect.DeepEqual(peeked, event.Values) {
+				t.Fatalf("bad peeked values (iteration %d): got %v, want %v", i, peeked, event.Values)
+			}
+		}
+	})
+}
+
 func TestChannelNameTooLong(t *testing.T) {
 	t.Parallel()
 	withPostgres(t, func(ctx context.Context, db *pgxpool.Pool, dsn string) {
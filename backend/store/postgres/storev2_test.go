@@ -1166,3 +1166,49 @@ func TestWatchEntityConfig(t *testing.T) {
 		}
 	})
 }
+
+func TestWatchEntityState(t *testing.T) {
+	testWithPostgresStoreV2(t, func(s storev2.Interface) {
+		stor, ok := s.(*StoreV2)
+		if !ok {
+			t.Fatal("expected storev2")
+		}
+		stor.watchInterval = time.Millisecond * 10
+		stor.watchTxnWindow = time.Second
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		watchChannel := stor.Watch(ctx, storev2.NewResourceRequest(ctx, "", "", entityStateStoreName))
+
+		createNamespace(t, s, "default")
+		createEntityConfig(t, s, "foo")
+		createEntityState(t, s, "foo")
+
+		for _, want := range []storev2.WatchActionType{storev2.WatchCreate, storev2.WatchUpdate} {
+			select {
+			case watchEvents, ok := <-watchChannel:
+				if !ok {
+					t.Fatal("unexpected watcher close")
+				}
+				event := watchEvents[0]
+				if watchErr := event.Err; watchErr != nil {
+					t.Fatalf("unexpected watcher error %v", watchErr)
+				}
+				if event.Key.Name != "foo" || event.Key.Namespace != "default" {
+					t.Errorf("expected name 'foo' namespace 'default', got %v, %v", event.Key.Name, event.Key.Namespace)
+				}
+				if event.Type != want {
+					t.Errorf("expected event type (%v), got %v", want, event.Type)
+				}
+				var entityState corev3.EntityState
+				if werr := event.Value.UnwrapInto(&entityState); werr != nil {
+					t.Fatal(werr)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("expected entity state notification but timed out")
+			}
+			// Updating the entity state refreshes its updated_at column
+			createEntityState(t, s, "foo")
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package tracing
+
+import (
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Attribute keys of the spans of the backend
+const (
+	NamespaceKey = attribute.Key("sensu.namespace")
+	EntityKey    = attribute.Key("sensu.entity")
+	CheckKey     = attribute.Key("sensu.check")
+	PipelineKey  = attribute.Key("sensu.pipeline")
+	ResourceKey  = attribute.Key("sensu.resource")
+)
+
+// EventAttributes returns the attributes identifying the event.
+func EventAttributes(event *corev2.Event) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{NamespaceKey.String(event.Namespace)}
+	if event.Entity != nil {
+		attrs = append(attrs, EntityKey.String(event.Entity.Name))
+	}
+	if event.Check != nil {
+		attrs = append(attrs, CheckKey.String(event.Check.Name))
+	}
+	return attrs
+}
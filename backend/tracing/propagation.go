@@ -0,0 +1,142 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	// AnnotationPrefix is the prefix of the annotations holding the trace
+	// context of an event while it crosses the message bus.
+	AnnotationPrefix = "sensu.io/"
+)
+
+// propagator propagates the W3C trace context, the traceparent and tracestate
+// fields.
+var propagator = propagation.TraceContext{}
+
+// ExtractHTTP returns a copy of the context with the trace context of the
+// headers, if any.
+func ExtractHTTP(ctx context.Context, header http.Header) context.Context {
+	return propagator.Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// InjectEvent sets the trace context of the context into the annotations of
+// the event, so the spans of the daemons receiving the event from the message
+// bus are part of the same trace. The annotations are removed if the context
+// holds no trace context.
+//
+// The annotations of the event are replaced rather than modified, since the
+// event may be shared with the subscribers of another topic.
+func InjectEvent(ctx context.Context, event *corev2.Event) {
+	fields := mapCarrier{}
+	propagator.Inject(ctx, fields)
+	if len(fields) == 0 && !hasTraceContext(event) {
+		return
+	}
+	annotations := withoutTraceContext(event.Annotations)
+	for key, value := range fields {
+		annotations[AnnotationPrefix+key] = value
+	}
+	event.Annotations = annotations
+}
+
+// ExtractEvent returns a copy of the context with the trace context of the
+// annotations of the event, if any.
+func ExtractEvent(ctx context.Context, event *corev2.Event) context.Context {
+	fields := mapCarrier{}
+	for _, field := range propagator.Fields() {
+		if value, ok := event.Annotations[AnnotationPrefix+field]; ok {
+			fields[field] = value
+		}
+	}
+	if len(fields) == 0 {
+		return ctx
+	}
+	return propagator.Extract(ctx, fields)
+}
+
+// StripEvent removes the trace context from the annotations of the event, so
+// it is not stored. The annotations of the event are replaced rather than
+// modified.
+func StripEvent(event *corev2.Event) {
+	if hasTraceContext(event) {
+		event.Annotations = withoutTraceContext(event.Annotations)
+	}
+}
+
+// StrippedEvent returns the event without the trace context in its
+// annotations. The event is copied if it has a trace context, since it may be
+// shared with the other subscribers of the message bus.
+func StrippedEvent(event *corev2.Event) *corev2.Event {
+	if !hasTraceContext(event) {
+		return event
+	}
+	stripped := *event
+	stripped.Annotations = withoutTraceContext(event.Annotations)
+	return &stripped
+}
+
+// Environment returns the environment variables holding the trace context of
+// the context, TRACEPARENT and TRACESTATE, for the commands executed in the
+// context of a span.
+func Environment(ctx context.Context) []string {
+	fields := mapCarrier{}
+	propagator.Inject(ctx, fields)
+	env := []string{}
+	for _, field := range propagator.Fields() {
+		if value, ok := fields[field]; ok {
+			env = append(env, fmt.Sprintf("%s=%s", strings.ToUpper(field), value))
+		}
+	}
+	return env
+}
+
+func hasTraceContext(event *corev2.Event) bool {
+	for _, field := range propagator.Fields() {
+		if _, ok := event.Annotations[AnnotationPrefix+field]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutTraceContext returns a copy of the annotations without the trace
+// context.
+func withoutTraceContext(annotations map[string]string) map[string]string {
+	result := make(map[string]string, len(annotations))
+	for key, value := range annotations {
+		result[key] = value
+	}
+	for _, field := range propagator.Fields() {
+		delete(result, AnnotationPrefix+field)
+	}
+	return result
+}
+
+// mapCarrier is a TextMapCarrier backed by a map. The empty fields, such as
+// an empty tracestate, are not set.
+type mapCarrier map[string]string
+
+func (c mapCarrier) Get(key string) string {
+	return c[key]
+}
+
+func (c mapCarrier) Set(key, value string) {
+	if value != "" {
+		c[key] = value
+	}
+}
+
+func (c mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
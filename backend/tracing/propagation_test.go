@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func startSpan(t *testing.T) (context.Context, trace.Span) {
+	t.Helper()
+	provider := sdktrace.NewTracerProvider()
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	return provider.Tracer(TracerName).Start(context.Background(), "test")
+}
+
+func TestEventPropagation(t *testing.T) {
+	ctx, span := startSpan(t)
+	defer span.End()
+
+	event := corev2.FixtureEvent("foo", "check-cpu")
+	annotations := map[string]string{"foo": "bar"}
+	event.Annotations = annotations
+
+	InjectEvent(ctx, event)
+	assert.Contains(t, event.Annotations, AnnotationPrefix+"traceparent")
+	assert.Equal(t, "bar", event.Annotations["foo"])
+	// The annotations shared with the other subscribers are left untouched
+	assert.Equal(t, map[string]string{"foo": "bar"}, annotations)
+
+	extracted := trace.SpanContextFromContext(ExtractEvent(context.Background(), event))
+	assert.True(t, extracted.IsRemote())
+	assert.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+
+	shared := event.Annotations
+	StripEvent(event)
+	assert.Equal(t, map[string]string{"foo": "bar"}, event.Annotations)
+	assert.Contains(t, shared, AnnotationPrefix+"traceparent")
+}
+
+func TestInjectEventWithoutTraceContext(t *testing.T) {
+	ctx, span := startSpan(t)
+	defer span.End()
+
+	event := corev2.FixtureEvent("foo", "check-cpu")
+	InjectEvent(ctx, event)
+	require.Contains(t, event.Annotations, AnnotationPrefix+"traceparent")
+
+	// The trace context of a previous publication is removed
+	InjectEvent(context.Background(), event)
+	assert.NotContains(t, event.Annotations, AnnotationPrefix+"traceparent")
+
+	// The context is unchanged when the event has no trace context
+	ctx = context.Background()
+	assert.Equal(t, ctx, ExtractEvent(ctx, event))
+}
+
+func TestEnvironment(t *testing.T) {
+	assert.Empty(t, Environment(context.Background()))
+
+	ctx, span := startSpan(t)
+	defer span.End()
+
+	env := Environment(ctx)
+	require.Len(t, env, 1)
+	assert.True(t, strings.HasPrefix(env[0], "TRACEPARENT=00-"+span.SpanContext().TraceID().String()))
+}
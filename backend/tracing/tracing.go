@@ -0,0 +1,140 @@
+// Package tracing provides the OpenTelemetry tracing of the backend. Spans are
+// exported with OTLP, and the trace context of an event is propagated across
+// the message bus with the annotations of the event.
+package tracing
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// TracerName is the name of the tracer of the backend.
+	TracerName = "github.com/sensu/sensu-go/backend"
+
+	// ServiceName is the name of the backend in the traces.
+	ServiceName = "sensu-backend"
+
+	// DefaultSampleRatio is the default ratio of the sampled traces.
+	DefaultSampleRatio = 1.0
+)
+
+// Config configures the export of the spans.
+type Config struct {
+	// Endpoint is the address of the OTLP gRPC receiver the spans are exported
+	// to, in the host:port form.
+	Endpoint string
+
+	// Insecure disables the transport security of the connection to the
+	// endpoint.
+	Insecure bool
+
+	// SampleRatio is the ratio of the traces started by the backend that are
+	// sampled, between 0 and 1. The traces whose parent is sampled, such as
+	// the traces of the API requests with a traceparent header, are always
+	// sampled.
+	SampleRatio float64
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.Endpoint == "" {
+		return errors.New("the tracing endpoint is required")
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("the tracing sample ratio must be between 0 and 1, got %v", c.SampleRatio)
+	}
+	return nil
+}
+
+// Tracer returns the tracer of the backend. Its spans are not recorded unless
+// an Exporter is started.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// Exporter is the daemon that exports the spans of the backend.
+type Exporter struct {
+	config   Config
+	provider *sdktrace.TracerProvider
+	errChan  chan error
+}
+
+// NewExporter returns a new exporter of the spans of the backend.
+func NewExporter(config Config) (*Exporter, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &Exporter{
+		config:  config,
+		errChan: make(chan error, 1),
+	}, nil
+}
+
+// Start connects to the OTLP receiver and installs the tracer provider of
+// the backend.
+func (e *Exporter) Start() error {
+	options := []otlpgrpc.Option{otlpgrpc.WithEndpoint(e.config.Endpoint)}
+	if e.config.Insecure {
+		options = append(options, otlpgrpc.WithInsecure())
+	} else {
+		options = append(options, otlpgrpc.WithTLSCredentials(credentials.NewTLS(&tls.Config{})))
+	}
+	exporter, err := otlp.NewExporter(context.Background(), otlpgrpc.NewDriver(options...))
+	if err != nil {
+		return fmt.Errorf("could not start the tracing exporter: %v", err)
+	}
+
+	e.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(e.config.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.ServiceNameKey.String(ServiceName),
+			semconv.ServiceVersionKey.String(version.Semver()),
+		)),
+	)
+	otel.SetTracerProvider(e.provider)
+	logger.WithField("endpoint", e.config.Endpoint).Info("exporting traces")
+	return nil
+}
+
+// Stop flushes the spans and disconnects from the OTLP receiver.
+func (e *Exporter) Stop() error {
+	if e.provider == nil {
+		return nil
+	}
+	otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	return e.provider.Shutdown(context.Background())
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (e *Exporter) Err() <-chan error {
+	return e.errChan
+}
+
+// Name returns the daemon name.
+func (e *Exporter) Name() string {
+	return "tracing"
+}
+
+// EndSpan records the error, if any, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
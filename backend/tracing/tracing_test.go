@@ -0,0 +1,26 @@
+package tracing
+
+import (
+	"testing"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "valid", config: Config{Endpoint: "localhost:4317", SampleRatio: DefaultSampleRatio}},
+		{name: "no sampling", config: Config{Endpoint: "localhost:4317"}},
+		{name: "missing endpoint", config: Config{SampleRatio: 0.5}, wantErr: true},
+		{name: "negative ratio", config: Config{Endpoint: "localhost:4317", SampleRatio: -0.1}, wantErr: true},
+		{name: "ratio above one", config: Config{Endpoint: "localhost:4317", SampleRatio: 1.5}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Config.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// AccessReviewPath is the api path for access reviews.
+var AccessReviewPath = CreateBasePath(coreAPIGroup, coreAPIVersion, corev2.AccessReviewResource)
+
+// PermissionsPath is the api path for effective permissions.
+var PermissionsPath = CreateBasePath(coreAPIGroup, coreAPIVersion, corev2.PermissionsResource)
+
+// ReviewAccess determines whether the request described by the review is
+// allowed. The review is performed for the current user, unless a user or
+// groups are given.
+func (client *RestClient) ReviewAccess(review *corev2.AccessReview) (*corev2.AccessReview, error) {
+	params := subjectParams(review.User, review.Groups)
+	params.Set("verb", review.Verb)
+	params.Set("resource", review.Resource)
+	if review.ResourceName != "" {
+		params.Set("name", review.ResourceName)
+	}
+	if review.Namespace != "" {
+		params.Set("namespace", review.Namespace)
+	}
+	for key, value := range review.Labels {
+		params.Add("label", key+"="+value)
+	}
+
+	res, err := client.R().SetQueryParamsFromValues(params).Get(AccessReviewPath())
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+
+	result := &corev2.AccessReview{}
+	return result, json.Unmarshal(res.Body(), result)
+}
+
+// FetchPermissions returns the effective permissions of the current user, or of
+// the given user and groups, optionally limited to a namespace.
+func (client *RestClient) FetchPermissions(user string, groups []string, namespace string) (*corev2.EffectivePermissions, error) {
+	params := subjectParams(user, groups)
+	if namespace != "" {
+		params.Set("namespace", namespace)
+	}
+
+	res, err := client.R().SetQueryParamsFromValues(params).Get(PermissionsPath())
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+
+	result := &corev2.EffectivePermissions{}
+	return result, json.Unmarshal(res.Body(), result)
+}
+
+func subjectParams(user string, groups []string) url.Values {
+	params := url.Values{}
+	if user != "" {
+		params.Set("user", user)
+	}
+	for _, group := range groups {
+		params.Add("group", group)
+	}
+	return params
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 )
@@ -94,6 +95,24 @@ func (client *RestClient) FetchCheck(name string) (*corev2.CheckConfig, error) {
 	return check, err
 }
 
+// FetchCheckSchedule fetches a preview of the next executions of a check
+func (client *RestClient) FetchCheckSchedule(name string, executions int) (*corev2.CheckSchedule, error) {
+	var schedule *corev2.CheckSchedule
+
+	path := ChecksPath(client.config.Namespace(), name, "schedule")
+	res, err := client.R().SetQueryParam("executions", strconv.Itoa(executions)).Get(path)
+	if err != nil {
+		return nil, fmt.Errorf("GET %q: %s", path, err)
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &schedule)
+	return schedule, err
+}
+
 // AddCheckHook associates an existing hook with an existing check
 func (client *RestClient) AddCheckHook(check *corev2.CheckConfig, checkHook *corev2.HookList) error {
 	path := ChecksPath(check.Namespace, check.Name, "hooks", checkHook.Type)
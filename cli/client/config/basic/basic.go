@@ -27,7 +27,12 @@ var logger = logrus.WithFields(logrus.Fields{
 type Config struct {
 	Cluster
 	Profile
-	path string
+
+	// root is the configuration directory, path is the directory of the
+	// active context within it
+	root    string
+	path    string
+	context string
 }
 
 // Cluster contains the Sensu cluster access information
@@ -70,18 +75,31 @@ func Load(flags *pflag.FlagSet, v *viper.Viper) *Config {
 		_ = flags.Parse(os.Args[1:])
 
 		if value := v.GetString("config-dir"); value != "" {
-			conf.path = value
+			conf.root = value
 		}
 	}
 
-	// Load the profile config file
-	if err := conf.open(profileFilename); err != nil {
-		logger.Debug(err)
+	// Select the context, from the flags or the current context file
+	conf.context = conf.currentContext()
+	if v != nil {
+		if value := v.GetString("context"); value != "" {
+			conf.context = value
+		}
 	}
+	// An invalid context name is reported by ValidateContext, no
+	// configuration file is loaded for it
+	if err := validateContextName(conf.context); err == nil {
+		conf.path = contextPath(conf.root, conf.context)
+
+		// Load the profile config file
+		if err := conf.open(profileFilename); err != nil {
+			logger.Debug(err)
+		}
 
-	// Load the cluster config file
-	if err := conf.open(clusterFilename); err != nil {
-		logger.Debug(err)
+		// Load the cluster config file
+		if err := conf.open(clusterFilename); err != nil {
+			logger.Debug(err)
+		}
 	}
 
 	if v != nil {
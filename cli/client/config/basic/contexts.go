@@ -0,0 +1,170 @@
+package basic
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli/client/config"
+)
+
+const (
+	contextsDirname        = "contexts"
+	currentContextFilename = "current-context"
+)
+
+var contextNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// validateContextName ensures the context name can safely be used as a
+// directory name
+func validateContextName(name string) error {
+	if !contextNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid context name %q: must only contain letters, digits, '_', '.' and '-'", name)
+	}
+	return nil
+}
+
+// contextPath returns the directory of the context. The default context lives
+// at the root of the configuration directory, so configurations predating
+// contexts keep working.
+func contextPath(root, name string) string {
+	if name == config.DefaultContext {
+		return root
+	}
+	return filepath.Join(root, contextsDirname, name)
+}
+
+// currentContext returns the context saved as the current one
+func (c *Config) currentContext() string {
+	content, err := ioutil.ReadFile(filepath.Join(c.root, currentContextFilename))
+	if err != nil {
+		return config.DefaultContext
+	}
+	if name := strings.TrimSpace(string(content)); name != "" {
+		return name
+	}
+	return config.DefaultContext
+}
+
+// contextExists returns whether the context has been configured
+func (c *Config) contextExists(name string) bool {
+	if name == config.DefaultContext {
+		return true
+	}
+	info, err := os.Stat(contextPath(c.root, name))
+	return err == nil && info.IsDir()
+}
+
+// ValidateContext returns an error if the name of the active context is
+// invalid, or if the context does not exist and may not be created. The error
+// lists the available contexts.
+func (c *Config) ValidateContext(create bool) error {
+	name := c.Context()
+	if err := validateContextName(name); err != nil {
+		return err
+	}
+	if create || c.contextExists(name) {
+		return nil
+	}
+	contexts, err := c.Contexts()
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(contexts))
+	for _, context := range contexts {
+		names = append(names, context.Name)
+	}
+	return fmt.Errorf("context %q does not exist, available contexts: %s", name, strings.Join(names, ", "))
+}
+
+// Context returns the name of the active context
+func (c *Config) Context() string {
+	if c.context == "" {
+		return config.DefaultContext
+	}
+	return c.context
+}
+
+// Contexts returns the default context and the named contexts, sorted by name
+func (c *Config) Contexts() ([]config.Context, error) {
+	names := []string{config.DefaultContext}
+	entries, err := ioutil.ReadDir(filepath.Join(c.root, contextsDirname))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && validateContextName(entry.Name()) == nil && entry.Name() != config.DefaultContext {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	current := c.currentContext()
+	contexts := make([]config.Context, 0, len(names))
+	for _, name := range names {
+		ctx := &Config{root: c.root, path: contextPath(c.root, name), context: name}
+		if err := ctx.open(profileFilename); err != nil {
+			logger.Debug(err)
+		}
+		if err := ctx.open(clusterFilename); err != nil {
+			logger.Debug(err)
+		}
+		contexts = append(contexts, config.Context{
+			Name:      name,
+			Current:   name == current,
+			APIUrl:    ctx.APIUrl(),
+			Namespace: ctx.Namespace(),
+			Format:    ctx.Format(),
+		})
+	}
+	return contexts, nil
+}
+
+// UseContext saves the context as the current one. Contexts are created by
+// configuring them with sensuctl configure --context.
+func (c *Config) UseContext(name string) error {
+	if err := validateContextName(name); err != nil {
+		return err
+	}
+	if !c.contextExists(name) {
+		return fmt.Errorf("context %q does not exist, create it with: sensuctl configure --context %s", name, name)
+	}
+	if err := os.MkdirAll(c.root, os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(c.root, currentContextFilename), []byte(name+"\n"), 0644)
+}
+
+// RenameContext renames a named context, and keeps it current if it was
+func (c *Config) RenameContext(old, new string) error {
+	for _, name := range []string{old, new} {
+		if err := validateContextName(name); err != nil {
+			return err
+		}
+		if name == config.DefaultContext {
+			return errors.New("the default context can't be renamed")
+		}
+	}
+	if !c.contextExists(old) {
+		return fmt.Errorf("context %q does not exist", old)
+	}
+	if c.contextExists(new) {
+		return fmt.Errorf("context %q already exists", new)
+	}
+	if err := os.Rename(contextPath(c.root, old), contextPath(c.root, new)); err != nil {
+		return err
+	}
+	if c.context == old {
+		c.context = new
+		c.path = contextPath(c.root, new)
+	}
+	if c.currentContext() == old {
+		return c.UseContext(new)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package basic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli/client/config"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadContext(t *testing.T, dir, context string) *Config {
+	t.Helper()
+	flags := pflag.NewFlagSet("config-dir", pflag.ContinueOnError)
+	flags.String("config-dir", dir, "")
+	flags.String("context", context, "")
+	v := viper.New()
+	_ = v.BindPFlags(flags)
+	return Load(flags, v)
+}
+
+func TestContexts(t *testing.T) {
+	dir := t.TempDir()
+
+	// The default context lives at the root of the configuration directory
+	conf := loadContext(t, dir, "")
+	assert.Equal(t, config.DefaultContext, conf.Context())
+	require.NoError(t, conf.SaveAPIUrl("http://default:8080"))
+	assert.FileExists(t, filepath.Join(dir, clusterFilename))
+
+	// Named contexts are created when configured
+	assert.Error(t, conf.UseContext("prod"))
+	prod := loadContext(t, dir, "prod")
+	assert.Equal(t, "prod", prod.Context())
+	assert.Empty(t, prod.APIUrl())
+	require.NoError(t, prod.SaveAPIUrl("https://prod:8080"))
+	require.NoError(t, prod.SaveNamespace("ops"))
+	assert.FileExists(t, filepath.Join(dir, contextsDirname, "prod", clusterFilename))
+
+	// Switching the current context
+	require.NoError(t, conf.UseContext("prod"))
+	conf = loadContext(t, dir, "")
+	assert.Equal(t, "prod", conf.Context())
+	assert.Equal(t, "https://prod:8080", conf.APIUrl())
+	assert.Equal(t, "ops", conf.Namespace())
+
+	// The context flag overrides the current context
+	assert.Equal(t, "http://default:8080", loadContext(t, dir, config.DefaultContext).APIUrl())
+
+	contexts, err := conf.Contexts()
+	require.NoError(t, err)
+	assert.Equal(t, []config.Context{
+		{Name: "default", APIUrl: "http://default:8080", Namespace: "default", Format: "tabular"},
+		{Name: "prod", Current: true, APIUrl: "https://prod:8080", Namespace: "ops", Format: "tabular"},
+	}, contexts)
+}
+
+func TestRenameContext(t *testing.T) {
+	dir := t.TempDir()
+	prod := loadContext(t, dir, "prod")
+	require.NoError(t, prod.SaveAPIUrl("https://prod:8080"))
+	require.NoError(t, prod.UseContext("prod"))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, contextsDirname, "staging"), os.ModePerm))
+
+	assert.Error(t, prod.RenameContext("prod", config.DefaultContext))
+	assert.Error(t, prod.RenameContext(config.DefaultContext, "foo"))
+	assert.Error(t, prod.RenameContext("prod", "staging"))
+	assert.Error(t, prod.RenameContext("qa", "foo"))
+	assert.Error(t, prod.RenameContext("prod", "../foo"))
+
+	require.NoError(t, prod.RenameContext("prod", "production"))
+	assert.Equal(t, "production", prod.Context())
+	current, err := ioutil.ReadFile(filepath.Join(dir, currentContextFilename))
+	require.NoError(t, err)
+	assert.Equal(t, "production\n", string(current))
+
+	conf := loadContext(t, dir, "")
+	assert.Equal(t, "production", conf.Context())
+	assert.Equal(t, "https://prod:8080", conf.APIUrl())
+}
+
+func TestInvalidContext(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, clusterFilename), []byte(`{"api-url":"http://default:8080"}`), 0644))
+
+	// Invalid contexts are not replaced by the default one
+	conf := loadContext(t, dir, "../etc")
+	assert.Equal(t, "../etc", conf.Context())
+	assert.Empty(t, conf.APIUrl())
+	assert.Error(t, conf.ValidateContext(false))
+	assert.Error(t, conf.ValidateContext(true))
+	assert.Error(t, conf.UseContext("../etc"))
+}
+
+func TestValidateContext(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, contextsDirname, "prod"), os.ModePerm))
+
+	assert.NoError(t, loadContext(t, dir, "").ValidateContext(false))
+	assert.NoError(t, loadContext(t, dir, "prod").ValidateContext(false))
+
+	// Unknown contexts may only be created
+	conf := loadContext(t, dir, "staging")
+	assert.NoError(t, conf.ValidateContext(true))
+	err := conf.ValidateContext(false)
+	require.Error(t, err)
+	assert.Equal(t, `context "staging" does not exist, available contexts: default, prod`, err.Error())
+}
@@ -7,6 +7,9 @@ import (
 )
 
 const (
+	// DefaultContext is the name of the context used when none is selected
+	DefaultContext = "default"
+
 	// DefaultNamespace represents the default namespace
 	DefaultNamespace = "default"
 
@@ -28,12 +31,25 @@ const (
 	// FormatYAML indicates YAML format for printers. It has the same layout
 	// as wrapped JSON.
 	FormatYAML = "yaml"
+
+	// FormatCSV indicates CSV format for printers, optionally followed by
+	// the columns to print, e.g. csv=metadata.name,interval.
+	FormatCSV = "csv"
+
+	// FormatJSONPath indicates JSONPath format for printers, followed by
+	// the template, e.g. jsonpath={.metadata.name}.
+	FormatJSONPath = "jsonpath"
+
+	// FormatTemplate indicates Go template format for printers, followed by
+	// the template, e.g. template={{.metadata.name}}.
+	FormatTemplate = "template"
 )
 
 // Config is an abstract configuration
 type Config interface {
 	Read
 	Write
+	Contexts
 }
 
 // Read contains all methods related to reading configuration
@@ -58,3 +74,28 @@ type Write interface {
 	SaveTrustedCAFile(string) error
 	SaveTimeout(time.Duration) error
 }
+
+// Contexts contains all methods related to named contexts, each context
+// carrying its own cluster access information and profile
+type Contexts interface {
+	// Context returns the name of the active context
+	Context() string
+	// ValidateContext returns an error if the name of the active context is
+	// invalid, or if the context does not exist and may not be created
+	ValidateContext(create bool) error
+	// Contexts returns all the contexts
+	Contexts() ([]Context, error)
+	// UseContext makes the context the current one
+	UseContext(string) error
+	// RenameContext renames a context
+	RenameContext(old, new string) error
+}
+
+// Context describes a named context
+type Context struct {
+	Name      string `json:"name" yaml:"name"`
+	Current   bool   `json:"current" yaml:"current"`
+	APIUrl    string `json:"api_url" yaml:"api_url"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	Format    string `json:"format" yaml:"format"`
+}
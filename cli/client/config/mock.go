@@ -104,3 +104,33 @@ func (m *MockConfig) APIKey() string {
 	args := m.Called()
 	return args.String(0)
 }
+
+// Context mocks the active context
+func (m *MockConfig) Context() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+// ValidateContext mocks validating the active context
+func (m *MockConfig) ValidateContext(create bool) error {
+	args := m.Called(create)
+	return args.Error(0)
+}
+
+// Contexts mocks listing the contexts
+func (m *MockConfig) Contexts() ([]Context, error) {
+	args := m.Called()
+	return args.Get(0).([]Context), args.Error(1)
+}
+
+// UseContext mocks switching the current context
+func (m *MockConfig) UseContext(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// RenameContext mocks renaming a context
+func (m *MockConfig) RenameContext(old, new string) error {
+	args := m.Called(old, new)
+	return args.Error(0)
+}
@@ -1,7 +1,13 @@
 package client
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
 	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -61,3 +67,80 @@ func (client *RestClient) ResolveEvent(event *corev2.Event) error {
 	event.Timestamp = event.Check.Executed
 	return client.UpdateEvent(event)
 }
+
+// WatchEvents streams the events of the namespace, or of all namespaces if the
+// namespace is empty, to fn until the stream is ended by the server or the
+// context is done. fn also receives the number of events the server dropped
+// since the previous event, because the client could not keep up.
+func (client *RestClient) WatchEvents(ctx context.Context, namespace string, options *ListOptions, fn func(event *corev2.Event, dropped uint64)) error {
+	request := client.R().SetContext(ctx).SetDoNotParseResponse(true)
+	request.SetQueryParam("watch", "true")
+	if options != nil {
+		ApplyListOptions(request, options)
+	}
+	res, err := request.Get(EventsPath(namespace))
+	if err != nil {
+		return err
+	}
+	body := res.RawBody()
+	defer body.Close()
+
+	if res.StatusCode() >= 400 {
+		return unmarshalStreamError(res.Status(), body)
+	}
+
+	// Events are streamed as server-sent events, with a type and JSON data
+	var eventType string
+	var dropped uint64
+	reader := bufio.NewReader(body)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// The stream ends when the server or the client times out, or
+			// when the context is done
+			return nil
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			eventType = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data := []byte(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+			switch eventType {
+			case "dropped":
+				var payload struct {
+					Count uint64 `json:"count"`
+				}
+				if err := json.Unmarshal(data, &payload); err != nil {
+					return err
+				}
+				dropped += payload.Count
+			case "event":
+				var event corev2.Event
+				if err := json.Unmarshal(data, &event); err != nil {
+					return err
+				}
+				fn(&event, dropped)
+				dropped = 0
+			}
+		case line == "":
+			eventType = ""
+		}
+	}
+}
+
+func unmarshalStreamError(status string, body io.Reader) error {
+	var apiErr APIError
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, &apiErr); err != nil {
+		if len(b) > 0 {
+			apiErr.Message = string(b)
+		} else {
+			apiErr.Message = fmt.Sprintf("the API returned: %s", status)
+		}
+	}
+	return apiErr
+}
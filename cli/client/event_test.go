@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli/client/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchEvents(t *testing.T) {
+	testHandler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/core/v2/namespaces/default/events", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("watch"))
+		assert.Equal(t, `event.check.status != "0"`, r.URL.Query().Get("fieldSelector"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("event: event\ndata: {\"check\":{\"metadata\":{\"name\":\"check-cpu\"}}}\n\n"))
+		_, _ = w.Write([]byte("event: dropped\ndata: {\"count\":3}\n\n"))
+		_, _ = w.Write([]byte("event: event\ndata: {\"check\":{\"metadata\":{\"name\":\"check-mem\"}}}\n\n"))
+	}
+	server := httptest.NewServer(http.HandlerFunc(testHandler))
+	defer server.Close()
+
+	mockConfig := &config.MockConfig{}
+	client := &RestClient{resty: resty.New(), config: mockConfig}
+	mockConfig.On("APIUrl").Return(server.URL)
+	mockConfig.On("Tokens").Return(&corev2.Tokens{Access: "foo"})
+	mockConfig.On("APIKey").Return("")
+
+	var checks []string
+	var dropped []uint64
+	err := client.WatchEvents(context.Background(), "default", &ListOptions{FieldSelector: `event.check.status != "0"`}, func(event *corev2.Event, n uint64) {
+		checks = append(checks, event.Check.Name)
+		dropped = append(dropped, n)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"check-cpu", "check-mem"}, checks)
+	assert.Equal(t, []uint64{0, 3}, dropped)
+}
+
+func TestWatchEventsError(t *testing.T) {
+	testHandler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"invalid field selector","code":1}`))
+	}
+	server := httptest.NewServer(http.HandlerFunc(testHandler))
+	defer server.Close()
+
+	mockConfig := &config.MockConfig{}
+	client := &RestClient{resty: resty.New(), config: mockConfig}
+	mockConfig.On("APIUrl").Return(server.URL)
+	mockConfig.On("Tokens").Return(&corev2.Tokens{Access: "foo"})
+	mockConfig.On("APIKey").Return("")
+
+	err := client.WatchEvents(context.Background(), "default", nil, func(*corev2.Event, uint64) {})
+	require.Error(t, err)
+	assert.Equal(t, "invalid field selector", err.(APIError).Message)
+}
@@ -166,6 +166,31 @@ func (client *RestClient) Put(path string, obj interface{}) error {
 
 // PutResource ...
 func (client *RestClient) PutResource(r types.Wrapper) error {
+	_, err := client.putResource(r, false)
+	return err
+}
+
+// PutResourceDryRun validates the resource against the API, without storing
+// it, and returns the resource as the API would store it.
+func (client *RestClient) PutResourceDryRun(r types.Wrapper) (*types.Wrapper, error) {
+	res, err := client.putResource(r, true)
+	if err != nil {
+		return nil, err
+	}
+	body := res.Body()
+	if len(body) == 0 || string(body) == "null" {
+		return &r, nil
+	}
+	value := reflect.New(reflect.TypeOf(r.Value).Elem()).Interface()
+	if err := json.Unmarshal(body, value); err != nil {
+		return nil, fmt.Errorf("error decoding the validated resource: %s", err)
+	}
+	validated := r
+	validated.Value = value
+	return &validated, nil
+}
+
+func (client *RestClient) putResource(r types.Wrapper, dryRun bool) (*resty.Response, error) {
 	var path string
 	switch value := r.Value.(type) {
 	case corev2.Resource:
@@ -184,15 +209,19 @@ func (client *RestClient) PutResource(r types.Wrapper) error {
 		bytes, err = json.Marshal(r)
 	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	res, err := client.R().SetBody(bytes).Put(path)
+	request := client.R().SetBody(bytes)
+	if dryRun {
+		request.SetQueryParam("dryRun", "true")
+	}
+	res, err := request.Put(path)
 	if err != nil {
-		return fmt.Errorf("PUT %q: %s", path, err)
+		return nil, fmt.Errorf("PUT %q: %s", path, err)
 	}
 	if res.StatusCode() >= 400 {
-		return UnmarshalError(res)
+		return nil, UnmarshalError(res)
 	}
-	return nil
+	return res, nil
 }
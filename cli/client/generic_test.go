@@ -0,0 +1,40 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli/client/config"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutResourceDryRun(t *testing.T) {
+	testHandler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/core/v2/namespaces/default/checks/check-cpu", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("dryRun"))
+
+		// The API returns the resource as it would store it
+		_, _ = w.Write([]byte(`{"metadata":{"name":"check-cpu","namespace":"default","created_by":"admin"},"command":"true","interval":60}`))
+	}
+	server := httptest.NewServer(http.HandlerFunc(testHandler))
+	defer server.Close()
+
+	mockConfig := &config.MockConfig{}
+	client := &RestClient{resty: resty.New(), config: mockConfig}
+	mockConfig.On("APIUrl").Return(server.URL)
+	mockConfig.On("Tokens").Return(&corev2.Tokens{Access: "foo"})
+	mockConfig.On("APIKey").Return("")
+
+	check := corev2.FixtureCheckConfig("check-cpu")
+	validated, err := client.PutResourceDryRun(types.WrapResource(check))
+	require.NoError(t, err)
+	require.IsType(t, &corev2.CheckConfig{}, validated.Value)
+	assert.Equal(t, "admin", validated.Value.(*corev2.CheckConfig).CreatedBy)
+	assert.Empty(t, check.CreatedBy)
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/go-resty/resty/v2"
@@ -26,6 +27,7 @@ type ListOptions struct {
 
 // APIClient client methods across the Sensu API
 type APIClient interface {
+	AccessReviewAPIClient
 	APIKeyClient
 	AuthenticationAPIClient
 	AssetAPIClient
@@ -50,6 +52,12 @@ type APIClient interface {
 	LicenseClient
 }
 
+// AccessReviewAPIClient client methods for reviewing permissions
+type AccessReviewAPIClient interface {
+	ReviewAccess(*corev2.AccessReview) (*corev2.AccessReview, error)
+	FetchPermissions(user string, groups []string, namespace string) (*corev2.EffectivePermissions, error)
+}
+
 // APIKeyClient exposes client methods for api keys.
 type APIKeyClient interface {
 	// PostAPIKey creates an api key and returns the location header.
@@ -73,6 +81,9 @@ type GenericClient interface {
 
 	// PutResource puts a resource according to its URIPath.
 	PutResource(types.Wrapper) error
+	// PutResourceDryRun validates a resource against the API, without
+	// storing it, and returns the validated resource.
+	PutResourceDryRun(types.Wrapper) (*types.Wrapper, error)
 }
 
 // AuthenticationAPIClient client methods for authenticating
@@ -96,6 +107,7 @@ type CheckAPIClient interface {
 	DeleteCheck(string, string) error
 	ExecuteCheck(*corev2.AdhocRequest) error
 	FetchCheck(string) (*corev2.CheckConfig, error)
+	FetchCheckSchedule(string, int) (*corev2.CheckSchedule, error)
 	UpdateCheck(*corev2.CheckConfig) error
 
 	AddCheckHook(check *corev2.CheckConfig, checkHook *corev2.HookList) error
@@ -140,6 +152,7 @@ type EventAPIClient interface {
 	DeleteEvent(namespace, entity, check string) error
 	UpdateEvent(*corev2.Event) error
 	ResolveEvent(*corev2.Event) error
+	WatchEvents(ctx context.Context, namespace string, options *ListOptions, fn func(event *corev2.Event, dropped uint64)) error
 }
 
 // HandlerAPIClient client methods for handlers
@@ -177,6 +190,7 @@ type NamespaceAPIClient interface {
 	UpdateNamespace(*corev2.Namespace) error
 	DeleteNamespace(string) error
 	FetchNamespace(string) (*corev2.Namespace, error)
+	CloneNamespace(string, *corev2.NamespaceClone) (*corev2.NamespaceCloneResult, error)
 }
 
 // PipelineAPIClient client methods for pipelines
@@ -195,8 +209,8 @@ type UserAPIClient interface {
 	RemoveGroupFromUser(string, string) error
 	RemoveAllGroupsFromUser(string) error
 	SetGroupsForUser(string, []string) error
-	UpdatePassword(username, newPasswordHash, currentPassword string) error
-	ResetPassword(username, passwordHash string) error
+	UpdatePassword(username, newPassword, currentPassword string) error
+	ResetPassword(username, newPassword string) error
 }
 
 // RoleAPIClient client methods for roles
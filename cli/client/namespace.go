@@ -72,3 +72,25 @@ func (client *RestClient) FetchNamespace(namespaceName string) (*corev2.Namespac
 	err = json.Unmarshal(res.Body(), &namespace)
 	return namespace, err
 }
+
+// CloneNamespace copies the resources of a namespace to another namespace
+func (client *RestClient) CloneNamespace(source string, clone *corev2.NamespaceClone) (*corev2.NamespaceCloneResult, error) {
+	bytes, err := json.Marshal(clone)
+	if err != nil {
+		return nil, err
+	}
+
+	path := NamespacesPath(source, "clone")
+	res, err := client.R().SetBody(bytes).Post(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+
+	var result *corev2.NamespaceCloneResult
+	err = json.Unmarshal(res.Body(), &result)
+	return result, err
+}
@@ -0,0 +1,15 @@
+package testing
+
+import corev2 "github.com/sensu/sensu-go/api/core/v2"
+
+// ReviewAccess ...
+func (c *MockClient) ReviewAccess(review *corev2.AccessReview) (*corev2.AccessReview, error) {
+	args := c.Called(review)
+	return args.Get(0).(*corev2.AccessReview), args.Error(1)
+}
+
+// FetchPermissions ...
+func (c *MockClient) FetchPermissions(user string, groups []string, namespace string) (*corev2.EffectivePermissions, error) {
+	args := c.Called(user, groups, namespace)
+	return args.Get(0).(*corev2.EffectivePermissions), args.Error(1)
+}
@@ -34,6 +34,12 @@ func (c *MockClient) FetchCheck(name string) (*corev2.CheckConfig, error) {
 	return args.Get(0).(*corev2.CheckConfig), args.Error(1)
 }
 
+// FetchCheckSchedule for use with mock lib
+func (c *MockClient) FetchCheckSchedule(name string, executions int) (*corev2.CheckSchedule, error) {
+	args := c.Called(name, executions)
+	return args.Get(0).(*corev2.CheckSchedule), args.Error(1)
+}
+
 // AddCheckHook for use with mock lib
 func (c *MockClient) AddCheckHook(check *corev2.CheckConfig, checkHook *corev2.HookList) error {
 	args := c.Called(check, checkHook)
@@ -3,6 +3,7 @@ package testing
 import (
 	"time"
 
+	"github.com/sensu/sensu-go/cli/client/config"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/mock"
 )
@@ -103,3 +104,33 @@ func (m *MockConfig) SaveTrustedCAFile(file string) error {
 	args := m.Called(file)
 	return args.Error(0)
 }
+
+// Context mocks the active context
+func (m *MockConfig) Context() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+// ValidateContext mocks validating the active context
+func (m *MockConfig) ValidateContext(create bool) error {
+	args := m.Called(create)
+	return args.Error(0)
+}
+
+// Contexts mocks listing the contexts
+func (m *MockConfig) Contexts() ([]config.Context, error) {
+	args := m.Called()
+	return args.Get(0).([]config.Context), args.Error(1)
+}
+
+// UseContext mocks switching the current context
+func (m *MockConfig) UseContext(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// RenameContext mocks renaming a context
+func (m *MockConfig) RenameContext(old, new string) error {
+	args := m.Called(old, new)
+	return args.Error(0)
+}
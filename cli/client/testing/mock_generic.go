@@ -49,3 +49,9 @@ func (c *MockClient) PutResource(r types.Wrapper) error {
 	args := c.Called(r)
 	return args.Error(0)
 }
+
+// PutResourceDryRun ...
+func (c *MockClient) PutResourceDryRun(r types.Wrapper) (*types.Wrapper, error) {
+	args := c.Called(r)
+	return args.Get(0).(*types.Wrapper), args.Error(1)
+}
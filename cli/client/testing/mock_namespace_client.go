@@ -27,3 +27,9 @@ func (c *MockClient) FetchNamespace(namespace string) (*corev2.Namespace, error)
 	args := c.Called(namespace)
 	return args.Get(0).(*corev2.Namespace), args.Error(1)
 }
+
+// CloneNamespace for use with mock lib
+func (c *MockClient) CloneNamespace(source string, clone *corev2.NamespaceClone) (*corev2.NamespaceCloneResult, error) {
+	args := c.Called(source, clone)
+	return args.Get(0).(*corev2.NamespaceCloneResult), args.Error(1)
+}
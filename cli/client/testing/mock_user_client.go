@@ -53,13 +53,13 @@ func (c *MockClient) SetGroupsForUser(username string, groups []string) error {
 }
 
 // ResetPassword for use with mock lib
-func (c *MockClient) ResetPassword(username, passwordHash string) error {
-	args := c.Called(username, passwordHash)
+func (c *MockClient) ResetPassword(username, newPassword string) error {
+	args := c.Called(username, newPassword)
 	return args.Error(0)
 }
 
 // UpdatePassword for use with mock lib
-func (c *MockClient) UpdatePassword(username, newPasswordHash, currentPassword string) error {
-	args := c.Called(username, newPasswordHash, currentPassword)
+func (c *MockClient) UpdatePassword(username, newPassword, currentPassword string) error {
+	args := c.Called(username, newPassword, currentPassword)
 	return args.Error(0)
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/bcrypt"
 )
 
 // UsersPath is the api path for users.
@@ -139,9 +140,17 @@ func (client *RestClient) SetGroupsForUser(username string, groups []string) err
 	return nil
 }
 
-// ResetPassword reset the password of given user on configured Sensu instance
-func (client *RestClient) ResetPassword(username, passwordHash string) error {
+// ResetPassword reset the password of given user on configured Sensu instance.
+// The new password is sent along with its hash so the backend can enforce its
+// password policy.
+func (client *RestClient) ResetPassword(username, newPassword string) error {
+	passwordHash, err := bcrypt.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
 	bytes, err := json.Marshal(map[string]string{
+		"new_password":  newPassword,
 		"password_hash": passwordHash,
 	})
 	if err != nil {
@@ -164,10 +173,18 @@ func (client *RestClient) ResetPassword(username, passwordHash string) error {
 	return nil
 }
 
-// UpdatePassword updates password of given user on configured Sensu instance
-func (client *RestClient) UpdatePassword(username, newPasswordHash, currentPassword string) error {
+// UpdatePassword updates password of given user on configured Sensu instance.
+// The new password is sent along with its hash so the backend can enforce its
+// password policy.
+func (client *RestClient) UpdatePassword(username, newPassword, currentPassword string) error {
+	newPasswordHash, err := bcrypt.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
 	bytes, err := json.Marshal(map[string]string{
 		"password":      currentPassword,
+		"new_password":  newPassword,
 		"password_hash": newPasswordHash,
 	})
 	if err != nil {
@@ -3,12 +3,23 @@ package apikey
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagExpires     = "expires"
+	flagDescription = "description"
+	flagNamespaces  = "namespaces"
+	flagVerbs       = "verbs"
+	flagResources   = "resources"
+)
+
 // GrantCommand adds a command that creates apikeys.
 func GrantCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +35,9 @@ func GrantCommand(cli *cli.SensuCli) *cobra.Command {
 			apikey := &corev2.APIKey{
 				Username: args[0],
 			}
+			if err := configureAPIKey(cmd, apikey, time.Now()); err != nil {
+				return err
+			}
 
 			location, err := cli.Client.PostAPIKey(apikey.URIPath(), apikey)
 			if err != nil {
@@ -35,5 +49,64 @@ func GrantCommand(cli *cli.SensuCli) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagExpires, "", "duration after which the api-key expires, e.g. 12h or 30d")
+	cmd.Flags().String(flagDescription, "", "description of the api-key")
+	cmd.Flags().StringSlice(flagNamespaces, nil, "namespaces the api-key is restricted to")
+	cmd.Flags().StringSlice(flagVerbs, nil, "verbs the api-key is restricted to, e.g. get,list")
+	cmd.Flags().StringSlice(flagResources, nil, "resources the api-key is restricted to (default \"*\" when --verbs is set)")
+
 	return cmd
 }
+
+// configureAPIKey sets the optional attributes of the api-key from the flags.
+func configureAPIKey(cmd *cobra.Command, apikey *corev2.APIKey, now time.Time) error {
+	flags := cmd.Flags()
+
+	if expires, _ := flags.GetString(flagExpires); expires != "" {
+		d, err := parseExpiry(expires)
+		if err != nil {
+			return err
+		}
+		apikey.ExpiresAt = now.Add(d).Unix()
+	}
+
+	apikey.Description, _ = flags.GetString(flagDescription)
+	apikey.Namespaces, _ = flags.GetStringSlice(flagNamespaces)
+
+	verbs, _ := flags.GetStringSlice(flagVerbs)
+	resources, _ := flags.GetStringSlice(flagResources)
+	if len(verbs) == 0 && len(resources) == 0 {
+		return nil
+	}
+	if len(verbs) == 0 {
+		return fmt.Errorf("--%s requires --%s", flagResources, flagVerbs)
+	}
+	if len(resources) == 0 {
+		resources = []string{corev2.ResourceAll}
+	}
+	apikey.Rules = []corev2.Rule{{Verbs: verbs, Resources: resources}}
+
+	return nil
+}
+
+// parseExpiry parses a positive duration, which may also be expressed in days
+// with the d suffix, e.g. 30d.
+func parseExpiry(s string) (time.Duration, error) {
+	var d time.Duration
+	if days := strings.TrimSuffix(s, "d"); days != s {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid expiry %q", s)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return 0, fmt.Errorf("invalid expiry %q", s)
+		}
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid expiry %q: must be positive", s)
+	}
+	return d, nil
+}
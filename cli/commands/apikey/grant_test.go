@@ -3,7 +3,9 @@ package apikey
 import (
 	"errors"
 	"testing"
+	"time"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
 	"github.com/stretchr/testify/assert"
@@ -50,6 +52,7 @@ func TestGrantCommandWithArgs(t *testing.T) {
 	require.NoError(t, err)
 	assert.Regexp("Created: location", out)
 }
+
 func TestGrantCommandServerError(t *testing.T) {
 	assert := assert.New(t)
 
@@ -64,3 +67,77 @@ func TestGrantCommandServerError(t *testing.T) {
 	assert.Error(err)
 	assert.Equal("err", err.Error())
 }
+
+func TestGrantCommandWithFlags(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("PostAPIKey", mock.Anything, mock.Anything).Return("location", nil)
+
+	cmd := GrantCommand(cli)
+	require.NoError(t, cmd.Flags().Set("expires", "30d"))
+	require.NoError(t, cmd.Flags().Set("description", "ci deploys"))
+	require.NoError(t, cmd.Flags().Set("namespaces", "dev,staging"))
+	require.NoError(t, cmd.Flags().Set("verbs", "get,list"))
+	out, err := test.RunCmd(cmd, []string{"user1"})
+	require.NoError(t, err)
+	assert.Regexp(t, "Created: location", out)
+
+	apikey := client.Calls[0].Arguments[1].(*corev2.APIKey)
+	assert.Equal(t, "ci deploys", apikey.Description)
+	assert.Equal(t, []string{"dev", "staging"}, apikey.Namespaces)
+	assert.Equal(t, []corev2.Rule{{Verbs: []string{"get", "list"}, Resources: []string{"*"}}}, apikey.Rules)
+	assert.InDelta(t, time.Now().Add(30*24*time.Hour).Unix(), apikey.ExpiresAt, 5)
+
+	// The global --namespace flag is not shadowed
+	assert.Nil(t, cmd.Flags().Lookup("namespace"))
+}
+
+func TestGrantCommandInvalidFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		flag  string
+		value string
+	}{
+		{name: "invalid expiry", flag: "expires", value: "soon"},
+		{name: "negative expiry", flag: "expires", value: "-1d"},
+		{name: "resources without verbs", flag: "resources", value: "checks"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := test.NewMockCLI()
+			client := cli.Client.(*client.MockClient)
+			client.On("PostAPIKey", mock.Anything, mock.Anything).Return("location", nil)
+
+			cmd := GrantCommand(cli)
+			require.NoError(t, cmd.Flags().Set(tt.flag, tt.value))
+			_, err := test.RunCmd(cmd, []string{"user1"})
+			assert.Error(t, err)
+			client.AssertNotCalled(t, "PostAPIKey", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestParseExpiry(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "30d", want: 30 * 24 * time.Hour},
+		{in: "12h", want: 12 * time.Hour},
+		{in: "0d", wantErr: true},
+		{in: "xd", wantErr: true},
+		{in: "1y", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseExpiry(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseExpiry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseExpiry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/commands/timeutil"
 	"github.com/sensu/sensu-go/cli/elements/list"
 	"github.com/spf13/cobra"
 )
@@ -62,12 +64,42 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Username",
 				Value: r.Username,
 			},
+			{
+				Label: "Description",
+				Value: r.Description,
+			},
 			{
 				Label: "Created At",
 				Value: time.Unix(r.CreatedAt, 0).String(),
 			},
+			{
+				Label: "Expires At",
+				Value: timeutil.HumanTimestamp(r.ExpiresAt),
+			},
+			{
+				Label: "Last Used At",
+				Value: timeutil.HumanTimestamp(r.LastUsedAt),
+			},
+			{
+				Label: "Namespaces",
+				Value: strings.Join(r.Namespaces, ", "),
+			},
+			{
+				Label: "Rules",
+				Value: rulesToString(r.Rules),
+			},
 		},
 	}
 
 	return list.Print(writer, cfg)
 }
+
+// rulesToString returns a short description of the rules, e.g.
+// "get,list on checks; get on events".
+func rulesToString(rules []corev2.Rule) string {
+	descriptions := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		descriptions = append(descriptions, fmt.Sprintf("%s on %s", strings.Join(rule.Verbs, ","), strings.Join(rule.Resources, ",")))
+	}
+	return strings.Join(descriptions, "; ")
+}
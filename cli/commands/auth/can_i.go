@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/spf13/cobra"
+)
+
+// CanICommand checks whether a request is allowed.
+func CanICommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "can-i VERB RESOURCE [NAME]",
+		Short:        "check whether a request is allowed",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 || len(args) > 3 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			review := &corev2.AccessReview{
+				Verb:     args[0],
+				Resource: args[1],
+			}
+			if len(args) == 3 {
+				review.ResourceName = args[2]
+			}
+			if isNamespaced(review.Resource) {
+				review.Namespace = cli.Config.Namespace()
+			}
+			review.User, review.Groups = subjectFromFlags(cmd)
+			labels, err := cmd.Flags().GetStringToString("label")
+			if err != nil {
+				return err
+			}
+			if len(labels) > 0 {
+				review.Labels = labels
+			}
+
+			result, err := cli.Client.ReviewAccess(review)
+			if err != nil {
+				return err
+			}
+
+			return helpers.Print(cmd, cli.Config.Format(), printReview, nil, result)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	addSubjectFlags(cmd)
+	_ = cmd.Flags().StringToString("label", nil,
+		"labels of the resource, as KEY=VALUE, checked against the label selectors of the rules")
+
+	return cmd
+}
+
+// isNamespaced returns whether the resource, given by its RBAC name, is
+// namespaced. Unknown resources, such as "*", are assumed to be namespaced.
+func isNamespaced(name string) bool {
+	r, err := resource.Resolve(name)
+	if err != nil {
+		return true
+	}
+	// SetNamespace is a no-op for global resources
+	r.SetNamespace("~sensu")
+	return r.GetObjectMeta().Namespace == "~sensu"
+}
+
+func printReview(v interface{}, w io.Writer) {
+	review, ok := v.(*corev2.AccessReview)
+	if !ok {
+		fmt.Fprintln(w, cli.TypeError)
+		return
+	}
+	if !review.Allowed {
+		fmt.Fprintln(w, "no")
+		return
+	}
+	binding := review.Binding
+	fmt.Fprintf(w, "yes, allowed by the %s %q", binding.Type, binding.Name)
+	if binding.Namespace != "" {
+		fmt.Fprintf(w, " in the namespace %q", binding.Namespace)
+	}
+	fmt.Fprintf(w, " with the %s %q\n", binding.RoleRef.Type, binding.RoleRef.Name)
+}
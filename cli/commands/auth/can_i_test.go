@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanICommand(t *testing.T) {
+	allowed := &corev2.AccessReview{
+		Allowed: true,
+		Binding: &corev2.BindingReference{
+			Type:    "ClusterRoleBinding",
+			Name:    "cluster-admin",
+			RoleRef: corev2.RoleRef{Type: "ClusterRole", Name: "cluster-admin"},
+		},
+	}
+	tests := []struct {
+		name       string
+		args       []string
+		flags      map[string]string
+		wantReview *corev2.AccessReview
+		result     *corev2.AccessReview
+		err        error
+		wantOut    string
+		wantErr    bool
+	}{
+		{
+			name:    "missing resource",
+			args:    []string{"get"},
+			wantErr: true,
+		},
+		{
+			name:       "namespaced resource is allowed",
+			args:       []string{"delete", "checks", "check-cpu"},
+			wantReview: &corev2.AccessReview{Verb: "delete", Resource: "checks", ResourceName: "check-cpu", Namespace: "default", Groups: []string{}},
+			result:     allowed,
+			wantOut:    `yes, allowed by the ClusterRoleBinding "cluster-admin" with the ClusterRole "cluster-admin"`,
+		},
+		{
+			name:       "global resource is denied for another user",
+			args:       []string{"create", "namespaces"},
+			flags:      map[string]string{"as": "bob", "as-group": "dev,ops"},
+			wantReview: &corev2.AccessReview{Verb: "create", Resource: "namespaces", User: "bob", Groups: []string{"dev", "ops"}},
+			result:     &corev2.AccessReview{},
+			wantOut:    "no",
+		},
+		{
+			name:       "server error",
+			args:       []string{"get", "checks"},
+			wantReview: &corev2.AccessReview{Verb: "get", Resource: "checks", Namespace: "default", Groups: []string{}},
+			result:     &corev2.AccessReview{},
+			err:        errors.New("error"),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := test.NewMockCLI()
+			config := cli.Config.(*client.MockConfig)
+			config.On("Format").Return("none")
+			client := cli.Client.(*client.MockClient)
+			client.On("ReviewAccess", mock.Anything).Return(tt.result, tt.err)
+
+			cmd := CanICommand(cli)
+			for name, value := range tt.flags {
+				require.NoError(t, cmd.Flags().Set(name, value))
+			}
+			out, err := test.RunCmd(cmd, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantReview != nil {
+				client.AssertCalled(t, "ReviewAccess", tt.wantReview)
+			}
+			assert.Contains(t, out, tt.wantOut)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/spf13/cobra"
+)
+
+// HelpCommand defines new parent
+func HelpCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Inspect authorization",
+		RunE:  helpers.DefaultSubCommandRunE,
+	}
+
+	// Add sub-commands
+	cmd.AddCommand(
+		CanICommand(cli),
+		ListPermissionsCommand(cli),
+	)
+
+	return cmd
+}
+
+// addSubjectFlags adds the flags used to review the permissions of another
+// user.
+func addSubjectFlags(cmd *cobra.Command) {
+	cmd.Flags().String("as", "", "review the permissions of this user instead of the current user")
+	cmd.Flags().StringSlice("as-group", nil, "review the permissions of these groups, instead of the groups of the user")
+}
+
+// subjectFromFlags returns the user and groups given by the flags.
+func subjectFromFlags(cmd *cobra.Command) (string, []string) {
+	user, _ := cmd.Flags().GetString("as")
+	groups, _ := cmd.Flags().GetStringSlice("as-group")
+	return user, groups
+}
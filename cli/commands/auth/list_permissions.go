@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/spf13/cobra"
+)
+
+// permissionRule is a rule granted by a binding, as displayed in the table.
+type permissionRule struct {
+	binding corev2.BindingReference
+	rule    corev2.Rule
+}
+
+// ListPermissionsCommand lists the effective permissions of a user.
+func ListPermissionsCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list-permissions",
+		Short:        "list the effective permissions per namespace",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			namespace := cli.Config.Namespace()
+			if ok, _ := cmd.Flags().GetBool(flags.AllNamespaces); ok {
+				namespace = ""
+			}
+			user, groups := subjectFromFlags(cmd)
+
+			result, err := cli.Client.FetchPermissions(user, groups, namespace)
+			if err != nil {
+				return err
+			}
+
+			return helpers.Print(cmd, cli.Config.Format(), printPermissions, nil, result)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddAllNamespace(cmd.Flags())
+	addSubjectFlags(cmd)
+
+	return cmd
+}
+
+func printPermissions(v interface{}, w io.Writer) {
+	permissions, ok := v.(*corev2.EffectivePermissions)
+	if !ok {
+		return
+	}
+	rows := []interface{}{}
+	for _, permission := range permissions.Permissions {
+		for _, rule := range permission.Rules {
+			rows = append(rows, permissionRule{binding: permission.Binding, rule: rule})
+		}
+	}
+
+	column := func(title string, fn func(permissionRule) string) *table.Column {
+		return &table.Column{
+			Title: title,
+			CellTransformer: func(data interface{}) string {
+				row, ok := data.(permissionRule)
+				if !ok {
+					return cli.TypeError
+				}
+				return fn(row)
+			},
+		}
+	}
+	table := table.New([]*table.Column{
+		column("Namespace", func(row permissionRule) string {
+			if row.binding.Namespace == "" {
+				return "*"
+			}
+			return row.binding.Namespace
+		}),
+		column("Binding", func(row permissionRule) string {
+			return row.binding.Type + "/" + row.binding.Name
+		}),
+		column("Role", func(row permissionRule) string {
+			return row.binding.RoleRef.Type + "/" + row.binding.RoleRef.Name
+		}),
+		column("Verbs", func(row permissionRule) string {
+			return strings.Join(row.rule.Verbs, ",")
+		}),
+		column("Resources", func(row permissionRule) string {
+			return strings.Join(row.rule.Resources, ",")
+		}),
+		column("Resource Names", func(row permissionRule) string {
+			return strings.Join(row.rule.ResourceNames, ",")
+		}),
+	})
+
+	table.Render(w, rows)
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPermissionsCommand(t *testing.T) {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("Format").Return("none")
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchPermissions", "bob", []string{}, "").Return(&corev2.EffectivePermissions{
+		User: "bob",
+		Permissions: []corev2.Permission{
+			{
+				Binding: corev2.BindingReference{
+					Type:    "ClusterRoleBinding",
+					Name:    "viewers",
+					RoleRef: corev2.RoleRef{Type: "ClusterRole", Name: "view"},
+				},
+				Rules: []corev2.Rule{{Verbs: []string{"get", "list"}, Resources: []string{"*"}}},
+			},
+			{
+				Binding: corev2.BindingReference{
+					Type:      "RoleBinding",
+					Name:      "dev-editors",
+					Namespace: "dev",
+					RoleRef:   corev2.RoleRef{Type: "Role", Name: "edit"},
+				},
+				Rules: []corev2.Rule{{Verbs: []string{"*"}, Resources: []string{"checks"}, ResourceNames: []string{"check-cpu"}}},
+			},
+		},
+	}, nil)
+
+	cmd := ListPermissionsCommand(cli)
+	require.NoError(t, cmd.Flags().Set("as", "bob"))
+	require.NoError(t, cmd.Flags().Set("all-namespaces", "true"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "ClusterRoleBinding/viewers")
+	assert.Contains(t, out, "get,list")
+	assert.Contains(t, out, "RoleBinding/dev-editors")
+	assert.Contains(t, out, "check-cpu")
+}
+
+func TestListPermissionsCommandArgs(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := ListPermissionsCommand(cli)
+	_, err := test.RunCmd(cmd, []string{"foo"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,191 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// ManifestVersion is the version of the archive format written by backup
+	// create. Restore refuses archives of other versions.
+	ManifestVersion = 1
+
+	// ManifestFile is the name of the manifest in the archive.
+	ManifestFile = "manifest.json"
+)
+
+// Manifest describes the content of a backup archive.
+type Manifest struct {
+	Version         int             `json:"version"`
+	CreatedAt       time.Time       `json:"created_at"`
+	APIUrl          string          `json:"api_url"`
+	SensuctlVersion string          `json:"sensuctl_version"`
+	Resources       []ManifestEntry `json:"resources"`
+}
+
+// ManifestEntry describes the file holding the resources of a type.
+type ManifestEntry struct {
+	// Type is the fully-qualified name of the type, e.g. core/v2.CheckConfig
+	Type  string `json:"type"`
+	File  string `json:"file"`
+	Count int    `json:"count"`
+}
+
+// HelpCommand defines new parent
+func HelpCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Back up and restore all the resources of a Sensu cluster",
+		RunE:  helpers.DefaultSubCommandRunE,
+	}
+
+	// Add sub-commands
+	cmd.AddCommand(
+		CreateCommand(cli),
+		RestoreCommand(cli),
+	)
+
+	return cmd
+}
+
+// typeName returns the fully-qualified name of the type of a resource.
+func typeName(w types.Wrapper) string {
+	return fmt.Sprintf("%s.%s", w.APIVersion, w.Type)
+}
+
+// fileName returns the name of the archive file holding the resources of a
+// type.
+func fileName(typeName string) string {
+	return fmt.Sprintf("resources/%s.json", strings.ReplaceAll(typeName, "/", "_"))
+}
+
+func sortedTypeNames(resources map[string][]types.Wrapper) []string {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// writeArchive writes a gzipped tarball holding the manifest and the resources
+// of each type of the manifest, which are grouped by type.
+func writeArchive(w io.Writer, manifest *Manifest, resources map[string][]types.Wrapper) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	manifest.Resources = manifest.Resources[:0]
+	files := map[string][]byte{}
+	var order []string
+	for _, name := range sortedTypeNames(resources) {
+		b, err := json.Marshal(resources[name])
+		if err != nil {
+			return err
+		}
+		entry := ManifestEntry{Type: name, File: fileName(name), Count: len(resources[name])}
+		manifest.Resources = append(manifest.Resources, entry)
+		files[entry.File] = b
+		order = append(order, entry.File)
+	}
+
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeArchiveFile(tw, ManifestFile, b, manifest.CreatedAt); err != nil {
+		return err
+	}
+	for _, name := range order {
+		if err := writeArchiveFile(tw, name, files[name], manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func writeArchiveFile(tw *tar.Writer, name string, b []byte, modTime time.Time) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(b)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(b)
+	return err
+}
+
+// readArchive reads the manifest and the resources of a backup archive.
+func readArchive(r io.Reader) (*Manifest, []types.Wrapper, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("not a backup archive: %s", err)
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("not a backup archive: %s", err)
+		}
+		b, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, nil, err
+		}
+		files[header.Name] = b
+	}
+
+	b, ok := files[ManifestFile]
+	if !ok {
+		return nil, nil, errors.New("not a backup archive: the manifest is missing")
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return nil, nil, fmt.Errorf("invalid manifest: %s", err)
+	}
+	if manifest.Version != ManifestVersion {
+		return nil, nil, fmt.Errorf("unsupported backup version %d, expected version %d", manifest.Version, ManifestVersion)
+	}
+
+	var resources []types.Wrapper
+	for _, entry := range manifest.Resources {
+		b, ok := files[entry.File]
+		if !ok {
+			return nil, nil, fmt.Errorf("the archive is missing %s", entry.File)
+		}
+		var wrappers []types.Wrapper
+		if err := json.Unmarshal(b, &wrappers); err != nil {
+			return nil, nil, fmt.Errorf("error reading %s: %s", entry.File, err)
+		}
+		if len(wrappers) != entry.Count {
+			return nil, nil, fmt.Errorf("%s holds %d resources, the manifest expects %d", entry.File, len(wrappers), entry.Count)
+		}
+		resources = append(resources, wrappers...)
+	}
+
+	return &manifest, resources, nil
+}
@@ -0,0 +1,64 @@
+package backup
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHelpCommand(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := HelpCommand(cli)
+
+	assert.NotNil(t, cmd, "cmd should be returned")
+	assert.Regexp(t, "backup", cmd.Use)
+	assert.Len(t, cmd.Commands(), 2)
+}
+
+func fixtureArchive(t *testing.T, manifest *Manifest) []byte {
+	t.Helper()
+	user := corev2.FixtureUser("foo")
+	user.Password = ""
+	user.PasswordHash = "$2a$10$hash"
+	resources := map[string][]types.Wrapper{
+		"core/v2.Namespace":   {types.WrapResource(corev2.FixtureNamespace("default"))},
+		"core/v2.User":        {types.WrapResource(user)},
+		"core/v2.CheckConfig": {types.WrapResource(corev2.FixtureCheckConfig("check-cpu")), types.WrapResource(corev2.FixtureCheckConfig("check-mem"))},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, writeArchive(&buf, manifest, resources))
+	return buf.Bytes()
+}
+
+func TestArchive(t *testing.T) {
+	manifest := &Manifest{Version: ManifestVersion, CreatedAt: time.Now().UTC(), APIUrl: "http://127.0.0.1:8080"}
+	archive := fixtureArchive(t, manifest)
+
+	got, resources, err := readArchive(bytes.NewReader(archive))
+	require.NoError(t, err)
+	assert.Equal(t, manifest.APIUrl, got.APIUrl)
+	assert.Equal(t, []ManifestEntry{
+		{Type: "core/v2.CheckConfig", File: "resources/core_v2.CheckConfig.json", Count: 2},
+		{Type: "core/v2.Namespace", File: "resources/core_v2.Namespace.json", Count: 1},
+		{Type: "core/v2.User", File: "resources/core_v2.User.json", Count: 1},
+	}, got.Resources)
+	require.Len(t, resources, 4)
+	user, ok := resources[3].Value.(*corev2.User)
+	require.True(t, ok)
+	assert.Equal(t, "$2a$10$hash", user.PasswordHash)
+}
+
+func TestArchiveErrors(t *testing.T) {
+	_, _, err := readArchive(bytes.NewReader([]byte("foo")))
+	assert.Error(t, err)
+
+	archive := fixtureArchive(t, &Manifest{Version: ManifestVersion + 1})
+	_, _, err = readArchive(bytes.NewReader(archive))
+	assert.EqualError(t, err, "unsupported backup version 2, expected version 1")
+}
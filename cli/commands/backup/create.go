@@ -0,0 +1,149 @@
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/version"
+	"github.com/spf13/cobra"
+)
+
+// ChunkSize is used to specify that a list of objects is to be fetched in
+// chunks of the given size, using the API's pagination capabilities.
+var ChunkSize = 100
+
+// includePasswordHashQuery makes the API include the password hashes when
+// listing users.
+const includePasswordHashQuery = "includePasswordHash=true"
+
+var createDescription = `sensuctl backup create
+
+Back up the resources of all namespaces to a gzipped tarball, holding a
+manifest and the resources of each type. Example:
+$ sensuctl backup create sensu-backup.tar.gz
+
+The backup includes the password hashes of users and the API keys, so the
+archive must be stored securely. Backing up users requires the permission to
+list the passwordhashes resource.
+
+Use --omit to exclude types from the backup:
+$ sensuctl backup create sensu-backup.tar.gz --omit events
+`
+
+// CreateCommand backs up the resources of all namespaces to an archive.
+func CreateCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create FILE",
+		Short:        "Back up all resources to an archive",
+		Long:         createDescription,
+		SilenceUsage: true,
+		RunE:         create(cli),
+	}
+
+	_ = cmd.Flags().StringP("omit", "o", "", "types to exclude from the backup")
+
+	return cmd
+}
+
+func create(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		requests, err := requestedTypes(cmd)
+		if err != nil {
+			return err
+		}
+
+		resources := map[string][]types.Wrapper{}
+		for _, req := range requests {
+			wrappers, err := list(cli.Client, req)
+			if err != nil {
+				return err
+			}
+			if len(wrappers) > 0 {
+				resources[typeName(wrappers[0])] = wrappers
+			}
+		}
+
+		f, err := os.OpenFile(args[0], os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		manifest := &Manifest{
+			Version:         ManifestVersion,
+			CreatedAt:       time.Now().UTC(),
+			APIUrl:          cli.Config.APIUrl(),
+			SensuctlVersion: version.Semver(),
+		}
+		if err := writeArchive(f, manifest, resources); err != nil {
+			return fmt.Errorf("error writing %s: %s", args[0], err)
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+
+		for _, entry := range manifest.Resources {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s: %d\n", entry.Type, entry.Count)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Backup written to %s\n", args[0])
+		return nil
+	}
+}
+
+// requestedTypes returns all the resource types, minus the omitted ones.
+func requestedTypes(cmd *cobra.Command) ([]corev2.Resource, error) {
+	requests, err := resource.GetResourceRequests("all", resource.All)
+	if err != nil {
+		return nil, err
+	}
+	omitSpec, err := cmd.Flags().GetString("omit")
+	if err != nil {
+		return nil, err
+	}
+	omitRequests, err := resource.GetResourceRequests(omitSpec, resource.All)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing --omit: %s", err)
+	}
+	return resource.TrimResources(requests, omitRequests), nil
+}
+
+// list lists the resources of a type in all namespaces.
+func list(cl client.GenericClient, req corev2.Resource) ([]types.Wrapper, error) {
+	if _, ok := req.(*corev2.User); ok {
+		return listUsers(cl)
+	}
+	req.SetNamespace(corev2.NamespaceTypeAll)
+	resources, err := resource.List(cl, req, ChunkSize)
+	if err != nil {
+		return nil, err
+	}
+	return resource.WrapResources(resources), nil
+}
+
+// listUsers lists the users with their password hashes. Unlike other types,
+// failing to list users is an error, since a backup without users could not
+// be restored.
+func listUsers(cl client.GenericClient) ([]types.Wrapper, error) {
+	var users []*corev2.User
+	usersPath := path.Join(corev2.URLPrefix, corev2.UsersResource) + "?" + includePasswordHashQuery
+	if err := cl.List(usersPath, &users, &client.ListOptions{ChunkSize: ChunkSize}, nil); err != nil {
+		return nil, fmt.Errorf("error listing users: %s", err)
+	}
+	wrappers := make([]types.Wrapper, 0, len(users))
+	for _, user := range users {
+		wrappers = append(wrappers, types.WrapResource(user))
+	}
+	return wrappers, nil
+}
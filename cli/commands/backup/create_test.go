@@ -0,0 +1,89 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli/client"
+	mockclient "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := CreateCommand(cli)
+
+	assert.NotNil(t, cmd, "cmd should be returned")
+	assert.NotNil(t, cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp(t, "create", cmd.Use)
+	assert.NotNil(t, cmd.Flag("omit"))
+
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+}
+
+func TestCreate(t *testing.T) {
+	cli := test.NewCLI()
+	config := cli.Config.(*mockclient.MockConfig)
+	config.On("APIUrl").Return("http://127.0.0.1:8080")
+	cl := cli.Client.(*mockclient.MockClient)
+
+	user := corev2.FixtureUser("foo")
+	user.Password = ""
+	user.PasswordHash = "$2a$10$hash"
+	cl.On("List", "/api/core/v2/users?includePasswordHash=true", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(
+		func(args mock.Arguments) {
+			users := args.Get(1).(*[]*corev2.User)
+			*users = []*corev2.User{user}
+		},
+	)
+	cl.On("List", mock.MatchedBy(func(path string) bool {
+		return strings.HasSuffix(path, "?types=CheckConfig")
+	}), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(
+		func(args mock.Arguments) {
+			checks := args.Get(1).(*[]*corev2.CheckConfig)
+			*checks = []*corev2.CheckConfig{corev2.FixtureCheckConfig("check-cpu")}
+		},
+	)
+	cl.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	file := filepath.Join(t.TempDir(), "backup.tar.gz")
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("omit", "events"))
+	out, err := test.RunCmd(cmd, []string{file})
+	require.NoError(t, err)
+	assert.Contains(t, out, "core/v2.CheckConfig: 1\n")
+	assert.Contains(t, out, "core/v2.User: 1\n")
+	cl.AssertNotCalled(t, "List", mock.MatchedBy(func(path string) bool {
+		return strings.HasSuffix(path, "?types=Event")
+	}), mock.Anything, mock.Anything, mock.Anything)
+
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	manifest, resources, err := readArchive(f)
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8080", manifest.APIUrl)
+	require.Len(t, resources, 2)
+	assert.Equal(t, "$2a$10$hash", resources[1].Value.(*corev2.User).PasswordHash)
+}
+
+func TestCreateUsersError(t *testing.T) {
+	cli := test.NewCLI()
+	cl := cli.Client.(*mockclient.MockClient)
+	cl.On("List", "/api/core/v2/users?includePasswordHash=true", mock.Anything, mock.Anything, mock.Anything).
+		Return(client.APIError{Message: "unauthorized to perform action"})
+	cl.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	file := filepath.Join(t.TempDir(), "backup.tar.gz")
+	_, err := test.RunCmd(CreateCommand(cli), []string{file})
+	assert.EqualError(t, err, "error listing users: unauthorized to perform action")
+	_, err = os.Stat(file)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,170 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/compat"
+	"github.com/spf13/cobra"
+)
+
+// Conflict policies, which determine how resources that already exist are
+// restored.
+const (
+	// ConflictSkip keeps the existing resources.
+	ConflictSkip = "skip"
+	// ConflictOverwrite replaces the existing resources.
+	ConflictOverwrite = "overwrite"
+	// ConflictFail stops the restore at the first existing resource.
+	ConflictFail = "fail"
+)
+
+var restoreDescription = `sensuctl backup restore
+
+Restore the resources of an archive written by sensuctl backup create. Example:
+$ sensuctl backup restore sensu-backup.tar.gz
+
+Resources are restored in dependency order, namespaces and users first. The
+--on-conflict flag determines what happens to the resources that already exist:
+"skip" keeps them, "overwrite" replaces them and "fail" stops the restore.
+
+Events are restored through the event pipeline, so they are processed by their
+handlers again. Use --omit to exclude them:
+$ sensuctl backup restore sensu-backup.tar.gz --omit events
+`
+
+// RestoreCommand restores the resources of an archive.
+func RestoreCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "restore FILE",
+		Short:        "Restore the resources of a backup archive",
+		Long:         restoreDescription,
+		SilenceUsage: true,
+		RunE:         restore(cli),
+	}
+
+	_ = cmd.Flags().String("on-conflict", ConflictSkip, fmt.Sprintf(`what to do with existing resources ("%s"|"%s"|"%s")`, ConflictSkip, ConflictOverwrite, ConflictFail))
+	_ = cmd.Flags().StringP("omit", "o", "", "types to exclude from the restore")
+
+	return cmd
+}
+
+func restore(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		policy, err := cmd.Flags().GetString("on-conflict")
+		if err != nil {
+			return err
+		}
+		switch policy {
+		case ConflictSkip, ConflictOverwrite, ConflictFail:
+		default:
+			return fmt.Errorf("invalid conflict policy: %q", policy)
+		}
+		requests, err := requestedTypes(cmd)
+		if err != nil {
+			return err
+		}
+		restored := map[string]bool{}
+		for _, req := range requests {
+			restored[typeName(types.WrapResource(req))] = true
+		}
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, archived, err := readArchive(f)
+		if err != nil {
+			return err
+		}
+
+		var resources []types.Wrapper
+		for _, w := range archived {
+			if restored[typeName(w)] {
+				resources = append(resources, w)
+			}
+		}
+		resource.SortForCreation(resources)
+
+		return restoreResources(cli.Client, resources, policy, cmd.OutOrStdout())
+	}
+}
+
+// restoreResources puts the resources in order, applying the conflict policy
+// to the resources that already exist.
+func restoreResources(cl client.GenericClient, resources []types.Wrapper, policy string, w io.Writer) error {
+	var restored, skipped int
+	for _, r := range resources {
+		exists, err := exists(cl, r)
+		if err != nil {
+			return fmt.Errorf("error restoring %s: %s", name(r), err)
+		}
+		if exists {
+			switch policy {
+			case ConflictFail:
+				return fmt.Errorf("%s already exists", name(r))
+			case ConflictSkip:
+				fmt.Fprintf(w, "%s skipped, it already exists\n", name(r))
+				skipped++
+				continue
+			}
+		}
+		if err := cl.PutResource(r); err != nil {
+			return fmt.Errorf("error restoring %s: %s", name(r), err)
+		}
+		if exists {
+			fmt.Fprintf(w, "%s overwritten\n", name(r))
+		} else {
+			fmt.Fprintf(w, "%s restored\n", name(r))
+		}
+		restored++
+	}
+	fmt.Fprintf(w, "Restored %d resource(s), skipped %d\n", restored, skipped)
+	return nil
+}
+
+// exists returns whether the resource exists.
+func exists(cl client.GenericClient, r types.Wrapper) (bool, error) {
+	var response json.RawMessage
+	if err := cl.Get(compat.URIPath(r.Value), &response); err != nil {
+		if err, ok := err.(client.APIError); ok && actions.ErrCode(err.Code) == actions.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// name is the human readable name of a resource. Some resources have no name
+// in their metadata, namespaces and events for instance, so resources are
+// named after their path.
+func name(w types.Wrapper) string {
+	segments := strings.Split(compat.URIPath(w.Value), "/")
+	id := segments[len(segments)-1]
+	if w.Type == "Event" && len(segments) > 1 {
+		id = path.Join(segments[len(segments)-2:]...)
+	}
+	if unescaped, err := url.PathUnescape(id); err == nil {
+		id = unescaped
+	}
+	if w.ObjectMeta.Namespace == "" {
+		return fmt.Sprintf("%s %s", typeName(w), id)
+	}
+	return fmt.Sprintf("%s %s/%s", typeName(w), w.ObjectMeta.Namespace, id)
+}
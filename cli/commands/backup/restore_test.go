@@ -0,0 +1,116 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli/client"
+	mockclient "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreCommand(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := RestoreCommand(cli)
+
+	assert.NotNil(t, cmd, "cmd should be returned")
+	assert.NotNil(t, cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp(t, "restore", cmd.Use)
+	for _, flag := range []string{"on-conflict", "omit"} {
+		assert.NotNil(t, cmd.Flag(flag), flag)
+	}
+
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+
+	cmd = RestoreCommand(cli)
+	require.NoError(t, cmd.Flags().Set("on-conflict", "foo"))
+	_, err = test.RunCmd(cmd, []string{"backup.tar.gz"})
+	assert.EqualError(t, err, `invalid conflict policy: "foo"`)
+}
+
+func TestRestore(t *testing.T) {
+	notFound := client.APIError{Code: uint32(actions.NotFound)}
+
+	tests := []struct {
+		name     string
+		policy   string
+		omit     string
+		wantErr  string
+		wantPut  []string
+		wantSkip bool
+		output   string
+	}{
+		{
+			name:    "skip existing resources",
+			policy:  ConflictSkip,
+			wantPut: []string{"Namespace", "User", "CheckConfig"},
+			output: "core/v2.Namespace default restored\n" +
+				"core/v2.User foo restored\n" +
+				"core/v2.CheckConfig default/check-cpu skipped, it already exists\n" +
+				"core/v2.CheckConfig default/check-mem restored\n" +
+				"Restored 3 resource(s), skipped 1\n",
+		},
+		{
+			name:    "overwrite existing resources",
+			policy:  ConflictOverwrite,
+			wantPut: []string{"Namespace", "User", "CheckConfig", "CheckConfig"},
+			output: "core/v2.Namespace default restored\n" +
+				"core/v2.User foo restored\n" +
+				"core/v2.CheckConfig default/check-cpu overwritten\n" +
+				"core/v2.CheckConfig default/check-mem restored\n" +
+				"Restored 4 resource(s), skipped 0\n",
+		},
+		{
+			name:    "fail on existing resources",
+			policy:  ConflictFail,
+			wantErr: "core/v2.CheckConfig default/check-cpu already exists",
+			wantPut: []string{"Namespace", "User"},
+		},
+		{
+			name:    "omitted types",
+			policy:  ConflictSkip,
+			omit:    "checks,users",
+			wantPut: []string{"Namespace"},
+			output: "core/v2.Namespace default restored\n" +
+				"Restored 1 resource(s), skipped 0\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "backup.tar.gz")
+			archive := fixtureArchive(t, &Manifest{Version: ManifestVersion, CreatedAt: time.Now().UTC()})
+			require.NoError(t, os.WriteFile(file, archive, 0600))
+
+			cli := test.NewCLI()
+			cl := cli.Client.(*mockclient.MockClient)
+			cl.On("Get", "/api/core/v2/namespaces/default/checks/check-cpu", mock.Anything).Return(nil)
+			cl.On("Get", mock.Anything, mock.Anything).Return(notFound)
+			var put []string
+			cl.On("PutResource", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				put = append(put, args.Get(0).(types.Wrapper).Type)
+			})
+
+			cmd := RestoreCommand(cli)
+			require.NoError(t, cmd.Flags().Set("on-conflict", tt.policy))
+			if tt.omit != "" {
+				require.NoError(t, cmd.Flags().Set("omit", tt.omit))
+			}
+			out, err := test.RunCmd(cmd, []string{file})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.output, out)
+			}
+			assert.Equal(t, tt.wantPut, put)
+		})
+	}
+}
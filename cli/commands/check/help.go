@@ -23,6 +23,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 		ExecuteCommand(cli),
 		ListCommand(cli),
 		InfoCommand(cli),
+		ScheduleCommand(cli),
 		UpdateCommand(cli),
 
 		// Remove commands (clear out fields)
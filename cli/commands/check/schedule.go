@@ -0,0 +1,132 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/commands/timeutil"
+	"github.com/sensu/sensu-go/cli/elements/list"
+	"github.com/spf13/cobra"
+)
+
+// ScheduleCommand defines the check schedule command
+func ScheduleCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "schedule [NAME]",
+		Short:        "show the next executions of a check and the entities it runs on",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			executions, err := cmd.Flags().GetInt("executions")
+			if err != nil {
+				return err
+			}
+			if executions < 1 {
+				return errors.New("executions must be a positive integer")
+			}
+
+			schedule, err := cli.Client.FetchCheckSchedule(args[0], executions)
+			if err != nil {
+				return err
+			}
+
+			// Determine the format to use to output the data
+			flag := helpers.GetChangedStringValueViper("format", cmd.Flags())
+			format := cli.Config.Format()
+			return helpers.PrintFormatted(flag, format, schedule, cmd.OutOrStdout(), printScheduleToList)
+		},
+	}
+
+	cmd.Flags().Int("executions", 5, "number of upcoming executions to show (at most 100)")
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printScheduleToList(v interface{}, writer io.Writer) error {
+	r, ok := v.(*corev2.CheckSchedule)
+	if !ok {
+		return fmt.Errorf("%t is not a CheckSchedule", v)
+	}
+
+	executions := make([]string, 0, len(r.Executions))
+	for _, execution := range r.Executions {
+		line := timeutil.HumanTimestamp(execution.Time)
+		if execution.Subdued {
+			line += " (subdued)"
+		}
+		executions = append(executions, line)
+	}
+
+	rows := []*list.Row{
+		{
+			Label: "Check",
+			Value: r.Check,
+		},
+		{
+			Label: "Scheduler",
+			Value: r.Scheduler,
+		},
+		{
+			Label: "Subdued?",
+			Value: strconv.FormatBool(r.Subdued),
+		},
+		{
+			Label: "Next Executions",
+			Value: strings.Join(executions, ", "),
+		},
+		{
+			Label: "Agents",
+			Value: entityNames(r.Agents, r.AgentCount),
+		},
+	}
+	if r.ProxyEntities != nil || r.ProxyEntityCount > 0 {
+		rows = append(rows, &list.Row{
+			Label: "Proxy Entities",
+			Value: entityNames(r.ProxyEntities, r.ProxyEntityCount),
+		})
+	}
+
+	if len(r.RoundRobin) > 0 {
+		subscriptions := make([]string, 0, len(r.RoundRobin))
+		for subscription := range r.RoundRobin {
+			subscriptions = append(subscriptions, subscription)
+		}
+		sort.Strings(subscriptions)
+		next := make([]string, 0, len(subscriptions))
+		for _, subscription := range subscriptions {
+			next = append(next, fmt.Sprintf("%s: %s", subscription, strings.Join(r.RoundRobin[subscription], ", ")))
+		}
+		rows = append(rows, &list.Row{
+			Label: "Next Round Robin",
+			Value: strings.Join(next, "; "),
+		})
+	}
+
+	cfg := &list.Config{
+		Title: r.Check,
+		Rows:  rows,
+	}
+
+	return list.Print(writer, cfg)
+}
+
+// entityNames returns the names of the entities, or only their number when
+// the names were omitted because the user is not allowed to list entities.
+func entityNames(names []string, count int) string {
+	if len(names) == 0 && count > 0 {
+		return fmt.Sprintf("%d (not allowed to list entities)", count)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,85 @@
+package check
+
+import (
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixtureCheckSchedule() *corev2.CheckSchedule {
+	return &corev2.CheckSchedule{
+		Check:     "check-cpu",
+		Namespace: "default",
+		Scheduler: "round-robin interval",
+		Executions: []corev2.ScheduledExecution{
+			{Time: 1600000000},
+			{Time: 1600000060, Subdued: true},
+		},
+		Agents:     []string{"agent-1", "agent-2"},
+		RoundRobin: map[string][]string{"linux": {"agent-2"}},
+	}
+}
+
+func TestScheduleCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := ScheduleCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("schedule", cmd.Use)
+	assert.Regexp("check", cmd.Short)
+}
+
+func TestScheduleCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchCheckSchedule", "check-cpu", 2).Return(fixtureCheckSchedule(), nil)
+
+	cmd := ScheduleCommand(cli)
+	require.NoError(t, cmd.Flags().Set("executions", "2"))
+	require.NoError(t, cmd.Flags().Set("format", "tabular"))
+
+	out, err := test.RunCmd(cmd, []string{"check-cpu"})
+	require.NoError(t, err)
+
+	assert.Contains(out, "Next Executions")
+	assert.Contains(out, "(subdued)")
+	assert.Contains(out, "agent-1, agent-2")
+	assert.Contains(out, "linux: agent-2")
+}
+
+func TestScheduleCommandRunMissingArgs(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := ScheduleCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+	require.Error(t, err)
+
+	assert.NotEmpty(out)
+	assert.Contains(out, "Usage")
+}
+
+func TestScheduleCommandRunEClosureWithErr(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchCheckSchedule", "check-cpu", 5).Return(&corev2.CheckSchedule{}, errors.New("my-err"))
+
+	cmd := ScheduleCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"check-cpu"})
+	require.Error(t, err)
+
+	assert.Equal("my-err", err.Error())
+	assert.Empty(out)
+}
@@ -14,7 +14,7 @@ import (
 // CreateCommand defines new command to create a cluster role
 func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:          "create [NAME] --verbs=VERBS --resources=RESOURCES [--resource-name=RESOURCE_NAMES]",
+		Use:          "create [NAME] --verbs=VERBS --resources=RESOURCES [--resource-name=RESOURCE_NAMES] [--label-selector=KEY=VALUE]",
 		Short:        "create a new cluster role with a single rule",
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -71,6 +71,14 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 			}
 			rule.ResourceNames = resourceNames
 
+			labelSelector, err := cmd.Flags().GetStringToString("label-selector")
+			if err != nil {
+				return err
+			}
+			if len(labelSelector) > 0 {
+				rule.LabelSelector = labelSelector
+			}
+
 			// Assign the rule to our cluster role and validate it
 			clusterRole.Rules = []types.Rule{rule}
 			if err := clusterRole.Validate(); err != nil {
@@ -104,6 +112,9 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	_ = cmd.Flags().StringSliceP("resource-name", "n", []string{},
 		"optional resource names that the rule applies to",
 	)
+	_ = cmd.Flags().StringToString("label-selector", nil,
+		"optional labels, as KEY=VALUE, that the objects must have for the rule to apply",
+	)
 
 	cmd.Flags().MarkDeprecated("resource", "please use resources instead.")
 	cmd.Flags().MarkDeprecated("verb", "please use verbs instead.")
@@ -4,6 +4,8 @@ import (
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/apikey"
 	"github.com/sensu/sensu-go/cli/commands/asset"
+	"github.com/sensu/sensu-go/cli/commands/auth"
+	"github.com/sensu/sensu-go/cli/commands/backup"
 	"github.com/sensu/sensu-go/cli/commands/check"
 	"github.com/sensu/sensu-go/cli/commands/cluster"
 	"github.com/sensu/sensu-go/cli/commands/clusterrole"
@@ -15,6 +17,7 @@ import (
 	"github.com/sensu/sensu-go/cli/commands/create"
 	"github.com/sensu/sensu-go/cli/commands/delete"
 	"github.com/sensu/sensu-go/cli/commands/describetype"
+	"github.com/sensu/sensu-go/cli/commands/diff"
 	"github.com/sensu/sensu-go/cli/commands/dump"
 	"github.com/sensu/sensu-go/cli/commands/edit"
 	"github.com/sensu/sensu-go/cli/commands/entity"
@@ -23,10 +26,12 @@ import (
 	"github.com/sensu/sensu-go/cli/commands/filter"
 	"github.com/sensu/sensu-go/cli/commands/handler"
 	"github.com/sensu/sensu-go/cli/commands/hook"
+	"github.com/sensu/sensu-go/cli/commands/lint"
 	"github.com/sensu/sensu-go/cli/commands/logout"
 	"github.com/sensu/sensu-go/cli/commands/mutator"
 	"github.com/sensu/sensu-go/cli/commands/namespace"
 	"github.com/sensu/sensu-go/cli/commands/pipeline"
+	"github.com/sensu/sensu-go/cli/commands/prune"
 	"github.com/sensu/sensu-go/cli/commands/role"
 	"github.com/sensu/sensu-go/cli/commands/rolebinding"
 	"github.com/sensu/sensu-go/cli/commands/silenced"
@@ -46,6 +51,7 @@ func AddCommands(rootCmd *cobra.Command, cli *cli.SensuCli) {
 		// Management Commands
 		asset.HelpCommand(cli),
 		apikey.HelpCommand(cli),
+		auth.HelpCommand(cli),
 		check.HelpCommand(cli),
 		config.HelpCommand(cli),
 		clusterrole.HelpCommand(cli),
@@ -64,10 +70,14 @@ func AddCommands(rootCmd *cobra.Command, cli *cli.SensuCli) {
 		silenced.HelpCommand(cli),
 		create.CreateCommand(cli),
 		delete.DeleteCommand(cli),
+		diff.Command(cli),
 		cluster.HelpCommand(cli),
 		edit.Command(cli),
 		tessen.HelpCommand(cli),
 		dump.Command(cli),
+		prune.Command(cli),
+		backup.HelpCommand(cli),
+		lint.Command(cli),
 		command.HelpCommand(cli),
 		describetype.Command(cli),
 	)
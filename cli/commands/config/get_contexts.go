@@ -0,0 +1,95 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client/config"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/commands/hooks"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/spf13/cobra"
+)
+
+// GetContextsCommand defines subcommand to list the contexts
+func GetContextsCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "get-contexts",
+		Short:        "List the contexts",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			contexts, err := cli.Config.Contexts()
+			if err != nil {
+				return err
+			}
+
+			// Determine the format to use to output the data
+			flag := helpers.GetChangedStringValueViper("format", cmd.Flags())
+			format := cli.Config.Format()
+			return helpers.PrintFormatted(flag, format, contexts, cmd.OutOrStdout(), printContextsToTable)
+		},
+		Annotations: map[string]string{
+			// We want to be able to run this command regardless of whether the CLI
+			// has been configured.
+			hooks.ConfigurationRequirement: hooks.ConfigurationNotRequired,
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printContextsToTable(v interface{}, writer io.Writer) error {
+	contexts, ok := v.([]config.Context)
+	if !ok {
+		return fmt.Errorf("%t is not a list of contexts", v)
+	}
+
+	table := table.New([]*table.Column{
+		{
+			Title: "Current",
+			CellTransformer: func(data interface{}) string {
+				if data.(config.Context).Current {
+					return "*"
+				}
+				return ""
+			},
+		},
+		{
+			Title:       "Name",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				return data.(config.Context).Name
+			},
+		},
+		{
+			Title: "API URL",
+			CellTransformer: func(data interface{}) string {
+				return data.(config.Context).APIUrl
+			},
+		},
+		{
+			Title: "Namespace",
+			CellTransformer: func(data interface{}) string {
+				return data.(config.Context).Namespace
+			},
+		},
+		{
+			Title: "Format",
+			CellTransformer: func(data interface{}) string {
+				return data.(config.Context).Format
+			},
+		},
+	})
+
+	table.Render(writer, contexts)
+	return nil
+}
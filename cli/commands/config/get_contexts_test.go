@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/cli/client/config"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetContextsCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := GetContextsCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("get-contexts", cmd.Use)
+	assert.NotNil(cmd.Flag("format"))
+}
+
+func TestGetContextsExec(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := GetContextsCommand(cli)
+
+	mockConfig := cli.Config.(*clienttest.MockConfig)
+	mockConfig.On("Format").Return(config.FormatTabular)
+	mockConfig.On("Contexts").Return([]config.Context{
+		{Name: "default", APIUrl: "http://127.0.0.1:8080", Namespace: "default", Format: "tabular"},
+		{Name: "prod", Current: true, APIUrl: "https://sensu.example.com:8080", Namespace: "ops", Format: "json"},
+	}, nil)
+
+	out, err := test.RunCmd(cmd, nil)
+	require.NoError(t, err)
+	assert.Contains(t, out, "https://sensu.example.com:8080")
+	assert.Regexp(t, `\*\s+\S*prod`, out)
+
+	cmd = GetContextsCommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", config.FormatJSON))
+	out, err = test.RunCmd(cmd, nil)
+	require.NoError(t, err)
+	assert.Contains(t, out, `"current": true`)
+}
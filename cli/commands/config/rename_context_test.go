@@ -0,0 +1,44 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenameContextCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := &cli.SensuCli{}
+	cmd := RenameContextCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("rename-context", cmd.Use)
+
+	out, err := test.RunCmd(cmd, []string{"one"})
+	assert.NotEmpty(out, "output should display help usage")
+	assert.Error(err, "error should be returned")
+}
+
+func TestRenameContextExec(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := RenameContextCommand(cli)
+
+	config := cli.Config.(*clienttest.MockConfig)
+	config.On("RenameContext", "staging", "qa").Return(nil)
+	config.On("RenameContext", "prod", "qa").Return(errors.New("context \"qa\" already exists"))
+
+	out, err := test.RunCmd(cmd, []string{"staging", "qa"})
+	assert.Regexp(`Renamed context "staging" to "qa"`, out)
+	assert.Nil(err, "Should not produce any errors")
+
+	_, err = test.RunCmd(cmd, []string{"prod", "qa"})
+	assert.Error(err)
+}
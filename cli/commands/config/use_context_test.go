@@ -0,0 +1,53 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUseContextCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := &cli.SensuCli{}
+	cmd := UseContextCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("use-context", cmd.Use)
+
+	out, err := test.RunCmd(cmd, []string{})
+	assert.NotEmpty(out, "output should display help usage")
+	assert.Error(err, "error should be returned")
+}
+
+func TestUseContextExec(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := UseContextCommand(cli)
+
+	config := cli.Config.(*clienttest.MockConfig)
+	config.On("UseContext", "staging").Return(nil)
+
+	out, err := test.RunCmd(cmd, []string{"staging"})
+	assert.Regexp(`Switched to context "staging"`, out)
+	assert.Nil(err, "Should not produce any errors")
+}
+
+func TestUseContextErr(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := UseContextCommand(cli)
+
+	config := cli.Config.(*clienttest.MockConfig)
+	config.On("UseContext", "staging").Return(errors.New("context does not exist"))
+
+	_, err := test.RunCmd(cmd, []string{"staging"})
+	assert.Error(err)
+}
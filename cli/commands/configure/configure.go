@@ -101,6 +101,8 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 			// We want to be able to run this command regardless of whether the CLI
 			// has been configured.
 			hooks.ConfigurationRequirement: hooks.ConfigurationNotRequired,
+			// Configuring a context creates it
+			hooks.ContextRequirement: hooks.ContextCreated,
 		},
 	}
 
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/sensu/sensu-go/cli/resource"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +20,7 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 
 	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files, directories, or URLs to create resources from")
 	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().Bool("dry-run", false, "validate the resources against the API without creating them")
 
 	return cmd
 }
@@ -36,17 +38,25 @@ func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
 		if err != nil {
 			return err
 		}
-		processor := resource.NewManagedByLabelPutter("sensuctl")
-		if len(inputs) == 0 {
-			return resource.ProcessStdin(cli, client, processor)
-		}
-		recurse, err := cmd.Flags().GetBool("recursive")
+		dryRun, err := cmd.Flags().GetBool("dry-run")
 		if err != nil {
 			return err
 		}
-		if err := resource.Process(cli, client, inputs, recurse, processor); err != nil {
+		processor := resource.NewManagedByLabelPutter("sensuctl")
+		processor.DryRun = dryRun
+		if len(inputs) == 0 {
+			err = resource.ProcessStdin(cli, client, processor)
+		} else {
+			var recurse bool
+			recurse, err = cmd.Flags().GetBool("recursive")
+			if err != nil {
+				return err
+			}
+			err = resource.Process(cli, client, inputs, recurse, processor)
+		}
+		if err != nil || !dryRun {
 			return err
 		}
-		return nil
+		return helpers.PrintWrappers(cli.Config.Format(), processor.Validated, cmd.OutOrStdout())
 	}
 }
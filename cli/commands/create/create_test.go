@@ -10,6 +10,7 @@ import (
 	"text/template"
 
 	"github.com/ghodss/yaml"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	mockclient "github.com/sensu/sensu-go/cli/client/testing"
 	cmdtesting "github.com/sensu/sensu-go/cli/commands/testing"
 	"github.com/sensu/sensu-go/types"
@@ -178,3 +179,35 @@ func TestCreateCommandStdin(t *testing.T) {
 	client.AssertCalled(t, "PutResource", mock.Anything)
 	client.AssertCalled(t, "PutResource", mock.Anything)
 }
+
+func TestCreateCommandDryRun(t *testing.T) {
+	cli := cmdtesting.NewMockCLI()
+	client := cli.Client.(*mockclient.MockClient)
+	cli.Config.(*mockclient.MockConfig).On("Format").Return("yaml")
+	validated := types.WrapResource(corev2.FixtureCheckConfig("validated"))
+	client.On("PutResourceDryRun", mock.Anything).Return(&validated, nil).Times(3)
+
+	cmd := CreateCommand(cli)
+	td, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(td)
+
+	fp := filepath.Join(td, "input")
+
+	f, err := os.Create(fp)
+	require.NoError(t, err)
+
+	err = resourceSpecTmpl.Execute(f, resources)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	require.NoError(t, cmd.Flags().Set("dry-run", "true"))
+	out, err := cmdtesting.RunCmd(cmd, nil)
+	require.NoError(t, err)
+
+	// The resources returned by the API are printed
+	require.Contains(t, out, "name: validated")
+	client.AssertExpectations(t)
+	client.AssertNotCalled(t, "PutResource", mock.Anything)
+}
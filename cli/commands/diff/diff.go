@@ -0,0 +1,340 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/client/config"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/compat"
+	"github.com/spf13/cobra"
+)
+
+// ExitDrift is the exit status of sensuctl diff when at least one resource
+// differs from its server version.
+const ExitDrift = 2
+
+// FormatUnified is the format of unified diffs.
+const FormatUnified = "unified"
+
+// DriftError is returned when resources differ from their server versions.
+type DriftError struct {
+	Count int
+}
+
+func (e *DriftError) Error() string {
+	return fmt.Sprintf("%d resource(s) differ from the server", e.Count)
+}
+
+// ExitStatus returns ExitDrift.
+func (e *DriftError) ExitStatus() int {
+	return ExitDrift
+}
+
+// Change is a change to a single field of a resource.
+type Change struct {
+	Path   string      `json:"path" yaml:"path"`
+	Server interface{} `json:"server" yaml:"server"`
+	Local  interface{} `json:"local" yaml:"local"`
+}
+
+// ResourceDiff holds the differences between a resource and its server
+// version.
+type ResourceDiff struct {
+	APIVersion string   `json:"api_version" yaml:"api_version"`
+	Type       string   `json:"type" yaml:"type"`
+	Namespace  string   `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Name       string   `json:"name" yaml:"name"`
+	Created    bool     `json:"created" yaml:"created"`
+	Changes    []Change `json:"changes" yaml:"changes"`
+
+	server string
+	local  string
+}
+
+// ID is the human readable identifier of the resource.
+func (d *ResourceDiff) ID() string {
+	if d.Namespace == "" {
+		return fmt.Sprintf("%s.%s/%s", d.APIVersion, d.Type, d.Name)
+	}
+	return fmt.Sprintf("%s.%s/%s/%s", d.APIVersion, d.Type, d.Namespace, d.Name)
+}
+
+// Unified returns the unified diff between the server and local versions of
+// the resource.
+func (d *ResourceDiff) Unified() (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(d.server),
+		B:        difflib.SplitLines(d.local),
+		FromFile: "server/" + d.ID(),
+		ToFile:   "local/" + d.ID(),
+		Context:  3,
+	})
+}
+
+var description = `sensuctl diff
+
+Show the changes that sensuctl create would make to the resources of the
+server. Example:
+$ sensuctl diff -f ./sensu -r
+
+The exit status is 0 when there are no changes, 2 when there are changes, and
+1 when an error occurs.
+`
+
+// Command compares resources with their server versions.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "diff [-r] [[-f URL] ... ]",
+		Short:        "Show differences between resources from file or URL (path, file://, http[s]://), or STDIN otherwise, and the server",
+		Long:         description,
+		SilenceUsage: true,
+		RunE:         execute(cli),
+	}
+
+	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files, directories, or URLs to compare")
+	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().String("format", FormatUnified, fmt.Sprintf(`format of the differences ("%s"|"%s"|"%s")`, FormatUnified, config.FormatJSON, config.FormatYAML))
+
+	return cmd
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		format, err := cmd.Flags().GetString("format")
+		if err != nil {
+			return err
+		}
+		switch format {
+		case FormatUnified, config.FormatJSON, config.FormatYAML:
+		default:
+			return fmt.Errorf("invalid format: %s", format)
+		}
+		t := &http.Transport{}
+		t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+		httpClient := &http.Client{Transport: t}
+		inputs, err := cmd.Flags().GetStringSlice("file")
+		if err != nil {
+			return err
+		}
+		collector := &resource.Collector{}
+		if len(inputs) == 0 {
+			err = resource.ProcessStdin(cli, httpClient, collector)
+		} else {
+			var recurse bool
+			if recurse, err = cmd.Flags().GetBool("recursive"); err != nil {
+				return err
+			}
+			err = resource.Process(cli, httpClient, inputs, recurse, collector)
+		}
+		if err != nil {
+			return err
+		}
+
+		diffs := []*ResourceDiff{}
+		for _, w := range collector.Resources {
+			// sensuctl create labels the resources it manages, the label is
+			// therefore expected on the server
+			resource.SetManagedByLabel(w, "sensuctl")
+			d, err := Diff(cli.Client, compat.V2Resource(w.Value))
+			if err != nil {
+				return err
+			}
+			if d != nil {
+				diffs = append(diffs, d)
+			}
+		}
+
+		if err := printDiffs(cmd.OutOrStdout(), format, diffs); err != nil {
+			return err
+		}
+		if len(diffs) > 0 {
+			return &DriftError{Count: len(diffs)}
+		}
+		return nil
+	}
+}
+
+func printDiffs(w io.Writer, format string, diffs []*ResourceDiff) error {
+	switch format {
+	case config.FormatJSON:
+		return helpers.PrintJSON(diffs, w)
+	case config.FormatYAML:
+		return helpers.PrintYAML(diffs, w)
+	}
+	for _, d := range diffs {
+		unified, err := d.Unified()
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprint(w, unified); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff fetches the server version of the local resource and returns the
+// differences between them, or nil if they do not differ.
+func Diff(cl client.GenericClient, local corev2.Resource) (*ResourceDiff, error) {
+	server, err := fetch(cl, local)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped := types.WrapResource(local)
+	d := &ResourceDiff{
+		APIVersion: wrapped.APIVersion,
+		Type:       wrapped.Type,
+		Namespace:  wrapped.ObjectMeta.Namespace,
+		Name:       wrapped.ObjectMeta.Name,
+		Created:    server == nil,
+		Changes:    []Change{},
+	}
+	if d.local, err = render(local); err != nil {
+		return nil, err
+	}
+	if server != nil {
+		if d.server, err = render(server); err != nil {
+			return nil, err
+		}
+	}
+	if d.local == d.server {
+		return nil, nil
+	}
+
+	localFields, err := flatten(local)
+	if err != nil {
+		return nil, err
+	}
+	serverFields := map[string]interface{}{}
+	if server != nil {
+		if serverFields, err = flatten(server); err != nil {
+			return nil, err
+		}
+	}
+	paths := make([]string, 0, len(localFields))
+	for path := range localFields {
+		paths = append(paths, path)
+	}
+	for path := range serverFields {
+		if _, ok := localFields[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if !reflect.DeepEqual(serverFields[path], localFields[path]) {
+			d.Changes = append(d.Changes, Change{
+				Path:   path,
+				Server: serverFields[path],
+				Local:  localFields[path],
+			})
+		}
+	}
+
+	return d, nil
+}
+
+// fetch gets the server version of the resource, or nil if it does not exist.
+func fetch(cl client.GenericClient, local corev2.Resource) (corev2.Resource, error) {
+	// We will assume that all resources outside core/v2 are returned as
+	// wrapped values
+	var response interface{}
+	if types.WrapResource(local).APIVersion == "core/v2" {
+		response = reflect.New(reflect.Indirect(reflect.ValueOf(local)).Type()).Interface()
+	} else {
+		response = &types.Wrapper{}
+	}
+
+	if err := cl.Get(local.URIPath(), response); err != nil {
+		if err, ok := err.(client.APIError); ok && actions.ErrCode(err.Code) == actions.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("API error: %s", err)
+	}
+
+	switch r := response.(type) {
+	case *types.Wrapper:
+		return compat.V2Resource(r.Value), nil
+	default:
+		return r.(corev2.Resource), nil
+	}
+}
+
+// render returns the YAML representation of the resource, without the fields
+// that are set by the server.
+func render(r corev2.Resource) (string, error) {
+	meta := compat.GetObjectMeta(r)
+	createdBy := meta.CreatedBy
+	meta.CreatedBy = ""
+	compat.SetObjectMeta(r, meta)
+	defer func() {
+		meta.CreatedBy = createdBy
+		compat.SetObjectMeta(r, meta)
+	}()
+
+	var buf bytes.Buffer
+	if err := helpers.PrintYAML(r, &buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// flatten returns the fields of the wrapped resource by path, without the
+// fields that are set by the server.
+func flatten(r corev2.Resource) (map[string]interface{}, error) {
+	b, err := json.Marshal(types.WrapResource(r))
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	flattenValue(fields, "", value)
+	for path := range fields {
+		if strings.HasSuffix(path, "metadata.created_by") {
+			delete(fields, path)
+		}
+	}
+	return fields, nil
+}
+
+func flattenValue(fields map[string]interface{}, path string, value interface{}) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for k, v := range value {
+			if path == "" {
+				flattenValue(fields, k, v)
+			} else {
+				flattenValue(fields, path+"."+k, v)
+			}
+		}
+	case []interface{}:
+		for i, v := range value {
+			flattenValue(fields, fmt.Sprintf("%s[%d]", path, i), v)
+		}
+	default:
+		fields[path] = value
+	}
+}
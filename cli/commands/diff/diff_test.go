@@ -0,0 +1,142 @@
+package diff
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli/client"
+	mockclient "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/command"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func writeInputs(t *testing.T, resources ...corev2.Resource) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "diff")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	f, err := os.Create(filepath.Join(dir, "resources.json"))
+	require.NoError(t, err)
+	defer f.Close()
+	for _, r := range resources {
+		require.NoError(t, json.NewEncoder(f).Encode(types.WrapResource(r)))
+	}
+	return dir
+}
+
+func TestCommand(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(t, cmd, "cmd should be returned")
+	assert.NotNil(t, cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp(t, "diff", cmd.Use)
+	for _, flag := range []string{"file", "recursive", "format"} {
+		assert.NotNil(t, cmd.Flag(flag), flag)
+	}
+
+	_, err := test.RunCmd(cmd, []string{"foo"})
+	assert.Error(t, err)
+
+	cmd = Command(cli)
+	require.NoError(t, cmd.Flags().Set("format", "tabular"))
+	_, err = test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	local := corev2.FixtureCheckConfig("check-cpu")
+	local.Interval = 30
+
+	unchanged := corev2.FixtureCheckConfig("check-cpu")
+	unchanged.Interval = 30
+	unchanged.Labels = map[string]string{corev2.ManagedByLabel: "sensuctl"}
+	unchanged.CreatedBy = "admin"
+
+	changed := corev2.FixtureCheckConfig("check-cpu")
+	changed.Labels = map[string]string{corev2.ManagedByLabel: "sensuctl"}
+
+	tests := []struct {
+		name     string
+		server   *corev2.CheckConfig
+		format   string
+		wantOut  []string
+		wantNone bool
+	}{
+		{
+			name:     "unchanged",
+			server:   unchanged,
+			wantNone: true,
+		},
+		{
+			name:    "changed",
+			server:  changed,
+			wantOut: []string{"--- server/core/v2.CheckConfig/default/check-cpu", "+++ local/core/v2.CheckConfig/default/check-cpu", "-  interval: 60", "+  interval: 30"},
+		},
+		{
+			name:    "created",
+			wantOut: []string{"+type: CheckConfig", "+  interval: 30"},
+		},
+		{
+			name:    "structured",
+			server:  changed,
+			format:  "json",
+			wantOut: []string{`"path": "spec.interval"`, `"server": 60`, `"local": 30`, `"created": false`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := test.NewCLI()
+			mc := cli.Client.(*mockclient.MockClient)
+			call := mc.On("Get", "/api/core/v2/namespaces/default/checks/check-cpu", mock.Anything)
+			if tt.server == nil {
+				call.Return(client.APIError{Code: uint32(actions.NotFound), Message: "not found"})
+			} else {
+				call.Return(nil).Run(func(args mock.Arguments) {
+					*args.Get(1).(*corev2.CheckConfig) = *tt.server
+				})
+			}
+
+			cmd := Command(cli)
+			require.NoError(t, cmd.Flags().Set("file", writeInputs(t, local)))
+			if tt.format != "" {
+				require.NoError(t, cmd.Flags().Set("format", tt.format))
+			}
+			out, err := test.RunCmd(cmd, []string{})
+			if tt.wantNone {
+				assert.NoError(t, err)
+				assert.Empty(t, out)
+				return
+			}
+			require.Error(t, err)
+			exitErr, ok := err.(command.CommandErrorer)
+			require.True(t, ok)
+			assert.Equal(t, ExitDrift, exitErr.ExitStatus())
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
+
+func TestDiffAPIError(t *testing.T) {
+	cli := test.NewCLI()
+	mc := cli.Client.(*mockclient.MockClient)
+	mc.On("Get", mock.Anything, mock.Anything).Return(client.APIError{Code: uint32(actions.InternalErr), Message: "boom"})
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", writeInputs(t, corev2.FixtureCheckConfig("check-cpu"))))
+	_, err := test.RunCmd(cmd, []string{})
+	require.Error(t, err)
+	_, ok := err.(command.CommandErrorer)
+	assert.False(t, ok)
+}
@@ -4,21 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/url"
 	"os"
-	"reflect"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
-	corev3 "github.com/sensu/sensu-go/api/core/v3"
-	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/cli"
-	"github.com/sensu/sensu-go/cli/client"
 	"github.com/sensu/sensu-go/cli/client/config"
 	"github.com/sensu/sensu-go/cli/commands/flags"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/sensu/sensu-go/cli/resource"
 	"github.com/sensu/sensu-go/types"
-	"github.com/sensu/sensu-go/types/compat"
 	"github.com/spf13/cobra"
 )
 
@@ -163,41 +157,14 @@ func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
 				req.SetNamespace(cli.Config.Namespace())
 			}
 
-			var val reflect.Value
-			if proxy, ok := req.(*corev3.V2ResourceProxy); ok {
-				val = reflect.New(reflect.SliceOf(reflect.TypeOf(proxy.Resource)))
-			} else {
-				val = reflect.New(reflect.SliceOf(reflect.TypeOf(req)))
-			}
-
-			err = cli.Client.List(
-				fmt.Sprintf("%s?types=%s", req.URIPath(), url.QueryEscape(types.WrapResource(req).Type)),
-				val.Interface(), &client.ListOptions{
-					ChunkSize: ChunkSize,
-				}, nil)
+			resources, err := resource.List(cli.Client, req, ChunkSize)
 			if err != nil {
-				// We want to ignore non-nil errors that are a result of
-				// resources not existing, or features being licensed.
-				if err, ok := err.(client.APIError); ok {
-					switch actions.ErrCode(err.Code) {
-					case actions.PaymentRequired, actions.NotFound, actions.PermissionDenied:
-						continue
-					}
-				}
-
-				return fmt.Errorf("API error: %s", err)
+				return err
 			}
-
-			val = reflect.Indirect(val)
-			if val.Len() == 0 {
+			if len(resources) == 0 {
 				continue
 			}
 
-			resources := make([]corev2.Resource, val.Len())
-			for i := range resources {
-				resources[i] = compat.V2Resource(val.Index(i).Interface())
-			}
-
 			switch format {
 			case config.FormatJSON:
 				err = helpers.PrintJSON(resources, w)
@@ -185,6 +185,10 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
 			if len(args) < 2 && !blank {
 				_ = cmd.Help()
 				return errors.New("invalid argument(s) received")
@@ -244,9 +248,14 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 				return err
 			}
 			processor := resource.NewPutter()
+			processor.DryRun = dryRun
 			if err := processor.Process(cli.Client, resources); err != nil {
 				return err
 			}
+			if dryRun {
+				fmt.Fprintf(cmd.OutOrStdout(), "Validated %s (dry run)\n", compat.URIPath(resources[0].Value))
+				return helpers.PrintWrappers(cli.Config.Format(), processor.Validated, cmd.OutOrStdout())
+			}
 			fmt.Fprintf(cmd.OutOrStdout(), "Updated %s\n", compat.URIPath(resources[0].Value))
 			return nil
 		},
@@ -254,6 +263,7 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 
 	helpers.AddFormatFlag(cmd.Flags())
 	_ = cmd.Flags().BoolP("blank", "b", false, "edit a blank resource, and create it on save")
+	_ = cmd.Flags().Bool("dry-run", false, "validate the edited resource against the API without saving it")
 
 	return cmd
 }
@@ -0,0 +1,154 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/spf13/cobra"
+)
+
+// StatusNonZero is the value of the status flag that matches the events of
+// failed checks.
+const StatusNonZero = "non-zero"
+
+// reconnectDelay is the delay between reconnections when streams end
+// immediately, to avoid hammering a backend that can't stream events.
+var reconnectDelay = time.Second
+
+// TailCommand defines new event tail command
+func TailCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "stream events as they are processed",
+		Long: `Stream events as they are processed, until interrupted.
+
+Only the events processed by the backend sensuctl is connected to are streamed,
+events processed by the other backends of a cluster are not.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			namespace := cli.Config.Namespace()
+			if ok, _ := cmd.Flags().GetBool(flags.AllNamespaces); ok {
+				namespace = corev2.NamespaceTypeAll
+			}
+
+			opts, err := helpers.ListOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			opts.ChunkSize = 0
+			if opts.FieldSelector, err = tailFieldSelector(cmd, opts.FieldSelector); err != nil {
+				return err
+			}
+
+			flag := helpers.GetChangedStringValueViper("format", cmd.Flags())
+			format := cli.Config.Format()
+			var printErr error
+			printEvent := func(event *corev2.Event, dropped uint64) {
+				if dropped > 0 {
+					fmt.Fprintf(cmd.ErrOrStderr(), "%d event(s) dropped, the backend streamed faster than sensuctl could print\n", dropped)
+				}
+				if err := helpers.PrintFormatted(flag, format, event, cmd.OutOrStdout(), printToLine); err != nil && printErr == nil {
+					printErr = err
+				}
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer cancel()
+
+			// The backend ends streams periodically, resume streaming until
+			// interrupted
+			for ctx.Err() == nil {
+				start := time.Now()
+				if err := cli.Client.WatchEvents(ctx, namespace, &opts, printEvent); err != nil {
+					return err
+				}
+				if printErr != nil {
+					return printErr
+				}
+				if time.Since(start) < reconnectDelay {
+					select {
+					case <-ctx.Done():
+					case <-time.After(reconnectDelay):
+					}
+				}
+			}
+			return nil
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddAllNamespace(cmd.Flags())
+	helpers.AddFieldSelectorFlag(cmd.Flags())
+	helpers.AddLabelSelectorFlag(cmd.Flags())
+	_ = cmd.Flags().String("entity", "", "only stream the events of this entity")
+	_ = cmd.Flags().String("check", "", "only stream the events of this check")
+	_ = cmd.Flags().String("status", "", fmt.Sprintf(`only stream the events with this check status, or "%s"`, StatusNonZero))
+
+	return cmd
+}
+
+// tailFieldSelector adds the entity, check and status flags to the field
+// selector.
+func tailFieldSelector(cmd *cobra.Command, fieldSelector string) (string, error) {
+	var statements []string
+	if fieldSelector != "" {
+		statements = append(statements, fieldSelector)
+	}
+	if entity, _ := cmd.Flags().GetString("entity"); entity != "" {
+		statements = append(statements, fmt.Sprintf("event.entity.name == %q", entity))
+	}
+	if check, _ := cmd.Flags().GetString("check"); check != "" {
+		statements = append(statements, fmt.Sprintf("event.check.name == %q", check))
+	}
+	if status, _ := cmd.Flags().GetString("status"); status != "" {
+		if status == StatusNonZero {
+			statements = append(statements, `event.check.status != "0"`)
+		} else if _, err := strconv.ParseUint(status, 10, 32); err == nil {
+			statements = append(statements, fmt.Sprintf("event.check.status == %q", status))
+		} else {
+			return "", fmt.Errorf("invalid status: %q, expected a number or %q", status, StatusNonZero)
+		}
+	}
+	return strings.Join(statements, " && "), nil
+}
+
+func printToLine(v interface{}, writer io.Writer) error {
+	event, ok := v.(*corev2.Event)
+	if !ok {
+		return fmt.Errorf("%t is not an Event", v)
+	}
+	var entity, check, output string
+	var status uint32
+	if event.Entity != nil {
+		entity = event.Entity.Name
+	}
+	if event.Check != nil {
+		check = event.Check.Name
+		status = event.Check.Status
+		output = strings.SplitN(strings.TrimSpace(event.Check.Output), "\n", 2)[0]
+	}
+	_, err := fmt.Fprintf(writer, "%s  %s  %s  %s  %d  %s\n",
+		time.Unix(event.Timestamp, 0).Format(time.RFC3339),
+		event.Namespace,
+		entity,
+		check,
+		status,
+		output,
+	)
+	return err
+}
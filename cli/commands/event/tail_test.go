@@ -0,0 +1,107 @@
+package event
+
+import (
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli/client"
+	mockclient "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTailCommand(t *testing.T) {
+	cli := newConfiguredCLI()
+	cmd := TailCommand(cli)
+
+	assert.NotNil(t, cmd, "cmd should be returned")
+	assert.NotNil(t, cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp(t, "tail", cmd.Use)
+	for _, flag := range []string{"entity", "check", "status", "field-selector", "label-selector", "all-namespaces", "format"} {
+		assert.NotNil(t, cmd.Flag(flag), flag)
+	}
+}
+
+func TestTailCommandRunEClosure(t *testing.T) {
+	cli := newConfiguredCLI()
+	mc := cli.Client.(*mockclient.MockClient)
+	errStop := errors.New("stop")
+	mc.On("WatchEvents", mock.Anything, "default", mock.Anything, mock.Anything).Return(errStop).Run(
+		func(args mock.Arguments) {
+			fn := args.Get(3).(func(*corev2.Event, uint64))
+			fn(corev2.FixtureEvent("foo", "check-cpu"), 0)
+		},
+	)
+
+	cmd := TailCommand(cli)
+	require.NoError(t, cmd.Flags().Set("entity", "foo"))
+	require.NoError(t, cmd.Flags().Set("status", StatusNonZero))
+	out, err := test.RunCmd(cmd, []string{})
+	assert.Equal(t, errStop, err)
+	assert.Contains(t, out, "check-cpu")
+
+	opts := mc.Calls[0].Arguments.Get(2).(*client.ListOptions)
+	assert.Equal(t, `event.entity.name == "foo" && event.check.status != "0"`, opts.FieldSelector)
+}
+
+func TestTailCommandAllNamespaces(t *testing.T) {
+	cli := newConfiguredCLI()
+	mc := cli.Client.(*mockclient.MockClient)
+	mc.On("WatchEvents", mock.Anything, corev2.NamespaceTypeAll, mock.Anything, mock.Anything).Return(errors.New("stop"))
+
+	cmd := TailCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.AllNamespaces, "true"))
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+	mc.AssertExpectations(t)
+}
+
+func TestTailFieldSelector(t *testing.T) {
+	tests := []struct {
+		name          string
+		fieldSelector string
+		flags         map[string]string
+		want          string
+		wantErr       bool
+	}{
+		{
+			name: "no flags",
+			want: "",
+		},
+		{
+			name:          "field selector only",
+			fieldSelector: "event.check.state == failing",
+			want:          "event.check.state == failing",
+		},
+		{
+			name:          "all flags",
+			fieldSelector: "event.check.state == failing",
+			flags:         map[string]string{"entity": "foo", "check": "check-cpu", "status": "2"},
+			want:          `event.check.state == failing && event.entity.name == "foo" && event.check.name == "check-cpu" && event.check.status == "2"`,
+		},
+		{
+			name:    "invalid status",
+			flags:   map[string]string{"status": "failing"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := TailCommand(newConfiguredCLI())
+			for k, v := range tt.flags {
+				require.NoError(t, cmd.Flags().Set(k, v))
+			}
+			got, err := tailFieldSelector(cmd, tt.fieldSelector)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
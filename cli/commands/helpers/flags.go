@@ -25,11 +25,14 @@ func AddFormatFlag(flagSet *pflag.FlagSet) {
 		"format",
 		config.DefaultFormat,
 		fmt.Sprintf(
-			`format of data returned ("%s"|"%s"|"%s"|"%s")`,
+			`format of data returned ("%s"|"%s"|"%s"|"%s"|"%s[=COLUMNS]"|"%s=TEMPLATE"|"%s=TEMPLATE")`,
 			config.FormatJSON,
 			config.FormatWrappedJSON,
 			config.FormatTabular,
 			config.FormatYAML,
+			config.FormatCSV,
+			config.FormatJSONPath,
+			config.FormatTemplate,
 		),
 	)
 }
@@ -0,0 +1,383 @@
+package helpers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JSONPath is a parsed JSONPath template, in the syntax used by kubectl: text
+// with expressions between braces. Expressions are paths such as
+// {.metadata.name}, {.subscriptions[0]}, {[*].metadata.name} or {..name},
+// quoted strings such as {"\n"}, and {range PATH}...{end} blocks that apply
+// their content to every value of the path.
+type JSONPath struct {
+	nodes []jsonPathNode
+}
+
+type jsonPathNode struct {
+	text  string
+	path  []jsonPathSegment
+	isRaw bool
+
+	// children of range nodes
+	rangeNodes []jsonPathNode
+}
+
+type jsonPathSegment struct {
+	// field is the name of a field
+	field string
+	// index is the index of an array element, when isIndex is set
+	index   int
+	isIndex bool
+	// all matches all the array elements or fields
+	all bool
+	// recursive matches the field at any depth
+	recursive bool
+}
+
+// ParseJSONPath parses a JSONPath template.
+func ParseJSONPath(template string) (*JSONPath, error) {
+	tokens, err := tokenizeJSONPath(template)
+	if err != nil {
+		return nil, err
+	}
+	nodes, _, _, err := parseJSONPathNodes(tokens, false)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONPath{nodes: nodes}, nil
+}
+
+type jsonPathToken struct {
+	text   string
+	isExpr bool
+}
+
+func tokenizeJSONPath(template string) ([]jsonPathToken, error) {
+	var tokens []jsonPathToken
+	for len(template) > 0 {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			tokens = append(tokens, jsonPathToken{text: template})
+			break
+		}
+		if start > 0 {
+			tokens = append(tokens, jsonPathToken{text: template[:start]})
+		}
+		// Find the closing brace, ignoring braces of quoted strings
+		end := -1
+		var quote byte
+		for i := start + 1; i < len(template); i++ {
+			c := template[i]
+			switch {
+			case quote != 0 && c == '\\':
+				i++
+			case quote != 0 && c == quote:
+				quote = 0
+			case quote == 0 && (c == '"' || c == '\''):
+				quote = c
+			case quote == 0 && c == '}':
+				end = i
+			}
+			if end >= 0 {
+				break
+			}
+		}
+		if end < 0 {
+			return nil, fmt.Errorf("jsonpath: unclosed expression in %q", template)
+		}
+		tokens = append(tokens, jsonPathToken{text: strings.TrimSpace(template[start+1 : end]), isExpr: true})
+		template = template[end+1:]
+	}
+	return tokens, nil
+}
+
+// parseJSONPathNodes parses the tokens until the end of the template, or until
+// {end} when parsing a range, in which case the remaining tokens are returned
+// and closed is set.
+func parseJSONPathNodes(tokens []jsonPathToken, inRange bool) (nodes []jsonPathNode, rest []jsonPathToken, closed bool, err error) {
+	for len(tokens) > 0 {
+		token := tokens[0]
+		tokens = tokens[1:]
+		if !token.isExpr {
+			nodes = append(nodes, jsonPathNode{text: token.text, isRaw: true})
+			continue
+		}
+		switch {
+		case token.text == "end":
+			if !inRange {
+				return nil, nil, false, errors.New("jsonpath: unexpected {end}")
+			}
+			return nodes, tokens, true, nil
+		case strings.HasPrefix(token.text, "range ") || token.text == "range":
+			path, err := parseJSONPathSegments(strings.TrimSpace(strings.TrimPrefix(token.text, "range")))
+			if err != nil {
+				return nil, nil, false, err
+			}
+			children, rest, closed, err := parseJSONPathNodes(tokens, true)
+			if err != nil {
+				return nil, nil, false, err
+			}
+			if !closed {
+				return nil, nil, false, errors.New("jsonpath: {range} is not closed by {end}")
+			}
+			if children == nil {
+				children = []jsonPathNode{}
+			}
+			nodes = append(nodes, jsonPathNode{path: path, rangeNodes: children})
+			tokens = rest
+		case strings.HasPrefix(token.text, `"`) || strings.HasPrefix(token.text, "'"):
+			text, err := unquoteJSONPath(token.text)
+			if err != nil {
+				return nil, nil, false, err
+			}
+			nodes = append(nodes, jsonPathNode{text: text, isRaw: true})
+		default:
+			path, err := parseJSONPathSegments(token.text)
+			if err != nil {
+				return nil, nil, false, err
+			}
+			nodes = append(nodes, jsonPathNode{path: path})
+		}
+	}
+	return nodes, nil, false, nil
+}
+
+func unquoteJSONPath(s string) (string, error) {
+	if strings.HasPrefix(s, "'") {
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return "", fmt.Errorf("jsonpath: invalid string %s", s)
+		}
+		return s[1 : len(s)-1], nil
+	}
+	text, err := strconv.Unquote(s)
+	if err != nil {
+		return "", fmt.Errorf("jsonpath: invalid string %s", s)
+	}
+	return text, nil
+}
+
+func parseJSONPathSegments(path string) ([]jsonPathSegment, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), "@")
+	var segments []jsonPathSegment
+	for len(path) > 0 {
+		switch {
+		case strings.HasPrefix(path, ".."):
+			name, rest := readJSONPathField(path[2:])
+			if name == "" {
+				return nil, fmt.Errorf("jsonpath: expected a field name after '..' in %q", path)
+			}
+			segments = append(segments, jsonPathSegment{field: name, recursive: true})
+			path = rest
+		case path[0] == '.':
+			name, rest := readJSONPathField(path[1:])
+			if name == "*" {
+				segments = append(segments, jsonPathSegment{all: true})
+			} else if name != "" {
+				segments = append(segments, jsonPathSegment{field: name})
+			}
+			path = rest
+		case path[0] == '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("jsonpath: unclosed '[' in %q", path)
+			}
+			inner := strings.TrimSpace(path[1:end])
+			path = path[end+1:]
+			switch {
+			case inner == "*":
+				segments = append(segments, jsonPathSegment{all: true})
+			case strings.HasPrefix(inner, "'") || strings.HasPrefix(inner, `"`):
+				name, err := unquoteJSONPath(inner)
+				if err != nil {
+					return nil, err
+				}
+				segments = append(segments, jsonPathSegment{field: name})
+			default:
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("jsonpath: invalid index %q", inner)
+				}
+				segments = append(segments, jsonPathSegment{index: index, isIndex: true})
+			}
+		default:
+			// Paths may omit the leading dot
+			name, rest := readJSONPathField(path)
+			if name == "" {
+				return nil, fmt.Errorf("jsonpath: unexpected %q", path)
+			}
+			segments = append(segments, jsonPathSegment{field: name})
+			path = rest
+		}
+	}
+	return segments, nil
+}
+
+func readJSONPathField(path string) (string, string) {
+	end := strings.IndexAny(path, ".[")
+	if end < 0 {
+		return path, ""
+	}
+	return path[:end], path[end:]
+}
+
+// Execute applies the template to the JSON representation of v.
+func (j *JSONPath) Execute(w io.Writer, v interface{}) error {
+	data, err := toJSONValue(v)
+	if err != nil {
+		return err
+	}
+	return executeJSONPathNodes(w, j.nodes, data)
+}
+
+func executeJSONPathNodes(w io.Writer, nodes []jsonPathNode, data interface{}) error {
+	for _, node := range nodes {
+		if node.isRaw {
+			if _, err := io.WriteString(w, node.text); err != nil {
+				return err
+			}
+			continue
+		}
+		values := evalJSONPath(node.path, data)
+		if node.rangeNodes != nil {
+			// A range over a single array ranges over its elements
+			if len(values) == 1 {
+				if array, ok := values[0].([]interface{}); ok {
+					values = array
+				}
+			}
+			for _, value := range values {
+				if err := executeJSONPathNodes(w, node.rangeNodes, value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		texts := make([]string, 0, len(values))
+		for _, value := range values {
+			text, err := formatJSONValue(value)
+			if err != nil {
+				return err
+			}
+			texts = append(texts, text)
+		}
+		if _, err := io.WriteString(w, strings.Join(texts, " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func evalJSONPath(path []jsonPathSegment, data interface{}) []interface{} {
+	values := []interface{}{data}
+	for _, segment := range path {
+		var next []interface{}
+		for _, value := range values {
+			next = append(next, evalJSONPathSegment(segment, value)...)
+		}
+		values = next
+	}
+	return values
+}
+
+func evalJSONPathSegment(segment jsonPathSegment, value interface{}) []interface{} {
+	if segment.recursive {
+		var values []interface{}
+		walkJSONValue(value, func(v interface{}) {
+			if object, ok := v.(map[string]interface{}); ok {
+				if field, ok := object[segment.field]; ok {
+					values = append(values, field)
+				}
+			}
+		})
+		return values
+	}
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if segment.all {
+			keys := sortedKeys(value)
+			values := make([]interface{}, 0, len(keys))
+			for _, key := range keys {
+				values = append(values, value[key])
+			}
+			return values
+		}
+		if field, ok := value[segment.field]; ok && !segment.isIndex {
+			return []interface{}{field}
+		}
+	case []interface{}:
+		if segment.all {
+			return value
+		}
+		if segment.isIndex {
+			index := segment.index
+			if index < 0 {
+				index += len(value)
+			}
+			if index >= 0 && index < len(value) {
+				return []interface{}{value[index]}
+			}
+		}
+	}
+	return nil
+}
+
+func walkJSONValue(value interface{}, fn func(interface{})) {
+	fn(value)
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(value) {
+			walkJSONValue(value[key], fn)
+		}
+	case []interface{}:
+		for _, v := range value {
+			walkJSONValue(v, fn)
+		}
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// toJSONValue returns the generic JSON representation of v, so that fields
+// are addressed by their JSON names.
+func toJSONValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(string(b)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// formatJSONValue formats scalars as text and other values as JSON.
+func formatJSONValue(value interface{}) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	}
+	b, err := json.Marshal(value)
+	return string(b), err
+}
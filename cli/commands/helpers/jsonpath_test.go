@@ -0,0 +1,112 @@
+package helpers
+
+import (
+	"bytes"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPath(t *testing.T) {
+	check := corev2.FixtureCheckConfig("check-cpu")
+	check.Subscriptions = []string{"linux", "windows"}
+	checks := []*corev2.CheckConfig{check, corev2.FixtureCheckConfig("check-mem")}
+
+	tests := []struct {
+		name     string
+		template string
+		v        interface{}
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "field",
+			template: "{.metadata.name}",
+			v:        check,
+			want:     "check-cpu",
+		},
+		{
+			name:     "root and text",
+			template: "name: {$.metadata.name}, interval: {.interval}",
+			v:        check,
+			want:     "name: check-cpu, interval: 60",
+		},
+		{
+			name:     "index",
+			template: "{.subscriptions[1]} {.subscriptions[-1]}",
+			v:        check,
+			want:     "windows windows",
+		},
+		{
+			name:     "bracket field",
+			template: "{['metadata']['namespace']}",
+			v:        check,
+			want:     "default",
+		},
+		{
+			name:     "wildcard",
+			template: "{[*].metadata.name}",
+			v:        checks,
+			want:     "check-cpu check-mem",
+		},
+		{
+			name:     "recursive descent",
+			template: "{..name}",
+			v:        checks,
+			want:     "check-cpu check-mem",
+		},
+		{
+			name:     "object",
+			template: "{.subscriptions}",
+			v:        check,
+			want:     `["linux","windows"]`,
+		},
+		{
+			name:     "range",
+			template: `{range [*]}{.metadata.name}{"\t"}{.interval}{"\n"}{end}`,
+			v:        checks,
+			want:     "check-cpu\t60\ncheck-mem\t60\n",
+		},
+		{
+			name:     "missing field",
+			template: "{.foo}",
+			v:        check,
+			want:     "",
+		},
+		{
+			name:     "unclosed expression",
+			template: "{.metadata.name",
+			wantErr:  true,
+		},
+		{
+			name:     "unclosed range",
+			template: "{range [*]}{.metadata.name}",
+			wantErr:  true,
+		},
+		{
+			name:     "unexpected end",
+			template: "{.metadata.name}{end}",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid index",
+			template: "{.subscriptions[a]}",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonPath, err := ParseJSONPath(tt.template)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, jsonPath.Execute(&buf, tt.v))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
@@ -1,14 +1,21 @@
 package helpers
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strings"
+	"text/template"
 
 	"github.com/sensu/sensu-go/cli/client/config"
 	"github.com/sensu/sensu-go/cli/commands/flags"
 	"github.com/sensu/sensu-go/cli/elements/list"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/compat"
 	"github.com/spf13/cobra"
 )
 
@@ -27,6 +34,21 @@ func PrintList(cmd *cobra.Command, format string, printTable printTableFunc, obj
 	return Print(cmd, format, printTable, objects, v)
 }
 
+// PrintWrappers prints the wrapped resources, in the wrapped JSON format for
+// the JSON formats and in YAML otherwise.
+func PrintWrappers(format string, wrappers []*types.Wrapper, w io.Writer) error {
+	resources := make([]types.Resource, 0, len(wrappers))
+	for _, wrapper := range wrappers {
+		resources = append(resources, compat.V2Resource(wrapper.Value))
+	}
+	switch format {
+	case config.FormatJSON, config.FormatWrappedJSON:
+		return PrintWrappedJSONList(resources, w)
+	default:
+		return PrintYAML(resources, w)
+	}
+}
+
 // Print displays
 func Print(cmd *cobra.Command, format string, printTable printTableFunc, objects []types.Resource, v interface{}) error {
 	viper, err := InitViper(cmd.Flags())
@@ -37,6 +59,9 @@ func Print(cmd *cobra.Command, format string, printTable printTableFunc, objects
 	if f := GetChangedStringValueEnv(flags.Format, viper); f != "" {
 		format = f
 	}
+	if ok, err := printScripting(format, v, cmd.OutOrStdout()); ok {
+		return err
+	}
 	switch format {
 	case config.FormatJSON:
 		return PrintJSON(v, cmd.OutOrStdout())
@@ -63,6 +88,9 @@ func PrintFormatted(flag string, format string, v interface{}, w io.Writer, prin
 	if flag != "" {
 		format = flag
 	}
+	if ok, err := printScripting(format, v, w); ok {
+		return err
+	}
 	switch format {
 	case config.FormatJSON:
 		return PrintJSON(v, w)
@@ -87,7 +115,8 @@ func PrintTitle(flag string, format string, title string, w io.Writer) error {
 	}
 	// checking the formats exclusively to cover invalid formats
 	// that get defaulted to tabular
-	if format != config.FormatJSON && format != config.FormatWrappedJSON && format != config.FormatYAML {
+	name, _ := SplitFormat(format)
+	if name != config.FormatJSON && name != config.FormatWrappedJSON && name != config.FormatYAML && !isScripting(name) {
 		cfg := &list.Config{
 			Title: title,
 		}
@@ -95,3 +124,170 @@ func PrintTitle(flag string, format string, title string, w io.Writer) error {
 	}
 	return nil
 }
+
+// SplitFormat splits formats that take an argument, such as
+// jsonpath={.metadata.name}, into the name of the format and its argument.
+func SplitFormat(format string) (name, arg string) {
+	if i := strings.IndexByte(format, '='); i >= 0 {
+		return format[:i], format[i+1:]
+	}
+	return format, ""
+}
+
+func isScripting(name string) bool {
+	return name == config.FormatCSV || name == config.FormatJSONPath || name == config.FormatTemplate
+}
+
+// printScripting prints v in the csv, jsonpath or template format, and returns
+// false if the format is none of them.
+func printScripting(format string, v interface{}, w io.Writer) (bool, error) {
+	name, arg := SplitFormat(format)
+	switch name {
+	case config.FormatCSV:
+		return true, PrintCSV(v, arg, w)
+	case config.FormatJSONPath:
+		if arg == "" {
+			return true, errors.New(`the jsonpath format requires a template, e.g. jsonpath='{.metadata.name}'`)
+		}
+		jsonPath, err := ParseJSONPath(arg)
+		if err != nil {
+			return true, err
+		}
+		return true, jsonPath.Execute(w, v)
+	case config.FormatTemplate:
+		if arg == "" {
+			return true, errors.New(`the template format requires a template, e.g. template='{{.metadata.name}}'`)
+		}
+		return true, PrintTemplate(v, arg, w)
+	}
+	return false, nil
+}
+
+// PrintTemplate executes the Go template against the JSON representation of
+// v, so that fields are addressed by their JSON names.
+func PrintTemplate(v interface{}, text string, w io.Writer) error {
+	tmpl, err := template.New("format").Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %s", err)
+	}
+	data, err := toJSONValue(v)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, data)
+}
+
+// PrintCSV prints v as CSV, with a record per element when v is a list. The
+// columns are the given comma separated field paths, such as
+// metadata.name,interval, or all the fields of the records when empty.
+// Nested fields are flattened into dotted paths, and lists of values are
+// joined with commas.
+func PrintCSV(v interface{}, columns string, w io.Writer) error {
+	data, err := toJSONValue(v)
+	if err != nil {
+		return err
+	}
+	var records []interface{}
+	if list, ok := data.([]interface{}); ok {
+		records = list
+	} else if data != nil {
+		records = []interface{}{data}
+	}
+
+	rows := make([]map[string]string, 0, len(records))
+	for _, record := range records {
+		row := map[string]string{}
+		if err := flattenCSV(row, "", record); err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+
+	var header []string
+	if columns != "" {
+		for _, column := range SafeSplitCSV(columns) {
+			header = append(header, strings.TrimPrefix(column, "."))
+		}
+	} else {
+		seen := map[string]bool{}
+		for _, row := range rows {
+			for key := range row {
+				if !seen[key] {
+					seen[key] = true
+					header = append(header, key)
+				}
+			}
+		}
+		sort.Strings(header)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := make([]string, len(header))
+		for i, column := range header {
+			record[i] = row[column]
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func flattenCSV(row map[string]string, path string, value interface{}) error {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, v := range value {
+			p := key
+			if path != "" {
+				p = path + "." + key
+			}
+			if err := flattenCSV(row, p, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			switch v.(type) {
+			case map[string]interface{}, []interface{}:
+				// Lists of objects are kept as JSON
+				b, err := json.Marshal(value)
+				if err != nil {
+					return err
+				}
+				row[path] = string(b)
+				return nil
+			}
+			text, err := formatJSONValue(v)
+			if err != nil {
+				return err
+			}
+			values = append(values, text)
+		}
+		row[path] = strings.Join(values, ",")
+		return nil
+	}
+	text, err := formatJSONValue(value)
+	if err != nil {
+		return err
+	}
+	row[path] = text
+	return nil
+}
+
+// FormatLabels returns the labels as a comma-separated list of KEY=VALUE
+// pairs, sorted by key.
+func FormatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,99 @@
+package helpers
+
+import (
+	"bytes"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitFormat(t *testing.T) {
+	name, arg := SplitFormat("jsonpath={.metadata.name}")
+	assert.Equal(t, "jsonpath", name)
+	assert.Equal(t, "{.metadata.name}", arg)
+
+	name, arg = SplitFormat("template={{.a}}={{.b}}")
+	assert.Equal(t, "template", name)
+	assert.Equal(t, "{{.a}}={{.b}}", arg)
+
+	name, arg = SplitFormat("json")
+	assert.Equal(t, "json", name)
+	assert.Equal(t, "", arg)
+}
+
+func TestPrintFormattedScripting(t *testing.T) {
+	check := corev2.FixtureCheckConfig("check-cpu")
+	check.Subscriptions = []string{"linux", "windows"}
+	checks := []*corev2.CheckConfig{check, corev2.FixtureCheckConfig("check-mem")}
+
+	tests := []struct {
+		name    string
+		format  string
+		v       interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "csv columns",
+			format: "csv=metadata.name,.interval,subscriptions",
+			v:      checks,
+			want:   "metadata.name,interval,subscriptions\ncheck-cpu,60,\"linux,windows\"\ncheck-mem,60,linux\n",
+		},
+		{
+			name:   "csv single resource",
+			format: "csv=metadata.name",
+			v:      check,
+			want:   "metadata.name\ncheck-cpu\n",
+		},
+		{
+			name:   "jsonpath",
+			format: "jsonpath={[*].metadata.name}",
+			v:      checks,
+			want:   "check-cpu check-mem",
+		},
+		{
+			name:    "jsonpath without template",
+			format:  "jsonpath",
+			v:       checks,
+			wantErr: true,
+		},
+		{
+			name:   "template",
+			format: `template={{range .}}{{.metadata.name}}:{{.interval}}{{"\n"}}{{end}}`,
+			v:      checks,
+			want:   "check-cpu:60\ncheck-mem:60\n",
+		},
+		{
+			name:    "invalid template",
+			format:  "template={{.metadata.name",
+			v:       check,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := PrintFormatted(tt.format, "tabular", tt.v, &buf, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestPrintCSVAllColumns(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, PrintCSV([]*corev2.Namespace{{Name: "default"}, {Name: "dev"}}, "", &buf))
+	assert.Equal(t, "name\ndefault\ndev\n", buf.String())
+}
+
+func TestPrintTitleScripting(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, PrintTitle("csv", "tabular", "title", &buf))
+	assert.Empty(t, buf.String())
+}
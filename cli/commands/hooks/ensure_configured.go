@@ -24,11 +24,26 @@ const (
 	// ConfigurationNotRequired specifies that the command does not require
 	// credentials to be configured to complete operations
 	ConfigurationNotRequired = "NO"
+
+	// ContextRequirement used to identify the annotation flag allowing a
+	// command to create the selected context if it does not exist
+	ContextRequirement = "CONTEXT_REQUIREMENT"
+
+	// ContextCreated specifies that the command creates the selected context
+	// if it does not exist
+	ContextCreated = "CREATED"
 )
 
 // ConfigurationPresent - unless the given command specifies that configuration
 // is not required, func checks that host & access-token have been configured.
 func ConfigurationPresent(cmd *cobra.Command, cli *cli.SensuCli) error {
+	// The selected context must exist, whether or not the command requires
+	// configuration
+	create := cmd.Annotations[ContextRequirement] == ContextCreated
+	if err := cli.Config.ValidateContext(create); err != nil {
+		return err
+	}
+
 	// If the command was configured to ignore whether or not the CLI has been
 	// configured stop execution.
 	if cmd.Annotations[ConfigurationRequirement] == ConfigurationNotRequired {
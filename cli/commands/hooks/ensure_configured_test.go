@@ -1,6 +1,7 @@
 package hooks
 
 import (
+	"errors"
 	"testing"
 
 	clientMock "github.com/sensu/sensu-go/cli/client/testing"
@@ -46,6 +47,7 @@ func TestConfigurationPresent(t *testing.T) {
 		apiKey        string
 		apiURL        string
 		tokens        *types.Tokens
+		contextErr    error
 		errorExpected bool
 	}{
 		{
@@ -97,6 +99,22 @@ func TestConfigurationPresent(t *testing.T) {
 			apiURL:        apiURL,
 			tokens:        invalidTokens,
 			errorExpected: false,
+		}, {
+			name:          "UnknownContext",
+			cmd:           cmdWithCheck,
+			apiKey:        apiKey,
+			apiURL:        apiURL,
+			tokens:        validTokens,
+			contextErr:    errors.New("context does not exist"),
+			errorExpected: true,
+		}, {
+			name:          "NoCheckUnknownContext",
+			cmd:           cmdNoCheck,
+			apiKey:        noApiKey,
+			apiURL:        "",
+			tokens:        nil,
+			contextErr:    errors.New("context does not exist"),
+			errorExpected: true,
 		},
 	}
 
@@ -107,6 +125,7 @@ func TestConfigurationPresent(t *testing.T) {
 			mockConfig.On("APIKey").Return(test.apiKey)
 			mockConfig.On("APIUrl").Return(test.apiURL)
 			mockConfig.On("Tokens").Return(test.tokens)
+			mockConfig.On("ValidateContext", false).Return(test.contextErr)
 
 			if test.errorExpected {
 				testAssert.Error(ConfigurationPresent(test.cmd, mockCli))
@@ -116,3 +135,17 @@ func TestConfigurationPresent(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigurationPresentContextCreated(t *testing.T) {
+	cmd := &cobra.Command{
+		Annotations: map[string]string{
+			ConfigurationRequirement: ConfigurationNotRequired,
+			ContextRequirement:       ContextCreated,
+		},
+	}
+	mockCli := cmdTesting.NewMockCLI()
+	mockConfig := mockCli.Config.(*clientMock.MockConfig)
+	mockConfig.On("ValidateContext", true).Return(nil)
+
+	assert.NoError(t, ConfigurationPresent(cmd, mockCli))
+}
@@ -0,0 +1,370 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/hooks"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/js"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/compat"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// builtInFilters are the filters provided by the backend.
+	builtInFilters = []string{"is_incident", "not_silenced", "has_metrics"}
+
+	// builtInMutators are the mutators provided by the backend.
+	builtInMutators = []string{"json", "only_check_output"}
+
+	// seededClusterRoles are the cluster roles created when the backend is
+	// initialized.
+	seededClusterRoles = []string{"cluster-admin", "admin", "edit", "view", "system:agent", "system:user"}
+)
+
+var description = `sensuctl lint
+
+Validate resource definitions without connecting to a backend. Example:
+$ sensuctl lint -f ./sensu -r
+
+Each resource is validated like the backend would, its JavaScript expressions
+are compiled, and the resources it references are looked up in the given files:
+the handlers, pipelines, assets and hooks of checks, the filters, mutator and
+handlers of handlers and pipelines, the assets of filters and mutators, and the
+roles of role bindings. References to resources that are not defined in the
+files are reported as warnings, since they may exist in the backend.
+
+Use --strict to also fail on warnings.
+`
+
+// Command validates resource definitions offline.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "lint [-r] -f FILE [-f FILE]...",
+		Short:        "Validate resource definitions from files without connecting to a backend",
+		Long:         description,
+		SilenceUsage: true,
+		RunE:         execute(cli),
+		Annotations: map[string]string{
+			// lint never connects to a backend
+			hooks.ConfigurationRequirement: hooks.ConfigurationNotRequired,
+		},
+	}
+
+	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files or directories to validate")
+	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().Bool("strict", false, "fail on warnings")
+
+	return cmd
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		inputs, err := cmd.Flags().GetStringSlice("file")
+		if err != nil {
+			return err
+		}
+		if len(inputs) == 0 {
+			return errors.New("at least one file or directory must be given with --file")
+		}
+		recurse, err := cmd.Flags().GetBool("recursive")
+		if err != nil {
+			return err
+		}
+		strict, err := cmd.Flags().GetBool("strict")
+		if err != nil {
+			return err
+		}
+
+		var resources []*resource.LocatedResource
+		var problems []*resource.LocatedError
+		for _, input := range inputs {
+			res, probs, err := parseFiles(input, recurse)
+			if err != nil {
+				return err
+			}
+			resources = append(resources, res...)
+			problems = append(problems, probs...)
+		}
+		problems = append(problems, Lint(resources, cli.Config.Namespace())...)
+
+		return report(cmd.OutOrStdout(), problems, strict)
+	}
+}
+
+// parseFiles parses the resources of a file, or of the files of a directory.
+func parseFiles(input string, recurse bool) ([]*resource.LocatedResource, []*resource.LocatedError, error) {
+	var resources []*resource.LocatedResource
+	var problems []*resource.LocatedError
+	err := filepath.Walk(input, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != input && !recurse {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		res, probs := resource.ParseLocated(f, path)
+		resources = append(resources, res...)
+		problems = append(problems, probs...)
+		return nil
+	})
+	return resources, problems, err
+}
+
+// report prints the problems sorted by location, and returns an error if there
+// are errors, or warnings in strict mode.
+func report(w io.Writer, problems []*resource.LocatedError, strict bool) error {
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].Location.File != problems[j].Location.File {
+			return problems[i].Location.File < problems[j].Location.File
+		}
+		return problems[i].Location.Line < problems[j].Location.Line
+	})
+	var errCount, warnCount int
+	for _, problem := range problems {
+		fmt.Fprintln(w, problem)
+		if problem.Warning {
+			warnCount++
+		} else {
+			errCount++
+		}
+	}
+	if errCount > 0 || (strict && warnCount > 0) {
+		return fmt.Errorf("found %d error(s) and %d warning(s)", errCount, warnCount)
+	}
+	if warnCount > 0 {
+		fmt.Fprintf(w, "found %d warning(s)\n", warnCount)
+	}
+	return nil
+}
+
+// Lint validates the resources, compiles their JavaScript expressions and
+// checks their references to other resources. Resources without a namespace
+// are linted as if they belonged to the given namespace.
+func Lint(resources []*resource.LocatedResource, namespace string) []*resource.LocatedError {
+	var problems []*resource.LocatedError
+	wrappers := make([]*types.Wrapper, 0, len(resources))
+	var valid []*resource.LocatedResource
+	for _, r := range resources {
+		if r.Value == nil {
+			problems = append(problems, &resource.LocatedError{Location: r.Location, Err: errors.New("resource is nil")})
+			continue
+		}
+		wrappers = append(wrappers, r.Wrapper)
+		valid = append(valid, r)
+	}
+	_ = resource.Validate(wrappers, namespace)
+
+	index := newIndex(valid)
+	for _, r := range valid {
+		l := &linter{resource: r, index: index}
+		l.lint()
+		problems = append(problems, l.problems...)
+	}
+	return problems
+}
+
+// index holds the names of the resources defined in the files, by type and
+// namespace.
+type index map[string]bool
+
+func indexKey(typ, namespace, name string) string {
+	return fmt.Sprintf("%s:%s/%s", typ, namespace, name)
+}
+
+func newIndex(resources []*resource.LocatedResource) index {
+	idx := index{}
+	for _, r := range resources {
+		meta := compat.GetObjectMeta(r.Value)
+		idx[indexKey(typeName(r.Wrapper), meta.Namespace, meta.Name)] = true
+	}
+	return idx
+}
+
+func typeName(w *types.Wrapper) string {
+	return fmt.Sprintf("%s.%s", w.APIVersion, w.Type)
+}
+
+// linter collects the problems of a resource.
+type linter struct {
+	resource *resource.LocatedResource
+	index    index
+	problems []*resource.LocatedError
+}
+
+func (l *linter) errorf(format string, args ...interface{}) {
+	l.problems = append(l.problems, &resource.LocatedError{
+		Location: l.resource.Location,
+		Err:      fmt.Errorf("%s: %s", l.name(), fmt.Sprintf(format, args...)),
+	})
+}
+
+func (l *linter) warnf(format string, args ...interface{}) {
+	l.problems = append(l.problems, &resource.LocatedError{
+		Location: l.resource.Location,
+		Err:      fmt.Errorf("%s: %s", l.name(), fmt.Sprintf(format, args...)),
+		Warning:  true,
+	})
+}
+
+// name is the human readable name of the resource.
+func (l *linter) name() string {
+	meta := compat.GetObjectMeta(l.resource.Value)
+	if meta.Namespace == "" {
+		return fmt.Sprintf("%s %s", typeName(l.resource.Wrapper), meta.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", typeName(l.resource.Wrapper), meta.Namespace, meta.Name)
+}
+
+func (l *linter) lint() {
+	// Compile the expressions first, validation would only report the first
+	// syntax error
+	syntaxErrors := l.lintExpressions()
+
+	if v, ok := l.resource.Value.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil && !syntaxErrors[err.Error()] {
+			l.errorf("%s", err)
+		}
+	}
+
+	l.lintReferences()
+}
+
+// lintExpressions compiles the JavaScript expressions of filters, proxy
+// requests and assets, and returns the syntax errors that were reported.
+func (l *linter) lintExpressions() map[string]bool {
+	reported := map[string]bool{}
+	check := func(field string, expressions []string) {
+		if err := js.ParseExpressions(expressions); err != nil {
+			l.errorf("%s: %s", field, err)
+			reported[err.Error()] = true
+		}
+	}
+	switch value := l.resource.Value.(type) {
+	case *corev2.EventFilter:
+		check("expressions", value.Expressions)
+	case *corev2.CheckConfig:
+		if value.ProxyRequests != nil {
+			check("proxy_requests.entity_attributes", value.ProxyRequests.EntityAttributes)
+		}
+	case *corev2.Asset:
+		check("filters", value.Filters)
+		for i, build := range value.Builds {
+			if build != nil {
+				check(fmt.Sprintf("builds[%d].filters", i), build.Filters)
+			}
+		}
+	}
+	return reported
+}
+
+// lintReferences checks that the resources referenced by the resource are
+// defined.
+func (l *linter) lintReferences() {
+	namespace := compat.GetObjectMeta(l.resource.Value).Namespace
+	switch value := l.resource.Value.(type) {
+	case *corev2.CheckConfig:
+		l.references("handlers", "Handler", namespace, value.Handlers, nil)
+		l.references("output_metric_handlers", "Handler", namespace, value.OutputMetricHandlers, nil)
+		l.references("runtime_assets", "Asset", namespace, value.RuntimeAssets, nil)
+		for _, hooks := range value.CheckHooks {
+			l.references("check_hooks", "HookConfig", namespace, hooks.Hooks, nil)
+		}
+		for _, ref := range value.Pipelines {
+			l.resourceReference("pipelines", namespace, ref)
+		}
+	case *corev2.Handler:
+		l.references("filters", "EventFilter", namespace, value.Filters, builtInFilters)
+		if value.Mutator != "" {
+			l.references("mutator", "Mutator", namespace, []string{value.Mutator}, builtInMutators)
+		}
+		l.references("handlers", "Handler", namespace, value.Handlers, nil)
+		l.references("runtime_assets", "Asset", namespace, value.RuntimeAssets, nil)
+	case *corev2.Pipeline:
+		for i, workflow := range value.Workflows {
+			if workflow == nil {
+				continue
+			}
+			field := fmt.Sprintf("workflows[%d]", i)
+			for _, ref := range workflow.Filters {
+				l.resourceReference(field+".filters", namespace, ref)
+			}
+			l.resourceReference(field+".mutator", namespace, workflow.Mutator)
+			l.resourceReference(field+".handler", namespace, workflow.Handler)
+		}
+	case *corev2.Mutator:
+		l.references("runtime_assets", "Asset", namespace, value.RuntimeAssets, nil)
+	case *corev2.EventFilter:
+		l.references("runtime_assets", "Asset", namespace, value.RuntimeAssets, nil)
+	case *corev2.HookConfig:
+		l.references("runtime_assets", "Asset", namespace, value.RuntimeAssets, nil)
+	case *corev2.RoleBinding:
+		switch value.RoleRef.Type {
+		case "Role":
+			l.references("role_ref", "Role", namespace, []string{value.RoleRef.Name}, nil)
+		case "ClusterRole":
+			l.references("role_ref", "ClusterRole", "", []string{value.RoleRef.Name}, seededClusterRoles)
+		}
+	case *corev2.ClusterRoleBinding:
+		l.references("role_ref", "ClusterRole", "", []string{value.RoleRef.Name}, seededClusterRoles)
+	}
+}
+
+// references warns about the names of core/v2 resources of the given type that
+// are neither defined nor built in.
+func (l *linter) references(field, typ, namespace string, names []string, builtIn []string) {
+	for _, name := range names {
+		if name == "" || contains(builtIn, name) {
+			continue
+		}
+		if !l.index[indexKey("core/v2."+typ, namespace, name)] {
+			l.warnf("%s: %s %q is not defined", field, strings.ToLower(typ), name)
+		}
+	}
+}
+
+// resourceReference checks a reference to a core/v2 resource. References to
+// resources of other API versions are not checked.
+func (l *linter) resourceReference(field, namespace string, ref *corev2.ResourceReference) {
+	if ref == nil || ref.APIVersion != "core/v2" {
+		return
+	}
+	var builtIn []string
+	switch ref.Type {
+	case "EventFilter":
+		builtIn = builtInFilters
+	case "Mutator":
+		builtIn = builtInMutators
+	}
+	l.references(field, ref.Type, namespace, []string{ref.Name}, builtIn)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
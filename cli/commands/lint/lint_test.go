@@ -0,0 +1,224 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	clientmock "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/hooks"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/compat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validManifests = `type: CheckConfig
+api_version: core/v2
+metadata:
+  name: check-cpu
+spec:
+  command: check-cpu.sh
+  interval: 60
+  subscriptions: [linux]
+  handlers: [slack]
+  runtime_assets: [check-cpu]
+---
+type: Handler
+api_version: core/v2
+metadata:
+  name: slack
+spec:
+  type: pipe
+  command: handler-slack
+  filters: [is_incident, production]
+  mutator: only_check_output
+---
+type: EventFilter
+api_version: core/v2
+metadata:
+  name: production
+spec:
+  action: allow
+  expressions:
+  - event.entity.labels.environment == 'production'
+---
+type: Asset
+api_version: core/v2
+metadata:
+  name: check-cpu
+spec:
+  url: http://example.com/asset.tar.gz
+  sha512: 4f926bf4328fbad2b9cac873d117f771914f4b837c9c85584c38ccf55a3ef3c2e8d154812246e5dda4a87450576b2c58ad9ab40c9e2edc31b288d066b195b21b
+---
+type: RoleBinding
+api_version: core/v2
+metadata:
+  name: ops
+spec:
+  role_ref:
+    type: ClusterRole
+    name: view
+  subjects:
+  - type: Group
+    name: ops
+`
+
+const invalidManifests = `{
+  "type": "CheckConfig",
+  "api_version": "core/v2",
+  "metadata": {"name": "check-mem"},
+  "spec": {
+    "command": "check-mem.sh",
+    "interval": 60,
+    "subscriptions": ["linux"],
+    "handlers": ["pagerduty"],
+    "proxy_requests": {"entity_attributes": ["entity.entity_class ==="]}
+  }
+}
+{
+  "type": "EventFilter",
+  "api_version": "core/v2",
+  "metadata": {"name": "broken"},
+  "spec": {"action": "allow", "expressions": ["event.check.status >", "event.check.status == 0", "("]}
+}
+{
+  "type": "RoleBinding",
+  "api_version": "core/v2",
+  "metadata": {"name": "dev"},
+  "spec": {"role_ref": {"type": "Role", "name": "developer"}, "subjects": [{"type": "Group", "name": "dev"}]}
+}
+{
+  "type": "Handler",
+  "api_version": "core/v2",
+  "metadata": {"name": "nameless"},
+  "spec": {"type": "pipe", "commmand": "handler"}
+}
+`
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+	return dir
+}
+
+func TestCommand(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(t, cmd, "cmd should be returned")
+	assert.NotNil(t, cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp(t, "lint", cmd.Use)
+	for _, flag := range []string{"file", "recursive", "strict"} {
+		assert.NotNil(t, cmd.Flag(flag), flag)
+	}
+
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+
+	_, err = test.RunCmd(cmd, []string{"foo"})
+	assert.Error(t, err)
+}
+
+func TestLintValid(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"sensu.yaml": validManifests})
+
+	cmd := Command(test.NewCLI())
+	require.NoError(t, cmd.Flags().Set("file", dir))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+}
+
+func TestLintWithoutConfiguration(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"sensu.yaml": validManifests})
+
+	cli := test.NewMockCLI()
+	config := cli.Config.(*clientmock.MockConfig)
+	config.On("APIKey").Return("")
+	config.On("APIUrl").Return("")
+	config.On("Tokens").Return((*types.Tokens)(nil))
+	config.On("ValidateContext", false).Return(nil)
+
+	cmd := Command(cli)
+	require.NoError(t, hooks.ConfigurationPresent(cmd, cli))
+	require.NoError(t, cmd.Flags().Set("file", dir))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+}
+
+func TestLintInvalid(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"sensu.yaml":          validManifests,
+		"nested/invalid.json": invalidManifests,
+	})
+	file := filepath.Join(dir, "nested", "invalid.json")
+
+	// Subdirectories are only linted with --recursive
+	cmd := Command(test.NewCLI())
+	require.NoError(t, cmd.Flags().Set("file", dir))
+	_, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+
+	cmd = Command(test.NewCLI())
+	require.NoError(t, cmd.Flags().Set("file", dir))
+	require.NoError(t, cmd.Flags().Set("recursive", "true"))
+	out, err := test.RunCmd(cmd, []string{})
+	assert.EqualError(t, err, "found 3 error(s) and 3 warning(s)")
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 6, out)
+	assert.Contains(t, lines[0], file+":1: core/v2.CheckConfig default/check-mem: proxy_requests.entity_attributes: syntax error in expression 0")
+	assert.Equal(t, file+`:1: warning: core/v2.CheckConfig default/check-mem: handlers: handler "pagerduty" is not defined`, lines[1])
+	assert.Contains(t, lines[2], file+":13: core/v2.EventFilter default/broken: expressions: syntax error in expression 0")
+	assert.Equal(t, file+`:19: warning: core/v2.RoleBinding default/dev: role_ref: role "developer" is not defined`, lines[3])
+	assert.Contains(t, lines[4], file+`:25: warning: json: unknown field "commmand"`)
+	assert.Equal(t, file+`:25: core/v2.Handler default/nameless: missing command`, lines[5])
+}
+
+func TestLintStrict(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"sensu.yaml": strings.Replace(validManifests, "[slack]", "[slack, email]", 1)})
+
+	cmd := Command(test.NewCLI())
+	require.NoError(t, cmd.Flags().Set("file", dir))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, `sensu.yaml:1: warning: core/v2.CheckConfig default/check-cpu: handlers: handler "email" is not defined`)
+	assert.Contains(t, out, "found 1 warning(s)")
+
+	cmd = Command(test.NewCLI())
+	require.NoError(t, cmd.Flags().Set("file", dir))
+	require.NoError(t, cmd.Flags().Set("strict", "true"))
+	_, err = test.RunCmd(cmd, []string{})
+	assert.EqualError(t, err, "found 0 error(s) and 1 warning(s)")
+}
+
+func TestLintReferences(t *testing.T) {
+	resources, problems := resource.ParseLocated(strings.NewReader(validManifests), "sensu.yaml")
+	require.Empty(t, problems)
+
+	// References are looked up in the namespace of the resource
+	for _, r := range resources {
+		if r.Type != "CheckConfig" {
+			compat.SetNamespace(r.Value, "other")
+		}
+	}
+	problems = Lint(resources, "default")
+	var got []string
+	for _, problem := range problems {
+		got = append(got, problem.Error())
+	}
+	assert.Equal(t, []string{
+		`sensu.yaml:1: warning: core/v2.CheckConfig default/check-cpu: handlers: handler "slack" is not defined`,
+		`sensu.yaml:1: warning: core/v2.CheckConfig default/check-cpu: runtime_assets: asset "check-cpu" is not defined`,
+	}, got)
+}
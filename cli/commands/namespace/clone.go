@@ -0,0 +1,144 @@
+package namespace
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/spf13/cobra"
+)
+
+var cloneDescription = `sensuctl namespace clone
+
+Copy the resources of a namespace to another namespace, which is created if it
+does not exist. Example:
+$ sensuctl namespace clone team-template team-ops --set team=ops
+
+By default the assets, checks, filters, handlers, hooks, mutators, pipelines,
+roles and role bindings are copied. Use --types to copy some of them only:
+$ sensuctl namespace clone team-template team-ops --types Role,RoleBinding
+
+The label values of the copied resources, and the --name-template used to name
+them, are evaluated with token substitution. The available tokens are
+{{ .name }}, the name of the resource, {{ .namespace }}, the destination
+namespace, {{ .source_namespace }} and the values given with --set as
+{{ .values.KEY }}:
+$ sensuctl namespace clone team-template team-ops --set team=ops \
+  --name-template "{{ .values.team }}-{{ .name }}"
+
+References between the copied resources, such as the handlers of a check, are
+not renamed by --name-template.
+
+Resources that already exist in the destination namespace are skipped, unless
+--overwrite is given. Every resource is copied with your permissions: you must
+be allowed to list its type in the source namespace, and to create it in the
+destination namespace.
+`
+
+// CloneCommand copies the resources of a namespace to another namespace.
+func CloneCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "clone SOURCE DESTINATION",
+		Short:        "copy the resources of a namespace to another namespace",
+		Long:         cloneDescription,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			cloneTypes, err := cmd.Flags().GetStringSlice("types")
+			if err != nil {
+				return err
+			}
+			nameTemplate, err := cmd.Flags().GetString("name-template")
+			if err != nil {
+				return err
+			}
+			values, err := cmd.Flags().GetStringToString("set")
+			if err != nil {
+				return err
+			}
+			overwrite, err := cmd.Flags().GetBool("overwrite")
+			if err != nil {
+				return err
+			}
+
+			clone := &corev2.NamespaceClone{
+				Destination:  args[1],
+				Types:        cloneTypes,
+				NameTemplate: nameTemplate,
+				Values:       values,
+				Overwrite:    overwrite,
+			}
+			if err := clone.Validate(); err != nil {
+				return err
+			}
+
+			result, err := cli.Client.CloneNamespace(args[0], clone)
+			if err != nil {
+				return err
+			}
+
+			// Determine the format to use to output the data
+			flag := helpers.GetChangedStringValueViper("format", cmd.Flags())
+			format := cli.Config.Format()
+			return helpers.PrintFormatted(flag, format, result, cmd.OutOrStdout(), printCloneToTable)
+		},
+	}
+
+	cmd.Flags().StringSlice("types", nil, fmt.Sprintf("types of the resources to copy (%s)", strings.Join(corev2.DefaultNamespaceCloneTypes, ",")))
+	cmd.Flags().String("name-template", "", "template of the names of the copied resources")
+	cmd.Flags().StringToString("set", nil, "template values, as KEY=VALUE")
+	cmd.Flags().Bool("overwrite", false, "overwrite the resources that already exist")
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printCloneToTable(v interface{}, writer io.Writer) error {
+	result, ok := v.(*corev2.NamespaceCloneResult)
+	if !ok {
+		return fmt.Errorf("%t is not a NamespaceCloneResult", v)
+	}
+
+	cell := func(fn func(corev2.ClonedResource) string) func(interface{}) string {
+		return func(data interface{}) string {
+			resource, ok := data.(corev2.ClonedResource)
+			if !ok {
+				return cli.TypeError
+			}
+			return fn(resource)
+		}
+	}
+
+	table := table.New([]*table.Column{
+		{
+			Title:           "Type",
+			ColumnStyle:     table.PrimaryTextStyle,
+			CellTransformer: cell(func(r corev2.ClonedResource) string { return r.Type }),
+		},
+		{
+			Title:           "Source",
+			CellTransformer: cell(func(r corev2.ClonedResource) string { return r.Source }),
+		},
+		{
+			Title:           "Name",
+			CellTransformer: cell(func(r corev2.ClonedResource) string { return r.Name }),
+		},
+		{
+			Title:           "Status",
+			CellTransformer: cell(func(r corev2.ClonedResource) string { return r.Status }),
+		},
+	})
+	table.Render(writer, result.Resources)
+
+	_, err := fmt.Fprintf(writer, "Cloned %d resource(s) from %s to %s\n", len(result.Resources), result.Source, result.Destination)
+	return err
+}
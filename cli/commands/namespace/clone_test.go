@@ -0,0 +1,77 @@
+package namespace
+
+import (
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloneCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := CloneCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("clone", cmd.Use)
+	assert.Regexp("namespace", cmd.Short)
+}
+
+func TestCloneCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+	cli := test.NewMockCLI()
+	result := &corev2.NamespaceCloneResult{
+		Source:      "template",
+		Destination: "ops",
+		Resources: []corev2.ClonedResource{
+			{Type: "Handler", Source: "slack", Name: "ops-slack", Status: corev2.CloneCreated},
+		},
+	}
+	want := &corev2.NamespaceClone{
+		Destination:  "ops",
+		Types:        []string{"Handler", "Role"},
+		NameTemplate: "{{ .values.team }}-{{ .name }}",
+		Values:       map[string]string{"team": "ops"},
+		Overwrite:    true,
+	}
+	cli.Client.(*client.MockClient).
+		On("CloneNamespace", "template", want).
+		Return(result, nil)
+	cli.Config.(*client.MockConfig).On("Format").Return("tabular")
+
+	cmd := CloneCommand(cli)
+	assert.NoError(cmd.Flags().Set("types", "Handler,Role"))
+	assert.NoError(cmd.Flags().Set("name-template", "{{ .values.team }}-{{ .name }}"))
+	assert.NoError(cmd.Flags().Set("set", "team=ops"))
+	assert.NoError(cmd.Flags().Set("overwrite", "true"))
+	out, err := test.RunCmd(cmd, []string{"template", "ops"})
+
+	assert.NoError(err)
+	assert.Regexp("ops-slack", out)
+	assert.Regexp("Cloned 1 resource\\(s\\) from template to ops", out)
+}
+
+func TestCloneCommandInvalidType(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := CloneCommand(cli)
+	assert.NoError(t, cmd.Flags().Set("types", "Entity"))
+	_, err := test.RunCmd(cmd, []string{"template", "ops"})
+	assert.Error(t, err)
+}
+
+func TestCloneCommandError(t *testing.T) {
+	cli := test.NewMockCLI()
+	cli.Client.(*client.MockClient).
+		On("CloneNamespace", "template", mock.Anything).
+		Return((*corev2.NamespaceCloneResult)(nil), errors.New("error"))
+
+	cmd := CloneCommand(cli)
+	_, err := test.RunCmd(cmd, []string{"template", "ops"})
+	assert.Error(t, err)
+}
@@ -16,6 +16,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 
 	// Add sub-commands
 	cmd.AddCommand(
+		CloneCommand(cli),
 		CreateCommand(cli),
 		DeleteCommand(cli),
 		ListCommand(cli),
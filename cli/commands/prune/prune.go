@@ -0,0 +1,176 @@
+package prune
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/compat"
+	"github.com/spf13/cobra"
+)
+
+// ManagedBy is the value of the managed_by label of the resources that are
+// candidates for pruning, as set by sensuctl create.
+const ManagedBy = "sensuctl"
+
+var (
+	// ChunkSize is used to specify that a list of objects is to be fetched in
+	// chunks of the given size, using the API's pagination capabilities.
+	ChunkSize = 100
+)
+
+var description = `sensuctl prune
+
+Delete the resources created by sensuctl create that are no longer present in
+the given files, directories or URLs. Example:
+$ sensuctl prune checks,handlers -f ./sensu -r
+
+Only resources labeled with sensu.io/managed_by: sensuctl are pruned. Use
+--dry-run to list the resources that would be deleted without deleting them.
+
+You can also use the 'all' qualifier to prune all supported resources:
+$ sensuctl prune all -f ./sensu -r
+`
+
+// Command deletes the resources managed by sensuctl that are absent from its
+// inputs.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "prune [RESOURCE TYPE],[RESOURCE TYPE]... [-r] -f FILE [-f FILE]...",
+		Short:        "Delete resources managed by sensuctl that are not present in files or URLs",
+		Long:         description,
+		SilenceUsage: true,
+		RunE:         execute(cli),
+	}
+
+	helpers.AddAllNamespace(cmd.Flags())
+	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files, directories, or URLs holding the resources to keep")
+	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().Bool("dry-run", false, "list the resources that would be pruned without deleting them")
+	_ = cmd.Flags().String(flags.LabelSelector, "", "only prune resources matching this label selector")
+
+	return cmd
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		inputs, err := cmd.Flags().GetStringSlice("file")
+		if err != nil {
+			return err
+		}
+		if len(inputs) == 0 {
+			return errors.New("at least one file, directory or URL must be given with --file")
+		}
+		recurse, err := cmd.Flags().GetBool("recursive")
+		if err != nil {
+			return err
+		}
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+		allNamespaces, err := cmd.Flags().GetBool(flags.AllNamespaces)
+		if err != nil {
+			return err
+		}
+		var sel *selector.Selector
+		if input, err := cmd.Flags().GetString(flags.LabelSelector); err != nil {
+			return err
+		} else if input != "" {
+			if sel, err = selector.ParseLabelSelector(input); err != nil {
+				return fmt.Errorf("invalid label selector: %s", err)
+			}
+		}
+
+		// parse the comma separated resource types and match against the defined actions
+		requests, err := resource.GetResourceRequests(args[0], resource.All)
+		if err != nil {
+			return err
+		}
+
+		t := &http.Transport{}
+		t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+		httpClient := &http.Client{Transport: t}
+		inputResources := &resource.Collector{}
+		if err := resource.Process(cli, httpClient, inputs, recurse, inputResources); err != nil {
+			return err
+		}
+		declared := make(map[string]bool, len(inputResources.Resources))
+		for _, w := range inputResources.Resources {
+			declared[key(types.WrapResource(compat.V2Resource(w.Value)))] = true
+		}
+
+		namespace := cli.Config.Namespace()
+		if allNamespaces {
+			namespace = corev2.NamespaceTypeAll
+		}
+
+		var pruned []types.Wrapper
+		for _, req := range requests {
+			req.SetNamespace(namespace)
+			resources, err := resource.List(cli.Client, req, ChunkSize)
+			if err != nil {
+				return err
+			}
+			for _, r := range resources {
+				w := types.WrapResource(r)
+				if w.ObjectMeta.Labels[corev2.ManagedByLabel] != ManagedBy {
+					continue
+				}
+				if sel != nil && !sel.Matches(w.ObjectMeta.Labels) {
+					continue
+				}
+				if declared[key(w)] {
+					continue
+				}
+				pruned = append(pruned, w)
+			}
+		}
+
+		resource.SortForDeletion(pruned)
+
+		return prune(cli.Client, pruned, dryRun, cmd.OutOrStdout())
+	}
+}
+
+// prune deletes the resources in order, or only lists them if dryRun is set.
+func prune(client client.GenericClient, resources []types.Wrapper, dryRun bool, w io.Writer) error {
+	for _, r := range resources {
+		if dryRun {
+			fmt.Fprintf(w, "%s (dry run)\n", name(r))
+			continue
+		}
+		path := compat.URIPath(r.Value)
+		if err := client.Delete(path); err != nil {
+			return fmt.Errorf("error deleting %s (%s): %s", name(r), path, err)
+		}
+		fmt.Fprintf(w, "%s pruned\n", name(r))
+	}
+	return nil
+}
+
+// key uniquely identifies a resource by its type, namespace and name.
+func key(w types.Wrapper) string {
+	return fmt.Sprintf("%s.%s:%s/%s", w.APIVersion, w.Type, w.ObjectMeta.Namespace, w.ObjectMeta.Name)
+}
+
+// name is the human readable name of a resource.
+func name(w types.Wrapper) string {
+	if w.ObjectMeta.Namespace == "" {
+		return fmt.Sprintf("%s.%s %s", w.APIVersion, w.Type, w.ObjectMeta.Name)
+	}
+	return fmt.Sprintf("%s.%s %s/%s", w.APIVersion, w.Type, w.ObjectMeta.Namespace, w.ObjectMeta.Name)
+}
@@ -0,0 +1,129 @@
+package prune
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func managedCheck(name string) *corev2.CheckConfig {
+	check := corev2.FixtureCheckConfig(name)
+	check.Labels = map[string]string{corev2.ManagedByLabel: ManagedBy, "team": "ops"}
+	return check
+}
+
+func writeInputs(t *testing.T, resources ...corev2.Resource) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "prune")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	f, err := os.Create(filepath.Join(dir, "resources.json"))
+	require.NoError(t, err)
+	defer f.Close()
+	for _, r := range resources {
+		require.NoError(t, json.NewEncoder(f).Encode(types.WrapResource(r)))
+	}
+	return dir
+}
+
+func TestCommand(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(t, cmd, "cmd should be returned")
+	assert.NotNil(t, cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp(t, "prune", cmd.Use)
+	for _, flag := range []string{"file", "recursive", "dry-run", "label-selector", "all-namespaces"} {
+		assert.NotNil(t, cmd.Flag(flag), flag)
+	}
+}
+
+func TestCommandArgs(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+
+	_, err = test.RunCmd(cmd, []string{"checks"})
+	assert.Error(t, err)
+
+	cmd = Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", writeInputs(t)))
+	_, err = test.RunCmd(cmd, []string{"check,foo"})
+	assert.Error(t, err)
+}
+
+func TestPrune(t *testing.T) {
+	keep := managedCheck("keep")
+	stale := managedCheck("stale")
+	other := managedCheck("other")
+	other.Labels["team"] = "dev"
+	unmanaged := corev2.FixtureCheckConfig("unmanaged")
+
+	tests := []struct {
+		name          string
+		dryRun        bool
+		labelSelector string
+		deleted       []string
+		output        string
+	}{
+		{
+			name:    "unmanaged and declared resources are kept",
+			deleted: []string{"stale", "other"},
+			output:  "core/v2.CheckConfig default/stale pruned\ncore/v2.CheckConfig default/other pruned\n",
+		},
+		{
+			name:          "label selector",
+			labelSelector: "team == ops",
+			deleted:       []string{"stale"},
+			output:        "core/v2.CheckConfig default/stale pruned\n",
+		},
+		{
+			name:   "dry run",
+			dryRun: true,
+			output: "core/v2.CheckConfig default/stale (dry run)\ncore/v2.CheckConfig default/other (dry run)\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := test.NewCLI()
+			client := cli.Client.(*client.MockClient)
+			client.On("List", "/api/core/v2/namespaces/default/checks?types=CheckConfig", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(
+				func(args mock.Arguments) {
+					checks := args.Get(1).(*[]*corev2.CheckConfig)
+					*checks = []*corev2.CheckConfig{keep, stale, other, unmanaged}
+				},
+			)
+			for _, name := range tt.deleted {
+				client.On("Delete", "/api/core/v2/namespaces/default/checks/"+name).Return(nil).Once()
+			}
+
+			cmd := Command(cli)
+			require.NoError(t, cmd.Flags().Set("file", writeInputs(t, keep)))
+			if tt.dryRun {
+				require.NoError(t, cmd.Flags().Set("dry-run", "true"))
+			}
+			if tt.labelSelector != "" {
+				require.NoError(t, cmd.Flags().Set("label-selector", tt.labelSelector))
+			}
+			out, err := test.RunCmd(cmd, []string{"checks"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.output, out)
+			client.AssertExpectations(t)
+			if len(tt.deleted) == 0 {
+				client.AssertNotCalled(t, "Delete", mock.Anything)
+			}
+		})
+	}
+}
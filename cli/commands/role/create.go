@@ -13,7 +13,7 @@ import (
 // CreateCommand defines new command to create roles
 func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:          "create [NAME] --verb=VERBS --resource=RESOURCES [--resource-name=RESOURCE_NAMES]",
+		Use:          "create [NAME] --verb=VERBS --resource=RESOURCES [--resource-name=RESOURCE_NAMES] [--label-selector=KEY=VALUE]",
 		Short:        "create a new role with a single rule",
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -55,6 +55,14 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 			}
 			rule.ResourceNames = resourceNames
 
+			labelSelector, err := cmd.Flags().GetStringToString("label-selector")
+			if err != nil {
+				return err
+			}
+			if len(labelSelector) > 0 {
+				rule.LabelSelector = labelSelector
+			}
+
 			// Assign the rule to our role and validate it
 			role.Rules = []v2.Rule{rule}
 			if err := role.Validate(); err != nil {
@@ -78,6 +86,9 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	_ = cmd.Flags().StringSliceP("resource-name", "n", []string{},
 		"optional resource names that the rule applies to",
 	)
+	_ = cmd.Flags().StringToString("label-selector", nil,
+		"optional labels, as KEY=VALUE, that the objects must have for the rule to apply",
+	)
 
 	return cmd
 }
@@ -8,6 +8,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -50,3 +51,22 @@ func TestCreateCommandRunEClosure(t *testing.T) {
 	assert.Regexp("Created", out)
 	assert.NoError(err)
 }
+
+func TestCreateCommandRunEClosureLabelSelector(t *testing.T) {
+	assert := assert.New(t)
+	cli := test.NewMockCLI()
+	cli.Client.(*client.MockClient).
+		On("CreateRole", mock.MatchedBy(func(role *types.Role) bool {
+			return role.Rules[0].LabelSelector["team"] == "a"
+		})).
+		Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("verb", "get"))
+	require.NoError(t, cmd.Flags().Set("resource", "checks"))
+	require.NoError(t, cmd.Flags().Set("label-selector", "team=a"))
+	out, err := test.RunCmd(cmd, []string{"foo"})
+
+	assert.Regexp("Created", out)
+	assert.NoError(err)
+}
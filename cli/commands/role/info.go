@@ -87,6 +87,16 @@ func printRulesToTable(v interface{}, io io.Writer) error {
 				return strings.Join(rule.ResourceNames, ",")
 			},
 		},
+		{
+			Title: "Label Selector",
+			CellTransformer: func(data interface{}) string {
+				rule, ok := data.(types.Rule)
+				if !ok {
+					return cli.TypeError
+				}
+				return helpers.FormatLabels(rule.LabelSelector)
+			},
+		},
 	})
 
 	table.Render(io, queryResults.Rules)
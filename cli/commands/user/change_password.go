@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/AlecAivazis/survey/v2"
-	"github.com/sensu/sensu-go/backend/authentication/bcrypt"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/flags"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
@@ -76,13 +75,8 @@ func SetPasswordCommand(cli *cli.SensuCli) *cobra.Command {
 				return err
 			}
 
-			hash, err := bcrypt.HashPassword(password.New)
-			if err != nil {
-				return err
-			}
-
 			// Update password
-			if err := cli.Client.UpdatePassword(username, hash, password.Current); err != nil {
+			if err := cli.Client.UpdatePassword(username, password.New, password.Current); err != nil {
 				return err
 			}
 
@@ -8,7 +8,6 @@ import (
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,7 +29,7 @@ func TestSetPasswordCommandRunEClosure(t *testing.T) {
 	cli := test.NewMockCLI()
 	clientMock := cli.Client.(*client.MockClient)
 	configMock := cli.Config.(*client.MockConfig)
-	clientMock.On("UpdatePassword", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	clientMock.On("UpdatePassword", "my-username", "my-new-password", "my-new-password").Return(nil)
 	claims := v2.FixtureClaims("foo", nil)
 	_, tokenString, _ := jwt.AccessToken(claims)
 	configMock.On("Tokens").Return(&v2.Tokens{Access: tokenString})
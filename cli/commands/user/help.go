@@ -24,6 +24,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 		RemoveGroupCommand(cli),
 		RemoveAllGroupsCommand(cli),
 		SetGroupsCommand(cli),
+		SessionsCommand(cli),
 		SetPasswordCommand(cli),
 		TestCredsCommand(cli),
 		HashPasswordCommand(cli),
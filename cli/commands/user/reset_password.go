@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/AlecAivazis/survey/v2"
-	"github.com/sensu/sensu-go/backend/authentication/bcrypt"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/flags"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
@@ -61,13 +60,8 @@ func ResetPasswordCommand(cli *cli.SensuCli) *cobra.Command {
 				return err
 			}
 
-			passwordHash, err := bcrypt.HashPassword(password.New)
-			if err != nil {
-				return err
-			}
-
 			// Reset password
-			if err := cli.Client.ResetPassword(username, passwordHash); err != nil {
+			if err := cli.Client.ResetPassword(username, password.New); err != nil {
 				return err
 			}
 
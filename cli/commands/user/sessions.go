@@ -0,0 +1,190 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/commands/timeutil"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/spf13/cobra"
+)
+
+// SessionsCommand defines the parent of the session commands
+func SessionsCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sessions",
+		Short: "Manage the sessions of users",
+		RunE:  helpers.DefaultSubCommandRunE,
+	}
+
+	cmd.AddCommand(
+		ListSessionsCommand(cli),
+		RevokeSessionCommand(cli),
+	)
+
+	return cmd
+}
+
+// ListSessionsCommand adds a command that displays the sessions, optionally
+// of a single user
+func ListSessionsCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list [USERNAME]",
+		Short:        "list sessions",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			opts, err := helpers.ListOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Select the sessions of the given user
+			if len(args) == 1 {
+				selector := fmt.Sprintf("session.username == %q", args[0])
+				if opts.FieldSelector != "" {
+					selector = fmt.Sprintf("%s && %s", opts.FieldSelector, selector)
+				}
+				opts.FieldSelector = selector
+			}
+
+			var header http.Header
+			session := &corev2.Session{}
+			results := []corev2.Session{}
+			err = cli.Client.List(session.URIPath(), &results, &opts, &header)
+			if err != nil {
+				return err
+			}
+
+			// Print the results based on the user preferences
+			resources := []corev2.Resource{}
+			for i := range results {
+				resources = append(resources, &results[i])
+			}
+			return helpers.PrintList(cmd, cli.Config.Format(), printSessionsToTable, resources, results, header)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddFieldSelectorFlag(cmd.Flags())
+	helpers.AddLabelSelectorFlag(cmd.Flags())
+	helpers.AddChunkSizeFlag(cmd.Flags())
+
+	return cmd
+}
+
+// RevokeSessionCommand adds a command that revokes a session
+func RevokeSessionCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "revoke [NAME]",
+		Short:        "revoke session given name",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			name := args[0]
+			if skipConfirm, _ := cmd.Flags().GetBool("skip-confirm"); !skipConfirm {
+				if confirmed := helpers.ConfirmDeleteResource(name, "session"); !confirmed {
+					fmt.Fprintln(cmd.OutOrStdout(), "Canceled")
+					return nil
+				}
+			}
+
+			session := &corev2.Session{
+				ObjectMeta: corev2.ObjectMeta{
+					Name: name,
+				},
+			}
+			if err := cli.Client.Delete(session.URIPath()); err != nil {
+				return err
+			}
+
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "Revoked")
+			return err
+		},
+	}
+
+	cmd.Flags().Bool("skip-confirm", false, "skip interactive confirmation prompt")
+
+	return cmd
+}
+
+func printSessionsToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Name",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				session, ok := data.(corev2.Session)
+				if !ok {
+					return cli.TypeError
+				}
+				return session.Name
+			},
+		},
+		{
+			Title: "Username",
+			CellTransformer: func(data interface{}) string {
+				session, ok := data.(corev2.Session)
+				if !ok {
+					return cli.TypeError
+				}
+				return session.Username
+			},
+		},
+		{
+			Title: "Issued At",
+			CellTransformer: func(data interface{}) string {
+				session, ok := data.(corev2.Session)
+				if !ok {
+					return cli.TypeError
+				}
+				return timeutil.HumanTimestamp(session.IssuedAt)
+			},
+		},
+		{
+			Title: "Refreshed At",
+			CellTransformer: func(data interface{}) string {
+				session, ok := data.(corev2.Session)
+				if !ok {
+					return cli.TypeError
+				}
+				return timeutil.HumanTimestamp(session.RefreshedAt)
+			},
+		},
+		{
+			Title: "Client IP",
+			CellTransformer: func(data interface{}) string {
+				session, ok := data.(corev2.Session)
+				if !ok {
+					return cli.TypeError
+				}
+				return session.ClientIP
+			},
+		},
+		{
+			Title: "User Agent",
+			CellTransformer: func(data interface{}) string {
+				session, ok := data.(corev2.Session)
+				if !ok {
+					return cli.TypeError
+				}
+				return session.UserAgent
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
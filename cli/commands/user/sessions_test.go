@@ -0,0 +1,117 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli/client"
+	clientmock "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionsCommand(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := SessionsCommand(cli)
+
+	assert.Regexp(t, "sessions", cmd.Use)
+	assert.Len(t, cmd.Commands(), 2)
+}
+
+func TestListSessionsCommand(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		fieldSelector string
+		wantSelector  string
+	}{
+		{
+			name: "all sessions",
+		},
+		{
+			name:         "sessions of a user",
+			args:         []string{"foo"},
+			wantSelector: `session.username == "foo"`,
+		},
+		{
+			name:          "sessions of a user with a field selector",
+			args:          []string{"foo"},
+			fieldSelector: `session.name == "abc"`,
+			wantSelector:  `session.name == "abc" && session.username == "foo"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := test.NewCLI()
+			mockClient := cli.Client.(*clientmock.MockClient)
+			mockClient.On("List", "/api/core/v2/sessions", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(
+				func(args mock.Arguments) {
+					assert.Equal(t, tt.wantSelector, args[2].(*client.ListOptions).FieldSelector)
+					resources := args[1].(*[]corev2.Session)
+					*resources = []corev2.Session{*corev2.FixtureSession("abc", "foo")}
+				},
+			)
+
+			cmd := ListSessionsCommand(cli)
+			require.NoError(t, cmd.Flags().Set(flags.Format, "json"))
+			if tt.fieldSelector != "" {
+				require.NoError(t, cmd.Flags().Set(flags.FieldSelector, tt.fieldSelector))
+			}
+			out, err := test.RunCmd(cmd, tt.args)
+			require.NoError(t, err)
+			assert.Contains(t, out, "abc")
+		})
+	}
+}
+
+func TestListSessionsCommandTable(t *testing.T) {
+	cli := test.NewCLI()
+	mockClient := cli.Client.(*clientmock.MockClient)
+	mockClient.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(
+		func(args mock.Arguments) {
+			session := corev2.FixtureSession("abc", "foo")
+			session.ClientIP = "10.0.0.1"
+			resources := args[1].(*[]corev2.Session)
+			*resources = []corev2.Session{*session}
+		},
+	)
+
+	cmd := ListSessionsCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Format, "none"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Client IP")
+	assert.Contains(t, out, "10.0.0.1")
+}
+
+func TestRevokeSessionCommand(t *testing.T) {
+	cli := test.NewMockCLI()
+	mockClient := cli.Client.(*clientmock.MockClient)
+	mockClient.On("Delete", "/api/core/v2/sessions/abc").Return(nil)
+
+	cmd := RevokeSessionCommand(cli)
+	require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+	out, err := test.RunCmd(cmd, []string{"abc"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Revoked")
+
+	// A session name is required
+	out, err = test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+	assert.Contains(t, out, "Usage")
+}
+
+func TestRevokeSessionCommandServerErr(t *testing.T) {
+	cli := test.NewMockCLI()
+	mockClient := cli.Client.(*clientmock.MockClient)
+	mockClient.On("Delete", mock.Anything).Return(errors.New("err"))
+
+	cmd := RevokeSessionCommand(cli)
+	require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+	_, err := test.RunCmd(cmd, []string{"abc"})
+	assert.EqualError(t, err, "err")
+}
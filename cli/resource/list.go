@@ -0,0 +1,53 @@
+package resource
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/compat"
+)
+
+// List lists the resources of the type of req, in the namespace of req, and
+// fetches them in chunks of the given size. Resources that do not exist, that
+// are not licensed, or that the user is not permitted to list are ignored and
+// result in an empty list.
+func List(cl client.GenericClient, req corev2.Resource, chunkSize int) ([]corev2.Resource, error) {
+	var val reflect.Value
+	if proxy, ok := req.(*corev3.V2ResourceProxy); ok {
+		val = reflect.New(reflect.SliceOf(reflect.TypeOf(proxy.Resource)))
+	} else {
+		val = reflect.New(reflect.SliceOf(reflect.TypeOf(req)))
+	}
+
+	err := cl.List(
+		fmt.Sprintf("%s?types=%s", req.URIPath(), url.QueryEscape(types.WrapResource(req).Type)),
+		val.Interface(), &client.ListOptions{
+			ChunkSize: chunkSize,
+		}, nil)
+	if err != nil {
+		// We want to ignore non-nil errors that are a result of
+		// resources not existing, or features being licensed.
+		if err, ok := err.(client.APIError); ok {
+			switch actions.ErrCode(err.Code) {
+			case actions.PaymentRequired, actions.NotFound, actions.PermissionDenied:
+				return nil, nil
+			}
+		}
+
+		return nil, fmt.Errorf("API error: %s", err)
+	}
+
+	val = reflect.Indirect(val)
+	resources := make([]corev2.Resource, val.Len())
+	for i := range resources {
+		resources[i] = compat.V2Resource(val.Index(i).Interface())
+	}
+
+	return resources, nil
+}
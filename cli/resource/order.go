@@ -0,0 +1,54 @@
+package resource
+
+import (
+	"sort"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeletionOrder is the order in which core/v2 resources are deleted, so that
+// resources are deleted before the resources they reference. Types that are
+// not listed are deleted first, and created last.
+var DeletionOrder = []string{
+	"RoleBinding",
+	"ClusterRoleBinding",
+	"Silenced",
+	"Aggregate",
+	"LifecyclePolicy",
+	"CheckConfig",
+	"Pipeline",
+	"Handler",
+	"EventFilter",
+	"Mutator",
+	"HookConfig",
+	"Asset",
+	"Role",
+	"ClusterRole",
+	"User",
+	"Namespace",
+}
+
+// SortForDeletion sorts the resources so that the resources referencing other
+// resources are deleted first.
+func SortForDeletion(resources []types.Wrapper) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		return deletionRank(resources[i]) < deletionRank(resources[j])
+	})
+}
+
+// SortForCreation sorts the resources so that the resources referenced by
+// other resources are created first.
+func SortForCreation(resources []types.Wrapper) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		return deletionRank(resources[i]) > deletionRank(resources[j])
+	})
+}
+
+func deletionRank(w types.Wrapper) int {
+	for i, t := range DeletionOrder {
+		if w.APIVersion == "core/v2" && w.Type == t {
+			return i
+		}
+	}
+	return -1
+}
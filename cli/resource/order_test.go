@@ -0,0 +1,39 @@
+package resource
+
+import (
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func fixtureResources() []types.Wrapper {
+	return []types.Wrapper{
+		types.WrapResource(corev2.FixtureNamespace("default")),
+		types.WrapResource(corev2.FixtureAsset("asset")),
+		types.WrapResource(corev2.FixtureCheckConfig("check")),
+		types.WrapResource(corev2.FixtureEntity("entity")),
+		types.WrapResource(corev2.FixtureRoleBinding("binding", "default")),
+	}
+}
+
+func resourceTypes(resources []types.Wrapper) []string {
+	var got []string
+	for _, r := range resources {
+		got = append(got, r.Type)
+	}
+	return got
+}
+
+func TestSortForDeletion(t *testing.T) {
+	resources := fixtureResources()
+	SortForDeletion(resources)
+	assert.Equal(t, []string{"Entity", "RoleBinding", "CheckConfig", "Asset", "Namespace"}, resourceTypes(resources))
+}
+
+func TestSortForCreation(t *testing.T) {
+	resources := fixtureResources()
+	SortForCreation(resources)
+	assert.Equal(t, []string{"Namespace", "Asset", "CheckConfig", "RoleBinding", "Entity"}, resourceTypes(resources))
+}
@@ -86,50 +86,184 @@ func Parse(in io.Reader) ([]*types.Wrapper, error) {
 // warn if there are any unknown fields in the resource. ignores errors because
 // they would be caught by the other decoder.
 func stripWrapperAndMaybeWarn(dec *json.Decoder, wrapper *types.Wrapper, count int) {
+	if err := unknownFields(dec, wrapper); err != nil {
+		describeError(count, fmt.Errorf("warning: %s", err))
+	}
+}
+
+// unknownFields returns an error if the spec of the next resource of the
+// decoder has fields that are unknown to its type.
+func unknownFields(dec *json.Decoder, wrapper *types.Wrapper) error {
 	envelope := map[string]*json.RawMessage{}
 	if err := dec.Decode(&envelope); err != nil {
-		return
+		return nil
 	}
 	msg := envelope["spec"]
 	if msg == nil {
-		return
+		return nil
 	}
 	resource, err := types.ResolveRaw(wrapper.APIVersion, wrapper.Type)
 	if err != nil {
-		return
+		return nil
 	}
 	dec = json.NewDecoder(bytes.NewReader(*msg))
 	dec.DisallowUnknownFields()
-	if err := dec.Decode(&resource); err != nil {
-		describeError(count, fmt.Errorf("warning: %s", err))
-	}
+	return dec.Decode(&resource)
+}
+
+// document is a YAML document or a stream of JSON resources, and the line it
+// starts at.
+type document struct {
+	text string
+	line int
 }
 
 // splitResources scans the content of the reader and splits the resources.
 // The resources should be separated by a line containing only "---".
 // An error will be returned if the data from the reader cannot be read.
 func splitResources(in io.Reader) ([]string, error) {
-	var resources []string
+	documents, err := splitDocuments(in)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]string, 0, len(documents))
+	for _, doc := range documents {
+		resources = append(resources, doc.text)
+	}
+	return resources, nil
+}
+
+// splitDocuments splits the content of the reader like splitResources, and
+// keeps the line each document starts at.
+func splitDocuments(in io.Reader) ([]document, error) {
+	var documents []document
 	inScanner := bufio.NewScanner(in)
-	currentResource := ""
+	current := document{line: 1}
+	lineNumber := 0
 	for inScanner.Scan() {
 		line := inScanner.Text()
+		lineNumber++
 		if strings.HasPrefix(line, "---") {
-			if currentResource != "" {
-				resources = append(resources, currentResource)
+			if current.text != "" {
+				documents = append(documents, current)
 			}
-			currentResource = ""
+			current = document{line: lineNumber + 1}
 		} else {
-			currentResource += line + "\n"
+			current.text += line + "\n"
 		}
 	}
 	if err := inScanner.Err(); err != nil {
 		return nil, err
 	}
-	if len(currentResource) > 0 {
-		resources = append(resources, currentResource)
+	if len(current.text) > 0 {
+		documents = append(documents, current)
 	}
-	return resources, nil
+	return documents, nil
+}
+
+// Location is the position of a resource in its input.
+type Location struct {
+	File string
+	Line int
+}
+
+func (l Location) String() string {
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+// LocatedResource is a parsed resource and its location.
+type LocatedResource struct {
+	*types.Wrapper
+	Location Location
+}
+
+// LocatedError is a problem found in a resource, or while parsing it.
+type LocatedError struct {
+	Location Location
+	Err      error
+	// Warning is set for problems that do not prevent the resource from being
+	// created, such as unknown fields.
+	Warning bool
+}
+
+func (e *LocatedError) Error() string {
+	if e.Warning {
+		return fmt.Sprintf("%s: warning: %s", e.Location, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", e.Location, e.Err)
+}
+
+// ParseLocated parses resources like Parse, but keeps the location of each
+// resource in the named file. Rather than printing the problems found while
+// parsing, it returns all of them, along with the resources that could be
+// parsed.
+func ParseLocated(in io.Reader, file string) ([]*LocatedResource, []*LocatedError) {
+	documents, err := splitDocuments(in)
+	if err != nil {
+		return nil, []*LocatedError{{Location: Location{File: file}, Err: err}}
+	}
+
+	var resources []*LocatedResource
+	var problems []*LocatedError
+	for _, doc := range documents {
+		b := []byte(doc.text)
+		isJSON := jsonRe.Match(b)
+		jsonBytes := b
+		if !isJSON {
+			if jsonBytes, err = yaml.YAMLToJSON(b); err != nil {
+				problems = append(problems, &LocatedError{Location: Location{File: file, Line: doc.line}, Err: err})
+				continue
+			}
+		}
+		dec := json.NewDecoder(bytes.NewReader(jsonBytes))
+		dec.DisallowUnknownFields()
+		warnDec := json.NewDecoder(bytes.NewReader(jsonBytes))
+		for dec.More() {
+			location := Location{File: file, Line: firstContentLine(doc)}
+			if isJSON {
+				location.Line = doc.line + lineOffset(jsonBytes, dec.InputOffset())
+			}
+			var w types.Wrapper
+			if err := dec.Decode(&w); err != nil {
+				// The rest of the document can't be decoded reliably
+				problems = append(problems, &LocatedError{Location: location, Err: err})
+				break
+			}
+			if err := unknownFields(warnDec, &w); err != nil {
+				problems = append(problems, &LocatedError{Location: location, Err: err, Warning: true})
+			}
+			resources = append(resources, &LocatedResource{Wrapper: &w, Location: location})
+		}
+	}
+
+	wrappers := make([]*types.Wrapper, len(resources))
+	for i := range resources {
+		wrappers[i] = resources[i].Wrapper
+	}
+	filterCheckSubdue(wrappers)
+
+	return resources, problems
+}
+
+// lineOffset returns the number of lines before the first non-whitespace byte
+// found from the offset.
+func lineOffset(b []byte, offset int64) int {
+	for offset < int64(len(b)) && strings.ContainsRune(" \t\r\n,", rune(b[offset])) {
+		offset++
+	}
+	return bytes.Count(b[:offset], []byte("\n"))
+}
+
+// firstContentLine returns the line of the first line of a YAML document that
+// isn't blank or a comment.
+func firstContentLine(doc document) int {
+	for i, line := range strings.Split(doc.text, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return doc.line + i
+		}
+	}
+	return doc.line
 }
 
 // filterCheckSubdue nils out any check subdue fields that are supplied.
@@ -124,7 +124,7 @@ func TestParse(t *testing.T) {
 				APIVersion: "core/v2",
 			},
 			Value: &corev2.CheckConfig{
-				ObjectMeta: corev2.ObjectMeta{Name: "foo"},
+				ObjectMeta: corev2.ObjectMeta{Name: "foo", Labels: map[string]string{}, Annotations: map[string]string{}},
 				Command:    "echo foo",
 				Interval:   100,
 			},
@@ -137,7 +137,7 @@ func TestParse(t *testing.T) {
 				APIVersion: "core/v2",
 			},
 			Value: &corev2.Handler{
-				ObjectMeta:    corev2.ObjectMeta{Name: "email", Namespace: "default"},
+				ObjectMeta:    corev2.ObjectMeta{Name: "email", Namespace: "default", Labels: map[string]string{}, Annotations: map[string]string{}},
 				Type:          "pipe",
 				Command:       "sensu-email-handler -u USERNAME -p PASSWORD",
 				Timeout:       10,
@@ -153,7 +153,7 @@ func TestParse(t *testing.T) {
 				APIVersion: "core/v2",
 			},
 			Value: &corev2.EventFilter{
-				ObjectMeta:  corev2.ObjectMeta{Name: "filter_minimum", Namespace: "default"},
+				ObjectMeta:  corev2.ObjectMeta{Name: "filter_minimum", Namespace: "default", Labels: map[string]string{}, Annotations: map[string]string{}},
 				Action:      "allow",
 				Expressions: []string{"event.check.occurrences == 1"},
 			},
@@ -264,3 +264,27 @@ func TestParse(t *testing.T) {
 		assert.Equal(t, testUnit.expectedWrappers, wrappers, "wrappers should be equal when processing '%s'", testUnit.name)
 	}
 }
+
+func TestParseLocated(t *testing.T) {
+	yamlMulti := "# checks\n\ntype: CheckConfig\napi_version: core/v2\nmetadata:\n  name: foo\nspec:\n  command: echo foo\n  interval: 100\n---\napi_version: core/v2\ntype: Handler\nmetadata:\n  name: email\nspec:\n  type: pipe\n  commmand: handler\n"
+	resources, problems := ParseLocated(strings.NewReader(yamlMulti), "sensu.yaml")
+	if assert.Len(t, resources, 2) {
+		assert.Equal(t, Location{File: "sensu.yaml", Line: 3}, resources[0].Location)
+		assert.Equal(t, Location{File: "sensu.yaml", Line: 11}, resources[1].Location)
+	}
+	if assert.Len(t, problems, 1) {
+		assert.True(t, problems[0].Warning)
+		assert.Equal(t, `sensu.yaml:11: warning: json: unknown field "commmand"`, problems[0].Error())
+	}
+
+	jsonStream := "\n{\"type\": \"CheckConfig\", \"api_version\": \"core/v2\", \"metadata\": {\"name\": \"foo\"}, \"spec\": {}}\n\n{\n  \"type\": \"Handler\",\n  \"api_version\": \"core/v2\",\n  \"metadata\": {\"name\": \"email\"},\n  \"spec\": {}\n}\n{\"type\": \"Foo\"}\n"
+	resources, problems = ParseLocated(strings.NewReader(jsonStream), "sensu.json")
+	if assert.Len(t, resources, 2) {
+		assert.Equal(t, 2, resources[0].Location.Line)
+		assert.Equal(t, 4, resources[1].Location.Line)
+	}
+	if assert.Len(t, problems, 1) {
+		assert.False(t, problems[0].Warning)
+		assert.Equal(t, 10, problems[0].Location.Line)
+	}
+}
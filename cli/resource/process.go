@@ -166,7 +166,12 @@ func ProcessStdin(cli *cli.SensuCli, client *http.Client, processor Processor) e
 }
 
 // Putter is a Processor that puts resources in the API.
-type Putter struct{}
+type Putter struct {
+	// DryRun makes the API validate the resources without storing them.
+	DryRun bool
+	// Validated holds the resources returned by the API on dry runs.
+	Validated []*types.Wrapper
+}
 
 // NewPutter instantiates a new Putter Processor.
 func NewPutter() *Putter {
@@ -176,7 +181,7 @@ func NewPutter() *Putter {
 // Process puts resources in the API.
 func (p *Putter) Process(client client.GenericClient, resources []*types.Wrapper) error {
 	for i, resource := range resources {
-		if err := client.PutResource(*resource); err != nil {
+		if err := p.put(client, resource); err != nil {
 			return fmt.Errorf(
 				"error putting resource #%d with name %q and namespace %q (%s): %s",
 				i, resource.ObjectMeta.Name, resource.ObjectMeta.Namespace, compat.URIPath(resource.Value), err,
@@ -186,11 +191,39 @@ func (p *Putter) Process(client client.GenericClient, resources []*types.Wrapper
 	return nil
 }
 
+func (p *Putter) put(client client.GenericClient, resource *types.Wrapper) error {
+	if !p.DryRun {
+		return client.PutResource(*resource)
+	}
+	validated, err := client.PutResourceDryRun(*resource)
+	if err != nil {
+		return err
+	}
+	p.Validated = append(p.Validated, validated)
+	return nil
+}
+
+// Collector is a Processor that keeps the processed resources, without
+// sending them to the API.
+type Collector struct {
+	Resources []*types.Wrapper
+}
+
+// Process keeps the resources.
+func (c *Collector) Process(client client.GenericClient, resources []*types.Wrapper) error {
+	c.Resources = append(c.Resources, resources...)
+	return nil
+}
+
 // ManagedByLabelPutter is a Processor that applies a corev2.ManagedByLabel
 // label with the chosen value to resources before passing them to a Putter.
 type ManagedByLabelPutter struct {
 	putter *Putter
 	Label  string
+	// DryRun makes the API validate the resources without storing them.
+	DryRun bool
+	// Validated holds the resources returned by the API on dry runs.
+	Validated []*types.Wrapper
 }
 
 func NewManagedByLabelPutter(label string) *ManagedByLabelPutter {
@@ -202,12 +235,17 @@ func NewManagedByLabelPutter(label string) *ManagedByLabelPutter {
 
 func (p *ManagedByLabelPutter) Process(client client.GenericClient, resources []*types.Wrapper) error {
 	for _, resource := range resources {
-		p.label(resource)
+		SetManagedByLabel(resource, p.Label)
 	}
-	return p.putter.Process(client, resources)
+	p.putter.DryRun = p.DryRun
+	err := p.putter.Process(client, resources)
+	p.Validated = p.putter.Validated
+	return err
 }
 
-func (p *ManagedByLabelPutter) label(resource *types.Wrapper) {
+// SetManagedByLabel applies a corev2.ManagedByLabel label with the given value
+// to the resource, unless the resource is managed by sensu-agent.
+func SetManagedByLabel(resource *types.Wrapper, label string) {
 	innerMeta := compat.GetObjectMeta(resource.Value)
 
 	if resource.ObjectMeta.Labels == nil {
@@ -221,7 +259,7 @@ func (p *ManagedByLabelPutter) label(resource *types.Wrapper) {
 	innerLabels := innerMeta.Labels
 
 	// By default the resource should be managed by sensuctl
-	managedBy := p.Label
+	managedBy := label
 
 	// Mark the resource as managed by `label` in the outer labels if none is
 	// already set
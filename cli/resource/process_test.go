@@ -109,8 +109,7 @@ func TestManagedByLabelPutter_label(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := tt.resource
-			processor := NewManagedByLabelPutter("sensuctl")
-			processor.label(&got)
+			SetManagedByLabel(&got, "sensuctl")
 
 			if !reflect.DeepEqual(got.ObjectMeta.Labels, tt.want) {
 				t.Errorf("inner labels = %v, want %v", got.ObjectMeta.Labels, tt.want)
@@ -20,6 +20,8 @@ var (
 		&corev2.User{},
 		&corev2.APIKey{},
 		&corev2.TessenConfig{},
+		&corev2.PasswordPolicy{},
+		&corev2.Aggregate{},
 		&corev2.Asset{},
 		&corev2.CheckConfig{},
 		&corev2.Entity{},
@@ -27,8 +29,10 @@ var (
 		&corev2.EventFilter{},
 		&corev2.Handler{},
 		&corev2.HookConfig{},
+		&corev2.LifecyclePolicy{},
 		&corev2.Mutator{},
 		&corev2.Pipeline{},
+		&corev2.ResourceQuota{},
 		&corev2.Role{},
 		&corev2.RoleBinding{},
 		&corev2.Silenced{},
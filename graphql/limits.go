@@ -0,0 +1,390 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// ErrCodeQueryTooDeep is the code of the error of the queries exceeding
+	// the maximum depth.
+	ErrCodeQueryTooDeep = "QUERY_TOO_DEEP"
+
+	// ErrCodeQueryTooComplex is the code of the error of the queries exceeding
+	// the maximum complexity.
+	ErrCodeQueryTooComplex = "QUERY_TOO_COMPLEX"
+
+	// ErrCodeRateLimited is the code of the error of the queries exceeding the
+	// rate limit of their user.
+	ErrCodeRateLimited = "RATE_LIMITED"
+
+	// defaultListSize is the number of items expected from the list fields
+	// without a limit argument.
+	defaultListSize = 10
+)
+
+// listSizeArguments are the arguments giving the number of items of a list.
+var listSizeArguments = []string{"limit", "first", "last"}
+
+// QueryLimits configures the limits of the queries. A zero value disables the
+// limit.
+type QueryLimits struct {
+	// MaxDepth is the maximum depth of the selections of a query.
+	MaxDepth int
+
+	// MaxComplexity is the maximum complexity of a query. Every field scores
+	// one, and the fields selected under a list are scored once per item
+	// requested with the limit or first argument of the list.
+	MaxComplexity int
+
+	// Rate is the complexity a user can spend per second, and Burst the
+	// complexity that can be spent at once. Subscriptions are not rate
+	// limited, since they are executed for every payload of their source.
+	Rate  rate.Limit
+	Burst int
+
+	// KeyFunc returns the user of the query, whose complexity is rate limited
+	// separately from the other users.
+	KeyFunc func(context.Context) string
+}
+
+// Enabled returns true if any limit is set.
+func (l QueryLimits) Enabled() bool {
+	return l.MaxDepth > 0 || l.MaxComplexity > 0 || l.Rate > 0
+}
+
+// QueryLimiter is a GraphQL middleware that rejects the queries exceeding the
+// limits, before their execution.
+type QueryLimiter struct {
+	limits QueryLimits
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewQueryLimiter instantiates new limiter.
+func NewQueryLimiter(limits QueryLimits) *QueryLimiter {
+	return &QueryLimiter{
+		limits:   limits,
+		limiters: map[string]*rate.Limiter{},
+	}
+}
+
+// Init is used to initialize the extension
+func (l *QueryLimiter) Init(ctx context.Context, p *graphql.Params) context.Context {
+	return ctx
+}
+
+// Name returns the name of the extension
+func (l *QueryLimiter) Name() string {
+	return "limiter"
+}
+
+// ParseDidStart is called before starting parsing
+func (l *QueryLimiter) ParseDidStart(ctx context.Context) (context.Context, graphql.ParseFinishFunc) {
+	return ctx, func(error) {}
+}
+
+// ValidationDidStart is called just before validation begins
+func (l *QueryLimiter) ValidationDidStart(ctx context.Context) (context.Context, graphql.ValidationFinishFunc) {
+	return ctx, func([]gqlerrors.FormattedError) {}
+}
+
+// ExecutionDidStart notifies about the start of the execution
+func (l *QueryLimiter) ExecutionDidStart(ctx context.Context) (context.Context, graphql.ExecutionFinishFunc) {
+	return ctx, func(*graphql.Result) {}
+}
+
+// ResolveFieldDidStart notifies about the start of the resolving of a field
+func (l *QueryLimiter) ResolveFieldDidStart(ctx context.Context, i *graphql.ResolveInfo) (context.Context, graphql.ResolveFieldFinishFunc) {
+	return ctx, func(interface{}, error) {}
+}
+
+// HasResult returns if the extension wants to add data to the result
+func (l *QueryLimiter) HasResult() bool {
+	return false
+}
+
+// GetResult returns the data that the extension wants to add to the result
+func (l *QueryLimiter) GetResult(ctx context.Context) interface{} {
+	return nil
+}
+
+// ValidateDocument scores the selected operation of the document, and returns
+// an error if it exceeds the limits.
+func (l *QueryLimiter) ValidateDocument(ctx context.Context, p *graphql.Params, doc *ast.Document) []gqlerrors.FormattedError {
+	scorer := newComplexityScorer(&p.Schema, doc, p.VariableValues)
+	operation := scorer.operation(p.OperationName)
+	if operation == nil {
+		// The execution reports the missing operation
+		return nil
+	}
+	complexity, depth := scorer.score(scorer.rootType(operation), operation.SelectionSet)
+
+	if l.limits.MaxDepth > 0 && depth > l.limits.MaxDepth {
+		return codedError(ErrCodeQueryTooDeep, "query depth of %d exceeds the maximum depth of %d", depth, l.limits.MaxDepth)
+	}
+	if l.limits.MaxComplexity > 0 && complexity > l.limits.MaxComplexity {
+		return codedError(ErrCodeQueryTooComplex, "query complexity of %d exceeds the maximum complexity of %d", complexity, l.limits.MaxComplexity)
+	}
+	if l.limits.Rate > 0 && operation.Operation != ast.OperationTypeSubscription {
+		if !l.allow(ctx, complexity) {
+			return codedError(ErrCodeRateLimited, "query complexity rate limit exceeded, try again later")
+		}
+	}
+	return nil
+}
+
+// allow spends the complexity from the rate limiter of the user. The queries
+// more complex than the burst spend the whole burst.
+func (l *QueryLimiter) allow(ctx context.Context, complexity int) bool {
+	var key string
+	if l.limits.KeyFunc != nil {
+		key = l.limits.KeyFunc(ctx)
+	}
+
+	l.mu.Lock()
+	limiter, ok := l.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(l.limits.Rate, l.limits.Burst)
+		l.limiters[key] = limiter
+	}
+	l.mu.Unlock()
+
+	if complexity > limiter.Burst() {
+		complexity = limiter.Burst()
+	}
+	return limiter.AllowN(time.Now(), complexity)
+}
+
+func codedError(code, format string, args ...interface{}) []gqlerrors.FormattedError {
+	return []gqlerrors.FormattedError{{
+		Message:    fmt.Sprintf(format, args...),
+		Extensions: map[string]interface{}{"code": code},
+	}}
+}
+
+// complexityScorer scores the complexity and the depth of the operations of a
+// document.
+type complexityScorer struct {
+	schema    *graphql.Schema
+	doc       *ast.Document
+	variables map[string]interface{}
+	fragments map[string]*ast.FragmentDefinition
+
+	// spreading holds the fragments being scored, so the cycles of fragments
+	// of the documents that were not validated are not followed.
+	spreading map[string]bool
+}
+
+func newComplexityScorer(schema *graphql.Schema, doc *ast.Document, variables map[string]interface{}) *complexityScorer {
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok && fragment.Name != nil {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+	return &complexityScorer{
+		schema:    schema,
+		doc:       doc,
+		variables: variables,
+		fragments: fragments,
+		spreading: map[string]bool{},
+	}
+}
+
+// operation returns the operation executed given the operation name, or nil.
+func (s *complexityScorer) operation(name string) *ast.OperationDefinition {
+	var operations []*ast.OperationDefinition
+	for _, def := range s.doc.Definitions {
+		if operation, ok := def.(*ast.OperationDefinition); ok {
+			operations = append(operations, operation)
+		}
+	}
+	if name == "" {
+		if len(operations) == 1 {
+			return operations[0]
+		}
+		return nil
+	}
+	for _, operation := range operations {
+		if operation.Name != nil && operation.Name.Value == name {
+			return operation
+		}
+	}
+	return nil
+}
+
+func (s *complexityScorer) rootType(operation *ast.OperationDefinition) graphql.Type {
+	var root *graphql.Object
+	switch operation.Operation {
+	case ast.OperationTypeMutation:
+		root = s.schema.MutationType()
+	case ast.OperationTypeSubscription:
+		root = s.schema.SubscriptionType()
+	default:
+		root = s.schema.QueryType()
+	}
+	if root == nil {
+		return nil
+	}
+	return root
+}
+
+// score returns the complexity and the depth of the selections of the given
+// type. The type is nil when unknown, in which case the fields are scored as
+// objects.
+func (s *complexityScorer) score(parent graphql.Type, set *ast.SelectionSet) (complexity, depth int) {
+	if set == nil {
+		return 0, 0
+	}
+	for _, selection := range set.Selections {
+		var c, d int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			c, d = s.scoreField(parent, selection)
+		case *ast.InlineFragment:
+			t := parent
+			if selection.TypeCondition != nil && selection.TypeCondition.Name != nil {
+				t = s.schema.Type(selection.TypeCondition.Name.Value)
+			}
+			c, d = s.score(t, selection.SelectionSet)
+		case *ast.FragmentSpread:
+			if selection.Name == nil {
+				continue
+			}
+			name := selection.Name.Value
+			fragment, ok := s.fragments[name]
+			if !ok || s.spreading[name] {
+				continue
+			}
+			t := parent
+			if fragment.TypeCondition != nil && fragment.TypeCondition.Name != nil {
+				t = s.schema.Type(fragment.TypeCondition.Name.Value)
+			}
+			s.spreading[name] = true
+			c, d = s.score(t, fragment.SelectionSet)
+			delete(s.spreading, name)
+		}
+		complexity = add(complexity, c)
+		if d > depth {
+			depth = d
+		}
+	}
+	return complexity, depth
+}
+
+func (s *complexityScorer) scoreField(parent graphql.Type, field *ast.Field) (complexity, depth int) {
+	if field.Name == nil || strings.HasPrefix(field.Name.Value, "__") {
+		// The introspection fields are resolved from the schema
+		return 0, 0
+	}
+
+	var def *graphql.FieldDefinition
+	switch parent := parent.(type) {
+	case *graphql.Object:
+		def = parent.Fields()[field.Name.Value]
+	case *graphql.Interface:
+		def = parent.Fields()[field.Name.Value]
+	}
+
+	var childType graphql.Type
+	size := 1
+	if def != nil {
+		childType, _ = graphql.GetNamed(def.Type).(graphql.Type)
+		size = s.listSize(field, def)
+	}
+	childComplexity, childDepth := s.score(childType, field.SelectionSet)
+	return add(1, multiply(size, childComplexity)), childDepth + 1
+}
+
+// listSize returns the number of items requested from the field, given by its
+// limit argument or the default value of the argument.
+func (s *complexityScorer) listSize(field *ast.Field, def *graphql.FieldDefinition) int {
+	for _, name := range listSizeArguments {
+		for _, arg := range field.Arguments {
+			if arg.Name != nil && arg.Name.Value == name {
+				if size, ok := s.intValue(arg.Value); ok {
+					return size
+				}
+			}
+		}
+		for _, arg := range def.Args {
+			if arg.Name() == name {
+				if size, ok := toInt(arg.DefaultValue); ok {
+					return size
+				}
+			}
+		}
+	}
+	if isList(def.Type) {
+		return defaultListSize
+	}
+	return 1
+}
+
+func (s *complexityScorer) intValue(value ast.Value) (int, bool) {
+	switch value := value.(type) {
+	case *ast.IntValue:
+		size, err := strconv.Atoi(value.Value)
+		return size, err == nil
+	case *ast.Variable:
+		if value.Name == nil {
+			return 0, false
+		}
+		return toInt(s.variables[value.Name.Value])
+	}
+	return 0, false
+}
+
+func toInt(value interface{}) (int, bool) {
+	var size float64
+	switch value := value.(type) {
+	case int:
+		size = float64(value)
+	case int32:
+		size = float64(value)
+	case int64:
+		size = float64(value)
+	case float64:
+		// The variables decoded from JSON are float64
+		size = value
+	default:
+		return 0, false
+	}
+	return int(math.Max(0, math.Min(size, math.MaxInt32))), true
+}
+
+func isList(t graphql.Type) bool {
+	if nonNull, ok := t.(*graphql.NonNull); ok {
+		t = nonNull.OfType
+	}
+	_, ok := t.(*graphql.List)
+	return ok
+}
+
+// add and multiply saturate rather than overflow, so the complexity of a
+// query requesting huge lists exceeds the maximum.
+func add(a, b int) int {
+	if a > math.MaxInt32-b {
+		return math.MaxInt32
+	}
+	return a + b
+}
+
+func multiply(a, b int) int {
+	if a != 0 && b > math.MaxInt32/a {
+		return math.MaxInt32
+	}
+	return a * b
+}
@@ -0,0 +1,162 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func limitsTestSchema(t *testing.T) graphql.Schema {
+	t.Helper()
+	check := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Check",
+		Fields: graphql.Fields{
+			"name":    &graphql.Field{Type: graphql.String},
+			"history": &graphql.Field{Type: graphql.NewList(graphql.Int)},
+		},
+	})
+	event := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Event",
+		Fields: graphql.Fields{
+			"id":    &graphql.Field{Type: graphql.String},
+			"check": &graphql.Field{Type: check},
+		},
+	})
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"events": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(event)),
+				Args: graphql.FieldConfigArgument{
+					"limit": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 10},
+				},
+			},
+			"checks": &graphql.Field{Type: graphql.NewList(check)},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{Query: query})
+	require.NoError(t, err)
+	return schema
+}
+
+func TestComplexityScorer(t *testing.T) {
+	schema := limitsTestSchema(t)
+
+	tests := []struct {
+		name           string
+		query          string
+		variables      map[string]interface{}
+		operationName  string
+		wantComplexity int
+		wantDepth      int
+	}{
+		{
+			name:           "default limit",
+			query:          `{ events { id check { name } } }`,
+			wantComplexity: 1 + 10*3,
+			wantDepth:      3,
+		},
+		{
+			name:           "limit argument",
+			query:          `{ events(limit: 100) { id } }`,
+			wantComplexity: 1 + 100,
+			wantDepth:      2,
+		},
+		{
+			name:           "limit variable",
+			query:          `query($limit: Int) { events(limit: $limit) { id } }`,
+			variables:      map[string]interface{}{"limit": float64(5)},
+			wantComplexity: 1 + 5,
+			wantDepth:      2,
+		},
+		{
+			name:           "list without limit",
+			query:          `{ checks { name } }`,
+			wantComplexity: 1 + defaultListSize,
+			wantDepth:      2,
+		},
+		{
+			name:           "fragments",
+			query:          `{ events(limit: 2) { ...E } } fragment E on Event { id ... on Event { check { name } } }`,
+			wantComplexity: 1 + 2*3,
+			wantDepth:      3,
+		},
+		{
+			name:           "fragment cycle",
+			query:          `{ events(limit: 1) { ...A } } fragment A on Event { id ...A }`,
+			wantComplexity: 2,
+			wantDepth:      2,
+		},
+		{
+			name:           "introspection",
+			query:          `{ __schema { types { fields { type { name } } } } events(limit: 1) { __typename } }`,
+			wantComplexity: 1,
+			wantDepth:      1,
+		},
+		{
+			name:           "named operation",
+			query:          `query A { checks { name } } query B { events(limit: 1) { id } }`,
+			operationName:  "B",
+			wantComplexity: 2,
+			wantDepth:      2,
+		},
+		{
+			name:           "saturated",
+			query:          `{ events(limit: 2147483647) { check { history } } }`,
+			wantComplexity: 2147483647,
+			wantDepth:      3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.Parse(parser.ParseParams{Source: tt.query})
+			require.NoError(t, err)
+			scorer := newComplexityScorer(&schema, doc, tt.variables)
+			operation := scorer.operation(tt.operationName)
+			require.NotNil(t, operation)
+			complexity, depth := scorer.score(scorer.rootType(operation), operation.SelectionSet)
+			assert.Equal(t, tt.wantComplexity, complexity)
+			assert.Equal(t, tt.wantDepth, depth)
+		})
+	}
+}
+
+func TestQueryLimiter(t *testing.T) {
+	schema := limitsTestSchema(t)
+	validate := func(limiter *QueryLimiter, user, query string) []string {
+		doc, err := parser.Parse(parser.ParseParams{Source: query})
+		require.NoError(t, err)
+		ctx := context.WithValue(context.Background(), userKey{}, user)
+		codes := []string{}
+		for _, err := range limiter.ValidateDocument(ctx, &graphql.Params{Schema: schema, Context: ctx}, doc) {
+			codes = append(codes, err.Extensions["code"].(string))
+		}
+		return codes
+	}
+
+	limiter := NewQueryLimiter(QueryLimits{MaxDepth: 2, MaxComplexity: 50})
+	assert.Empty(t, validate(limiter, "", `{ events(limit: 10) { id } }`))
+	assert.Equal(t, []string{ErrCodeQueryTooDeep}, validate(limiter, "", `{ events(limit: 1) { check { name } } }`))
+	assert.Equal(t, []string{ErrCodeQueryTooComplex}, validate(limiter, "", `{ events(limit: 100) { id } }`))
+
+	limiter = NewQueryLimiter(QueryLimits{
+		Rate:    1,
+		Burst:   20,
+		KeyFunc: func(ctx context.Context) string { return ctx.Value(userKey{}).(string) },
+	})
+	query := `{ events(limit: 9) { id } }`
+	assert.Empty(t, validate(limiter, "alice", query))
+	assert.Empty(t, validate(limiter, "alice", query))
+	assert.Equal(t, []string{ErrCodeRateLimited}, validate(limiter, "alice", query))
+	// Users are rate limited separately
+	assert.Empty(t, validate(limiter, "bob", query))
+	// Queries more complex than the burst spend the whole burst
+	assert.Empty(t, validate(limiter, "carol", `{ events(limit: 100) { id } }`))
+	assert.Equal(t, []string{ErrCodeRateLimited}, validate(limiter, "carol", `{ events(limit: 1) { id } }`))
+}
+
+type userKey struct{}
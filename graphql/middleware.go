@@ -5,6 +5,7 @@ import (
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
 )
 
 // Middleware is an interface for extending a service with operations that
@@ -36,6 +37,15 @@ type Middleware interface {
 	GetResult(context.Context) interface{}
 }
 
+// DocumentValidator is implemented by the middleware that validate the parsed
+// document of a query before its execution, such as the QueryLimiter. Unlike
+// the validation of the schema, it can not be skipped.
+type DocumentValidator interface {
+	// ValidateDocument returns the errors of the document, if any. The
+	// execution of the query is skipped when errors are returned.
+	ValidateDocument(context.Context, *graphql.Params, *ast.Document) []gqlerrors.FormattedError
+}
+
 type (
 	// parseFinishFuncHandler handles the call of all the ParseFinishFuncs from the extenisons
 	parseFinishFuncHandler func(error)
@@ -88,6 +98,18 @@ func MiddlewareHandleValidationDidStart(s *Service, p *graphql.Params) validatio
 	}
 }
 
+// MiddlewareHandleValidateDocument runs the ValidateDocument functions of the
+// extensions implementing DocumentValidator, and returns their errors.
+func MiddlewareHandleValidateDocument(s *Service, p *graphql.Params, doc *ast.Document) []gqlerrors.FormattedError {
+	var errs []gqlerrors.FormattedError
+	for _, m := range s.mware {
+		if validator, ok := m.(DocumentValidator); ok {
+			errs = append(errs, validator.ValidateDocument(p.Context, p, doc)...)
+		}
+	}
+	return errs
+}
+
 // MiddlewareHandleExecutionDidStart handles the ExecutionDidStart func
 func MiddlewareHandleExecutionDidStart(s *Service, p *graphql.ExecuteParams) executionFinishFuncHandler {
 	fs := map[string]graphql.ExecutionFinishFunc{}
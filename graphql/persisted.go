@@ -0,0 +1,87 @@
+package graphql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+const (
+	// ErrCodePersistedQueryNotFound is the code of the error of the queries
+	// missing from the persisted queries.
+	ErrCodePersistedQueryNotFound = "PERSISTED_QUERY_NOT_FOUND"
+
+	// ErrCodePersistedQueryNotSupported is the code of the error of the
+	// queries given by hash when there are no persisted queries.
+	ErrCodePersistedQueryNotSupported = "PERSISTED_QUERY_NOT_SUPPORTED"
+)
+
+// ErrQueryHashMismatch is returned when a query does not match its hash.
+var ErrQueryHashMismatch = errors.New("provided sha does not match query")
+
+// PersistedQueries is an allow list of queries, addressed by the hex encoded
+// SHA-256 hash of their text.
+type PersistedQueries map[string]string
+
+// QueryHash returns the hex encoded SHA-256 hash of the query.
+func QueryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewPersistedQueries returns the persisted queries of the given queries.
+func NewPersistedQueries(queries ...string) PersistedQueries {
+	persisted := make(PersistedQueries, len(queries))
+	for _, query := range queries {
+		persisted[QueryHash(query)] = query
+	}
+	return persisted
+}
+
+// LoadPersistedQueries reads the persisted queries from the JSON file at the
+// given path, an object of the queries by hash.
+func LoadPersistedQueries(path string) (PersistedQueries, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the persisted queries: %w", err)
+	}
+	persisted := PersistedQueries{}
+	if err := json.Unmarshal(b, &persisted); err != nil {
+		return nil, fmt.Errorf("could not decode the persisted queries %s: %w", path, err)
+	}
+	for hash, query := range persisted {
+		if QueryHash(query) != hash {
+			return nil, fmt.Errorf("persisted query %s: %w", hash, ErrQueryHashMismatch)
+		}
+	}
+	return persisted, nil
+}
+
+// resolveQuery returns the query to execute given the query params. The query
+// is looked up by hash if only the hash is given. When there are persisted
+// queries, only those queries are executed.
+func resolveQuery(persisted PersistedQueries, p QueryParams) (string, []gqlerrors.FormattedError) {
+	if p.QueryHash != "" && p.Query != "" && QueryHash(p.Query) != p.QueryHash {
+		return "", gqlerrors.FormatErrors(ErrQueryHashMismatch)
+	}
+	if persisted == nil {
+		if p.Query == "" && p.QueryHash != "" {
+			return "", codedError(ErrCodePersistedQueryNotSupported, "PersistedQueryNotSupported")
+		}
+		return p.Query, nil
+	}
+	hash := p.QueryHash
+	if hash == "" {
+		hash = QueryHash(p.Query)
+	}
+	query, ok := persisted[hash]
+	if !ok {
+		return "", codedError(ErrCodePersistedQueryNotFound, "PersistedQueryNotFound")
+	}
+	return query, nil
+}
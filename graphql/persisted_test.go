@@ -0,0 +1,71 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPersistedQueries(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, queries map[string]string) string {
+		b, err := json.Marshal(queries)
+		require.NoError(t, err)
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, b, 0600))
+		return path
+	}
+
+	query := "{ viewer { user { username } } }"
+	persisted, err := LoadPersistedQueries(write("valid.json", map[string]string{QueryHash(query): query}))
+	require.NoError(t, err)
+	assert.Equal(t, NewPersistedQueries(query), persisted)
+
+	_, err = LoadPersistedQueries(write("mismatch.json", map[string]string{QueryHash("{ a }"): query}))
+	assert.True(t, errors.Is(err, ErrQueryHashMismatch))
+
+	_, err = LoadPersistedQueries(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestResolveQuery(t *testing.T) {
+	query := "{ viewer { user { username } } }"
+	persisted := NewPersistedQueries(query)
+
+	tests := []struct {
+		name      string
+		persisted PersistedQueries
+		params    QueryParams
+		want      string
+		wantCode  string
+		wantErr   bool
+	}{
+		{name: "query", params: QueryParams{Query: "{ a }"}, want: "{ a }"},
+		{name: "query and hash", params: QueryParams{Query: query, QueryHash: QueryHash(query)}, want: query},
+		{name: "hash mismatch", params: QueryParams{Query: "{ a }", QueryHash: QueryHash(query)}, wantErr: true},
+		{name: "hash not supported", params: QueryParams{QueryHash: QueryHash(query)}, wantCode: ErrCodePersistedQueryNotSupported},
+		{name: "persisted hash", persisted: persisted, params: QueryParams{QueryHash: QueryHash(query)}, want: query},
+		{name: "persisted query", persisted: persisted, params: QueryParams{Query: query}, want: query},
+		{name: "not persisted", persisted: persisted, params: QueryParams{Query: "{ a }"}, wantCode: ErrCodePersistedQueryNotFound},
+		{name: "unknown hash", persisted: persisted, params: QueryParams{QueryHash: QueryHash("{ a }")}, wantCode: ErrCodePersistedQueryNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs := resolveQuery(tt.persisted, tt.params)
+			if tt.wantErr || tt.wantCode != "" {
+				require.Len(t, errs, 1)
+				if tt.wantCode != "" {
+					assert.Equal(t, tt.wantCode, errs[0].Extensions["code"])
+				}
+				return
+			}
+			assert.Empty(t, errs)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
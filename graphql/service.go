@@ -16,6 +16,9 @@ type Service struct {
 	// the default executor is used.
 	Executor func(p graphql.ExecuteParams) *graphql.Result
 
+	// PersistedQueries are the only queries executed, if any.
+	PersistedQueries PersistedQueries
+
 	schema graphql.Schema
 	types  *typeRegister
 	mware  []Middleware
@@ -160,6 +163,7 @@ func (service *Service) Middleware() []Middleware {
 type QueryParams struct {
 	OperationName  string
 	Query          string
+	QueryHash      string
 	RootObject     map[string]interface{}
 	SkipValidation bool
 	Variables      map[string]interface{}
@@ -167,11 +171,16 @@ type QueryParams struct {
 
 // Do executes given query.
 func (service *Service) Do(ctx context.Context, p QueryParams) *Result {
+	query, errs := resolveQuery(service.PersistedQueries, p)
+	if len(errs) > 0 {
+		return &graphql.Result{Errors: errs}
+	}
+
 	schema := service.schema
 	params := graphql.Params{
 		Context:        ctx,
 		OperationName:  p.OperationName,
-		RequestString:  p.Query,
+		RequestString:  query,
 		RootObject:     p.RootObject,
 		Schema:         schema,
 		VariableValues: p.Variables,
@@ -183,7 +192,7 @@ func (service *Service) Do(ctx context.Context, p QueryParams) *Result {
 	// parse the source
 	parseFinishFn := MiddlewareHandleParseDidStart(service, &params)
 	source := source.NewSource(&source.Source{
-		Body: []byte(p.Query),
+		Body: []byte(query),
 		Name: "GraphQL request",
 	})
 	AST, err := parser.Parse(parser.ParseParams{Source: source})
@@ -202,12 +211,19 @@ func (service *Service) Do(ctx context.Context, p QueryParams) *Result {
 		}
 	}
 
+	// validate document with the middleware, such as the query limits
+	if errs := MiddlewareHandleValidateDocument(service, &params, AST); len(errs) > 0 {
+		return &graphql.Result{Errors: errs}
+	}
+
 	// execute query
 	return service.Executor(graphql.ExecuteParams{
-		Schema:  schema,
-		AST:     AST,
-		Args:    p.Variables,
-		Context: ctx,
+		Schema:        schema,
+		AST:           AST,
+		OperationName: p.OperationName,
+		Args:          p.Variables,
+		Root:          p.RootObject,
+		Context:       ctx,
 	})
 }
 